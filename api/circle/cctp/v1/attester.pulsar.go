@@ -13,14 +13,26 @@ import (
 )
 
 var (
-	md_Attester          protoreflect.MessageDescriptor
-	fd_Attester_attester protoreflect.FieldDescriptor
+	md_Attester                    protoreflect.MessageDescriptor
+	fd_Attester_attester           protoreflect.FieldDescriptor
+	fd_Attester_key_type           protoreflect.FieldDescriptor
+	fd_Attester_operator           protoreflect.FieldDescriptor
+	fd_Attester_added_at_height    protoreflect.FieldDescriptor
+	fd_Attester_status             protoreflect.FieldDescriptor
+	fd_Attester_disabled_at_height protoreflect.FieldDescriptor
+	fd_Attester_disable_reason     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_attester_proto_init()
 	md_Attester = File_circle_cctp_v1_attester_proto.Messages().ByName("Attester")
 	fd_Attester_attester = md_Attester.Fields().ByName("attester")
+	fd_Attester_key_type = md_Attester.Fields().ByName("key_type")
+	fd_Attester_operator = md_Attester.Fields().ByName("operator")
+	fd_Attester_added_at_height = md_Attester.Fields().ByName("added_at_height")
+	fd_Attester_status = md_Attester.Fields().ByName("status")
+	fd_Attester_disabled_at_height = md_Attester.Fields().ByName("disabled_at_height")
+	fd_Attester_disable_reason = md_Attester.Fields().ByName("disable_reason")
 }
 
 var _ protoreflect.Message = (*fastReflection_Attester)(nil)
@@ -94,6 +106,42 @@ func (x *fastReflection_Attester) Range(f func(protoreflect.FieldDescriptor, pro
 			return
 		}
 	}
+	if x.KeyType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.KeyType))
+		if !f(fd_Attester_key_type, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_Attester_operator, value) {
+			return
+		}
+	}
+	if x.AddedAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.AddedAtHeight)
+		if !f(fd_Attester_added_at_height, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_Attester_status, value) {
+			return
+		}
+	}
+	if x.DisabledAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.DisabledAtHeight)
+		if !f(fd_Attester_disabled_at_height, value) {
+			return
+		}
+	}
+	if x.DisableReason != "" {
+		value := protoreflect.ValueOfString(x.DisableReason)
+		if !f(fd_Attester_disable_reason, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -111,6 +159,18 @@ func (x *fastReflection_Attester) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	case "circle.cctp.v1.Attester.attester":
 		return x.Attester != ""
+	case "circle.cctp.v1.Attester.key_type":
+		return x.KeyType != 0
+	case "circle.cctp.v1.Attester.operator":
+		return x.Operator != ""
+	case "circle.cctp.v1.Attester.added_at_height":
+		return x.AddedAtHeight != int64(0)
+	case "circle.cctp.v1.Attester.status":
+		return x.Status != 0
+	case "circle.cctp.v1.Attester.disabled_at_height":
+		return x.DisabledAtHeight != int64(0)
+	case "circle.cctp.v1.Attester.disable_reason":
+		return x.DisableReason != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Attester"))
@@ -129,6 +189,18 @@ func (x *fastReflection_Attester) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	case "circle.cctp.v1.Attester.attester":
 		x.Attester = ""
+	case "circle.cctp.v1.Attester.key_type":
+		x.KeyType = 0
+	case "circle.cctp.v1.Attester.operator":
+		x.Operator = ""
+	case "circle.cctp.v1.Attester.added_at_height":
+		x.AddedAtHeight = int64(0)
+	case "circle.cctp.v1.Attester.status":
+		x.Status = 0
+	case "circle.cctp.v1.Attester.disabled_at_height":
+		x.DisabledAtHeight = int64(0)
+	case "circle.cctp.v1.Attester.disable_reason":
+		x.DisableReason = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Attester"))
@@ -148,6 +220,24 @@ func (x *fastReflection_Attester) Get(descriptor protoreflect.FieldDescriptor) p
 	case "circle.cctp.v1.Attester.attester":
 		value := x.Attester
 		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.Attester.key_type":
+		value := x.KeyType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "circle.cctp.v1.Attester.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.Attester.added_at_height":
+		value := x.AddedAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "circle.cctp.v1.Attester.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "circle.cctp.v1.Attester.disabled_at_height":
+		value := x.DisabledAtHeight
+		return protoreflect.ValueOfInt64(value)
+	case "circle.cctp.v1.Attester.disable_reason":
+		value := x.DisableReason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Attester"))
@@ -170,6 +260,18 @@ func (x *fastReflection_Attester) Set(fd protoreflect.FieldDescriptor, value pro
 	switch fd.FullName() {
 	case "circle.cctp.v1.Attester.attester":
 		x.Attester = value.Interface().(string)
+	case "circle.cctp.v1.Attester.key_type":
+		x.KeyType = (KeyType)(value.Enum())
+	case "circle.cctp.v1.Attester.operator":
+		x.Operator = value.Interface().(string)
+	case "circle.cctp.v1.Attester.added_at_height":
+		x.AddedAtHeight = value.Int()
+	case "circle.cctp.v1.Attester.status":
+		x.Status = (AttesterStatus)(value.Enum())
+	case "circle.cctp.v1.Attester.disabled_at_height":
+		x.DisabledAtHeight = value.Int()
+	case "circle.cctp.v1.Attester.disable_reason":
+		x.DisableReason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Attester"))
@@ -192,6 +294,18 @@ func (x *fastReflection_Attester) Mutable(fd protoreflect.FieldDescriptor) proto
 	switch fd.FullName() {
 	case "circle.cctp.v1.Attester.attester":
 		panic(fmt.Errorf("field attester of message circle.cctp.v1.Attester is not mutable"))
+	case "circle.cctp.v1.Attester.key_type":
+		panic(fmt.Errorf("field key_type of message circle.cctp.v1.Attester is not mutable"))
+	case "circle.cctp.v1.Attester.operator":
+		panic(fmt.Errorf("field operator of message circle.cctp.v1.Attester is not mutable"))
+	case "circle.cctp.v1.Attester.added_at_height":
+		panic(fmt.Errorf("field added_at_height of message circle.cctp.v1.Attester is not mutable"))
+	case "circle.cctp.v1.Attester.status":
+		panic(fmt.Errorf("field status of message circle.cctp.v1.Attester is not mutable"))
+	case "circle.cctp.v1.Attester.disabled_at_height":
+		panic(fmt.Errorf("field disabled_at_height of message circle.cctp.v1.Attester is not mutable"))
+	case "circle.cctp.v1.Attester.disable_reason":
+		panic(fmt.Errorf("field disable_reason of message circle.cctp.v1.Attester is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Attester"))
@@ -207,6 +321,18 @@ func (x *fastReflection_Attester) NewField(fd protoreflect.FieldDescriptor) prot
 	switch fd.FullName() {
 	case "circle.cctp.v1.Attester.attester":
 		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.Attester.key_type":
+		return protoreflect.ValueOfEnum(0)
+	case "circle.cctp.v1.Attester.operator":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.Attester.added_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "circle.cctp.v1.Attester.status":
+		return protoreflect.ValueOfEnum(0)
+	case "circle.cctp.v1.Attester.disabled_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "circle.cctp.v1.Attester.disable_reason":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Attester"))
@@ -280,6 +406,26 @@ func (x *fastReflection_Attester) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.KeyType != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeyType))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.AddedAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.AddedAtHeight))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		if x.DisabledAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.DisabledAtHeight))
+		}
+		l = len(x.DisableReason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -309,6 +455,40 @@ func (x *fastReflection_Attester) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.DisableReason) > 0 {
+			i -= len(x.DisableReason)
+			copy(dAtA[i:], x.DisableReason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DisableReason)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.DisabledAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DisabledAtHeight))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.AddedAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AddedAtHeight))
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.KeyType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeyType))
+			i--
+			dAtA[i] = 0x10
+		}
 		if len(x.Attester) > 0 {
 			i -= len(x.Attester)
 			copy(dAtA[i:], x.Attester)
@@ -397,6 +577,146 @@ func (x *fastReflection_Attester) ProtoMethods() *protoiface.Methods {
 				}
 				x.Attester = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				}
+				x.KeyType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeyType |= KeyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AddedAtHeight", wireType)
+				}
+				x.AddedAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AddedAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= AttesterStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisabledAtHeight", wireType)
+				}
+				x.DisabledAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DisabledAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DisableReason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DisableReason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -432,123 +752,1240 @@ func (x *fastReflection_Attester) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-//
-// Copyright (c) 2023, © Circle Internet Financial, LTD.
-//
-// Licensed under the Apache License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-// http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
+var (
+	md_AttesterHistoryEntry           protoreflect.MessageDescriptor
+	fd_AttesterHistoryEntry_id        protoreflect.FieldDescriptor
+	fd_AttesterHistoryEntry_attester  protoreflect.FieldDescriptor
+	fd_AttesterHistoryEntry_key_type  protoreflect.FieldDescriptor
+	fd_AttesterHistoryEntry_status    protoreflect.FieldDescriptor
+	fd_AttesterHistoryEntry_operator  protoreflect.FieldDescriptor
+	fd_AttesterHistoryEntry_reason    protoreflect.FieldDescriptor
+	fd_AttesterHistoryEntry_height    protoreflect.FieldDescriptor
+	fd_AttesterHistoryEntry_authority protoreflect.FieldDescriptor
+)
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: circle/cctp/v1/attester.proto
+func init() {
+	file_circle_cctp_v1_attester_proto_init()
+	md_AttesterHistoryEntry = File_circle_cctp_v1_attester_proto.Messages().ByName("AttesterHistoryEntry")
+	fd_AttesterHistoryEntry_id = md_AttesterHistoryEntry.Fields().ByName("id")
+	fd_AttesterHistoryEntry_attester = md_AttesterHistoryEntry.Fields().ByName("attester")
+	fd_AttesterHistoryEntry_key_type = md_AttesterHistoryEntry.Fields().ByName("key_type")
+	fd_AttesterHistoryEntry_status = md_AttesterHistoryEntry.Fields().ByName("status")
+	fd_AttesterHistoryEntry_operator = md_AttesterHistoryEntry.Fields().ByName("operator")
+	fd_AttesterHistoryEntry_reason = md_AttesterHistoryEntry.Fields().ByName("reason")
+	fd_AttesterHistoryEntry_height = md_AttesterHistoryEntry.Fields().ByName("height")
+	fd_AttesterHistoryEntry_authority = md_AttesterHistoryEntry.Fields().ByName("authority")
+}
 
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
+var _ protoreflect.Message = (*fastReflection_AttesterHistoryEntry)(nil)
 
-// *
-// A public key used to verify message signatures
-// @param attester ECDSA uncompressed public key, hex encoded
-type Attester struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_AttesterHistoryEntry AttesterHistoryEntry
 
-	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+func (x *AttesterHistoryEntry) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AttesterHistoryEntry)(x)
 }
 
-func (x *Attester) Reset() {
-	*x = Attester{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_attester_proto_msgTypes[0]
+func (x *AttesterHistoryEntry) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_attester_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *Attester) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_AttesterHistoryEntry_messageType fastReflection_AttesterHistoryEntry_messageType
+var _ protoreflect.MessageType = fastReflection_AttesterHistoryEntry_messageType{}
 
-func (*Attester) ProtoMessage() {}
+type fastReflection_AttesterHistoryEntry_messageType struct{}
 
-// Deprecated: Use Attester.ProtoReflect.Descriptor instead.
-func (*Attester) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_attester_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_AttesterHistoryEntry_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AttesterHistoryEntry)(nil)
 }
-
-func (x *Attester) GetAttester() string {
-	if x != nil {
-		return x.Attester
-	}
-	return ""
+func (x fastReflection_AttesterHistoryEntry_messageType) New() protoreflect.Message {
+	return new(fastReflection_AttesterHistoryEntry)
 }
-
-var File_circle_cctp_v1_attester_proto protoreflect.FileDescriptor
-
-var file_circle_cctp_v1_attester_proto_rawDesc = []byte{
-	0x0a, 0x1d, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31,
-	0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
-	0x0e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x22,
-	0x26, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x42, 0xb8, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e,
-	0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0d,
-	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a,
-	0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63,
-	0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x63, 0x63, 0x74, 0x70,
-	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x63, 0x63, 0x74, 0x70,
-	0x2f, 0x76, 0x31, 0x3b, 0x63, 0x63, 0x74, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58,
-	0xaa, 0x02, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x63, 0x74, 0x70, 0x2e, 0x56,
-	0x31, 0xca, 0x02, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x43, 0x63, 0x74, 0x70, 0x5c,
-	0x56, 0x31, 0xe2, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x43, 0x63, 0x74, 0x70,
-	0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea,
-	0x02, 0x10, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x43, 0x63, 0x74, 0x70, 0x3a, 0x3a,
-	0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x fastReflection_AttesterHistoryEntry_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttesterHistoryEntry
 }
 
-var (
-	file_circle_cctp_v1_attester_proto_rawDescOnce sync.Once
-	file_circle_cctp_v1_attester_proto_rawDescData = file_circle_cctp_v1_attester_proto_rawDesc
-)
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_AttesterHistoryEntry) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttesterHistoryEntry
+}
 
-func file_circle_cctp_v1_attester_proto_rawDescGZIP() []byte {
-	file_circle_cctp_v1_attester_proto_rawDescOnce.Do(func() {
-		file_circle_cctp_v1_attester_proto_rawDescData = protoimpl.X.CompressGZIP(file_circle_cctp_v1_attester_proto_rawDescData)
-	})
-	return file_circle_cctp_v1_attester_proto_rawDescData
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_AttesterHistoryEntry) Type() protoreflect.MessageType {
+	return _fastReflection_AttesterHistoryEntry_messageType
 }
 
-var file_circle_cctp_v1_attester_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
-var file_circle_cctp_v1_attester_proto_goTypes = []interface{}{
-	(*Attester)(nil), // 0: circle.cctp.v1.Attester
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_AttesterHistoryEntry) New() protoreflect.Message {
+	return new(fastReflection_AttesterHistoryEntry)
 }
-var file_circle_cctp_v1_attester_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_AttesterHistoryEntry) Interface() protoreflect.ProtoMessage {
+	return (*AttesterHistoryEntry)(x)
 }
 
-func init() { file_circle_cctp_v1_attester_proto_init() }
-func file_circle_cctp_v1_attester_proto_init() {
-	if File_circle_cctp_v1_attester_proto != nil {
-		return
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_AttesterHistoryEntry) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_AttesterHistoryEntry_id, value) {
+			return
+		}
+	}
+	if x.Attester != "" {
+		value := protoreflect.ValueOfString(x.Attester)
+		if !f(fd_AttesterHistoryEntry_attester, value) {
+			return
+		}
+	}
+	if x.KeyType != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.KeyType))
+		if !f(fd_AttesterHistoryEntry_key_type, value) {
+			return
+		}
+	}
+	if x.Status != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Status))
+		if !f(fd_AttesterHistoryEntry_status, value) {
+			return
+		}
+	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_AttesterHistoryEntry_operator, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_AttesterHistoryEntry_reason, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_AttesterHistoryEntry_height, value) {
+			return
+		}
+	}
+	if x.Authority != "" {
+		value := protoreflect.ValueOfString(x.Authority)
+		if !f(fd_AttesterHistoryEntry_authority, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_AttesterHistoryEntry) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterHistoryEntry.id":
+		return x.Id != uint64(0)
+	case "circle.cctp.v1.AttesterHistoryEntry.attester":
+		return x.Attester != ""
+	case "circle.cctp.v1.AttesterHistoryEntry.key_type":
+		return x.KeyType != 0
+	case "circle.cctp.v1.AttesterHistoryEntry.status":
+		return x.Status != 0
+	case "circle.cctp.v1.AttesterHistoryEntry.operator":
+		return x.Operator != ""
+	case "circle.cctp.v1.AttesterHistoryEntry.reason":
+		return x.Reason != ""
+	case "circle.cctp.v1.AttesterHistoryEntry.height":
+		return x.Height != int64(0)
+	case "circle.cctp.v1.AttesterHistoryEntry.authority":
+		return x.Authority != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterHistoryEntry"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttesterHistoryEntry) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterHistoryEntry.id":
+		x.Id = uint64(0)
+	case "circle.cctp.v1.AttesterHistoryEntry.attester":
+		x.Attester = ""
+	case "circle.cctp.v1.AttesterHistoryEntry.key_type":
+		x.KeyType = 0
+	case "circle.cctp.v1.AttesterHistoryEntry.status":
+		x.Status = 0
+	case "circle.cctp.v1.AttesterHistoryEntry.operator":
+		x.Operator = ""
+	case "circle.cctp.v1.AttesterHistoryEntry.reason":
+		x.Reason = ""
+	case "circle.cctp.v1.AttesterHistoryEntry.height":
+		x.Height = int64(0)
+	case "circle.cctp.v1.AttesterHistoryEntry.authority":
+		x.Authority = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterHistoryEntry"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_AttesterHistoryEntry) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.AttesterHistoryEntry.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "circle.cctp.v1.AttesterHistoryEntry.attester":
+		value := x.Attester
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.AttesterHistoryEntry.key_type":
+		value := x.KeyType
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "circle.cctp.v1.AttesterHistoryEntry.status":
+		value := x.Status
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "circle.cctp.v1.AttesterHistoryEntry.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.AttesterHistoryEntry.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.AttesterHistoryEntry.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "circle.cctp.v1.AttesterHistoryEntry.authority":
+		value := x.Authority
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterHistoryEntry"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterHistoryEntry does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttesterHistoryEntry) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterHistoryEntry.id":
+		x.Id = value.Uint()
+	case "circle.cctp.v1.AttesterHistoryEntry.attester":
+		x.Attester = value.Interface().(string)
+	case "circle.cctp.v1.AttesterHistoryEntry.key_type":
+		x.KeyType = (KeyType)(value.Enum())
+	case "circle.cctp.v1.AttesterHistoryEntry.status":
+		x.Status = (AttesterStatus)(value.Enum())
+	case "circle.cctp.v1.AttesterHistoryEntry.operator":
+		x.Operator = value.Interface().(string)
+	case "circle.cctp.v1.AttesterHistoryEntry.reason":
+		x.Reason = value.Interface().(string)
+	case "circle.cctp.v1.AttesterHistoryEntry.height":
+		x.Height = value.Int()
+	case "circle.cctp.v1.AttesterHistoryEntry.authority":
+		x.Authority = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterHistoryEntry"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttesterHistoryEntry) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterHistoryEntry.id":
+		panic(fmt.Errorf("field id of message circle.cctp.v1.AttesterHistoryEntry is not mutable"))
+	case "circle.cctp.v1.AttesterHistoryEntry.attester":
+		panic(fmt.Errorf("field attester of message circle.cctp.v1.AttesterHistoryEntry is not mutable"))
+	case "circle.cctp.v1.AttesterHistoryEntry.key_type":
+		panic(fmt.Errorf("field key_type of message circle.cctp.v1.AttesterHistoryEntry is not mutable"))
+	case "circle.cctp.v1.AttesterHistoryEntry.status":
+		panic(fmt.Errorf("field status of message circle.cctp.v1.AttesterHistoryEntry is not mutable"))
+	case "circle.cctp.v1.AttesterHistoryEntry.operator":
+		panic(fmt.Errorf("field operator of message circle.cctp.v1.AttesterHistoryEntry is not mutable"))
+	case "circle.cctp.v1.AttesterHistoryEntry.reason":
+		panic(fmt.Errorf("field reason of message circle.cctp.v1.AttesterHistoryEntry is not mutable"))
+	case "circle.cctp.v1.AttesterHistoryEntry.height":
+		panic(fmt.Errorf("field height of message circle.cctp.v1.AttesterHistoryEntry is not mutable"))
+	case "circle.cctp.v1.AttesterHistoryEntry.authority":
+		panic(fmt.Errorf("field authority of message circle.cctp.v1.AttesterHistoryEntry is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterHistoryEntry"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_AttesterHistoryEntry) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterHistoryEntry.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.cctp.v1.AttesterHistoryEntry.attester":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.AttesterHistoryEntry.key_type":
+		return protoreflect.ValueOfEnum(0)
+	case "circle.cctp.v1.AttesterHistoryEntry.status":
+		return protoreflect.ValueOfEnum(0)
+	case "circle.cctp.v1.AttesterHistoryEntry.operator":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.AttesterHistoryEntry.reason":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.AttesterHistoryEntry.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "circle.cctp.v1.AttesterHistoryEntry.authority":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterHistoryEntry"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterHistoryEntry does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_AttesterHistoryEntry) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.AttesterHistoryEntry", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_AttesterHistoryEntry) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_AttesterHistoryEntry) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_AttesterHistoryEntry) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_AttesterHistoryEntry) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*AttesterHistoryEntry)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Attester)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.KeyType != 0 {
+			n += 1 + runtime.Sov(uint64(x.KeyType))
+		}
+		if x.Status != 0 {
+			n += 1 + runtime.Sov(uint64(x.Status))
+		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Authority)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*AttesterHistoryEntry)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Authority) > 0 {
+			i -= len(x.Authority)
+			copy(dAtA[i:], x.Authority)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Authority)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x38
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Status != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Status))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.KeyType != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.KeyType))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.Attester) > 0 {
+			i -= len(x.Attester)
+			copy(dAtA[i:], x.Attester)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Attester)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*AttesterHistoryEntry)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttesterHistoryEntry: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttesterHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Attester = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				}
+				x.KeyType = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.KeyType |= KeyType(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				}
+				x.Status = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Status |= AttesterStatus(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Authority = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: circle/cctp/v1/attester.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// *
+// The signature scheme of an attester key
+// @param KEY_TYPE_SECP256K1 65 byte recoverable ECDSA signatures, one per signer
+// @param KEY_TYPE_ED25519 32 byte public key and 64 byte signature, one pair per signer
+// @param KEY_TYPE_BLS12_381 signer bitmap followed by a single 96 byte aggregate signature
+type KeyType int32
+
+const (
+	KeyType_KEY_TYPE_SECP256K1 KeyType = 0
+	KeyType_KEY_TYPE_ED25519   KeyType = 1
+	KeyType_KEY_TYPE_BLS12_381 KeyType = 2
+)
+
+// Enum value maps for KeyType.
+var (
+	KeyType_name = map[int32]string{
+		0: "KEY_TYPE_SECP256K1",
+		1: "KEY_TYPE_ED25519",
+		2: "KEY_TYPE_BLS12_381",
+	}
+	KeyType_value = map[string]int32{
+		"KEY_TYPE_SECP256K1": 0,
+		"KEY_TYPE_ED25519":   1,
+		"KEY_TYPE_BLS12_381": 2,
+	}
+)
+
+func (x KeyType) Enum() *KeyType {
+	p := new(KeyType)
+	*p = x
+	return p
+}
+
+func (x KeyType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (KeyType) Descriptor() protoreflect.EnumDescriptor {
+	return file_circle_cctp_v1_attester_proto_enumTypes[0].Descriptor()
+}
+
+func (KeyType) Type() protoreflect.EnumType {
+	return &file_circle_cctp_v1_attester_proto_enumTypes[0]
+}
+
+func (x KeyType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use KeyType.Descriptor instead.
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_attester_proto_rawDescGZIP(), []int{0}
+}
+
+// *
+// Whether an attester currently counts towards the signature threshold
+// @param ATTESTER_STATUS_ENABLED the attester's signatures are accepted
+// @param ATTESTER_STATUS_DISABLED the attester was disabled, its record is kept for auditing
+type AttesterStatus int32
+
+const (
+	AttesterStatus_ATTESTER_STATUS_ENABLED  AttesterStatus = 0
+	AttesterStatus_ATTESTER_STATUS_DISABLED AttesterStatus = 1
+)
+
+// Enum value maps for AttesterStatus.
+var (
+	AttesterStatus_name = map[int32]string{
+		0: "ATTESTER_STATUS_ENABLED",
+		1: "ATTESTER_STATUS_DISABLED",
+	}
+	AttesterStatus_value = map[string]int32{
+		"ATTESTER_STATUS_ENABLED":  0,
+		"ATTESTER_STATUS_DISABLED": 1,
+	}
+)
+
+func (x AttesterStatus) Enum() *AttesterStatus {
+	p := new(AttesterStatus)
+	*p = x
+	return p
+}
+
+func (x AttesterStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (AttesterStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_circle_cctp_v1_attester_proto_enumTypes[1].Descriptor()
+}
+
+func (AttesterStatus) Type() protoreflect.EnumType {
+	return &file_circle_cctp_v1_attester_proto_enumTypes[1]
+}
+
+func (x AttesterStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use AttesterStatus.Descriptor instead.
+func (AttesterStatus) EnumDescriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_attester_proto_rawDescGZIP(), []int{1}
+}
+
+// *
+// A public key used to verify message signatures
+// @param attester public key, hex encoded. ECDSA uncompressed for secp256k1,
+// 32 bytes for ed25519 and 48 bytes compressed G1 for BLS12-381
+// @param key_type signature scheme of the public key
+// @param operator free form label of who operates the key
+// @param added_at_height block height at which the attester was last enabled
+// @param status whether the attester is enabled or disabled
+// @param disabled_at_height block height at which the attester was disabled
+// @param disable_reason reason given by the attester manager when disabling
+type Attester struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attester         string         `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	KeyType          KeyType        `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=circle.cctp.v1.KeyType" json:"key_type,omitempty"`
+	Operator         string         `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	AddedAtHeight    int64          `protobuf:"varint,4,opt,name=added_at_height,json=addedAtHeight,proto3" json:"added_at_height,omitempty"`
+	Status           AttesterStatus `protobuf:"varint,5,opt,name=status,proto3,enum=circle.cctp.v1.AttesterStatus" json:"status,omitempty"`
+	DisabledAtHeight int64          `protobuf:"varint,6,opt,name=disabled_at_height,json=disabledAtHeight,proto3" json:"disabled_at_height,omitempty"`
+	DisableReason    string         `protobuf:"bytes,7,opt,name=disable_reason,json=disableReason,proto3" json:"disable_reason,omitempty"`
+}
+
+func (x *Attester) Reset() {
+	*x = Attester{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_attester_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attester) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attester) ProtoMessage() {}
+
+// Deprecated: Use Attester.ProtoReflect.Descriptor instead.
+func (*Attester) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_attester_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Attester) GetAttester() string {
+	if x != nil {
+		return x.Attester
+	}
+	return ""
+}
+
+func (x *Attester) GetKeyType() KeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return KeyType_KEY_TYPE_SECP256K1
+}
+
+func (x *Attester) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *Attester) GetAddedAtHeight() int64 {
+	if x != nil {
+		return x.AddedAtHeight
+	}
+	return 0
+}
+
+func (x *Attester) GetStatus() AttesterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttesterStatus_ATTESTER_STATUS_ENABLED
+}
+
+func (x *Attester) GetDisabledAtHeight() int64 {
+	if x != nil {
+		return x.DisabledAtHeight
+	}
+	return 0
+}
+
+func (x *Attester) GetDisableReason() string {
+	if x != nil {
+		return x.DisableReason
+	}
+	return ""
+}
+
+// *
+// A single change to the attester set
+// @param id sequence number of the change, starting at 0
+// @param attester public key of the attester, hex encoded
+// @param key_type signature scheme of the public key
+// @param status status of the attester after the change
+// @param operator operator label at the time of the change
+// @param reason reason given when disabling the attester
+// @param height block height of the change
+// @param authority attester manager who made the change
+type AttesterHistoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id        uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attester  string         `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	KeyType   KeyType        `protobuf:"varint,3,opt,name=key_type,json=keyType,proto3,enum=circle.cctp.v1.KeyType" json:"key_type,omitempty"`
+	Status    AttesterStatus `protobuf:"varint,4,opt,name=status,proto3,enum=circle.cctp.v1.AttesterStatus" json:"status,omitempty"`
+	Operator  string         `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason    string         `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height    int64          `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Authority string         `protobuf:"bytes,8,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (x *AttesterHistoryEntry) Reset() {
+	*x = AttesterHistoryEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_attester_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterHistoryEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterHistoryEntry) ProtoMessage() {}
+
+// Deprecated: Use AttesterHistoryEntry.ProtoReflect.Descriptor instead.
+func (*AttesterHistoryEntry) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_attester_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttesterHistoryEntry) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AttesterHistoryEntry) GetAttester() string {
+	if x != nil {
+		return x.Attester
+	}
+	return ""
+}
+
+func (x *AttesterHistoryEntry) GetKeyType() KeyType {
+	if x != nil {
+		return x.KeyType
+	}
+	return KeyType_KEY_TYPE_SECP256K1
+}
+
+func (x *AttesterHistoryEntry) GetStatus() AttesterStatus {
+	if x != nil {
+		return x.Status
+	}
+	return AttesterStatus_ATTESTER_STATUS_ENABLED
+}
+
+func (x *AttesterHistoryEntry) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+func (x *AttesterHistoryEntry) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AttesterHistoryEntry) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *AttesterHistoryEntry) GetAuthority() string {
+	if x != nil {
+		return x.Authority
+	}
+	return ""
+}
+
+var File_circle_cctp_v1_attester_proto protoreflect.FileDescriptor
+
+var file_circle_cctp_v1_attester_proto_rawDesc = []byte{
+	0x0a, 0x1d, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31,
+	0x2f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x0e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x22,
+	0xab, 0x02, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72,
+	0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x64, 0x64, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x61, 0x64, 0x64, 0x65, 0x64, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1e, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76,
+	0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x41, 0x74,
+	0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x5f, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x98, 0x02,
+	0x0a, 0x14, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x12, 0x32, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63,
+	0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x52, 0x07, 0x6b,
+	0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e,
+	0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a,
+	0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61,
+	0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x74, 0x79, 0x2a, 0x4f, 0x0a, 0x07, 0x4b, 0x65, 0x79, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x53, 0x45, 0x43, 0x50, 0x32, 0x35, 0x36, 0x4b, 0x31, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4b,
+	0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x45, 0x44, 0x32, 0x35, 0x35, 0x31, 0x39, 0x10,
+	0x01, 0x12, 0x16, 0x0a, 0x12, 0x4b, 0x45, 0x59, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x4c,
+	0x53, 0x31, 0x32, 0x5f, 0x33, 0x38, 0x31, 0x10, 0x02, 0x2a, 0x4b, 0x0a, 0x0e, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x17, 0x41,
+	0x54, 0x54, 0x45, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x45,
+	0x4e, 0x41, 0x42, 0x4c, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1c, 0x0a, 0x18, 0x41, 0x54, 0x54, 0x45,
+	0x53, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x49, 0x53, 0x41,
+	0x42, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x42, 0xb8, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x63,
+	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0d, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c,
+	0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x63, 0x63, 0x74, 0x70, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x63, 0x63, 0x74, 0x70, 0x2f,
+	0x76, 0x31, 0x3b, 0x63, 0x63, 0x74, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa,
+	0x02, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x43, 0x63, 0x74, 0x70, 0x2e, 0x56, 0x31,
+	0xca, 0x02, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x43, 0x63, 0x74, 0x70, 0x5c, 0x56,
+	0x31, 0xe2, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x43, 0x63, 0x74, 0x70, 0x5c,
+	0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02,
+	0x10, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x43, 0x63, 0x74, 0x70, 0x3a, 0x3a, 0x56,
+	0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_circle_cctp_v1_attester_proto_rawDescOnce sync.Once
+	file_circle_cctp_v1_attester_proto_rawDescData = file_circle_cctp_v1_attester_proto_rawDesc
+)
+
+func file_circle_cctp_v1_attester_proto_rawDescGZIP() []byte {
+	file_circle_cctp_v1_attester_proto_rawDescOnce.Do(func() {
+		file_circle_cctp_v1_attester_proto_rawDescData = protoimpl.X.CompressGZIP(file_circle_cctp_v1_attester_proto_rawDescData)
+	})
+	return file_circle_cctp_v1_attester_proto_rawDescData
+}
+
+var file_circle_cctp_v1_attester_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_circle_cctp_v1_attester_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_circle_cctp_v1_attester_proto_goTypes = []interface{}{
+	(KeyType)(0),                 // 0: circle.cctp.v1.KeyType
+	(AttesterStatus)(0),          // 1: circle.cctp.v1.AttesterStatus
+	(*Attester)(nil),             // 2: circle.cctp.v1.Attester
+	(*AttesterHistoryEntry)(nil), // 3: circle.cctp.v1.AttesterHistoryEntry
+}
+var file_circle_cctp_v1_attester_proto_depIdxs = []int32{
+	0, // 0: circle.cctp.v1.Attester.key_type:type_name -> circle.cctp.v1.KeyType
+	1, // 1: circle.cctp.v1.Attester.status:type_name -> circle.cctp.v1.AttesterStatus
+	0, // 2: circle.cctp.v1.AttesterHistoryEntry.key_type:type_name -> circle.cctp.v1.KeyType
+	1, // 3: circle.cctp.v1.AttesterHistoryEntry.status:type_name -> circle.cctp.v1.AttesterStatus
+	4, // [4:4] is the sub-list for method output_type
+	4, // [4:4] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_circle_cctp_v1_attester_proto_init() }
+func file_circle_cctp_v1_attester_proto_init() {
+	if File_circle_cctp_v1_attester_proto != nil {
+		return
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_circle_cctp_v1_attester_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
@@ -563,19 +2000,32 @@ func file_circle_cctp_v1_attester_proto_init() {
 				return nil
 			}
 		}
+		file_circle_cctp_v1_attester_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttesterHistoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_circle_cctp_v1_attester_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      2,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_circle_cctp_v1_attester_proto_goTypes,
 		DependencyIndexes: file_circle_cctp_v1_attester_proto_depIdxs,
+		EnumInfos:         file_circle_cctp_v1_attester_proto_enumTypes,
 		MessageInfos:      file_circle_cctp_v1_attester_proto_msgTypes,
 	}.Build()
 	File_circle_cctp_v1_attester_proto = out.File
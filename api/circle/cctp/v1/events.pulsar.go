@@ -16,12 +16,14 @@ import (
 var (
 	md_AttesterEnabled          protoreflect.MessageDescriptor
 	fd_AttesterEnabled_attester protoreflect.FieldDescriptor
+	fd_AttesterEnabled_operator protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
 	md_AttesterEnabled = File_circle_cctp_v1_events_proto.Messages().ByName("AttesterEnabled")
 	fd_AttesterEnabled_attester = md_AttesterEnabled.Fields().ByName("attester")
+	fd_AttesterEnabled_operator = md_AttesterEnabled.Fields().ByName("operator")
 }
 
 var _ protoreflect.Message = (*fastReflection_AttesterEnabled)(nil)
@@ -95,6 +97,12 @@ func (x *fastReflection_AttesterEnabled) Range(f func(protoreflect.FieldDescript
 			return
 		}
 	}
+	if x.Operator != "" {
+		value := protoreflect.ValueOfString(x.Operator)
+		if !f(fd_AttesterEnabled_operator, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -112,6 +120,8 @@ func (x *fastReflection_AttesterEnabled) Has(fd protoreflect.FieldDescriptor) bo
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterEnabled.attester":
 		return x.Attester != ""
+	case "circle.cctp.v1.AttesterEnabled.operator":
+		return x.Operator != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterEnabled"))
@@ -130,6 +140,8 @@ func (x *fastReflection_AttesterEnabled) Clear(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterEnabled.attester":
 		x.Attester = ""
+	case "circle.cctp.v1.AttesterEnabled.operator":
+		x.Operator = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterEnabled"))
@@ -149,6 +161,9 @@ func (x *fastReflection_AttesterEnabled) Get(descriptor protoreflect.FieldDescri
 	case "circle.cctp.v1.AttesterEnabled.attester":
 		value := x.Attester
 		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.AttesterEnabled.operator":
+		value := x.Operator
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterEnabled"))
@@ -171,6 +186,8 @@ func (x *fastReflection_AttesterEnabled) Set(fd protoreflect.FieldDescriptor, va
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterEnabled.attester":
 		x.Attester = value.Interface().(string)
+	case "circle.cctp.v1.AttesterEnabled.operator":
+		x.Operator = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterEnabled"))
@@ -193,6 +210,8 @@ func (x *fastReflection_AttesterEnabled) Mutable(fd protoreflect.FieldDescriptor
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterEnabled.attester":
 		panic(fmt.Errorf("field attester of message circle.cctp.v1.AttesterEnabled is not mutable"))
+	case "circle.cctp.v1.AttesterEnabled.operator":
+		panic(fmt.Errorf("field operator of message circle.cctp.v1.AttesterEnabled is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterEnabled"))
@@ -208,6 +227,8 @@ func (x *fastReflection_AttesterEnabled) NewField(fd protoreflect.FieldDescripto
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterEnabled.attester":
 		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.AttesterEnabled.operator":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterEnabled"))
@@ -281,6 +302,10 @@ func (x *fastReflection_AttesterEnabled) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Operator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -310,6 +335,13 @@ func (x *fastReflection_AttesterEnabled) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Operator) > 0 {
+			i -= len(x.Operator)
+			copy(dAtA[i:], x.Operator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Operator)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Attester) > 0 {
 			i -= len(x.Attester)
 			copy(dAtA[i:], x.Attester)
@@ -398,6 +430,38 @@ func (x *fastReflection_AttesterEnabled) ProtoMethods() *protoiface.Methods {
 				}
 				x.Attester = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Operator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -436,12 +500,14 @@ func (x *fastReflection_AttesterEnabled) ProtoMethods() *protoiface.Methods {
 var (
 	md_AttesterDisabled          protoreflect.MessageDescriptor
 	fd_AttesterDisabled_attester protoreflect.FieldDescriptor
+	fd_AttesterDisabled_reason   protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
 	md_AttesterDisabled = File_circle_cctp_v1_events_proto.Messages().ByName("AttesterDisabled")
 	fd_AttesterDisabled_attester = md_AttesterDisabled.Fields().ByName("attester")
+	fd_AttesterDisabled_reason = md_AttesterDisabled.Fields().ByName("reason")
 }
 
 var _ protoreflect.Message = (*fastReflection_AttesterDisabled)(nil)
@@ -515,6 +581,12 @@ func (x *fastReflection_AttesterDisabled) Range(f func(protoreflect.FieldDescrip
 			return
 		}
 	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_AttesterDisabled_reason, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -532,6 +604,8 @@ func (x *fastReflection_AttesterDisabled) Has(fd protoreflect.FieldDescriptor) b
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterDisabled.attester":
 		return x.Attester != ""
+	case "circle.cctp.v1.AttesterDisabled.reason":
+		return x.Reason != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterDisabled"))
@@ -550,6 +624,8 @@ func (x *fastReflection_AttesterDisabled) Clear(fd protoreflect.FieldDescriptor)
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterDisabled.attester":
 		x.Attester = ""
+	case "circle.cctp.v1.AttesterDisabled.reason":
+		x.Reason = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterDisabled"))
@@ -569,6 +645,9 @@ func (x *fastReflection_AttesterDisabled) Get(descriptor protoreflect.FieldDescr
 	case "circle.cctp.v1.AttesterDisabled.attester":
 		value := x.Attester
 		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.AttesterDisabled.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterDisabled"))
@@ -591,6 +670,8 @@ func (x *fastReflection_AttesterDisabled) Set(fd protoreflect.FieldDescriptor, v
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterDisabled.attester":
 		x.Attester = value.Interface().(string)
+	case "circle.cctp.v1.AttesterDisabled.reason":
+		x.Reason = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterDisabled"))
@@ -613,6 +694,8 @@ func (x *fastReflection_AttesterDisabled) Mutable(fd protoreflect.FieldDescripto
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterDisabled.attester":
 		panic(fmt.Errorf("field attester of message circle.cctp.v1.AttesterDisabled is not mutable"))
+	case "circle.cctp.v1.AttesterDisabled.reason":
+		panic(fmt.Errorf("field reason of message circle.cctp.v1.AttesterDisabled is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterDisabled"))
@@ -628,6 +711,8 @@ func (x *fastReflection_AttesterDisabled) NewField(fd protoreflect.FieldDescript
 	switch fd.FullName() {
 	case "circle.cctp.v1.AttesterDisabled.attester":
 		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.AttesterDisabled.reason":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterDisabled"))
@@ -701,6 +786,10 @@ func (x *fastReflection_AttesterDisabled) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -730,6 +819,13 @@ func (x *fastReflection_AttesterDisabled) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x12
+		}
 		if len(x.Attester) > 0 {
 			i -= len(x.Attester)
 			copy(dAtA[i:], x.Attester)
@@ -818,6 +914,38 @@ func (x *fastReflection_AttesterDisabled) ProtoMethods() *protoiface.Methods {
 				}
 				x.Attester = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1790,9 +1918,10 @@ func (x *fastReflection_OwnerUpdated) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_OwnershipTransferStarted                protoreflect.MessageDescriptor
-	fd_OwnershipTransferStarted_previous_owner protoreflect.FieldDescriptor
-	fd_OwnershipTransferStarted_new_owner      protoreflect.FieldDescriptor
+	md_OwnershipTransferStarted                   protoreflect.MessageDescriptor
+	fd_OwnershipTransferStarted_previous_owner    protoreflect.FieldDescriptor
+	fd_OwnershipTransferStarted_new_owner         protoreflect.FieldDescriptor
+	fd_OwnershipTransferStarted_expires_at_height protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -1800,6 +1929,7 @@ func init() {
 	md_OwnershipTransferStarted = File_circle_cctp_v1_events_proto.Messages().ByName("OwnershipTransferStarted")
 	fd_OwnershipTransferStarted_previous_owner = md_OwnershipTransferStarted.Fields().ByName("previous_owner")
 	fd_OwnershipTransferStarted_new_owner = md_OwnershipTransferStarted.Fields().ByName("new_owner")
+	fd_OwnershipTransferStarted_expires_at_height = md_OwnershipTransferStarted.Fields().ByName("expires_at_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_OwnershipTransferStarted)(nil)
@@ -1879,6 +2009,12 @@ func (x *fastReflection_OwnershipTransferStarted) Range(f func(protoreflect.Fiel
 			return
 		}
 	}
+	if x.ExpiresAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAtHeight)
+		if !f(fd_OwnershipTransferStarted_expires_at_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -1898,6 +2034,8 @@ func (x *fastReflection_OwnershipTransferStarted) Has(fd protoreflect.FieldDescr
 		return x.PreviousOwner != ""
 	case "circle.cctp.v1.OwnershipTransferStarted.new_owner":
 		return x.NewOwner != ""
+	case "circle.cctp.v1.OwnershipTransferStarted.expires_at_height":
+		return x.ExpiresAtHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.OwnershipTransferStarted"))
@@ -1918,6 +2056,8 @@ func (x *fastReflection_OwnershipTransferStarted) Clear(fd protoreflect.FieldDes
 		x.PreviousOwner = ""
 	case "circle.cctp.v1.OwnershipTransferStarted.new_owner":
 		x.NewOwner = ""
+	case "circle.cctp.v1.OwnershipTransferStarted.expires_at_height":
+		x.ExpiresAtHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.OwnershipTransferStarted"))
@@ -1940,6 +2080,9 @@ func (x *fastReflection_OwnershipTransferStarted) Get(descriptor protoreflect.Fi
 	case "circle.cctp.v1.OwnershipTransferStarted.new_owner":
 		value := x.NewOwner
 		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.OwnershipTransferStarted.expires_at_height":
+		value := x.ExpiresAtHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.OwnershipTransferStarted"))
@@ -1964,6 +2107,8 @@ func (x *fastReflection_OwnershipTransferStarted) Set(fd protoreflect.FieldDescr
 		x.PreviousOwner = value.Interface().(string)
 	case "circle.cctp.v1.OwnershipTransferStarted.new_owner":
 		x.NewOwner = value.Interface().(string)
+	case "circle.cctp.v1.OwnershipTransferStarted.expires_at_height":
+		x.ExpiresAtHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.OwnershipTransferStarted"))
@@ -1988,6 +2133,8 @@ func (x *fastReflection_OwnershipTransferStarted) Mutable(fd protoreflect.FieldD
 		panic(fmt.Errorf("field previous_owner of message circle.cctp.v1.OwnershipTransferStarted is not mutable"))
 	case "circle.cctp.v1.OwnershipTransferStarted.new_owner":
 		panic(fmt.Errorf("field new_owner of message circle.cctp.v1.OwnershipTransferStarted is not mutable"))
+	case "circle.cctp.v1.OwnershipTransferStarted.expires_at_height":
+		panic(fmt.Errorf("field expires_at_height of message circle.cctp.v1.OwnershipTransferStarted is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.OwnershipTransferStarted"))
@@ -2005,6 +2152,8 @@ func (x *fastReflection_OwnershipTransferStarted) NewField(fd protoreflect.Field
 		return protoreflect.ValueOfString("")
 	case "circle.cctp.v1.OwnershipTransferStarted.new_owner":
 		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.OwnershipTransferStarted.expires_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.OwnershipTransferStarted"))
@@ -2082,6 +2231,9 @@ func (x *fastReflection_OwnershipTransferStarted) ProtoMethods() *protoiface.Met
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExpiresAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAtHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2111,6 +2263,11 @@ func (x *fastReflection_OwnershipTransferStarted) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ExpiresAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAtHeight))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.NewOwner) > 0 {
 			i -= len(x.NewOwner)
 			copy(dAtA[i:], x.NewOwner)
@@ -2238,6 +2395,25 @@ func (x *fastReflection_OwnershipTransferStarted) ProtoMethods() *protoiface.Met
 				}
 				x.NewOwner = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+				}
+				x.ExpiresAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2274,27 +2450,29 @@ func (x *fastReflection_OwnershipTransferStarted) ProtoMethods() *protoiface.Met
 }
 
 var (
-	md_PauserUpdated                 protoreflect.MessageDescriptor
-	fd_PauserUpdated_previous_pauser protoreflect.FieldDescriptor
-	fd_PauserUpdated_new_pauser      protoreflect.FieldDescriptor
+	md_AttesterManagerTransferStarted                           protoreflect.MessageDescriptor
+	fd_AttesterManagerTransferStarted_previous_attester_manager protoreflect.FieldDescriptor
+	fd_AttesterManagerTransferStarted_new_attester_manager      protoreflect.FieldDescriptor
+	fd_AttesterManagerTransferStarted_expires_at_height         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_PauserUpdated = File_circle_cctp_v1_events_proto.Messages().ByName("PauserUpdated")
-	fd_PauserUpdated_previous_pauser = md_PauserUpdated.Fields().ByName("previous_pauser")
-	fd_PauserUpdated_new_pauser = md_PauserUpdated.Fields().ByName("new_pauser")
+	md_AttesterManagerTransferStarted = File_circle_cctp_v1_events_proto.Messages().ByName("AttesterManagerTransferStarted")
+	fd_AttesterManagerTransferStarted_previous_attester_manager = md_AttesterManagerTransferStarted.Fields().ByName("previous_attester_manager")
+	fd_AttesterManagerTransferStarted_new_attester_manager = md_AttesterManagerTransferStarted.Fields().ByName("new_attester_manager")
+	fd_AttesterManagerTransferStarted_expires_at_height = md_AttesterManagerTransferStarted.Fields().ByName("expires_at_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_PauserUpdated)(nil)
+var _ protoreflect.Message = (*fastReflection_AttesterManagerTransferStarted)(nil)
 
-type fastReflection_PauserUpdated PauserUpdated
+type fastReflection_AttesterManagerTransferStarted AttesterManagerTransferStarted
 
-func (x *PauserUpdated) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_PauserUpdated)(x)
+func (x *AttesterManagerTransferStarted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AttesterManagerTransferStarted)(x)
 }
 
-func (x *PauserUpdated) slowProtoReflect() protoreflect.Message {
+func (x *AttesterManagerTransferStarted) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2306,43 +2484,43 @@ func (x *PauserUpdated) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_PauserUpdated_messageType fastReflection_PauserUpdated_messageType
-var _ protoreflect.MessageType = fastReflection_PauserUpdated_messageType{}
+var _fastReflection_AttesterManagerTransferStarted_messageType fastReflection_AttesterManagerTransferStarted_messageType
+var _ protoreflect.MessageType = fastReflection_AttesterManagerTransferStarted_messageType{}
 
-type fastReflection_PauserUpdated_messageType struct{}
+type fastReflection_AttesterManagerTransferStarted_messageType struct{}
 
-func (x fastReflection_PauserUpdated_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_PauserUpdated)(nil)
+func (x fastReflection_AttesterManagerTransferStarted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AttesterManagerTransferStarted)(nil)
 }
-func (x fastReflection_PauserUpdated_messageType) New() protoreflect.Message {
-	return new(fastReflection_PauserUpdated)
+func (x fastReflection_AttesterManagerTransferStarted_messageType) New() protoreflect.Message {
+	return new(fastReflection_AttesterManagerTransferStarted)
 }
-func (x fastReflection_PauserUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_PauserUpdated
+func (x fastReflection_AttesterManagerTransferStarted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttesterManagerTransferStarted
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_PauserUpdated) Descriptor() protoreflect.MessageDescriptor {
-	return md_PauserUpdated
+func (x *fastReflection_AttesterManagerTransferStarted) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttesterManagerTransferStarted
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_PauserUpdated) Type() protoreflect.MessageType {
-	return _fastReflection_PauserUpdated_messageType
+func (x *fastReflection_AttesterManagerTransferStarted) Type() protoreflect.MessageType {
+	return _fastReflection_AttesterManagerTransferStarted_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_PauserUpdated) New() protoreflect.Message {
-	return new(fastReflection_PauserUpdated)
+func (x *fastReflection_AttesterManagerTransferStarted) New() protoreflect.Message {
+	return new(fastReflection_AttesterManagerTransferStarted)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_PauserUpdated) Interface() protoreflect.ProtoMessage {
-	return (*PauserUpdated)(x)
+func (x *fastReflection_AttesterManagerTransferStarted) Interface() protoreflect.ProtoMessage {
+	return (*AttesterManagerTransferStarted)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2350,16 +2528,22 @@ func (x *fastReflection_PauserUpdated) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_PauserUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PreviousPauser != "" {
-		value := protoreflect.ValueOfString(x.PreviousPauser)
-		if !f(fd_PauserUpdated_previous_pauser, value) {
+func (x *fastReflection_AttesterManagerTransferStarted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PreviousAttesterManager != "" {
+		value := protoreflect.ValueOfString(x.PreviousAttesterManager)
+		if !f(fd_AttesterManagerTransferStarted_previous_attester_manager, value) {
 			return
 		}
 	}
-	if x.NewPauser != "" {
-		value := protoreflect.ValueOfString(x.NewPauser)
-		if !f(fd_PauserUpdated_new_pauser, value) {
+	if x.NewAttesterManager != "" {
+		value := protoreflect.ValueOfString(x.NewAttesterManager)
+		if !f(fd_AttesterManagerTransferStarted_new_attester_manager, value) {
+			return
+		}
+	}
+	if x.ExpiresAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAtHeight)
+		if !f(fd_AttesterManagerTransferStarted_expires_at_height, value) {
 			return
 		}
 	}
@@ -2376,17 +2560,19 @@ func (x *fastReflection_PauserUpdated) Range(f func(protoreflect.FieldDescriptor
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_PauserUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AttesterManagerTransferStarted) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.PauserUpdated.previous_pauser":
-		return x.PreviousPauser != ""
-	case "circle.cctp.v1.PauserUpdated.new_pauser":
-		return x.NewPauser != ""
+	case "circle.cctp.v1.AttesterManagerTransferStarted.previous_attester_manager":
+		return x.PreviousAttesterManager != ""
+	case "circle.cctp.v1.AttesterManagerTransferStarted.new_attester_manager":
+		return x.NewAttesterManager != ""
+	case "circle.cctp.v1.AttesterManagerTransferStarted.expires_at_height":
+		return x.ExpiresAtHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2396,17 +2582,19 @@ func (x *fastReflection_PauserUpdated) Has(fd protoreflect.FieldDescriptor) bool
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PauserUpdated) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AttesterManagerTransferStarted) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.PauserUpdated.previous_pauser":
-		x.PreviousPauser = ""
-	case "circle.cctp.v1.PauserUpdated.new_pauser":
-		x.NewPauser = ""
+	case "circle.cctp.v1.AttesterManagerTransferStarted.previous_attester_manager":
+		x.PreviousAttesterManager = ""
+	case "circle.cctp.v1.AttesterManagerTransferStarted.new_attester_manager":
+		x.NewAttesterManager = ""
+	case "circle.cctp.v1.AttesterManagerTransferStarted.expires_at_height":
+		x.ExpiresAtHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2416,19 +2604,22 @@ func (x *fastReflection_PauserUpdated) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_PauserUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttesterManagerTransferStarted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.PauserUpdated.previous_pauser":
-		value := x.PreviousPauser
+	case "circle.cctp.v1.AttesterManagerTransferStarted.previous_attester_manager":
+		value := x.PreviousAttesterManager
 		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.PauserUpdated.new_pauser":
-		value := x.NewPauser
+	case "circle.cctp.v1.AttesterManagerTransferStarted.new_attester_manager":
+		value := x.NewAttesterManager
 		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.AttesterManagerTransferStarted.expires_at_height":
+		value := x.ExpiresAtHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerTransferStarted does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2442,17 +2633,19 @@ func (x *fastReflection_PauserUpdated) Get(descriptor protoreflect.FieldDescript
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PauserUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AttesterManagerTransferStarted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.PauserUpdated.previous_pauser":
-		x.PreviousPauser = value.Interface().(string)
-	case "circle.cctp.v1.PauserUpdated.new_pauser":
-		x.NewPauser = value.Interface().(string)
+	case "circle.cctp.v1.AttesterManagerTransferStarted.previous_attester_manager":
+		x.PreviousAttesterManager = value.Interface().(string)
+	case "circle.cctp.v1.AttesterManagerTransferStarted.new_attester_manager":
+		x.NewAttesterManager = value.Interface().(string)
+	case "circle.cctp.v1.AttesterManagerTransferStarted.expires_at_height":
+		x.ExpiresAtHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2466,44 +2659,48 @@ func (x *fastReflection_PauserUpdated) Set(fd protoreflect.FieldDescriptor, valu
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PauserUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttesterManagerTransferStarted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.PauserUpdated.previous_pauser":
-		panic(fmt.Errorf("field previous_pauser of message circle.cctp.v1.PauserUpdated is not mutable"))
-	case "circle.cctp.v1.PauserUpdated.new_pauser":
-		panic(fmt.Errorf("field new_pauser of message circle.cctp.v1.PauserUpdated is not mutable"))
+	case "circle.cctp.v1.AttesterManagerTransferStarted.previous_attester_manager":
+		panic(fmt.Errorf("field previous_attester_manager of message circle.cctp.v1.AttesterManagerTransferStarted is not mutable"))
+	case "circle.cctp.v1.AttesterManagerTransferStarted.new_attester_manager":
+		panic(fmt.Errorf("field new_attester_manager of message circle.cctp.v1.AttesterManagerTransferStarted is not mutable"))
+	case "circle.cctp.v1.AttesterManagerTransferStarted.expires_at_height":
+		panic(fmt.Errorf("field expires_at_height of message circle.cctp.v1.AttesterManagerTransferStarted is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_PauserUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttesterManagerTransferStarted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.PauserUpdated.previous_pauser":
+	case "circle.cctp.v1.AttesterManagerTransferStarted.previous_attester_manager":
 		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.PauserUpdated.new_pauser":
+	case "circle.cctp.v1.AttesterManagerTransferStarted.new_attester_manager":
 		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.AttesterManagerTransferStarted.expires_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_PauserUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AttesterManagerTransferStarted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.PauserUpdated", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.AttesterManagerTransferStarted", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2511,7 +2708,7 @@ func (x *fastReflection_PauserUpdated) WhichOneof(d protoreflect.OneofDescriptor
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_PauserUpdated) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AttesterManagerTransferStarted) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -2522,7 +2719,7 @@ func (x *fastReflection_PauserUpdated) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_PauserUpdated) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AttesterManagerTransferStarted) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -2534,7 +2731,7 @@ func (x *fastReflection_PauserUpdated) SetUnknown(fields protoreflect.RawFields)
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_PauserUpdated) IsValid() bool {
+func (x *fastReflection_AttesterManagerTransferStarted) IsValid() bool {
 	return x != nil
 }
 
@@ -2544,9 +2741,9 @@ func (x *fastReflection_PauserUpdated) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AttesterManagerTransferStarted) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*PauserUpdated)
+		x := input.Message.Interface().(*AttesterManagerTransferStarted)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2558,14 +2755,17 @@ func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.PreviousPauser)
+		l = len(x.PreviousAttesterManager)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.NewPauser)
+		l = len(x.NewAttesterManager)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExpiresAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAtHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -2576,7 +2776,7 @@ func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*PauserUpdated)
+		x := input.Message.Interface().(*AttesterManagerTransferStarted)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2595,17 +2795,22 @@ func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NewPauser) > 0 {
-			i -= len(x.NewPauser)
-			copy(dAtA[i:], x.NewPauser)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewPauser)))
+		if x.ExpiresAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAtHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.NewAttesterManager) > 0 {
+			i -= len(x.NewAttesterManager)
+			copy(dAtA[i:], x.NewAttesterManager)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewAttesterManager)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.PreviousPauser) > 0 {
-			i -= len(x.PreviousPauser)
-			copy(dAtA[i:], x.PreviousPauser)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousPauser)))
+		if len(x.PreviousAttesterManager) > 0 {
+			i -= len(x.PreviousAttesterManager)
+			copy(dAtA[i:], x.PreviousAttesterManager)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousAttesterManager)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -2620,7 +2825,7 @@ func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*PauserUpdated)
+		x := input.Message.Interface().(*AttesterManagerTransferStarted)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -2652,15 +2857,15 @@ func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauserUpdated: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttesterManagerTransferStarted: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauserUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttesterManagerTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPauser", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousAttesterManager", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2688,11 +2893,11 @@ func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousPauser = string(dAtA[iNdEx:postIndex])
+				x.PreviousAttesterManager = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewAttesterManager", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -2720,8 +2925,27 @@ func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewPauser = string(dAtA[iNdEx:postIndex])
+				x.NewAttesterManager = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+				}
+				x.ExpiresAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -2758,27 +2982,29 @@ func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_AttesterManagerUpdated                           protoreflect.MessageDescriptor
-	fd_AttesterManagerUpdated_previous_attester_manager protoreflect.FieldDescriptor
-	fd_AttesterManagerUpdated_new_attester_manager      protoreflect.FieldDescriptor
+	md_PauserTransferStarted                   protoreflect.MessageDescriptor
+	fd_PauserTransferStarted_previous_pauser   protoreflect.FieldDescriptor
+	fd_PauserTransferStarted_new_pauser        protoreflect.FieldDescriptor
+	fd_PauserTransferStarted_expires_at_height protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_AttesterManagerUpdated = File_circle_cctp_v1_events_proto.Messages().ByName("AttesterManagerUpdated")
-	fd_AttesterManagerUpdated_previous_attester_manager = md_AttesterManagerUpdated.Fields().ByName("previous_attester_manager")
-	fd_AttesterManagerUpdated_new_attester_manager = md_AttesterManagerUpdated.Fields().ByName("new_attester_manager")
+	md_PauserTransferStarted = File_circle_cctp_v1_events_proto.Messages().ByName("PauserTransferStarted")
+	fd_PauserTransferStarted_previous_pauser = md_PauserTransferStarted.Fields().ByName("previous_pauser")
+	fd_PauserTransferStarted_new_pauser = md_PauserTransferStarted.Fields().ByName("new_pauser")
+	fd_PauserTransferStarted_expires_at_height = md_PauserTransferStarted.Fields().ByName("expires_at_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_AttesterManagerUpdated)(nil)
+var _ protoreflect.Message = (*fastReflection_PauserTransferStarted)(nil)
 
-type fastReflection_AttesterManagerUpdated AttesterManagerUpdated
+type fastReflection_PauserTransferStarted PauserTransferStarted
 
-func (x *AttesterManagerUpdated) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_AttesterManagerUpdated)(x)
+func (x *PauserTransferStarted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PauserTransferStarted)(x)
 }
 
-func (x *AttesterManagerUpdated) slowProtoReflect() protoreflect.Message {
+func (x *PauserTransferStarted) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2790,43 +3016,43 @@ func (x *AttesterManagerUpdated) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_AttesterManagerUpdated_messageType fastReflection_AttesterManagerUpdated_messageType
-var _ protoreflect.MessageType = fastReflection_AttesterManagerUpdated_messageType{}
+var _fastReflection_PauserTransferStarted_messageType fastReflection_PauserTransferStarted_messageType
+var _ protoreflect.MessageType = fastReflection_PauserTransferStarted_messageType{}
 
-type fastReflection_AttesterManagerUpdated_messageType struct{}
+type fastReflection_PauserTransferStarted_messageType struct{}
 
-func (x fastReflection_AttesterManagerUpdated_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_AttesterManagerUpdated)(nil)
+func (x fastReflection_PauserTransferStarted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PauserTransferStarted)(nil)
 }
-func (x fastReflection_AttesterManagerUpdated_messageType) New() protoreflect.Message {
-	return new(fastReflection_AttesterManagerUpdated)
+func (x fastReflection_PauserTransferStarted_messageType) New() protoreflect.Message {
+	return new(fastReflection_PauserTransferStarted)
 }
-func (x fastReflection_AttesterManagerUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_AttesterManagerUpdated
+func (x fastReflection_PauserTransferStarted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauserTransferStarted
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_AttesterManagerUpdated) Descriptor() protoreflect.MessageDescriptor {
-	return md_AttesterManagerUpdated
+func (x *fastReflection_PauserTransferStarted) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauserTransferStarted
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_AttesterManagerUpdated) Type() protoreflect.MessageType {
-	return _fastReflection_AttesterManagerUpdated_messageType
+func (x *fastReflection_PauserTransferStarted) Type() protoreflect.MessageType {
+	return _fastReflection_PauserTransferStarted_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_AttesterManagerUpdated) New() protoreflect.Message {
-	return new(fastReflection_AttesterManagerUpdated)
+func (x *fastReflection_PauserTransferStarted) New() protoreflect.Message {
+	return new(fastReflection_PauserTransferStarted)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_AttesterManagerUpdated) Interface() protoreflect.ProtoMessage {
-	return (*AttesterManagerUpdated)(x)
+func (x *fastReflection_PauserTransferStarted) Interface() protoreflect.ProtoMessage {
+	return (*PauserTransferStarted)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -2834,16 +3060,22 @@ func (x *fastReflection_AttesterManagerUpdated) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_AttesterManagerUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.PreviousAttesterManager != "" {
-		value := protoreflect.ValueOfString(x.PreviousAttesterManager)
-		if !f(fd_AttesterManagerUpdated_previous_attester_manager, value) {
+func (x *fastReflection_PauserTransferStarted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PreviousPauser != "" {
+		value := protoreflect.ValueOfString(x.PreviousPauser)
+		if !f(fd_PauserTransferStarted_previous_pauser, value) {
 			return
 		}
 	}
-	if x.NewAttesterManager != "" {
-		value := protoreflect.ValueOfString(x.NewAttesterManager)
-		if !f(fd_AttesterManagerUpdated_new_attester_manager, value) {
+	if x.NewPauser != "" {
+		value := protoreflect.ValueOfString(x.NewPauser)
+		if !f(fd_PauserTransferStarted_new_pauser, value) {
+			return
+		}
+	}
+	if x.ExpiresAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAtHeight)
+		if !f(fd_PauserTransferStarted_expires_at_height, value) {
 			return
 		}
 	}
@@ -2860,17 +3092,19 @@ func (x *fastReflection_AttesterManagerUpdated) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_AttesterManagerUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PauserTransferStarted) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
-		return x.PreviousAttesterManager != ""
-	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
-		return x.NewAttesterManager != ""
+	case "circle.cctp.v1.PauserTransferStarted.previous_pauser":
+		return x.PreviousPauser != ""
+	case "circle.cctp.v1.PauserTransferStarted.new_pauser":
+		return x.NewPauser != ""
+	case "circle.cctp.v1.PauserTransferStarted.expires_at_height":
+		return x.ExpiresAtHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2880,17 +3114,19 @@ func (x *fastReflection_AttesterManagerUpdated) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AttesterManagerUpdated) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PauserTransferStarted) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
-		x.PreviousAttesterManager = ""
-	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
-		x.NewAttesterManager = ""
+	case "circle.cctp.v1.PauserTransferStarted.previous_pauser":
+		x.PreviousPauser = ""
+	case "circle.cctp.v1.PauserTransferStarted.new_pauser":
+		x.NewPauser = ""
+	case "circle.cctp.v1.PauserTransferStarted.expires_at_height":
+		x.ExpiresAtHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2900,19 +3136,22 @@ func (x *fastReflection_AttesterManagerUpdated) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_AttesterManagerUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauserTransferStarted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
-		value := x.PreviousAttesterManager
+	case "circle.cctp.v1.PauserTransferStarted.previous_pauser":
+		value := x.PreviousPauser
 		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
-		value := x.NewAttesterManager
+	case "circle.cctp.v1.PauserTransferStarted.new_pauser":
+		value := x.NewPauser
 		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.PauserTransferStarted.expires_at_height":
+		value := x.ExpiresAtHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserTransferStarted does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -2926,17 +3165,19 @@ func (x *fastReflection_AttesterManagerUpdated) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AttesterManagerUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PauserTransferStarted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
-		x.PreviousAttesterManager = value.Interface().(string)
-	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
-		x.NewAttesterManager = value.Interface().(string)
+	case "circle.cctp.v1.PauserTransferStarted.previous_pauser":
+		x.PreviousPauser = value.Interface().(string)
+	case "circle.cctp.v1.PauserTransferStarted.new_pauser":
+		x.NewPauser = value.Interface().(string)
+	case "circle.cctp.v1.PauserTransferStarted.expires_at_height":
+		x.ExpiresAtHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -2950,44 +3191,48 @@ func (x *fastReflection_AttesterManagerUpdated) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AttesterManagerUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauserTransferStarted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
-		panic(fmt.Errorf("field previous_attester_manager of message circle.cctp.v1.AttesterManagerUpdated is not mutable"))
-	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
-		panic(fmt.Errorf("field new_attester_manager of message circle.cctp.v1.AttesterManagerUpdated is not mutable"))
+	case "circle.cctp.v1.PauserTransferStarted.previous_pauser":
+		panic(fmt.Errorf("field previous_pauser of message circle.cctp.v1.PauserTransferStarted is not mutable"))
+	case "circle.cctp.v1.PauserTransferStarted.new_pauser":
+		panic(fmt.Errorf("field new_pauser of message circle.cctp.v1.PauserTransferStarted is not mutable"))
+	case "circle.cctp.v1.PauserTransferStarted.expires_at_height":
+		panic(fmt.Errorf("field expires_at_height of message circle.cctp.v1.PauserTransferStarted is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_AttesterManagerUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauserTransferStarted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
+	case "circle.cctp.v1.PauserTransferStarted.previous_pauser":
 		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
+	case "circle.cctp.v1.PauserTransferStarted.new_pauser":
 		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.PauserTransferStarted.expires_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_AttesterManagerUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PauserTransferStarted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.AttesterManagerUpdated", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.PauserTransferStarted", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -2995,7 +3240,7 @@ func (x *fastReflection_AttesterManagerUpdated) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_AttesterManagerUpdated) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PauserTransferStarted) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3006,7 +3251,7 @@ func (x *fastReflection_AttesterManagerUpdated) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_AttesterManagerUpdated) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PauserTransferStarted) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3018,7 +3263,7 @@ func (x *fastReflection_AttesterManagerUpdated) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_AttesterManagerUpdated) IsValid() bool {
+func (x *fastReflection_PauserTransferStarted) IsValid() bool {
 	return x != nil
 }
 
@@ -3028,9 +3273,9 @@ func (x *fastReflection_AttesterManagerUpdated) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PauserTransferStarted) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*AttesterManagerUpdated)
+		x := input.Message.Interface().(*PauserTransferStarted)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3042,14 +3287,17 @@ func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.PreviousAttesterManager)
+		l = len(x.PreviousPauser)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.NewAttesterManager)
+		l = len(x.NewPauser)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExpiresAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAtHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3060,7 +3308,7 @@ func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*AttesterManagerUpdated)
+		x := input.Message.Interface().(*PauserTransferStarted)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3079,17 +3327,22 @@ func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.NewAttesterManager) > 0 {
-			i -= len(x.NewAttesterManager)
-			copy(dAtA[i:], x.NewAttesterManager)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewAttesterManager)))
+		if x.ExpiresAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAtHeight))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.NewPauser) > 0 {
+			i -= len(x.NewPauser)
+			copy(dAtA[i:], x.NewPauser)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewPauser)))
 			i--
 			dAtA[i] = 0x12
 		}
-		if len(x.PreviousAttesterManager) > 0 {
-			i -= len(x.PreviousAttesterManager)
-			copy(dAtA[i:], x.PreviousAttesterManager)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousAttesterManager)))
+		if len(x.PreviousPauser) > 0 {
+			i -= len(x.PreviousPauser)
+			copy(dAtA[i:], x.PreviousPauser)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousPauser)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -3104,7 +3357,7 @@ func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*AttesterManagerUpdated)
+		x := input.Message.Interface().(*PauserTransferStarted)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3136,15 +3389,15 @@ func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttesterManagerUpdated: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauserTransferStarted: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttesterManagerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauserTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousAttesterManager", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPauser", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3172,11 +3425,11 @@ func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.PreviousAttesterManager = string(dAtA[iNdEx:postIndex])
+				x.PreviousPauser = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewAttesterManager", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -3204,8 +3457,27 @@ func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Metho
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.NewAttesterManager = string(dAtA[iNdEx:postIndex])
+				x.NewPauser = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+				}
+				x.ExpiresAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3242,27 +3514,29 @@ func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_TokenControllerUpdated                           protoreflect.MessageDescriptor
-	fd_TokenControllerUpdated_previous_token_controller protoreflect.FieldDescriptor
-	fd_TokenControllerUpdated_new_token_controller      protoreflect.FieldDescriptor
+	md_TokenControllerTransferStarted                           protoreflect.MessageDescriptor
+	fd_TokenControllerTransferStarted_previous_token_controller protoreflect.FieldDescriptor
+	fd_TokenControllerTransferStarted_new_token_controller      protoreflect.FieldDescriptor
+	fd_TokenControllerTransferStarted_expires_at_height         protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_TokenControllerUpdated = File_circle_cctp_v1_events_proto.Messages().ByName("TokenControllerUpdated")
-	fd_TokenControllerUpdated_previous_token_controller = md_TokenControllerUpdated.Fields().ByName("previous_token_controller")
-	fd_TokenControllerUpdated_new_token_controller = md_TokenControllerUpdated.Fields().ByName("new_token_controller")
+	md_TokenControllerTransferStarted = File_circle_cctp_v1_events_proto.Messages().ByName("TokenControllerTransferStarted")
+	fd_TokenControllerTransferStarted_previous_token_controller = md_TokenControllerTransferStarted.Fields().ByName("previous_token_controller")
+	fd_TokenControllerTransferStarted_new_token_controller = md_TokenControllerTransferStarted.Fields().ByName("new_token_controller")
+	fd_TokenControllerTransferStarted_expires_at_height = md_TokenControllerTransferStarted.Fields().ByName("expires_at_height")
 }
 
-var _ protoreflect.Message = (*fastReflection_TokenControllerUpdated)(nil)
+var _ protoreflect.Message = (*fastReflection_TokenControllerTransferStarted)(nil)
 
-type fastReflection_TokenControllerUpdated TokenControllerUpdated
+type fastReflection_TokenControllerTransferStarted TokenControllerTransferStarted
 
-func (x *TokenControllerUpdated) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TokenControllerUpdated)(x)
+func (x *TokenControllerTransferStarted) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenControllerTransferStarted)(x)
 }
 
-func (x *TokenControllerUpdated) slowProtoReflect() protoreflect.Message {
+func (x *TokenControllerTransferStarted) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3274,43 +3548,43 @@ func (x *TokenControllerUpdated) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TokenControllerUpdated_messageType fastReflection_TokenControllerUpdated_messageType
-var _ protoreflect.MessageType = fastReflection_TokenControllerUpdated_messageType{}
+var _fastReflection_TokenControllerTransferStarted_messageType fastReflection_TokenControllerTransferStarted_messageType
+var _ protoreflect.MessageType = fastReflection_TokenControllerTransferStarted_messageType{}
 
-type fastReflection_TokenControllerUpdated_messageType struct{}
+type fastReflection_TokenControllerTransferStarted_messageType struct{}
 
-func (x fastReflection_TokenControllerUpdated_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TokenControllerUpdated)(nil)
+func (x fastReflection_TokenControllerTransferStarted_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenControllerTransferStarted)(nil)
 }
-func (x fastReflection_TokenControllerUpdated_messageType) New() protoreflect.Message {
-	return new(fastReflection_TokenControllerUpdated)
+func (x fastReflection_TokenControllerTransferStarted_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenControllerTransferStarted)
 }
-func (x fastReflection_TokenControllerUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TokenControllerUpdated
+func (x fastReflection_TokenControllerTransferStarted_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenControllerTransferStarted
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TokenControllerUpdated) Descriptor() protoreflect.MessageDescriptor {
-	return md_TokenControllerUpdated
+func (x *fastReflection_TokenControllerTransferStarted) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenControllerTransferStarted
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TokenControllerUpdated) Type() protoreflect.MessageType {
-	return _fastReflection_TokenControllerUpdated_messageType
+func (x *fastReflection_TokenControllerTransferStarted) Type() protoreflect.MessageType {
+	return _fastReflection_TokenControllerTransferStarted_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TokenControllerUpdated) New() protoreflect.Message {
-	return new(fastReflection_TokenControllerUpdated)
+func (x *fastReflection_TokenControllerTransferStarted) New() protoreflect.Message {
+	return new(fastReflection_TokenControllerTransferStarted)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TokenControllerUpdated) Interface() protoreflect.ProtoMessage {
-	return (*TokenControllerUpdated)(x)
+func (x *fastReflection_TokenControllerTransferStarted) Interface() protoreflect.ProtoMessage {
+	return (*TokenControllerTransferStarted)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3318,16 +3592,22 @@ func (x *fastReflection_TokenControllerUpdated) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TokenControllerUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_TokenControllerTransferStarted) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 	if x.PreviousTokenController != "" {
 		value := protoreflect.ValueOfString(x.PreviousTokenController)
-		if !f(fd_TokenControllerUpdated_previous_token_controller, value) {
+		if !f(fd_TokenControllerTransferStarted_previous_token_controller, value) {
 			return
 		}
 	}
 	if x.NewTokenController != "" {
 		value := protoreflect.ValueOfString(x.NewTokenController)
-		if !f(fd_TokenControllerUpdated_new_token_controller, value) {
+		if !f(fd_TokenControllerTransferStarted_new_token_controller, value) {
+			return
+		}
+	}
+	if x.ExpiresAtHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.ExpiresAtHeight)
+		if !f(fd_TokenControllerTransferStarted_expires_at_height, value) {
 			return
 		}
 	}
@@ -3344,17 +3624,19 @@ func (x *fastReflection_TokenControllerUpdated) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TokenControllerUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TokenControllerTransferStarted) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.previous_token_controller":
 		return x.PreviousTokenController != ""
-	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.new_token_controller":
 		return x.NewTokenController != ""
+	case "circle.cctp.v1.TokenControllerTransferStarted.expires_at_height":
+		return x.ExpiresAtHeight != int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3364,17 +3646,19 @@ func (x *fastReflection_TokenControllerUpdated) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenControllerUpdated) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TokenControllerTransferStarted) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.previous_token_controller":
 		x.PreviousTokenController = ""
-	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.new_token_controller":
 		x.NewTokenController = ""
+	case "circle.cctp.v1.TokenControllerTransferStarted.expires_at_height":
+		x.ExpiresAtHeight = int64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3384,19 +3668,22 @@ func (x *fastReflection_TokenControllerUpdated) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TokenControllerUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenControllerTransferStarted) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.previous_token_controller":
 		value := x.PreviousTokenController
 		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.new_token_controller":
 		value := x.NewTokenController
 		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.TokenControllerTransferStarted.expires_at_height":
+		value := x.ExpiresAtHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerTransferStarted does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3410,17 +3697,19 @@ func (x *fastReflection_TokenControllerUpdated) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenControllerUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TokenControllerTransferStarted) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.previous_token_controller":
 		x.PreviousTokenController = value.Interface().(string)
-	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.new_token_controller":
 		x.NewTokenController = value.Interface().(string)
+	case "circle.cctp.v1.TokenControllerTransferStarted.expires_at_height":
+		x.ExpiresAtHeight = value.Int()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3434,44 +3723,48 @@ func (x *fastReflection_TokenControllerUpdated) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenControllerUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenControllerTransferStarted) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
-		panic(fmt.Errorf("field previous_token_controller of message circle.cctp.v1.TokenControllerUpdated is not mutable"))
-	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
-		panic(fmt.Errorf("field new_token_controller of message circle.cctp.v1.TokenControllerUpdated is not mutable"))
+	case "circle.cctp.v1.TokenControllerTransferStarted.previous_token_controller":
+		panic(fmt.Errorf("field previous_token_controller of message circle.cctp.v1.TokenControllerTransferStarted is not mutable"))
+	case "circle.cctp.v1.TokenControllerTransferStarted.new_token_controller":
+		panic(fmt.Errorf("field new_token_controller of message circle.cctp.v1.TokenControllerTransferStarted is not mutable"))
+	case "circle.cctp.v1.TokenControllerTransferStarted.expires_at_height":
+		panic(fmt.Errorf("field expires_at_height of message circle.cctp.v1.TokenControllerTransferStarted is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TokenControllerUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenControllerTransferStarted) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.previous_token_controller":
 		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+	case "circle.cctp.v1.TokenControllerTransferStarted.new_token_controller":
 		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.TokenControllerTransferStarted.expires_at_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerTransferStarted"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerTransferStarted does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TokenControllerUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TokenControllerTransferStarted) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.TokenControllerUpdated", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.TokenControllerTransferStarted", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3479,7 +3772,7 @@ func (x *fastReflection_TokenControllerUpdated) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TokenControllerUpdated) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TokenControllerTransferStarted) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3490,7 +3783,7 @@ func (x *fastReflection_TokenControllerUpdated) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenControllerUpdated) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TokenControllerTransferStarted) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3502,7 +3795,7 @@ func (x *fastReflection_TokenControllerUpdated) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TokenControllerUpdated) IsValid() bool {
+func (x *fastReflection_TokenControllerTransferStarted) IsValid() bool {
 	return x != nil
 }
 
@@ -3512,9 +3805,9 @@ func (x *fastReflection_TokenControllerUpdated) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TokenControllerTransferStarted) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TokenControllerUpdated)
+		x := input.Message.Interface().(*TokenControllerTransferStarted)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3534,6 +3827,9 @@ func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Metho
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.ExpiresAtHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExpiresAtHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3544,7 +3840,7 @@ func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TokenControllerUpdated)
+		x := input.Message.Interface().(*TokenControllerTransferStarted)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3563,6 +3859,11 @@ func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.ExpiresAtHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExpiresAtHeight))
+			i--
+			dAtA[i] = 0x18
+		}
 		if len(x.NewTokenController) > 0 {
 			i -= len(x.NewTokenController)
 			copy(dAtA[i:], x.NewTokenController)
@@ -3588,7 +3889,7 @@ func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TokenControllerUpdated)
+		x := input.Message.Interface().(*TokenControllerTransferStarted)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3620,10 +3921,10 @@ func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenControllerUpdated: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenControllerTransferStarted: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenControllerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenControllerTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
@@ -3690,6 +3991,25 @@ func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Metho
 				}
 				x.NewTokenController = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+				}
+				x.ExpiresAtHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExpiresAtHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -3726,23 +4046,27 @@ func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Metho
 }
 
 var (
-	md_BurningAndMintingPausedEvent protoreflect.MessageDescriptor
+	md_PauserUpdated                 protoreflect.MessageDescriptor
+	fd_PauserUpdated_previous_pauser protoreflect.FieldDescriptor
+	fd_PauserUpdated_new_pauser      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_BurningAndMintingPausedEvent = File_circle_cctp_v1_events_proto.Messages().ByName("BurningAndMintingPausedEvent")
+	md_PauserUpdated = File_circle_cctp_v1_events_proto.Messages().ByName("PauserUpdated")
+	fd_PauserUpdated_previous_pauser = md_PauserUpdated.Fields().ByName("previous_pauser")
+	fd_PauserUpdated_new_pauser = md_PauserUpdated.Fields().ByName("new_pauser")
 }
 
-var _ protoreflect.Message = (*fastReflection_BurningAndMintingPausedEvent)(nil)
+var _ protoreflect.Message = (*fastReflection_PauserUpdated)(nil)
 
-type fastReflection_BurningAndMintingPausedEvent BurningAndMintingPausedEvent
+type fastReflection_PauserUpdated PauserUpdated
 
-func (x *BurningAndMintingPausedEvent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BurningAndMintingPausedEvent)(x)
+func (x *PauserUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PauserUpdated)(x)
 }
 
-func (x *BurningAndMintingPausedEvent) slowProtoReflect() protoreflect.Message {
+func (x *PauserUpdated) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3754,43 +4078,43 @@ func (x *BurningAndMintingPausedEvent) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BurningAndMintingPausedEvent_messageType fastReflection_BurningAndMintingPausedEvent_messageType
-var _ protoreflect.MessageType = fastReflection_BurningAndMintingPausedEvent_messageType{}
+var _fastReflection_PauserUpdated_messageType fastReflection_PauserUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_PauserUpdated_messageType{}
 
-type fastReflection_BurningAndMintingPausedEvent_messageType struct{}
+type fastReflection_PauserUpdated_messageType struct{}
 
-func (x fastReflection_BurningAndMintingPausedEvent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BurningAndMintingPausedEvent)(nil)
+func (x fastReflection_PauserUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PauserUpdated)(nil)
 }
-func (x fastReflection_BurningAndMintingPausedEvent_messageType) New() protoreflect.Message {
-	return new(fastReflection_BurningAndMintingPausedEvent)
+func (x fastReflection_PauserUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_PauserUpdated)
 }
-func (x fastReflection_BurningAndMintingPausedEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BurningAndMintingPausedEvent
+func (x fastReflection_PauserUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauserUpdated
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BurningAndMintingPausedEvent) Descriptor() protoreflect.MessageDescriptor {
-	return md_BurningAndMintingPausedEvent
+func (x *fastReflection_PauserUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_PauserUpdated
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BurningAndMintingPausedEvent) Type() protoreflect.MessageType {
-	return _fastReflection_BurningAndMintingPausedEvent_messageType
+func (x *fastReflection_PauserUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_PauserUpdated_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BurningAndMintingPausedEvent) New() protoreflect.Message {
-	return new(fastReflection_BurningAndMintingPausedEvent)
+func (x *fastReflection_PauserUpdated) New() protoreflect.Message {
+	return new(fastReflection_PauserUpdated)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BurningAndMintingPausedEvent) Interface() protoreflect.ProtoMessage {
-	return (*BurningAndMintingPausedEvent)(x)
+func (x *fastReflection_PauserUpdated) Interface() protoreflect.ProtoMessage {
+	return (*PauserUpdated)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -3798,7 +4122,19 @@ func (x *fastReflection_BurningAndMintingPausedEvent) Interface() protoreflect.P
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BurningAndMintingPausedEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_PauserUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PreviousPauser != "" {
+		value := protoreflect.ValueOfString(x.PreviousPauser)
+		if !f(fd_PauserUpdated_previous_pauser, value) {
+			return
+		}
+	}
+	if x.NewPauser != "" {
+		value := protoreflect.ValueOfString(x.NewPauser)
+		if !f(fd_PauserUpdated_new_pauser, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -3812,13 +4148,17 @@ func (x *fastReflection_BurningAndMintingPausedEvent) Range(f func(protoreflect.
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BurningAndMintingPausedEvent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_PauserUpdated) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "circle.cctp.v1.PauserUpdated.previous_pauser":
+		return x.PreviousPauser != ""
+	case "circle.cctp.v1.PauserUpdated.new_pauser":
+		return x.NewPauser != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3828,13 +4168,17 @@ func (x *fastReflection_BurningAndMintingPausedEvent) Has(fd protoreflect.FieldD
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BurningAndMintingPausedEvent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_PauserUpdated) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "circle.cctp.v1.PauserUpdated.previous_pauser":
+		x.PreviousPauser = ""
+	case "circle.cctp.v1.PauserUpdated.new_pauser":
+		x.NewPauser = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3844,13 +4188,19 @@ func (x *fastReflection_BurningAndMintingPausedEvent) Clear(fd protoreflect.Fiel
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BurningAndMintingPausedEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauserUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "circle.cctp.v1.PauserUpdated.previous_pauser":
+		value := x.PreviousPauser
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.PauserUpdated.new_pauser":
+		value := x.NewPauser
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -3864,13 +4214,17 @@ func (x *fastReflection_BurningAndMintingPausedEvent) Get(descriptor protoreflec
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BurningAndMintingPausedEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_PauserUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "circle.cctp.v1.PauserUpdated.previous_pauser":
+		x.PreviousPauser = value.Interface().(string)
+	case "circle.cctp.v1.PauserUpdated.new_pauser":
+		x.NewPauser = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -3884,36 +4238,44 @@ func (x *fastReflection_BurningAndMintingPausedEvent) Set(fd protoreflect.FieldD
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BurningAndMintingPausedEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauserUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "circle.cctp.v1.PauserUpdated.previous_pauser":
+		panic(fmt.Errorf("field previous_pauser of message circle.cctp.v1.PauserUpdated is not mutable"))
+	case "circle.cctp.v1.PauserUpdated.new_pauser":
+		panic(fmt.Errorf("field new_pauser of message circle.cctp.v1.PauserUpdated is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BurningAndMintingPausedEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_PauserUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "circle.cctp.v1.PauserUpdated.previous_pauser":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.PauserUpdated.new_pauser":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.PauserUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.PauserUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BurningAndMintingPausedEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_PauserUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.BurningAndMintingPausedEvent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.PauserUpdated", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -3921,7 +4283,7 @@ func (x *fastReflection_BurningAndMintingPausedEvent) WhichOneof(d protoreflect.
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BurningAndMintingPausedEvent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_PauserUpdated) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -3932,7 +4294,7 @@ func (x *fastReflection_BurningAndMintingPausedEvent) GetUnknown() protoreflect.
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BurningAndMintingPausedEvent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_PauserUpdated) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -3944,7 +4306,7 @@ func (x *fastReflection_BurningAndMintingPausedEvent) SetUnknown(fields protoref
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BurningAndMintingPausedEvent) IsValid() bool {
+func (x *fastReflection_PauserUpdated) IsValid() bool {
 	return x != nil
 }
 
@@ -3954,9 +4316,9 @@ func (x *fastReflection_BurningAndMintingPausedEvent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BurningAndMintingPausedEvent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_PauserUpdated) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BurningAndMintingPausedEvent)
+		x := input.Message.Interface().(*PauserUpdated)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3968,6 +4330,14 @@ func (x *fastReflection_BurningAndMintingPausedEvent) ProtoMethods() *protoiface
 		var n int
 		var l int
 		_ = l
+		l = len(x.PreviousPauser)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewPauser)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -3978,7 +4348,7 @@ func (x *fastReflection_BurningAndMintingPausedEvent) ProtoMethods() *protoiface
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BurningAndMintingPausedEvent)
+		x := input.Message.Interface().(*PauserUpdated)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -3997,6 +4367,20 @@ func (x *fastReflection_BurningAndMintingPausedEvent) ProtoMethods() *protoiface
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NewPauser) > 0 {
+			i -= len(x.NewPauser)
+			copy(dAtA[i:], x.NewPauser)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewPauser)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PreviousPauser) > 0 {
+			i -= len(x.PreviousPauser)
+			copy(dAtA[i:], x.PreviousPauser)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousPauser)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4008,7 +4392,7 @@ func (x *fastReflection_BurningAndMintingPausedEvent) ProtoMethods() *protoiface
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BurningAndMintingPausedEvent)
+		x := input.Message.Interface().(*PauserUpdated)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4040,12 +4424,76 @@ func (x *fastReflection_BurningAndMintingPausedEvent) ProtoMethods() *protoiface
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurningAndMintingPausedEvent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauserUpdated: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurningAndMintingPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PauserUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousPauser", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousPauser = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewPauser = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4082,23 +4530,27 @@ func (x *fastReflection_BurningAndMintingPausedEvent) ProtoMethods() *protoiface
 }
 
 var (
-	md_BurningAndMintingUnpausedEvent protoreflect.MessageDescriptor
+	md_AttesterManagerUpdated                           protoreflect.MessageDescriptor
+	fd_AttesterManagerUpdated_previous_attester_manager protoreflect.FieldDescriptor
+	fd_AttesterManagerUpdated_new_attester_manager      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_BurningAndMintingUnpausedEvent = File_circle_cctp_v1_events_proto.Messages().ByName("BurningAndMintingUnpausedEvent")
+	md_AttesterManagerUpdated = File_circle_cctp_v1_events_proto.Messages().ByName("AttesterManagerUpdated")
+	fd_AttesterManagerUpdated_previous_attester_manager = md_AttesterManagerUpdated.Fields().ByName("previous_attester_manager")
+	fd_AttesterManagerUpdated_new_attester_manager = md_AttesterManagerUpdated.Fields().ByName("new_attester_manager")
 }
 
-var _ protoreflect.Message = (*fastReflection_BurningAndMintingUnpausedEvent)(nil)
+var _ protoreflect.Message = (*fastReflection_AttesterManagerUpdated)(nil)
 
-type fastReflection_BurningAndMintingUnpausedEvent BurningAndMintingUnpausedEvent
+type fastReflection_AttesterManagerUpdated AttesterManagerUpdated
 
-func (x *BurningAndMintingUnpausedEvent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_BurningAndMintingUnpausedEvent)(x)
+func (x *AttesterManagerUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_AttesterManagerUpdated)(x)
 }
 
-func (x *BurningAndMintingUnpausedEvent) slowProtoReflect() protoreflect.Message {
+func (x *AttesterManagerUpdated) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4110,43 +4562,43 @@ func (x *BurningAndMintingUnpausedEvent) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_BurningAndMintingUnpausedEvent_messageType fastReflection_BurningAndMintingUnpausedEvent_messageType
-var _ protoreflect.MessageType = fastReflection_BurningAndMintingUnpausedEvent_messageType{}
+var _fastReflection_AttesterManagerUpdated_messageType fastReflection_AttesterManagerUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_AttesterManagerUpdated_messageType{}
 
-type fastReflection_BurningAndMintingUnpausedEvent_messageType struct{}
+type fastReflection_AttesterManagerUpdated_messageType struct{}
 
-func (x fastReflection_BurningAndMintingUnpausedEvent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_BurningAndMintingUnpausedEvent)(nil)
+func (x fastReflection_AttesterManagerUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_AttesterManagerUpdated)(nil)
 }
-func (x fastReflection_BurningAndMintingUnpausedEvent_messageType) New() protoreflect.Message {
-	return new(fastReflection_BurningAndMintingUnpausedEvent)
+func (x fastReflection_AttesterManagerUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_AttesterManagerUpdated)
 }
-func (x fastReflection_BurningAndMintingUnpausedEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_BurningAndMintingUnpausedEvent
+func (x fastReflection_AttesterManagerUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttesterManagerUpdated
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Descriptor() protoreflect.MessageDescriptor {
-	return md_BurningAndMintingUnpausedEvent
+func (x *fastReflection_AttesterManagerUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_AttesterManagerUpdated
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Type() protoreflect.MessageType {
-	return _fastReflection_BurningAndMintingUnpausedEvent_messageType
+func (x *fastReflection_AttesterManagerUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_AttesterManagerUpdated_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) New() protoreflect.Message {
-	return new(fastReflection_BurningAndMintingUnpausedEvent)
+func (x *fastReflection_AttesterManagerUpdated) New() protoreflect.Message {
+	return new(fastReflection_AttesterManagerUpdated)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Interface() protoreflect.ProtoMessage {
-	return (*BurningAndMintingUnpausedEvent)(x)
+func (x *fastReflection_AttesterManagerUpdated) Interface() protoreflect.ProtoMessage {
+	return (*AttesterManagerUpdated)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4154,7 +4606,19 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_AttesterManagerUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PreviousAttesterManager != "" {
+		value := protoreflect.ValueOfString(x.PreviousAttesterManager)
+		if !f(fd_AttesterManagerUpdated_previous_attester_manager, value) {
+			return
+		}
+	}
+	if x.NewAttesterManager != "" {
+		value := protoreflect.ValueOfString(x.NewAttesterManager)
+		if !f(fd_AttesterManagerUpdated_new_attester_manager, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4168,13 +4632,17 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_AttesterManagerUpdated) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
+		return x.PreviousAttesterManager != ""
+	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
+		return x.NewAttesterManager != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4184,13 +4652,17 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_AttesterManagerUpdated) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
+		x.PreviousAttesterManager = ""
+	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
+		x.NewAttesterManager = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4200,13 +4672,19 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttesterManagerUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
+		value := x.PreviousAttesterManager
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
+		value := x.NewAttesterManager
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4220,13 +4698,17 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_AttesterManagerUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
+		x.PreviousAttesterManager = value.Interface().(string)
+	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
+		x.NewAttesterManager = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4240,36 +4722,44 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttesterManagerUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
+		panic(fmt.Errorf("field previous_attester_manager of message circle.cctp.v1.AttesterManagerUpdated is not mutable"))
+	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
+		panic(fmt.Errorf("field new_attester_manager of message circle.cctp.v1.AttesterManagerUpdated is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_AttesterManagerUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "circle.cctp.v1.AttesterManagerUpdated.previous_attester_manager":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.AttesterManagerUpdated.new_attester_manager":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.AttesterManagerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.AttesterManagerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_AttesterManagerUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.BurningAndMintingUnpausedEvent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.AttesterManagerUpdated", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4277,7 +4767,7 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_AttesterManagerUpdated) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4288,7 +4778,7 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_AttesterManagerUpdated) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4300,7 +4790,7 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) IsValid() bool {
+func (x *fastReflection_AttesterManagerUpdated) IsValid() bool {
 	return x != nil
 }
 
@@ -4310,9 +4800,9 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_BurningAndMintingUnpausedEvent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_AttesterManagerUpdated) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*BurningAndMintingUnpausedEvent)
+		x := input.Message.Interface().(*AttesterManagerUpdated)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4324,6 +4814,14 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
+		l = len(x.PreviousAttesterManager)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewAttesterManager)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4334,7 +4832,7 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*BurningAndMintingUnpausedEvent)
+		x := input.Message.Interface().(*AttesterManagerUpdated)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4353,6 +4851,20 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NewAttesterManager) > 0 {
+			i -= len(x.NewAttesterManager)
+			copy(dAtA[i:], x.NewAttesterManager)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewAttesterManager)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PreviousAttesterManager) > 0 {
+			i -= len(x.PreviousAttesterManager)
+			copy(dAtA[i:], x.PreviousAttesterManager)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousAttesterManager)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4364,7 +4876,7 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*BurningAndMintingUnpausedEvent)
+		x := input.Message.Interface().(*AttesterManagerUpdated)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4396,12 +4908,76 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurningAndMintingUnpausedEvent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttesterManagerUpdated: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurningAndMintingUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: AttesterManagerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousAttesterManager", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousAttesterManager = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewAttesterManager", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewAttesterManager = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4438,23 +5014,27 @@ func (x *fastReflection_BurningAndMintingUnpausedEvent) ProtoMethods() *protoifa
 }
 
 var (
-	md_SendingAndReceivingPausedEvent protoreflect.MessageDescriptor
+	md_TokenControllerUpdated                           protoreflect.MessageDescriptor
+	fd_TokenControllerUpdated_previous_token_controller protoreflect.FieldDescriptor
+	fd_TokenControllerUpdated_new_token_controller      protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_SendingAndReceivingPausedEvent = File_circle_cctp_v1_events_proto.Messages().ByName("SendingAndReceivingPausedEvent")
+	md_TokenControllerUpdated = File_circle_cctp_v1_events_proto.Messages().ByName("TokenControllerUpdated")
+	fd_TokenControllerUpdated_previous_token_controller = md_TokenControllerUpdated.Fields().ByName("previous_token_controller")
+	fd_TokenControllerUpdated_new_token_controller = md_TokenControllerUpdated.Fields().ByName("new_token_controller")
 }
 
-var _ protoreflect.Message = (*fastReflection_SendingAndReceivingPausedEvent)(nil)
+var _ protoreflect.Message = (*fastReflection_TokenControllerUpdated)(nil)
 
-type fastReflection_SendingAndReceivingPausedEvent SendingAndReceivingPausedEvent
+type fastReflection_TokenControllerUpdated TokenControllerUpdated
 
-func (x *SendingAndReceivingPausedEvent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SendingAndReceivingPausedEvent)(x)
+func (x *TokenControllerUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenControllerUpdated)(x)
 }
 
-func (x *SendingAndReceivingPausedEvent) slowProtoReflect() protoreflect.Message {
+func (x *TokenControllerUpdated) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4466,43 +5046,43 @@ func (x *SendingAndReceivingPausedEvent) slowProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SendingAndReceivingPausedEvent_messageType fastReflection_SendingAndReceivingPausedEvent_messageType
-var _ protoreflect.MessageType = fastReflection_SendingAndReceivingPausedEvent_messageType{}
+var _fastReflection_TokenControllerUpdated_messageType fastReflection_TokenControllerUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_TokenControllerUpdated_messageType{}
 
-type fastReflection_SendingAndReceivingPausedEvent_messageType struct{}
+type fastReflection_TokenControllerUpdated_messageType struct{}
 
-func (x fastReflection_SendingAndReceivingPausedEvent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SendingAndReceivingPausedEvent)(nil)
+func (x fastReflection_TokenControllerUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenControllerUpdated)(nil)
 }
-func (x fastReflection_SendingAndReceivingPausedEvent_messageType) New() protoreflect.Message {
-	return new(fastReflection_SendingAndReceivingPausedEvent)
+func (x fastReflection_TokenControllerUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenControllerUpdated)
 }
-func (x fastReflection_SendingAndReceivingPausedEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SendingAndReceivingPausedEvent
+func (x fastReflection_TokenControllerUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenControllerUpdated
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Descriptor() protoreflect.MessageDescriptor {
-	return md_SendingAndReceivingPausedEvent
+func (x *fastReflection_TokenControllerUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenControllerUpdated
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Type() protoreflect.MessageType {
-	return _fastReflection_SendingAndReceivingPausedEvent_messageType
+func (x *fastReflection_TokenControllerUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_TokenControllerUpdated_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SendingAndReceivingPausedEvent) New() protoreflect.Message {
-	return new(fastReflection_SendingAndReceivingPausedEvent)
+func (x *fastReflection_TokenControllerUpdated) New() protoreflect.Message {
+	return new(fastReflection_TokenControllerUpdated)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Interface() protoreflect.ProtoMessage {
-	return (*SendingAndReceivingPausedEvent)(x)
+func (x *fastReflection_TokenControllerUpdated) Interface() protoreflect.ProtoMessage {
+	return (*TokenControllerUpdated)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4510,7 +5090,19 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) Interface() protoreflect
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_TokenControllerUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.PreviousTokenController != "" {
+		value := protoreflect.ValueOfString(x.PreviousTokenController)
+		if !f(fd_TokenControllerUpdated_previous_token_controller, value) {
+			return
+		}
+	}
+	if x.NewTokenController != "" {
+		value := protoreflect.ValueOfString(x.NewTokenController)
+		if !f(fd_TokenControllerUpdated_new_token_controller, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4524,13 +5116,17 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) Range(f func(protoreflec
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TokenControllerUpdated) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
+	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+		return x.PreviousTokenController != ""
+	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+		return x.NewTokenController != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4540,13 +5136,17 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) Has(fd protoreflect.Fiel
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TokenControllerUpdated) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
+	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+		x.PreviousTokenController = ""
+	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+		x.NewTokenController = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4556,13 +5156,19 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) Clear(fd protoreflect.Fi
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenControllerUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
+	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+		value := x.PreviousTokenController
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+		value := x.NewTokenController
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4576,13 +5182,17 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) Get(descriptor protorefl
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TokenControllerUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
+	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+		x.PreviousTokenController = value.Interface().(string)
+	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+		x.NewTokenController = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4596,36 +5206,44 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) Set(fd protoreflect.Fiel
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendingAndReceivingPausedEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenControllerUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+		panic(fmt.Errorf("field previous_token_controller of message circle.cctp.v1.TokenControllerUpdated is not mutable"))
+	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+		panic(fmt.Errorf("field new_token_controller of message circle.cctp.v1.TokenControllerUpdated is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SendingAndReceivingPausedEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenControllerUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "circle.cctp.v1.TokenControllerUpdated.previous_token_controller":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.TokenControllerUpdated.new_token_controller":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenControllerUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenControllerUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SendingAndReceivingPausedEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TokenControllerUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.SendingAndReceivingPausedEvent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.TokenControllerUpdated", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4633,7 +5251,7 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) WhichOneof(d protoreflec
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SendingAndReceivingPausedEvent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TokenControllerUpdated) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -4644,7 +5262,7 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) GetUnknown() protoreflec
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendingAndReceivingPausedEvent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TokenControllerUpdated) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -4656,7 +5274,7 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) SetUnknown(fields protor
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SendingAndReceivingPausedEvent) IsValid() bool {
+func (x *fastReflection_TokenControllerUpdated) IsValid() bool {
 	return x != nil
 }
 
@@ -4666,9 +5284,9 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SendingAndReceivingPausedEvent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TokenControllerUpdated) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SendingAndReceivingPausedEvent)
+		x := input.Message.Interface().(*TokenControllerUpdated)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4680,6 +5298,14 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) ProtoMethods() *protoifa
 		var n int
 		var l int
 		_ = l
+		l = len(x.PreviousTokenController)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.NewTokenController)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -4690,7 +5316,7 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) ProtoMethods() *protoifa
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SendingAndReceivingPausedEvent)
+		x := input.Message.Interface().(*TokenControllerUpdated)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4709,6 +5335,20 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) ProtoMethods() *protoifa
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.NewTokenController) > 0 {
+			i -= len(x.NewTokenController)
+			copy(dAtA[i:], x.NewTokenController)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.NewTokenController)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.PreviousTokenController) > 0 {
+			i -= len(x.PreviousTokenController)
+			copy(dAtA[i:], x.PreviousTokenController)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PreviousTokenController)))
+			i--
+			dAtA[i] = 0xa
+		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -4720,7 +5360,7 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) ProtoMethods() *protoifa
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SendingAndReceivingPausedEvent)
+		x := input.Message.Interface().(*TokenControllerUpdated)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -4752,12 +5392,76 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) ProtoMethods() *protoifa
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendingAndReceivingPausedEvent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenControllerUpdated: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendingAndReceivingPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenControllerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PreviousTokenController", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PreviousTokenController = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewTokenController", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.NewTokenController = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -4794,23 +5498,23 @@ func (x *fastReflection_SendingAndReceivingPausedEvent) ProtoMethods() *protoifa
 }
 
 var (
-	md_SendingAndReceivingUnpausedEvent protoreflect.MessageDescriptor
+	md_BurningAndMintingPausedEvent protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_SendingAndReceivingUnpausedEvent = File_circle_cctp_v1_events_proto.Messages().ByName("SendingAndReceivingUnpausedEvent")
+	md_BurningAndMintingPausedEvent = File_circle_cctp_v1_events_proto.Messages().ByName("BurningAndMintingPausedEvent")
 }
 
-var _ protoreflect.Message = (*fastReflection_SendingAndReceivingUnpausedEvent)(nil)
+var _ protoreflect.Message = (*fastReflection_BurningAndMintingPausedEvent)(nil)
 
-type fastReflection_SendingAndReceivingUnpausedEvent SendingAndReceivingUnpausedEvent
+type fastReflection_BurningAndMintingPausedEvent BurningAndMintingPausedEvent
 
-func (x *SendingAndReceivingUnpausedEvent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SendingAndReceivingUnpausedEvent)(x)
+func (x *BurningAndMintingPausedEvent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BurningAndMintingPausedEvent)(x)
 }
 
-func (x *SendingAndReceivingUnpausedEvent) slowProtoReflect() protoreflect.Message {
+func (x *BurningAndMintingPausedEvent) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4822,43 +5526,43 @@ func (x *SendingAndReceivingUnpausedEvent) slowProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SendingAndReceivingUnpausedEvent_messageType fastReflection_SendingAndReceivingUnpausedEvent_messageType
-var _ protoreflect.MessageType = fastReflection_SendingAndReceivingUnpausedEvent_messageType{}
+var _fastReflection_BurningAndMintingPausedEvent_messageType fastReflection_BurningAndMintingPausedEvent_messageType
+var _ protoreflect.MessageType = fastReflection_BurningAndMintingPausedEvent_messageType{}
 
-type fastReflection_SendingAndReceivingUnpausedEvent_messageType struct{}
+type fastReflection_BurningAndMintingPausedEvent_messageType struct{}
 
-func (x fastReflection_SendingAndReceivingUnpausedEvent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SendingAndReceivingUnpausedEvent)(nil)
+func (x fastReflection_BurningAndMintingPausedEvent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BurningAndMintingPausedEvent)(nil)
 }
-func (x fastReflection_SendingAndReceivingUnpausedEvent_messageType) New() protoreflect.Message {
-	return new(fastReflection_SendingAndReceivingUnpausedEvent)
+func (x fastReflection_BurningAndMintingPausedEvent_messageType) New() protoreflect.Message {
+	return new(fastReflection_BurningAndMintingPausedEvent)
 }
-func (x fastReflection_SendingAndReceivingUnpausedEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SendingAndReceivingUnpausedEvent
+func (x fastReflection_BurningAndMintingPausedEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BurningAndMintingPausedEvent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Descriptor() protoreflect.MessageDescriptor {
-	return md_SendingAndReceivingUnpausedEvent
+func (x *fastReflection_BurningAndMintingPausedEvent) Descriptor() protoreflect.MessageDescriptor {
+	return md_BurningAndMintingPausedEvent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Type() protoreflect.MessageType {
-	return _fastReflection_SendingAndReceivingUnpausedEvent_messageType
+func (x *fastReflection_BurningAndMintingPausedEvent) Type() protoreflect.MessageType {
+	return _fastReflection_BurningAndMintingPausedEvent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) New() protoreflect.Message {
-	return new(fastReflection_SendingAndReceivingUnpausedEvent)
+func (x *fastReflection_BurningAndMintingPausedEvent) New() protoreflect.Message {
+	return new(fastReflection_BurningAndMintingPausedEvent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Interface() protoreflect.ProtoMessage {
-	return (*SendingAndReceivingUnpausedEvent)(x)
+func (x *fastReflection_BurningAndMintingPausedEvent) Interface() protoreflect.ProtoMessage {
+	return (*BurningAndMintingPausedEvent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -4866,7 +5570,7 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) Interface() protorefle
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+func (x *fastReflection_BurningAndMintingPausedEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -4880,13 +5584,13 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) Range(f func(protorefl
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BurningAndMintingPausedEvent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4896,13 +5600,13 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) Has(fd protoreflect.Fi
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BurningAndMintingPausedEvent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4912,13 +5616,13 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) Clear(fd protoreflect.
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurningAndMintingPausedEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -4932,13 +5636,13 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) Get(descriptor protore
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BurningAndMintingPausedEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -4952,36 +5656,36 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) Set(fd protoreflect.Fi
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurningAndMintingPausedEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurningAndMintingPausedEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BurningAndMintingPausedEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.SendingAndReceivingUnpausedEvent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.BurningAndMintingPausedEvent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -4989,7 +5693,7 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) WhichOneof(d protorefl
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BurningAndMintingPausedEvent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5000,7 +5704,7 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) GetUnknown() protorefl
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BurningAndMintingPausedEvent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5012,7 +5716,7 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) SetUnknown(fields prot
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) IsValid() bool {
+func (x *fastReflection_BurningAndMintingPausedEvent) IsValid() bool {
 	return x != nil
 }
 
@@ -5022,9 +5726,9 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SendingAndReceivingUnpausedEvent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BurningAndMintingPausedEvent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SendingAndReceivingUnpausedEvent)
+		x := input.Message.Interface().(*BurningAndMintingPausedEvent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5046,7 +5750,7 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) ProtoMethods() *protoi
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SendingAndReceivingUnpausedEvent)
+		x := input.Message.Interface().(*BurningAndMintingPausedEvent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5076,7 +5780,7 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) ProtoMethods() *protoi
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SendingAndReceivingUnpausedEvent)
+		x := input.Message.Interface().(*BurningAndMintingPausedEvent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5108,10 +5812,10 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) ProtoMethods() *protoi
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendingAndReceivingUnpausedEvent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurningAndMintingPausedEvent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendingAndReceivingUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurningAndMintingPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			default:
@@ -5150,39 +5854,23 @@ func (x *fastReflection_SendingAndReceivingUnpausedEvent) ProtoMethods() *protoi
 }
 
 var (
-	md_DepositForBurn                             protoreflect.MessageDescriptor
-	fd_DepositForBurn_nonce                       protoreflect.FieldDescriptor
-	fd_DepositForBurn_burn_token                  protoreflect.FieldDescriptor
-	fd_DepositForBurn_amount                      protoreflect.FieldDescriptor
-	fd_DepositForBurn_depositor                   protoreflect.FieldDescriptor
-	fd_DepositForBurn_mint_recipient              protoreflect.FieldDescriptor
-	fd_DepositForBurn_destination_domain          protoreflect.FieldDescriptor
-	fd_DepositForBurn_destination_token_messenger protoreflect.FieldDescriptor
-	fd_DepositForBurn_destination_caller          protoreflect.FieldDescriptor
+	md_BurningAndMintingUnpausedEvent protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_DepositForBurn = File_circle_cctp_v1_events_proto.Messages().ByName("DepositForBurn")
-	fd_DepositForBurn_nonce = md_DepositForBurn.Fields().ByName("nonce")
-	fd_DepositForBurn_burn_token = md_DepositForBurn.Fields().ByName("burn_token")
-	fd_DepositForBurn_amount = md_DepositForBurn.Fields().ByName("amount")
-	fd_DepositForBurn_depositor = md_DepositForBurn.Fields().ByName("depositor")
-	fd_DepositForBurn_mint_recipient = md_DepositForBurn.Fields().ByName("mint_recipient")
-	fd_DepositForBurn_destination_domain = md_DepositForBurn.Fields().ByName("destination_domain")
-	fd_DepositForBurn_destination_token_messenger = md_DepositForBurn.Fields().ByName("destination_token_messenger")
-	fd_DepositForBurn_destination_caller = md_DepositForBurn.Fields().ByName("destination_caller")
+	md_BurningAndMintingUnpausedEvent = File_circle_cctp_v1_events_proto.Messages().ByName("BurningAndMintingUnpausedEvent")
 }
 
-var _ protoreflect.Message = (*fastReflection_DepositForBurn)(nil)
+var _ protoreflect.Message = (*fastReflection_BurningAndMintingUnpausedEvent)(nil)
 
-type fastReflection_DepositForBurn DepositForBurn
+type fastReflection_BurningAndMintingUnpausedEvent BurningAndMintingUnpausedEvent
 
-func (x *DepositForBurn) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_DepositForBurn)(x)
+func (x *BurningAndMintingUnpausedEvent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_BurningAndMintingUnpausedEvent)(x)
 }
 
-func (x *DepositForBurn) slowProtoReflect() protoreflect.Message {
+func (x *BurningAndMintingUnpausedEvent) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5194,43 +5882,43 @@ func (x *DepositForBurn) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_DepositForBurn_messageType fastReflection_DepositForBurn_messageType
-var _ protoreflect.MessageType = fastReflection_DepositForBurn_messageType{}
+var _fastReflection_BurningAndMintingUnpausedEvent_messageType fastReflection_BurningAndMintingUnpausedEvent_messageType
+var _ protoreflect.MessageType = fastReflection_BurningAndMintingUnpausedEvent_messageType{}
 
-type fastReflection_DepositForBurn_messageType struct{}
+type fastReflection_BurningAndMintingUnpausedEvent_messageType struct{}
 
-func (x fastReflection_DepositForBurn_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_DepositForBurn)(nil)
+func (x fastReflection_BurningAndMintingUnpausedEvent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_BurningAndMintingUnpausedEvent)(nil)
 }
-func (x fastReflection_DepositForBurn_messageType) New() protoreflect.Message {
-	return new(fastReflection_DepositForBurn)
+func (x fastReflection_BurningAndMintingUnpausedEvent_messageType) New() protoreflect.Message {
+	return new(fastReflection_BurningAndMintingUnpausedEvent)
 }
-func (x fastReflection_DepositForBurn_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_DepositForBurn
+func (x fastReflection_BurningAndMintingUnpausedEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_BurningAndMintingUnpausedEvent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_DepositForBurn) Descriptor() protoreflect.MessageDescriptor {
-	return md_DepositForBurn
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Descriptor() protoreflect.MessageDescriptor {
+	return md_BurningAndMintingUnpausedEvent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_DepositForBurn) Type() protoreflect.MessageType {
-	return _fastReflection_DepositForBurn_messageType
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Type() protoreflect.MessageType {
+	return _fastReflection_BurningAndMintingUnpausedEvent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_DepositForBurn) New() protoreflect.Message {
-	return new(fastReflection_DepositForBurn)
+func (x *fastReflection_BurningAndMintingUnpausedEvent) New() protoreflect.Message {
+	return new(fastReflection_BurningAndMintingUnpausedEvent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_DepositForBurn) Interface() protoreflect.ProtoMessage {
-	return (*DepositForBurn)(x)
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Interface() protoreflect.ProtoMessage {
+	return (*BurningAndMintingUnpausedEvent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -5238,55 +5926,7 @@ func (x *fastReflection_DepositForBurn) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_DepositForBurn) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Nonce != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_DepositForBurn_nonce, value) {
-			return
-		}
-	}
-	if x.BurnToken != "" {
-		value := protoreflect.ValueOfString(x.BurnToken)
-		if !f(fd_DepositForBurn_burn_token, value) {
-			return
-		}
-	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_DepositForBurn_amount, value) {
-			return
-		}
-	}
-	if x.Depositor != "" {
-		value := protoreflect.ValueOfString(x.Depositor)
-		if !f(fd_DepositForBurn_depositor, value) {
-			return
-		}
-	}
-	if len(x.MintRecipient) != 0 {
-		value := protoreflect.ValueOfBytes(x.MintRecipient)
-		if !f(fd_DepositForBurn_mint_recipient, value) {
-			return
-		}
-	}
-	if x.DestinationDomain != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.DestinationDomain)
-		if !f(fd_DepositForBurn_destination_domain, value) {
-			return
-		}
-	}
-	if len(x.DestinationTokenMessenger) != 0 {
-		value := protoreflect.ValueOfBytes(x.DestinationTokenMessenger)
-		if !f(fd_DepositForBurn_destination_token_messenger, value) {
-			return
-		}
-	}
-	if len(x.DestinationCaller) != 0 {
-		value := protoreflect.ValueOfBytes(x.DestinationCaller)
-		if !f(fd_DepositForBurn_destination_caller, value) {
-			return
-		}
-	}
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -5300,29 +5940,13 @@ func (x *fastReflection_DepositForBurn) Range(f func(protoreflect.FieldDescripto
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_DepositForBurn) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.DepositForBurn.nonce":
-		return x.Nonce != uint64(0)
-	case "circle.cctp.v1.DepositForBurn.burn_token":
-		return x.BurnToken != ""
-	case "circle.cctp.v1.DepositForBurn.amount":
-		return x.Amount != ""
-	case "circle.cctp.v1.DepositForBurn.depositor":
-		return x.Depositor != ""
-	case "circle.cctp.v1.DepositForBurn.mint_recipient":
-		return len(x.MintRecipient) != 0
-	case "circle.cctp.v1.DepositForBurn.destination_domain":
-		return x.DestinationDomain != uint32(0)
-	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
-		return len(x.DestinationTokenMessenger) != 0
-	case "circle.cctp.v1.DepositForBurn.destination_caller":
-		return len(x.DestinationCaller) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5332,29 +5956,13 @@ func (x *fastReflection_DepositForBurn) Has(fd protoreflect.FieldDescriptor) boo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositForBurn) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.DepositForBurn.nonce":
-		x.Nonce = uint64(0)
-	case "circle.cctp.v1.DepositForBurn.burn_token":
-		x.BurnToken = ""
-	case "circle.cctp.v1.DepositForBurn.amount":
-		x.Amount = ""
-	case "circle.cctp.v1.DepositForBurn.depositor":
-		x.Depositor = ""
-	case "circle.cctp.v1.DepositForBurn.mint_recipient":
-		x.MintRecipient = nil
-	case "circle.cctp.v1.DepositForBurn.destination_domain":
-		x.DestinationDomain = uint32(0)
-	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
-		x.DestinationTokenMessenger = nil
-	case "circle.cctp.v1.DepositForBurn.destination_caller":
-		x.DestinationCaller = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5364,37 +5972,13 @@ func (x *fastReflection_DepositForBurn) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_DepositForBurn) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.DepositForBurn.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfUint64(value)
-	case "circle.cctp.v1.DepositForBurn.burn_token":
-		value := x.BurnToken
-		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.DepositForBurn.amount":
-		value := x.Amount
-		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.DepositForBurn.depositor":
-		value := x.Depositor
-		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.DepositForBurn.mint_recipient":
-		value := x.MintRecipient
-		return protoreflect.ValueOfBytes(value)
-	case "circle.cctp.v1.DepositForBurn.destination_domain":
-		value := x.DestinationDomain
-		return protoreflect.ValueOfUint32(value)
-	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
-		value := x.DestinationTokenMessenger
-		return protoreflect.ValueOfBytes(value)
-	case "circle.cctp.v1.DepositForBurn.destination_caller":
-		value := x.DestinationCaller
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -5408,29 +5992,13 @@ func (x *fastReflection_DepositForBurn) Get(descriptor protoreflect.FieldDescrip
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositForBurn) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.DepositForBurn.nonce":
-		x.Nonce = value.Uint()
-	case "circle.cctp.v1.DepositForBurn.burn_token":
-		x.BurnToken = value.Interface().(string)
-	case "circle.cctp.v1.DepositForBurn.amount":
-		x.Amount = value.Interface().(string)
-	case "circle.cctp.v1.DepositForBurn.depositor":
-		x.Depositor = value.Interface().(string)
-	case "circle.cctp.v1.DepositForBurn.mint_recipient":
-		x.MintRecipient = value.Bytes()
-	case "circle.cctp.v1.DepositForBurn.destination_domain":
-		x.DestinationDomain = uint32(value.Uint())
-	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
-		x.DestinationTokenMessenger = value.Bytes()
-	case "circle.cctp.v1.DepositForBurn.destination_caller":
-		x.DestinationCaller = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -5444,68 +6012,36 @@ func (x *fastReflection_DepositForBurn) Set(fd protoreflect.FieldDescriptor, val
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositForBurn) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.DepositForBurn.nonce":
-		panic(fmt.Errorf("field nonce of message circle.cctp.v1.DepositForBurn is not mutable"))
-	case "circle.cctp.v1.DepositForBurn.burn_token":
-		panic(fmt.Errorf("field burn_token of message circle.cctp.v1.DepositForBurn is not mutable"))
-	case "circle.cctp.v1.DepositForBurn.amount":
-		panic(fmt.Errorf("field amount of message circle.cctp.v1.DepositForBurn is not mutable"))
-	case "circle.cctp.v1.DepositForBurn.depositor":
-		panic(fmt.Errorf("field depositor of message circle.cctp.v1.DepositForBurn is not mutable"))
-	case "circle.cctp.v1.DepositForBurn.mint_recipient":
-		panic(fmt.Errorf("field mint_recipient of message circle.cctp.v1.DepositForBurn is not mutable"))
-	case "circle.cctp.v1.DepositForBurn.destination_domain":
-		panic(fmt.Errorf("field destination_domain of message circle.cctp.v1.DepositForBurn is not mutable"))
-	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
-		panic(fmt.Errorf("field destination_token_messenger of message circle.cctp.v1.DepositForBurn is not mutable"))
-	case "circle.cctp.v1.DepositForBurn.destination_caller":
-		panic(fmt.Errorf("field destination_caller of message circle.cctp.v1.DepositForBurn is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_DepositForBurn) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.DepositForBurn.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "circle.cctp.v1.DepositForBurn.burn_token":
-		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.DepositForBurn.amount":
-		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.DepositForBurn.depositor":
-		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.DepositForBurn.mint_recipient":
-		return protoreflect.ValueOfBytes(nil)
-	case "circle.cctp.v1.DepositForBurn.destination_domain":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
-		return protoreflect.ValueOfBytes(nil)
-	case "circle.cctp.v1.DepositForBurn.destination_caller":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.BurningAndMintingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.BurningAndMintingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_DepositForBurn) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.DepositForBurn", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.BurningAndMintingUnpausedEvent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -5513,7 +6049,7 @@ func (x *fastReflection_DepositForBurn) WhichOneof(d protoreflect.OneofDescripto
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_DepositForBurn) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -5524,7 +6060,7 @@ func (x *fastReflection_DepositForBurn) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_DepositForBurn) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -5536,7 +6072,7 @@ func (x *fastReflection_DepositForBurn) SetUnknown(fields protoreflect.RawFields
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_DepositForBurn) IsValid() bool {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) IsValid() bool {
 	return x != nil
 }
 
@@ -5546,9 +6082,9 @@ func (x *fastReflection_DepositForBurn) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_DepositForBurn) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_BurningAndMintingUnpausedEvent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*DepositForBurn)
+		x := input.Message.Interface().(*BurningAndMintingUnpausedEvent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5560,36 +6096,6 @@ func (x *fastReflection_DepositForBurn) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		if x.Nonce != 0 {
-			n += 1 + runtime.Sov(uint64(x.Nonce))
-		}
-		l = len(x.BurnToken)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Amount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Depositor)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MintRecipient)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.DestinationDomain != 0 {
-			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
-		}
-		l = len(x.DestinationTokenMessenger)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.DestinationCaller)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5600,7 +6106,7 @@ func (x *fastReflection_DepositForBurn) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*DepositForBurn)
+		x := input.Message.Interface().(*BurningAndMintingUnpausedEvent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5619,58 +6125,6 @@ func (x *fastReflection_DepositForBurn) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.DestinationCaller) > 0 {
-			i -= len(x.DestinationCaller)
-			copy(dAtA[i:], x.DestinationCaller)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationCaller)))
-			i--
-			dAtA[i] = 0x42
-		}
-		if len(x.DestinationTokenMessenger) > 0 {
-			i -= len(x.DestinationTokenMessenger)
-			copy(dAtA[i:], x.DestinationTokenMessenger)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationTokenMessenger)))
-			i--
-			dAtA[i] = 0x3a
-		}
-		if x.DestinationDomain != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
-			i--
-			dAtA[i] = 0x30
-		}
-		if len(x.MintRecipient) > 0 {
-			i -= len(x.MintRecipient)
-			copy(dAtA[i:], x.MintRecipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintRecipient)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Depositor) > 0 {
-			i -= len(x.Depositor)
-			copy(dAtA[i:], x.Depositor)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.BurnToken) > 0 {
-			i -= len(x.BurnToken)
-			copy(dAtA[i:], x.BurnToken)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnToken)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
-			i--
-			dAtA[i] = 0x8
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -5682,7 +6136,7 @@ func (x *fastReflection_DepositForBurn) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*DepositForBurn)
+		x := input.Message.Interface().(*BurningAndMintingUnpausedEvent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -5714,262 +6168,26 @@ func (x *fastReflection_DepositForBurn) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositForBurn: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurningAndMintingUnpausedEvent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: BurningAndMintingUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-				}
-				x.Nonce = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnToken", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BurnToken = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if postIndex > l {
+				if (iNdEx + skippy) > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Depositor = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 5:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MintRecipient = append(x.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-				if x.MintRecipient == nil {
-					x.MintRecipient = []byte{}
-				}
-				iNdEx = postIndex
-			case 6:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
-				}
-				x.DestinationDomain = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.DestinationDomain |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 7:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationTokenMessenger", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DestinationTokenMessenger = append(x.DestinationTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
-				if x.DestinationTokenMessenger == nil {
-					x.DestinationTokenMessenger = []byte{}
-				}
-				iNdEx = postIndex
-			case 8:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.DestinationCaller = append(x.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-				if x.DestinationCaller == nil {
-					x.DestinationCaller = []byte{}
-				}
-				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
 				}
 				iNdEx += skippy
 			}
@@ -5992,29 +6210,23 @@ func (x *fastReflection_DepositForBurn) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MintAndWithdraw                protoreflect.MessageDescriptor
-	fd_MintAndWithdraw_mint_recipient protoreflect.FieldDescriptor
-	fd_MintAndWithdraw_amount         protoreflect.FieldDescriptor
-	fd_MintAndWithdraw_mint_token     protoreflect.FieldDescriptor
+	md_SendingAndReceivingPausedEvent protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_MintAndWithdraw = File_circle_cctp_v1_events_proto.Messages().ByName("MintAndWithdraw")
-	fd_MintAndWithdraw_mint_recipient = md_MintAndWithdraw.Fields().ByName("mint_recipient")
-	fd_MintAndWithdraw_amount = md_MintAndWithdraw.Fields().ByName("amount")
-	fd_MintAndWithdraw_mint_token = md_MintAndWithdraw.Fields().ByName("mint_token")
+	md_SendingAndReceivingPausedEvent = File_circle_cctp_v1_events_proto.Messages().ByName("SendingAndReceivingPausedEvent")
 }
 
-var _ protoreflect.Message = (*fastReflection_MintAndWithdraw)(nil)
+var _ protoreflect.Message = (*fastReflection_SendingAndReceivingPausedEvent)(nil)
 
-type fastReflection_MintAndWithdraw MintAndWithdraw
+type fastReflection_SendingAndReceivingPausedEvent SendingAndReceivingPausedEvent
 
-func (x *MintAndWithdraw) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MintAndWithdraw)(x)
+func (x *SendingAndReceivingPausedEvent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SendingAndReceivingPausedEvent)(x)
 }
 
-func (x *MintAndWithdraw) slowProtoReflect() protoreflect.Message {
+func (x *SendingAndReceivingPausedEvent) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6026,43 +6238,43 @@ func (x *MintAndWithdraw) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MintAndWithdraw_messageType fastReflection_MintAndWithdraw_messageType
-var _ protoreflect.MessageType = fastReflection_MintAndWithdraw_messageType{}
+var _fastReflection_SendingAndReceivingPausedEvent_messageType fastReflection_SendingAndReceivingPausedEvent_messageType
+var _ protoreflect.MessageType = fastReflection_SendingAndReceivingPausedEvent_messageType{}
 
-type fastReflection_MintAndWithdraw_messageType struct{}
+type fastReflection_SendingAndReceivingPausedEvent_messageType struct{}
 
-func (x fastReflection_MintAndWithdraw_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MintAndWithdraw)(nil)
+func (x fastReflection_SendingAndReceivingPausedEvent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SendingAndReceivingPausedEvent)(nil)
 }
-func (x fastReflection_MintAndWithdraw_messageType) New() protoreflect.Message {
-	return new(fastReflection_MintAndWithdraw)
+func (x fastReflection_SendingAndReceivingPausedEvent_messageType) New() protoreflect.Message {
+	return new(fastReflection_SendingAndReceivingPausedEvent)
 }
-func (x fastReflection_MintAndWithdraw_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MintAndWithdraw
+func (x fastReflection_SendingAndReceivingPausedEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SendingAndReceivingPausedEvent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MintAndWithdraw) Descriptor() protoreflect.MessageDescriptor {
-	return md_MintAndWithdraw
+func (x *fastReflection_SendingAndReceivingPausedEvent) Descriptor() protoreflect.MessageDescriptor {
+	return md_SendingAndReceivingPausedEvent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MintAndWithdraw) Type() protoreflect.MessageType {
-	return _fastReflection_MintAndWithdraw_messageType
+func (x *fastReflection_SendingAndReceivingPausedEvent) Type() protoreflect.MessageType {
+	return _fastReflection_SendingAndReceivingPausedEvent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MintAndWithdraw) New() protoreflect.Message {
-	return new(fastReflection_MintAndWithdraw)
+func (x *fastReflection_SendingAndReceivingPausedEvent) New() protoreflect.Message {
+	return new(fastReflection_SendingAndReceivingPausedEvent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MintAndWithdraw) Interface() protoreflect.ProtoMessage {
-	return (*MintAndWithdraw)(x)
+func (x *fastReflection_SendingAndReceivingPausedEvent) Interface() protoreflect.ProtoMessage {
+	return (*SendingAndReceivingPausedEvent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6070,25 +6282,7 @@ func (x *fastReflection_MintAndWithdraw) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MintAndWithdraw) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.MintRecipient) != 0 {
-		value := protoreflect.ValueOfBytes(x.MintRecipient)
-		if !f(fd_MintAndWithdraw_mint_recipient, value) {
-			return
-		}
-	}
-	if x.Amount != "" {
-		value := protoreflect.ValueOfString(x.Amount)
-		if !f(fd_MintAndWithdraw_amount, value) {
-			return
-		}
-	}
-	if x.MintToken != "" {
-		value := protoreflect.ValueOfString(x.MintToken)
-		if !f(fd_MintAndWithdraw_mint_token, value) {
-			return
-		}
-	}
+func (x *fastReflection_SendingAndReceivingPausedEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6102,19 +6296,13 @@ func (x *fastReflection_MintAndWithdraw) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MintAndWithdraw) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SendingAndReceivingPausedEvent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
-		return len(x.MintRecipient) != 0
-	case "circle.cctp.v1.MintAndWithdraw.amount":
-		return x.Amount != ""
-	case "circle.cctp.v1.MintAndWithdraw.mint_token":
-		return x.MintToken != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6124,19 +6312,13 @@ func (x *fastReflection_MintAndWithdraw) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MintAndWithdraw) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SendingAndReceivingPausedEvent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
-		x.MintRecipient = nil
-	case "circle.cctp.v1.MintAndWithdraw.amount":
-		x.Amount = ""
-	case "circle.cctp.v1.MintAndWithdraw.mint_token":
-		x.MintToken = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6146,22 +6328,13 @@ func (x *fastReflection_MintAndWithdraw) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MintAndWithdraw) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendingAndReceivingPausedEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
-		value := x.MintRecipient
-		return protoreflect.ValueOfBytes(value)
-	case "circle.cctp.v1.MintAndWithdraw.amount":
-		value := x.Amount
-		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.MintAndWithdraw.mint_token":
-		value := x.MintToken
-		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6175,19 +6348,13 @@ func (x *fastReflection_MintAndWithdraw) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MintAndWithdraw) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SendingAndReceivingPausedEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
-		x.MintRecipient = value.Bytes()
-	case "circle.cctp.v1.MintAndWithdraw.amount":
-		x.Amount = value.Interface().(string)
-	case "circle.cctp.v1.MintAndWithdraw.mint_token":
-		x.MintToken = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6201,48 +6368,36 @@ func (x *fastReflection_MintAndWithdraw) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MintAndWithdraw) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendingAndReceivingPausedEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
-		panic(fmt.Errorf("field mint_recipient of message circle.cctp.v1.MintAndWithdraw is not mutable"))
-	case "circle.cctp.v1.MintAndWithdraw.amount":
-		panic(fmt.Errorf("field amount of message circle.cctp.v1.MintAndWithdraw is not mutable"))
-	case "circle.cctp.v1.MintAndWithdraw.mint_token":
-		panic(fmt.Errorf("field mint_token of message circle.cctp.v1.MintAndWithdraw is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MintAndWithdraw) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendingAndReceivingPausedEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
-		return protoreflect.ValueOfBytes(nil)
-	case "circle.cctp.v1.MintAndWithdraw.amount":
-		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.MintAndWithdraw.mint_token":
-		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingPausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingPausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MintAndWithdraw) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SendingAndReceivingPausedEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MintAndWithdraw", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.SendingAndReceivingPausedEvent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6250,7 +6405,7 @@ func (x *fastReflection_MintAndWithdraw) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MintAndWithdraw) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SendingAndReceivingPausedEvent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6261,7 +6416,7 @@ func (x *fastReflection_MintAndWithdraw) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MintAndWithdraw) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SendingAndReceivingPausedEvent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6273,7 +6428,7 @@ func (x *fastReflection_MintAndWithdraw) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MintAndWithdraw) IsValid() bool {
+func (x *fastReflection_SendingAndReceivingPausedEvent) IsValid() bool {
 	return x != nil
 }
 
@@ -6283,9 +6438,9 @@ func (x *fastReflection_MintAndWithdraw) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MintAndWithdraw) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SendingAndReceivingPausedEvent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MintAndWithdraw)
+		x := input.Message.Interface().(*SendingAndReceivingPausedEvent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6297,18 +6452,6 @@ func (x *fastReflection_MintAndWithdraw) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.MintRecipient)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.Amount)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.MintToken)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6319,7 +6462,7 @@ func (x *fastReflection_MintAndWithdraw) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MintAndWithdraw)
+		x := input.Message.Interface().(*SendingAndReceivingPausedEvent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6338,27 +6481,6 @@ func (x *fastReflection_MintAndWithdraw) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MintToken) > 0 {
-			i -= len(x.MintToken)
-			copy(dAtA[i:], x.MintToken)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintToken)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if len(x.Amount) > 0 {
-			i -= len(x.Amount)
-			copy(dAtA[i:], x.Amount)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.MintRecipient) > 0 {
-			i -= len(x.MintRecipient)
-			copy(dAtA[i:], x.MintRecipient)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintRecipient)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6370,7 +6492,7 @@ func (x *fastReflection_MintAndWithdraw) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MintAndWithdraw)
+		x := input.Message.Interface().(*SendingAndReceivingPausedEvent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6402,110 +6524,12 @@ func (x *fastReflection_MintAndWithdraw) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintAndWithdraw: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendingAndReceivingPausedEvent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintAndWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendingAndReceivingPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MintRecipient = append(x.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-				if x.MintRecipient == nil {
-					x.MintRecipient = []byte{}
-				}
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Amount = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintToken", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.MintToken = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -6542,29 +6566,23 @@ func (x *fastReflection_MintAndWithdraw) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TokenPairLinked               protoreflect.MessageDescriptor
-	fd_TokenPairLinked_local_token   protoreflect.FieldDescriptor
-	fd_TokenPairLinked_remote_domain protoreflect.FieldDescriptor
-	fd_TokenPairLinked_remote_token  protoreflect.FieldDescriptor
+	md_SendingAndReceivingUnpausedEvent protoreflect.MessageDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_TokenPairLinked = File_circle_cctp_v1_events_proto.Messages().ByName("TokenPairLinked")
-	fd_TokenPairLinked_local_token = md_TokenPairLinked.Fields().ByName("local_token")
-	fd_TokenPairLinked_remote_domain = md_TokenPairLinked.Fields().ByName("remote_domain")
-	fd_TokenPairLinked_remote_token = md_TokenPairLinked.Fields().ByName("remote_token")
+	md_SendingAndReceivingUnpausedEvent = File_circle_cctp_v1_events_proto.Messages().ByName("SendingAndReceivingUnpausedEvent")
 }
 
-var _ protoreflect.Message = (*fastReflection_TokenPairLinked)(nil)
+var _ protoreflect.Message = (*fastReflection_SendingAndReceivingUnpausedEvent)(nil)
 
-type fastReflection_TokenPairLinked TokenPairLinked
+type fastReflection_SendingAndReceivingUnpausedEvent SendingAndReceivingUnpausedEvent
 
-func (x *TokenPairLinked) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TokenPairLinked)(x)
+func (x *SendingAndReceivingUnpausedEvent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SendingAndReceivingUnpausedEvent)(x)
 }
 
-func (x *TokenPairLinked) slowProtoReflect() protoreflect.Message {
+func (x *SendingAndReceivingUnpausedEvent) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -6576,43 +6594,43 @@ func (x *TokenPairLinked) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TokenPairLinked_messageType fastReflection_TokenPairLinked_messageType
-var _ protoreflect.MessageType = fastReflection_TokenPairLinked_messageType{}
+var _fastReflection_SendingAndReceivingUnpausedEvent_messageType fastReflection_SendingAndReceivingUnpausedEvent_messageType
+var _ protoreflect.MessageType = fastReflection_SendingAndReceivingUnpausedEvent_messageType{}
 
-type fastReflection_TokenPairLinked_messageType struct{}
+type fastReflection_SendingAndReceivingUnpausedEvent_messageType struct{}
 
-func (x fastReflection_TokenPairLinked_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TokenPairLinked)(nil)
+func (x fastReflection_SendingAndReceivingUnpausedEvent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SendingAndReceivingUnpausedEvent)(nil)
 }
-func (x fastReflection_TokenPairLinked_messageType) New() protoreflect.Message {
-	return new(fastReflection_TokenPairLinked)
+func (x fastReflection_SendingAndReceivingUnpausedEvent_messageType) New() protoreflect.Message {
+	return new(fastReflection_SendingAndReceivingUnpausedEvent)
 }
-func (x fastReflection_TokenPairLinked_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TokenPairLinked
+func (x fastReflection_SendingAndReceivingUnpausedEvent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SendingAndReceivingUnpausedEvent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TokenPairLinked) Descriptor() protoreflect.MessageDescriptor {
-	return md_TokenPairLinked
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Descriptor() protoreflect.MessageDescriptor {
+	return md_SendingAndReceivingUnpausedEvent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TokenPairLinked) Type() protoreflect.MessageType {
-	return _fastReflection_TokenPairLinked_messageType
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Type() protoreflect.MessageType {
+	return _fastReflection_SendingAndReceivingUnpausedEvent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TokenPairLinked) New() protoreflect.Message {
-	return new(fastReflection_TokenPairLinked)
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) New() protoreflect.Message {
+	return new(fastReflection_SendingAndReceivingUnpausedEvent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TokenPairLinked) Interface() protoreflect.ProtoMessage {
-	return (*TokenPairLinked)(x)
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Interface() protoreflect.ProtoMessage {
+	return (*SendingAndReceivingUnpausedEvent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -6620,25 +6638,7 @@ func (x *fastReflection_TokenPairLinked) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TokenPairLinked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.LocalToken != "" {
-		value := protoreflect.ValueOfString(x.LocalToken)
-		if !f(fd_TokenPairLinked_local_token, value) {
-			return
-		}
-	}
-	if x.RemoteDomain != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.RemoteDomain)
-		if !f(fd_TokenPairLinked_remote_domain, value) {
-			return
-		}
-	}
-	if len(x.RemoteToken) != 0 {
-		value := protoreflect.ValueOfBytes(x.RemoteToken)
-		if !f(fd_TokenPairLinked_remote_token, value) {
-			return
-		}
-	}
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
 }
 
 // Has reports whether a field is populated.
@@ -6652,19 +6652,13 @@ func (x *fastReflection_TokenPairLinked) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TokenPairLinked) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairLinked.local_token":
-		return x.LocalToken != ""
-	case "circle.cctp.v1.TokenPairLinked.remote_domain":
-		return x.RemoteDomain != uint32(0)
-	case "circle.cctp.v1.TokenPairLinked.remote_token":
-		return len(x.RemoteToken) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6674,19 +6668,13 @@ func (x *fastReflection_TokenPairLinked) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenPairLinked) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairLinked.local_token":
-		x.LocalToken = ""
-	case "circle.cctp.v1.TokenPairLinked.remote_domain":
-		x.RemoteDomain = uint32(0)
-	case "circle.cctp.v1.TokenPairLinked.remote_token":
-		x.RemoteToken = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6696,22 +6684,13 @@ func (x *fastReflection_TokenPairLinked) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TokenPairLinked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.TokenPairLinked.local_token":
-		value := x.LocalToken
-		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.TokenPairLinked.remote_domain":
-		value := x.RemoteDomain
-		return protoreflect.ValueOfUint32(value)
-	case "circle.cctp.v1.TokenPairLinked.remote_token":
-		value := x.RemoteToken
-		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -6725,19 +6704,13 @@ func (x *fastReflection_TokenPairLinked) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenPairLinked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairLinked.local_token":
-		x.LocalToken = value.Interface().(string)
-	case "circle.cctp.v1.TokenPairLinked.remote_domain":
-		x.RemoteDomain = uint32(value.Uint())
-	case "circle.cctp.v1.TokenPairLinked.remote_token":
-		x.RemoteToken = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -6751,48 +6724,36 @@ func (x *fastReflection_TokenPairLinked) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenPairLinked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairLinked.local_token":
-		panic(fmt.Errorf("field local_token of message circle.cctp.v1.TokenPairLinked is not mutable"))
-	case "circle.cctp.v1.TokenPairLinked.remote_domain":
-		panic(fmt.Errorf("field remote_domain of message circle.cctp.v1.TokenPairLinked is not mutable"))
-	case "circle.cctp.v1.TokenPairLinked.remote_token":
-		panic(fmt.Errorf("field remote_token of message circle.cctp.v1.TokenPairLinked is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TokenPairLinked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairLinked.local_token":
-		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.TokenPairLinked.remote_domain":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "circle.cctp.v1.TokenPairLinked.remote_token":
-		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SendingAndReceivingUnpausedEvent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.SendingAndReceivingUnpausedEvent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TokenPairLinked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.TokenPairLinked", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.SendingAndReceivingUnpausedEvent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -6800,7 +6761,7 @@ func (x *fastReflection_TokenPairLinked) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TokenPairLinked) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -6811,7 +6772,7 @@ func (x *fastReflection_TokenPairLinked) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenPairLinked) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -6823,7 +6784,7 @@ func (x *fastReflection_TokenPairLinked) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TokenPairLinked) IsValid() bool {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) IsValid() bool {
 	return x != nil
 }
 
@@ -6833,9 +6794,9 @@ func (x *fastReflection_TokenPairLinked) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TokenPairLinked) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_SendingAndReceivingUnpausedEvent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TokenPairLinked)
+		x := input.Message.Interface().(*SendingAndReceivingUnpausedEvent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6847,17 +6808,6 @@ func (x *fastReflection_TokenPairLinked) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.LocalToken)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		if x.RemoteDomain != 0 {
-			n += 1 + runtime.Sov(uint64(x.RemoteDomain))
-		}
-		l = len(x.RemoteToken)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -6868,7 +6818,7 @@ func (x *fastReflection_TokenPairLinked) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TokenPairLinked)
+		x := input.Message.Interface().(*SendingAndReceivingUnpausedEvent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6887,25 +6837,6 @@ func (x *fastReflection_TokenPairLinked) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RemoteToken) > 0 {
-			i -= len(x.RemoteToken)
-			copy(dAtA[i:], x.RemoteToken)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoteToken)))
-			i--
-			dAtA[i] = 0x1a
-		}
-		if x.RemoteDomain != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemoteDomain))
-			i--
-			dAtA[i] = 0x10
-		}
-		if len(x.LocalToken) > 0 {
-			i -= len(x.LocalToken)
-			copy(dAtA[i:], x.LocalToken)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LocalToken)))
-			i--
-			dAtA[i] = 0xa
-		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
 		} else {
@@ -6917,7 +6848,7 @@ func (x *fastReflection_TokenPairLinked) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TokenPairLinked)
+		x := input.Message.Interface().(*SendingAndReceivingUnpausedEvent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -6949,97 +6880,12 @@ func (x *fastReflection_TokenPairLinked) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPairLinked: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendingAndReceivingUnpausedEvent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPairLinked: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SendingAndReceivingUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
-			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.LocalToken = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
-				}
-				x.RemoteDomain = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.RemoteDomain |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 3:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.RemoteToken = append(x.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
-				if x.RemoteToken == nil {
-					x.RemoteToken = []byte{}
-				}
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -7076,29 +6922,39 @@ func (x *fastReflection_TokenPairLinked) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_TokenPairUnlinked               protoreflect.MessageDescriptor
-	fd_TokenPairUnlinked_local_token   protoreflect.FieldDescriptor
-	fd_TokenPairUnlinked_remote_domain protoreflect.FieldDescriptor
-	fd_TokenPairUnlinked_remote_token  protoreflect.FieldDescriptor
+	md_DepositForBurn                             protoreflect.MessageDescriptor
+	fd_DepositForBurn_nonce                       protoreflect.FieldDescriptor
+	fd_DepositForBurn_burn_token                  protoreflect.FieldDescriptor
+	fd_DepositForBurn_amount                      protoreflect.FieldDescriptor
+	fd_DepositForBurn_depositor                   protoreflect.FieldDescriptor
+	fd_DepositForBurn_mint_recipient              protoreflect.FieldDescriptor
+	fd_DepositForBurn_destination_domain          protoreflect.FieldDescriptor
+	fd_DepositForBurn_destination_token_messenger protoreflect.FieldDescriptor
+	fd_DepositForBurn_destination_caller          protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_TokenPairUnlinked = File_circle_cctp_v1_events_proto.Messages().ByName("TokenPairUnlinked")
-	fd_TokenPairUnlinked_local_token = md_TokenPairUnlinked.Fields().ByName("local_token")
-	fd_TokenPairUnlinked_remote_domain = md_TokenPairUnlinked.Fields().ByName("remote_domain")
-	fd_TokenPairUnlinked_remote_token = md_TokenPairUnlinked.Fields().ByName("remote_token")
+	md_DepositForBurn = File_circle_cctp_v1_events_proto.Messages().ByName("DepositForBurn")
+	fd_DepositForBurn_nonce = md_DepositForBurn.Fields().ByName("nonce")
+	fd_DepositForBurn_burn_token = md_DepositForBurn.Fields().ByName("burn_token")
+	fd_DepositForBurn_amount = md_DepositForBurn.Fields().ByName("amount")
+	fd_DepositForBurn_depositor = md_DepositForBurn.Fields().ByName("depositor")
+	fd_DepositForBurn_mint_recipient = md_DepositForBurn.Fields().ByName("mint_recipient")
+	fd_DepositForBurn_destination_domain = md_DepositForBurn.Fields().ByName("destination_domain")
+	fd_DepositForBurn_destination_token_messenger = md_DepositForBurn.Fields().ByName("destination_token_messenger")
+	fd_DepositForBurn_destination_caller = md_DepositForBurn.Fields().ByName("destination_caller")
 }
 
-var _ protoreflect.Message = (*fastReflection_TokenPairUnlinked)(nil)
+var _ protoreflect.Message = (*fastReflection_DepositForBurn)(nil)
 
-type fastReflection_TokenPairUnlinked TokenPairUnlinked
+type fastReflection_DepositForBurn DepositForBurn
 
-func (x *TokenPairUnlinked) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_TokenPairUnlinked)(x)
+func (x *DepositForBurn) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_DepositForBurn)(x)
 }
 
-func (x *TokenPairUnlinked) slowProtoReflect() protoreflect.Message {
+func (x *DepositForBurn) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7110,43 +6966,43 @@ func (x *TokenPairUnlinked) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_TokenPairUnlinked_messageType fastReflection_TokenPairUnlinked_messageType
-var _ protoreflect.MessageType = fastReflection_TokenPairUnlinked_messageType{}
+var _fastReflection_DepositForBurn_messageType fastReflection_DepositForBurn_messageType
+var _ protoreflect.MessageType = fastReflection_DepositForBurn_messageType{}
 
-type fastReflection_TokenPairUnlinked_messageType struct{}
+type fastReflection_DepositForBurn_messageType struct{}
 
-func (x fastReflection_TokenPairUnlinked_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_TokenPairUnlinked)(nil)
+func (x fastReflection_DepositForBurn_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_DepositForBurn)(nil)
 }
-func (x fastReflection_TokenPairUnlinked_messageType) New() protoreflect.Message {
-	return new(fastReflection_TokenPairUnlinked)
+func (x fastReflection_DepositForBurn_messageType) New() protoreflect.Message {
+	return new(fastReflection_DepositForBurn)
 }
-func (x fastReflection_TokenPairUnlinked_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_TokenPairUnlinked
+func (x fastReflection_DepositForBurn_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositForBurn
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_TokenPairUnlinked) Descriptor() protoreflect.MessageDescriptor {
-	return md_TokenPairUnlinked
+func (x *fastReflection_DepositForBurn) Descriptor() protoreflect.MessageDescriptor {
+	return md_DepositForBurn
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_TokenPairUnlinked) Type() protoreflect.MessageType {
-	return _fastReflection_TokenPairUnlinked_messageType
+func (x *fastReflection_DepositForBurn) Type() protoreflect.MessageType {
+	return _fastReflection_DepositForBurn_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_TokenPairUnlinked) New() protoreflect.Message {
-	return new(fastReflection_TokenPairUnlinked)
+func (x *fastReflection_DepositForBurn) New() protoreflect.Message {
+	return new(fastReflection_DepositForBurn)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_TokenPairUnlinked) Interface() protoreflect.ProtoMessage {
-	return (*TokenPairUnlinked)(x)
+func (x *fastReflection_DepositForBurn) Interface() protoreflect.ProtoMessage {
+	return (*DepositForBurn)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7154,22 +7010,52 @@ func (x *fastReflection_TokenPairUnlinked) Interface() protoreflect.ProtoMessage
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_TokenPairUnlinked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.LocalToken != "" {
-		value := protoreflect.ValueOfString(x.LocalToken)
-		if !f(fd_TokenPairUnlinked_local_token, value) {
+func (x *fastReflection_DepositForBurn) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_DepositForBurn_nonce, value) {
 			return
 		}
 	}
-	if x.RemoteDomain != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.RemoteDomain)
-		if !f(fd_TokenPairUnlinked_remote_domain, value) {
+	if x.BurnToken != "" {
+		value := protoreflect.ValueOfString(x.BurnToken)
+		if !f(fd_DepositForBurn_burn_token, value) {
 			return
 		}
 	}
-	if len(x.RemoteToken) != 0 {
-		value := protoreflect.ValueOfBytes(x.RemoteToken)
-		if !f(fd_TokenPairUnlinked_remote_token, value) {
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_DepositForBurn_amount, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_DepositForBurn_depositor, value) {
+			return
+		}
+	}
+	if len(x.MintRecipient) != 0 {
+		value := protoreflect.ValueOfBytes(x.MintRecipient)
+		if !f(fd_DepositForBurn_mint_recipient, value) {
+			return
+		}
+	}
+	if x.DestinationDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DestinationDomain)
+		if !f(fd_DepositForBurn_destination_domain, value) {
+			return
+		}
+	}
+	if len(x.DestinationTokenMessenger) != 0 {
+		value := protoreflect.ValueOfBytes(x.DestinationTokenMessenger)
+		if !f(fd_DepositForBurn_destination_token_messenger, value) {
+			return
+		}
+	}
+	if len(x.DestinationCaller) != 0 {
+		value := protoreflect.ValueOfBytes(x.DestinationCaller)
+		if !f(fd_DepositForBurn_destination_caller, value) {
 			return
 		}
 	}
@@ -7186,19 +7072,29 @@ func (x *fastReflection_TokenPairUnlinked) Range(f func(protoreflect.FieldDescri
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_TokenPairUnlinked) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_DepositForBurn) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairUnlinked.local_token":
-		return x.LocalToken != ""
-	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
-		return x.RemoteDomain != uint32(0)
-	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
-		return len(x.RemoteToken) != 0
+	case "circle.cctp.v1.DepositForBurn.nonce":
+		return x.Nonce != uint64(0)
+	case "circle.cctp.v1.DepositForBurn.burn_token":
+		return x.BurnToken != ""
+	case "circle.cctp.v1.DepositForBurn.amount":
+		return x.Amount != ""
+	case "circle.cctp.v1.DepositForBurn.depositor":
+		return x.Depositor != ""
+	case "circle.cctp.v1.DepositForBurn.mint_recipient":
+		return len(x.MintRecipient) != 0
+	case "circle.cctp.v1.DepositForBurn.destination_domain":
+		return x.DestinationDomain != uint32(0)
+	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
+		return len(x.DestinationTokenMessenger) != 0
+	case "circle.cctp.v1.DepositForBurn.destination_caller":
+		return len(x.DestinationCaller) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7208,19 +7104,29 @@ func (x *fastReflection_TokenPairUnlinked) Has(fd protoreflect.FieldDescriptor)
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenPairUnlinked) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_DepositForBurn) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairUnlinked.local_token":
-		x.LocalToken = ""
-	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
-		x.RemoteDomain = uint32(0)
-	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
-		x.RemoteToken = nil
+	case "circle.cctp.v1.DepositForBurn.nonce":
+		x.Nonce = uint64(0)
+	case "circle.cctp.v1.DepositForBurn.burn_token":
+		x.BurnToken = ""
+	case "circle.cctp.v1.DepositForBurn.amount":
+		x.Amount = ""
+	case "circle.cctp.v1.DepositForBurn.depositor":
+		x.Depositor = ""
+	case "circle.cctp.v1.DepositForBurn.mint_recipient":
+		x.MintRecipient = nil
+	case "circle.cctp.v1.DepositForBurn.destination_domain":
+		x.DestinationDomain = uint32(0)
+	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
+		x.DestinationTokenMessenger = nil
+	case "circle.cctp.v1.DepositForBurn.destination_caller":
+		x.DestinationCaller = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7230,22 +7136,37 @@ func (x *fastReflection_TokenPairUnlinked) Clear(fd protoreflect.FieldDescriptor
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_TokenPairUnlinked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositForBurn) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.TokenPairUnlinked.local_token":
-		value := x.LocalToken
+	case "circle.cctp.v1.DepositForBurn.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "circle.cctp.v1.DepositForBurn.burn_token":
+		value := x.BurnToken
 		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
-		value := x.RemoteDomain
+	case "circle.cctp.v1.DepositForBurn.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.DepositForBurn.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.DepositForBurn.mint_recipient":
+		value := x.MintRecipient
+		return protoreflect.ValueOfBytes(value)
+	case "circle.cctp.v1.DepositForBurn.destination_domain":
+		value := x.DestinationDomain
 		return protoreflect.ValueOfUint32(value)
-	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
-		value := x.RemoteToken
+	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
+		value := x.DestinationTokenMessenger
+		return protoreflect.ValueOfBytes(value)
+	case "circle.cctp.v1.DepositForBurn.destination_caller":
+		value := x.DestinationCaller
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7259,19 +7180,29 @@ func (x *fastReflection_TokenPairUnlinked) Get(descriptor protoreflect.FieldDesc
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenPairUnlinked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_DepositForBurn) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairUnlinked.local_token":
-		x.LocalToken = value.Interface().(string)
-	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
-		x.RemoteDomain = uint32(value.Uint())
-	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
-		x.RemoteToken = value.Bytes()
+	case "circle.cctp.v1.DepositForBurn.nonce":
+		x.Nonce = value.Uint()
+	case "circle.cctp.v1.DepositForBurn.burn_token":
+		x.BurnToken = value.Interface().(string)
+	case "circle.cctp.v1.DepositForBurn.amount":
+		x.Amount = value.Interface().(string)
+	case "circle.cctp.v1.DepositForBurn.depositor":
+		x.Depositor = value.Interface().(string)
+	case "circle.cctp.v1.DepositForBurn.mint_recipient":
+		x.MintRecipient = value.Bytes()
+	case "circle.cctp.v1.DepositForBurn.destination_domain":
+		x.DestinationDomain = uint32(value.Uint())
+	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
+		x.DestinationTokenMessenger = value.Bytes()
+	case "circle.cctp.v1.DepositForBurn.destination_caller":
+		x.DestinationCaller = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7285,48 +7216,68 @@ func (x *fastReflection_TokenPairUnlinked) Set(fd protoreflect.FieldDescriptor,
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenPairUnlinked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositForBurn) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairUnlinked.local_token":
-		panic(fmt.Errorf("field local_token of message circle.cctp.v1.TokenPairUnlinked is not mutable"))
-	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
-		panic(fmt.Errorf("field remote_domain of message circle.cctp.v1.TokenPairUnlinked is not mutable"))
-	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
-		panic(fmt.Errorf("field remote_token of message circle.cctp.v1.TokenPairUnlinked is not mutable"))
+	case "circle.cctp.v1.DepositForBurn.nonce":
+		panic(fmt.Errorf("field nonce of message circle.cctp.v1.DepositForBurn is not mutable"))
+	case "circle.cctp.v1.DepositForBurn.burn_token":
+		panic(fmt.Errorf("field burn_token of message circle.cctp.v1.DepositForBurn is not mutable"))
+	case "circle.cctp.v1.DepositForBurn.amount":
+		panic(fmt.Errorf("field amount of message circle.cctp.v1.DepositForBurn is not mutable"))
+	case "circle.cctp.v1.DepositForBurn.depositor":
+		panic(fmt.Errorf("field depositor of message circle.cctp.v1.DepositForBurn is not mutable"))
+	case "circle.cctp.v1.DepositForBurn.mint_recipient":
+		panic(fmt.Errorf("field mint_recipient of message circle.cctp.v1.DepositForBurn is not mutable"))
+	case "circle.cctp.v1.DepositForBurn.destination_domain":
+		panic(fmt.Errorf("field destination_domain of message circle.cctp.v1.DepositForBurn is not mutable"))
+	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
+		panic(fmt.Errorf("field destination_token_messenger of message circle.cctp.v1.DepositForBurn is not mutable"))
+	case "circle.cctp.v1.DepositForBurn.destination_caller":
+		panic(fmt.Errorf("field destination_caller of message circle.cctp.v1.DepositForBurn is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_TokenPairUnlinked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_DepositForBurn) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.TokenPairUnlinked.local_token":
+	case "circle.cctp.v1.DepositForBurn.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.cctp.v1.DepositForBurn.burn_token":
 		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
+	case "circle.cctp.v1.DepositForBurn.amount":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.DepositForBurn.depositor":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.DepositForBurn.mint_recipient":
+		return protoreflect.ValueOfBytes(nil)
+	case "circle.cctp.v1.DepositForBurn.destination_domain":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
+	case "circle.cctp.v1.DepositForBurn.destination_token_messenger":
+		return protoreflect.ValueOfBytes(nil)
+	case "circle.cctp.v1.DepositForBurn.destination_caller":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.DepositForBurn"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.DepositForBurn does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_TokenPairUnlinked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_DepositForBurn) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.TokenPairUnlinked", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.DepositForBurn", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7334,7 +7285,7 @@ func (x *fastReflection_TokenPairUnlinked) WhichOneof(d protoreflect.OneofDescri
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_TokenPairUnlinked) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_DepositForBurn) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7345,7 +7296,7 @@ func (x *fastReflection_TokenPairUnlinked) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_TokenPairUnlinked) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_DepositForBurn) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7357,7 +7308,7 @@ func (x *fastReflection_TokenPairUnlinked) SetUnknown(fields protoreflect.RawFie
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_TokenPairUnlinked) IsValid() bool {
+func (x *fastReflection_DepositForBurn) IsValid() bool {
 	return x != nil
 }
 
@@ -7367,9 +7318,9 @@ func (x *fastReflection_TokenPairUnlinked) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_DepositForBurn) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*TokenPairUnlinked)
+		x := input.Message.Interface().(*DepositForBurn)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7381,14 +7332,33 @@ func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.LocalToken)
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		l = len(x.BurnToken)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.RemoteDomain != 0 {
-			n += 1 + runtime.Sov(uint64(x.RemoteDomain))
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RemoteToken)
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MintRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DestinationDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		}
+		l = len(x.DestinationTokenMessenger)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationCaller)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7402,7 +7372,7 @@ func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*TokenPairUnlinked)
+		x := input.Message.Interface().(*DepositForBurn)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7421,24 +7391,57 @@ func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RemoteToken) > 0 {
-			i -= len(x.RemoteToken)
-			copy(dAtA[i:], x.RemoteToken)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoteToken)))
+		if len(x.DestinationCaller) > 0 {
+			i -= len(x.DestinationCaller)
+			copy(dAtA[i:], x.DestinationCaller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationCaller)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if len(x.DestinationTokenMessenger) > 0 {
+			i -= len(x.DestinationTokenMessenger)
+			copy(dAtA[i:], x.DestinationTokenMessenger)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationTokenMessenger)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.DestinationDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
+			i--
+			dAtA[i] = 0x30
+		}
+		if len(x.MintRecipient) > 0 {
+			i -= len(x.MintRecipient)
+			copy(dAtA[i:], x.MintRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintRecipient)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
 			i--
 			dAtA[i] = 0x1a
 		}
-		if x.RemoteDomain != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemoteDomain))
+		if len(x.BurnToken) > 0 {
+			i -= len(x.BurnToken)
+			copy(dAtA[i:], x.BurnToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnToken)))
 			i--
-			dAtA[i] = 0x10
+			dAtA[i] = 0x12
 		}
-		if len(x.LocalToken) > 0 {
-			i -= len(x.LocalToken)
-			copy(dAtA[i:], x.LocalToken)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LocalToken)))
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -7451,7 +7454,7 @@ func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*TokenPairUnlinked)
+		x := input.Message.Interface().(*DepositForBurn)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7483,15 +7486,34 @@ func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPairUnlinked: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositForBurn: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPairUnlinked: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: DepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnToken", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -7519,13 +7541,13 @@ func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.LocalToken = string(dAtA[iNdEx:postIndex])
+				x.BurnToken = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			case 2:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 				}
-				x.RemoteDomain = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -7535,14 +7557,59 @@ func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.RemoteDomain |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -7569,66 +7636,157 @@ func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RemoteToken = append(x.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
-				if x.RemoteToken == nil {
-					x.RemoteToken = []byte{}
+				x.MintRecipient = append(x.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+				if x.MintRecipient == nil {
+					x.MintRecipient = []byte{}
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.DestinationDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationTokenMessenger", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.DestinationTokenMessenger = append(x.DestinationTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
+				if x.DestinationTokenMessenger == nil {
+					x.DestinationTokenMessenger = []byte{}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationCaller = append(x.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+				if x.DestinationCaller == nil {
+					x.DestinationCaller = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
 var (
-	md_MessageSent         protoreflect.MessageDescriptor
-	fd_MessageSent_message protoreflect.FieldDescriptor
+	md_MintAndWithdraw                protoreflect.MessageDescriptor
+	fd_MintAndWithdraw_mint_recipient protoreflect.FieldDescriptor
+	fd_MintAndWithdraw_amount         protoreflect.FieldDescriptor
+	fd_MintAndWithdraw_mint_token     protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_MessageSent = File_circle_cctp_v1_events_proto.Messages().ByName("MessageSent")
-	fd_MessageSent_message = md_MessageSent.Fields().ByName("message")
+	md_MintAndWithdraw = File_circle_cctp_v1_events_proto.Messages().ByName("MintAndWithdraw")
+	fd_MintAndWithdraw_mint_recipient = md_MintAndWithdraw.Fields().ByName("mint_recipient")
+	fd_MintAndWithdraw_amount = md_MintAndWithdraw.Fields().ByName("amount")
+	fd_MintAndWithdraw_mint_token = md_MintAndWithdraw.Fields().ByName("mint_token")
 }
 
-var _ protoreflect.Message = (*fastReflection_MessageSent)(nil)
+var _ protoreflect.Message = (*fastReflection_MintAndWithdraw)(nil)
 
-type fastReflection_MessageSent MessageSent
+type fastReflection_MintAndWithdraw MintAndWithdraw
 
-func (x *MessageSent) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MessageSent)(x)
+func (x *MintAndWithdraw) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintAndWithdraw)(x)
 }
 
-func (x *MessageSent) slowProtoReflect() protoreflect.Message {
+func (x *MintAndWithdraw) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -7640,43 +7798,43 @@ func (x *MessageSent) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MessageSent_messageType fastReflection_MessageSent_messageType
-var _ protoreflect.MessageType = fastReflection_MessageSent_messageType{}
+var _fastReflection_MintAndWithdraw_messageType fastReflection_MintAndWithdraw_messageType
+var _ protoreflect.MessageType = fastReflection_MintAndWithdraw_messageType{}
 
-type fastReflection_MessageSent_messageType struct{}
+type fastReflection_MintAndWithdraw_messageType struct{}
 
-func (x fastReflection_MessageSent_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MessageSent)(nil)
+func (x fastReflection_MintAndWithdraw_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintAndWithdraw)(nil)
 }
-func (x fastReflection_MessageSent_messageType) New() protoreflect.Message {
-	return new(fastReflection_MessageSent)
+func (x fastReflection_MintAndWithdraw_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintAndWithdraw)
 }
-func (x fastReflection_MessageSent_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MessageSent
+func (x fastReflection_MintAndWithdraw_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintAndWithdraw
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MessageSent) Descriptor() protoreflect.MessageDescriptor {
-	return md_MessageSent
+func (x *fastReflection_MintAndWithdraw) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintAndWithdraw
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MessageSent) Type() protoreflect.MessageType {
-	return _fastReflection_MessageSent_messageType
+func (x *fastReflection_MintAndWithdraw) Type() protoreflect.MessageType {
+	return _fastReflection_MintAndWithdraw_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MessageSent) New() protoreflect.Message {
-	return new(fastReflection_MessageSent)
+func (x *fastReflection_MintAndWithdraw) New() protoreflect.Message {
+	return new(fastReflection_MintAndWithdraw)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MessageSent) Interface() protoreflect.ProtoMessage {
-	return (*MessageSent)(x)
+func (x *fastReflection_MintAndWithdraw) Interface() protoreflect.ProtoMessage {
+	return (*MintAndWithdraw)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -7684,10 +7842,22 @@ func (x *fastReflection_MessageSent) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MessageSent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if len(x.Message) != 0 {
-		value := protoreflect.ValueOfBytes(x.Message)
-		if !f(fd_MessageSent_message, value) {
+func (x *fastReflection_MintAndWithdraw) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.MintRecipient) != 0 {
+		value := protoreflect.ValueOfBytes(x.MintRecipient)
+		if !f(fd_MintAndWithdraw_mint_recipient, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MintAndWithdraw_amount, value) {
+			return
+		}
+	}
+	if x.MintToken != "" {
+		value := protoreflect.ValueOfString(x.MintToken)
+		if !f(fd_MintAndWithdraw_mint_token, value) {
 			return
 		}
 	}
@@ -7704,15 +7874,19 @@ func (x *fastReflection_MessageSent) Range(f func(protoreflect.FieldDescriptor,
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MessageSent) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MintAndWithdraw) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageSent.message":
-		return len(x.Message) != 0
+	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
+		return len(x.MintRecipient) != 0
+	case "circle.cctp.v1.MintAndWithdraw.amount":
+		return x.Amount != ""
+	case "circle.cctp.v1.MintAndWithdraw.mint_token":
+		return x.MintToken != ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7722,15 +7896,19 @@ func (x *fastReflection_MessageSent) Has(fd protoreflect.FieldDescriptor) bool {
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessageSent) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MintAndWithdraw) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageSent.message":
-		x.Message = nil
+	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
+		x.MintRecipient = nil
+	case "circle.cctp.v1.MintAndWithdraw.amount":
+		x.Amount = ""
+	case "circle.cctp.v1.MintAndWithdraw.mint_token":
+		x.MintToken = ""
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7740,16 +7918,22 @@ func (x *fastReflection_MessageSent) Clear(fd protoreflect.FieldDescriptor) {
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MessageSent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MintAndWithdraw) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.MessageSent.message":
-		value := x.Message
+	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
+		value := x.MintRecipient
 		return protoreflect.ValueOfBytes(value)
+	case "circle.cctp.v1.MintAndWithdraw.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.MintAndWithdraw.mint_token":
+		value := x.MintToken
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -7763,15 +7947,19 @@ func (x *fastReflection_MessageSent) Get(descriptor protoreflect.FieldDescriptor
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessageSent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MintAndWithdraw) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageSent.message":
-		x.Message = value.Bytes()
+	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
+		x.MintRecipient = value.Bytes()
+	case "circle.cctp.v1.MintAndWithdraw.amount":
+		x.Amount = value.Interface().(string)
+	case "circle.cctp.v1.MintAndWithdraw.mint_token":
+		x.MintToken = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -7785,40 +7973,48 @@ func (x *fastReflection_MessageSent) Set(fd protoreflect.FieldDescriptor, value
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessageSent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MintAndWithdraw) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageSent.message":
-		panic(fmt.Errorf("field message of message circle.cctp.v1.MessageSent is not mutable"))
+	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
+		panic(fmt.Errorf("field mint_recipient of message circle.cctp.v1.MintAndWithdraw is not mutable"))
+	case "circle.cctp.v1.MintAndWithdraw.amount":
+		panic(fmt.Errorf("field amount of message circle.cctp.v1.MintAndWithdraw is not mutable"))
+	case "circle.cctp.v1.MintAndWithdraw.mint_token":
+		panic(fmt.Errorf("field mint_token of message circle.cctp.v1.MintAndWithdraw is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MessageSent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MintAndWithdraw) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageSent.message":
+	case "circle.cctp.v1.MintAndWithdraw.mint_recipient":
 		return protoreflect.ValueOfBytes(nil)
+	case "circle.cctp.v1.MintAndWithdraw.amount":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.MintAndWithdraw.mint_token":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintAndWithdraw"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MintAndWithdraw does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MessageSent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MintAndWithdraw) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MessageSent", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MintAndWithdraw", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -7826,7 +8022,7 @@ func (x *fastReflection_MessageSent) WhichOneof(d protoreflect.OneofDescriptor)
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MessageSent) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MintAndWithdraw) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -7837,7 +8033,7 @@ func (x *fastReflection_MessageSent) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessageSent) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MintAndWithdraw) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -7849,7 +8045,7 @@ func (x *fastReflection_MessageSent) SetUnknown(fields protoreflect.RawFields) {
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MessageSent) IsValid() bool {
+func (x *fastReflection_MintAndWithdraw) IsValid() bool {
 	return x != nil
 }
 
@@ -7859,9 +8055,9 @@ func (x *fastReflection_MessageSent) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MintAndWithdraw) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MessageSent)
+		x := input.Message.Interface().(*MintAndWithdraw)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7873,7 +8069,15 @@ func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Message)
+		l = len(x.MintRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MintToken)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -7887,7 +8091,7 @@ func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MessageSent)
+		x := input.Message.Interface().(*MintAndWithdraw)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7906,10 +8110,24 @@ func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.Message) > 0 {
-			i -= len(x.Message)
-			copy(dAtA[i:], x.Message)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Message)))
+		if len(x.MintToken) > 0 {
+			i -= len(x.MintToken)
+			copy(dAtA[i:], x.MintToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintToken)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MintRecipient) > 0 {
+			i -= len(x.MintRecipient)
+			copy(dAtA[i:], x.MintRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintRecipient)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -7924,7 +8142,7 @@ func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MessageSent)
+		x := input.Message.Interface().(*MintAndWithdraw)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -7956,15 +8174,15 @@ func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageSent: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintAndWithdraw: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageSent: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintAndWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -7991,10 +8209,74 @@ func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Message = append(x.Message[:0], dAtA[iNdEx:postIndex]...)
-				if x.Message == nil {
-					x.Message = []byte{}
+				x.MintRecipient = append(x.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+				if x.MintRecipient == nil {
+					x.MintRecipient = []byte{}
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintToken = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
@@ -8032,33 +8314,29 @@ func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MessageReceived               protoreflect.MessageDescriptor
-	fd_MessageReceived_caller        protoreflect.FieldDescriptor
-	fd_MessageReceived_source_domain protoreflect.FieldDescriptor
-	fd_MessageReceived_nonce         protoreflect.FieldDescriptor
-	fd_MessageReceived_sender        protoreflect.FieldDescriptor
-	fd_MessageReceived_message_body  protoreflect.FieldDescriptor
+	md_TokenPairLinked               protoreflect.MessageDescriptor
+	fd_TokenPairLinked_local_token   protoreflect.FieldDescriptor
+	fd_TokenPairLinked_remote_domain protoreflect.FieldDescriptor
+	fd_TokenPairLinked_remote_token  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_MessageReceived = File_circle_cctp_v1_events_proto.Messages().ByName("MessageReceived")
-	fd_MessageReceived_caller = md_MessageReceived.Fields().ByName("caller")
-	fd_MessageReceived_source_domain = md_MessageReceived.Fields().ByName("source_domain")
-	fd_MessageReceived_nonce = md_MessageReceived.Fields().ByName("nonce")
-	fd_MessageReceived_sender = md_MessageReceived.Fields().ByName("sender")
-	fd_MessageReceived_message_body = md_MessageReceived.Fields().ByName("message_body")
+	md_TokenPairLinked = File_circle_cctp_v1_events_proto.Messages().ByName("TokenPairLinked")
+	fd_TokenPairLinked_local_token = md_TokenPairLinked.Fields().ByName("local_token")
+	fd_TokenPairLinked_remote_domain = md_TokenPairLinked.Fields().ByName("remote_domain")
+	fd_TokenPairLinked_remote_token = md_TokenPairLinked.Fields().ByName("remote_token")
 }
 
-var _ protoreflect.Message = (*fastReflection_MessageReceived)(nil)
+var _ protoreflect.Message = (*fastReflection_TokenPairLinked)(nil)
 
-type fastReflection_MessageReceived MessageReceived
+type fastReflection_TokenPairLinked TokenPairLinked
 
-func (x *MessageReceived) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MessageReceived)(x)
+func (x *TokenPairLinked) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenPairLinked)(x)
 }
 
-func (x *MessageReceived) slowProtoReflect() protoreflect.Message {
+func (x *TokenPairLinked) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8070,43 +8348,43 @@ func (x *MessageReceived) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MessageReceived_messageType fastReflection_MessageReceived_messageType
-var _ protoreflect.MessageType = fastReflection_MessageReceived_messageType{}
+var _fastReflection_TokenPairLinked_messageType fastReflection_TokenPairLinked_messageType
+var _ protoreflect.MessageType = fastReflection_TokenPairLinked_messageType{}
 
-type fastReflection_MessageReceived_messageType struct{}
+type fastReflection_TokenPairLinked_messageType struct{}
 
-func (x fastReflection_MessageReceived_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MessageReceived)(nil)
+func (x fastReflection_TokenPairLinked_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenPairLinked)(nil)
 }
-func (x fastReflection_MessageReceived_messageType) New() protoreflect.Message {
-	return new(fastReflection_MessageReceived)
+func (x fastReflection_TokenPairLinked_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenPairLinked)
 }
-func (x fastReflection_MessageReceived_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MessageReceived
+func (x fastReflection_TokenPairLinked_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenPairLinked
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MessageReceived) Descriptor() protoreflect.MessageDescriptor {
-	return md_MessageReceived
+func (x *fastReflection_TokenPairLinked) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenPairLinked
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MessageReceived) Type() protoreflect.MessageType {
-	return _fastReflection_MessageReceived_messageType
+func (x *fastReflection_TokenPairLinked) Type() protoreflect.MessageType {
+	return _fastReflection_TokenPairLinked_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MessageReceived) New() protoreflect.Message {
-	return new(fastReflection_MessageReceived)
+func (x *fastReflection_TokenPairLinked) New() protoreflect.Message {
+	return new(fastReflection_TokenPairLinked)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MessageReceived) Interface() protoreflect.ProtoMessage {
-	return (*MessageReceived)(x)
+func (x *fastReflection_TokenPairLinked) Interface() protoreflect.ProtoMessage {
+	return (*TokenPairLinked)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8114,34 +8392,22 @@ func (x *fastReflection_MessageReceived) Interface() protoreflect.ProtoMessage {
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MessageReceived) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Caller != "" {
-		value := protoreflect.ValueOfString(x.Caller)
-		if !f(fd_MessageReceived_caller, value) {
-			return
-		}
-	}
-	if x.SourceDomain != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.SourceDomain)
-		if !f(fd_MessageReceived_source_domain, value) {
-			return
-		}
-	}
-	if x.Nonce != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.Nonce)
-		if !f(fd_MessageReceived_nonce, value) {
+func (x *fastReflection_TokenPairLinked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LocalToken != "" {
+		value := protoreflect.ValueOfString(x.LocalToken)
+		if !f(fd_TokenPairLinked_local_token, value) {
 			return
 		}
 	}
-	if len(x.Sender) != 0 {
-		value := protoreflect.ValueOfBytes(x.Sender)
-		if !f(fd_MessageReceived_sender, value) {
+	if x.RemoteDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RemoteDomain)
+		if !f(fd_TokenPairLinked_remote_domain, value) {
 			return
 		}
 	}
-	if len(x.MessageBody) != 0 {
-		value := protoreflect.ValueOfBytes(x.MessageBody)
-		if !f(fd_MessageReceived_message_body, value) {
+	if len(x.RemoteToken) != 0 {
+		value := protoreflect.ValueOfBytes(x.RemoteToken)
+		if !f(fd_TokenPairLinked_remote_token, value) {
 			return
 		}
 	}
@@ -8158,23 +8424,19 @@ func (x *fastReflection_MessageReceived) Range(f func(protoreflect.FieldDescript
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MessageReceived) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TokenPairLinked) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageReceived.caller":
-		return x.Caller != ""
-	case "circle.cctp.v1.MessageReceived.source_domain":
-		return x.SourceDomain != uint32(0)
-	case "circle.cctp.v1.MessageReceived.nonce":
-		return x.Nonce != uint64(0)
-	case "circle.cctp.v1.MessageReceived.sender":
-		return len(x.Sender) != 0
-	case "circle.cctp.v1.MessageReceived.message_body":
-		return len(x.MessageBody) != 0
+	case "circle.cctp.v1.TokenPairLinked.local_token":
+		return x.LocalToken != ""
+	case "circle.cctp.v1.TokenPairLinked.remote_domain":
+		return x.RemoteDomain != uint32(0)
+	case "circle.cctp.v1.TokenPairLinked.remote_token":
+		return len(x.RemoteToken) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8184,23 +8446,19 @@ func (x *fastReflection_MessageReceived) Has(fd protoreflect.FieldDescriptor) bo
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessageReceived) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TokenPairLinked) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageReceived.caller":
-		x.Caller = ""
-	case "circle.cctp.v1.MessageReceived.source_domain":
-		x.SourceDomain = uint32(0)
-	case "circle.cctp.v1.MessageReceived.nonce":
-		x.Nonce = uint64(0)
-	case "circle.cctp.v1.MessageReceived.sender":
-		x.Sender = nil
-	case "circle.cctp.v1.MessageReceived.message_body":
-		x.MessageBody = nil
+	case "circle.cctp.v1.TokenPairLinked.local_token":
+		x.LocalToken = ""
+	case "circle.cctp.v1.TokenPairLinked.remote_domain":
+		x.RemoteDomain = uint32(0)
+	case "circle.cctp.v1.TokenPairLinked.remote_token":
+		x.RemoteToken = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8210,28 +8468,22 @@ func (x *fastReflection_MessageReceived) Clear(fd protoreflect.FieldDescriptor)
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MessageReceived) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenPairLinked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.MessageReceived.caller":
-		value := x.Caller
+	case "circle.cctp.v1.TokenPairLinked.local_token":
+		value := x.LocalToken
 		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.MessageReceived.source_domain":
-		value := x.SourceDomain
+	case "circle.cctp.v1.TokenPairLinked.remote_domain":
+		value := x.RemoteDomain
 		return protoreflect.ValueOfUint32(value)
-	case "circle.cctp.v1.MessageReceived.nonce":
-		value := x.Nonce
-		return protoreflect.ValueOfUint64(value)
-	case "circle.cctp.v1.MessageReceived.sender":
-		value := x.Sender
-		return protoreflect.ValueOfBytes(value)
-	case "circle.cctp.v1.MessageReceived.message_body":
-		value := x.MessageBody
+	case "circle.cctp.v1.TokenPairLinked.remote_token":
+		value := x.RemoteToken
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8245,23 +8497,19 @@ func (x *fastReflection_MessageReceived) Get(descriptor protoreflect.FieldDescri
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessageReceived) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TokenPairLinked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageReceived.caller":
-		x.Caller = value.Interface().(string)
-	case "circle.cctp.v1.MessageReceived.source_domain":
-		x.SourceDomain = uint32(value.Uint())
-	case "circle.cctp.v1.MessageReceived.nonce":
-		x.Nonce = value.Uint()
-	case "circle.cctp.v1.MessageReceived.sender":
-		x.Sender = value.Bytes()
-	case "circle.cctp.v1.MessageReceived.message_body":
-		x.MessageBody = value.Bytes()
+	case "circle.cctp.v1.TokenPairLinked.local_token":
+		x.LocalToken = value.Interface().(string)
+	case "circle.cctp.v1.TokenPairLinked.remote_domain":
+		x.RemoteDomain = uint32(value.Uint())
+	case "circle.cctp.v1.TokenPairLinked.remote_token":
+		x.RemoteToken = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8275,56 +8523,48 @@ func (x *fastReflection_MessageReceived) Set(fd protoreflect.FieldDescriptor, va
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessageReceived) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenPairLinked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageReceived.caller":
-		panic(fmt.Errorf("field caller of message circle.cctp.v1.MessageReceived is not mutable"))
-	case "circle.cctp.v1.MessageReceived.source_domain":
-		panic(fmt.Errorf("field source_domain of message circle.cctp.v1.MessageReceived is not mutable"))
-	case "circle.cctp.v1.MessageReceived.nonce":
-		panic(fmt.Errorf("field nonce of message circle.cctp.v1.MessageReceived is not mutable"))
-	case "circle.cctp.v1.MessageReceived.sender":
-		panic(fmt.Errorf("field sender of message circle.cctp.v1.MessageReceived is not mutable"))
-	case "circle.cctp.v1.MessageReceived.message_body":
-		panic(fmt.Errorf("field message_body of message circle.cctp.v1.MessageReceived is not mutable"))
+	case "circle.cctp.v1.TokenPairLinked.local_token":
+		panic(fmt.Errorf("field local_token of message circle.cctp.v1.TokenPairLinked is not mutable"))
+	case "circle.cctp.v1.TokenPairLinked.remote_domain":
+		panic(fmt.Errorf("field remote_domain of message circle.cctp.v1.TokenPairLinked is not mutable"))
+	case "circle.cctp.v1.TokenPairLinked.remote_token":
+		panic(fmt.Errorf("field remote_token of message circle.cctp.v1.TokenPairLinked is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MessageReceived) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenPairLinked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MessageReceived.caller":
+	case "circle.cctp.v1.TokenPairLinked.local_token":
 		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.MessageReceived.source_domain":
+	case "circle.cctp.v1.TokenPairLinked.remote_domain":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "circle.cctp.v1.MessageReceived.nonce":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "circle.cctp.v1.MessageReceived.sender":
-		return protoreflect.ValueOfBytes(nil)
-	case "circle.cctp.v1.MessageReceived.message_body":
+	case "circle.cctp.v1.TokenPairLinked.remote_token":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairLinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairLinked does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MessageReceived) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TokenPairLinked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MessageReceived", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.TokenPairLinked", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8332,7 +8572,7 @@ func (x *fastReflection_MessageReceived) WhichOneof(d protoreflect.OneofDescript
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MessageReceived) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TokenPairLinked) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8343,7 +8583,7 @@ func (x *fastReflection_MessageReceived) GetUnknown() protoreflect.RawFields {
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MessageReceived) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TokenPairLinked) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8355,7 +8595,7 @@ func (x *fastReflection_MessageReceived) SetUnknown(fields protoreflect.RawField
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MessageReceived) IsValid() bool {
+func (x *fastReflection_TokenPairLinked) IsValid() bool {
 	return x != nil
 }
 
@@ -8365,9 +8605,9 @@ func (x *fastReflection_MessageReceived) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TokenPairLinked) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MessageReceived)
+		x := input.Message.Interface().(*TokenPairLinked)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8379,21 +8619,14 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 		var n int
 		var l int
 		_ = l
-		l = len(x.Caller)
+		l = len(x.LocalToken)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.SourceDomain != 0 {
-			n += 1 + runtime.Sov(uint64(x.SourceDomain))
-		}
-		if x.Nonce != 0 {
-			n += 1 + runtime.Sov(uint64(x.Nonce))
-		}
-		l = len(x.Sender)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.RemoteDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemoteDomain))
 		}
-		l = len(x.MessageBody)
+		l = len(x.RemoteToken)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -8407,7 +8640,7 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MessageReceived)
+		x := input.Message.Interface().(*TokenPairLinked)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8426,34 +8659,22 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.MessageBody) > 0 {
-			i -= len(x.MessageBody)
-			copy(dAtA[i:], x.MessageBody)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MessageBody)))
-			i--
-			dAtA[i] = 0x2a
-		}
-		if len(x.Sender) > 0 {
-			i -= len(x.Sender)
-			copy(dAtA[i:], x.Sender)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
-			i--
-			dAtA[i] = 0x22
-		}
-		if x.Nonce != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+		if len(x.RemoteToken) > 0 {
+			i -= len(x.RemoteToken)
+			copy(dAtA[i:], x.RemoteToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoteToken)))
 			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
-		if x.SourceDomain != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.SourceDomain))
+		if x.RemoteDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemoteDomain))
 			i--
 			dAtA[i] = 0x10
 		}
-		if len(x.Caller) > 0 {
-			i -= len(x.Caller)
-			copy(dAtA[i:], x.Caller)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Caller)))
+		if len(x.LocalToken) > 0 {
+			i -= len(x.LocalToken)
+			copy(dAtA[i:], x.LocalToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LocalToken)))
 			i--
 			dAtA[i] = 0xa
 		}
@@ -8468,7 +8689,7 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MessageReceived)
+		x := input.Message.Interface().(*TokenPairLinked)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8500,15 +8721,15 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageReceived: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPairLinked: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPairLinked: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -8536,13 +8757,13 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Caller = string(dAtA[iNdEx:postIndex])
+				x.LocalToken = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
 				}
-				x.SourceDomain = 0
+				x.RemoteDomain = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -8552,67 +8773,14 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.SourceDomain |= uint32(b&0x7F) << shift
+					x.RemoteDomain |= uint32(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-				}
-				x.Nonce = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Nonce |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 4:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-				}
-				var byteLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					byteLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if byteLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + byteLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Sender = append(x.Sender[:0], dAtA[iNdEx:postIndex]...)
-				if x.Sender == nil {
-					x.Sender = []byte{}
-				}
-				iNdEx = postIndex
-			case 5:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MessageBody", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -8639,9 +8807,9 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.MessageBody = append(x.MessageBody[:0], dAtA[iNdEx:postIndex]...)
-				if x.MessageBody == nil {
-					x.MessageBody = []byte{}
+				x.RemoteToken = append(x.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
+				if x.RemoteToken == nil {
+					x.RemoteToken = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -8680,25 +8848,29 @@ func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 }
 
 var (
-	md_MaxMessageBodySizeUpdated                           protoreflect.MessageDescriptor
-	fd_MaxMessageBodySizeUpdated_new_max_message_body_size protoreflect.FieldDescriptor
+	md_TokenPairUnlinked               protoreflect.MessageDescriptor
+	fd_TokenPairUnlinked_local_token   protoreflect.FieldDescriptor
+	fd_TokenPairUnlinked_remote_domain protoreflect.FieldDescriptor
+	fd_TokenPairUnlinked_remote_token  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_MaxMessageBodySizeUpdated = File_circle_cctp_v1_events_proto.Messages().ByName("MaxMessageBodySizeUpdated")
-	fd_MaxMessageBodySizeUpdated_new_max_message_body_size = md_MaxMessageBodySizeUpdated.Fields().ByName("new_max_message_body_size")
+	md_TokenPairUnlinked = File_circle_cctp_v1_events_proto.Messages().ByName("TokenPairUnlinked")
+	fd_TokenPairUnlinked_local_token = md_TokenPairUnlinked.Fields().ByName("local_token")
+	fd_TokenPairUnlinked_remote_domain = md_TokenPairUnlinked.Fields().ByName("remote_domain")
+	fd_TokenPairUnlinked_remote_token = md_TokenPairUnlinked.Fields().ByName("remote_token")
 }
 
-var _ protoreflect.Message = (*fastReflection_MaxMessageBodySizeUpdated)(nil)
+var _ protoreflect.Message = (*fastReflection_TokenPairUnlinked)(nil)
 
-type fastReflection_MaxMessageBodySizeUpdated MaxMessageBodySizeUpdated
+type fastReflection_TokenPairUnlinked TokenPairUnlinked
 
-func (x *MaxMessageBodySizeUpdated) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_MaxMessageBodySizeUpdated)(x)
+func (x *TokenPairUnlinked) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_TokenPairUnlinked)(x)
 }
 
-func (x *MaxMessageBodySizeUpdated) slowProtoReflect() protoreflect.Message {
+func (x *TokenPairUnlinked) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -8710,43 +8882,43 @@ func (x *MaxMessageBodySizeUpdated) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_MaxMessageBodySizeUpdated_messageType fastReflection_MaxMessageBodySizeUpdated_messageType
-var _ protoreflect.MessageType = fastReflection_MaxMessageBodySizeUpdated_messageType{}
+var _fastReflection_TokenPairUnlinked_messageType fastReflection_TokenPairUnlinked_messageType
+var _ protoreflect.MessageType = fastReflection_TokenPairUnlinked_messageType{}
 
-type fastReflection_MaxMessageBodySizeUpdated_messageType struct{}
+type fastReflection_TokenPairUnlinked_messageType struct{}
 
-func (x fastReflection_MaxMessageBodySizeUpdated_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_MaxMessageBodySizeUpdated)(nil)
+func (x fastReflection_TokenPairUnlinked_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_TokenPairUnlinked)(nil)
 }
-func (x fastReflection_MaxMessageBodySizeUpdated_messageType) New() protoreflect.Message {
-	return new(fastReflection_MaxMessageBodySizeUpdated)
+func (x fastReflection_TokenPairUnlinked_messageType) New() protoreflect.Message {
+	return new(fastReflection_TokenPairUnlinked)
 }
-func (x fastReflection_MaxMessageBodySizeUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_MaxMessageBodySizeUpdated
+func (x fastReflection_TokenPairUnlinked_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenPairUnlinked
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Descriptor() protoreflect.MessageDescriptor {
-	return md_MaxMessageBodySizeUpdated
+func (x *fastReflection_TokenPairUnlinked) Descriptor() protoreflect.MessageDescriptor {
+	return md_TokenPairUnlinked
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Type() protoreflect.MessageType {
-	return _fastReflection_MaxMessageBodySizeUpdated_messageType
+func (x *fastReflection_TokenPairUnlinked) Type() protoreflect.MessageType {
+	return _fastReflection_TokenPairUnlinked_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_MaxMessageBodySizeUpdated) New() protoreflect.Message {
-	return new(fastReflection_MaxMessageBodySizeUpdated)
+func (x *fastReflection_TokenPairUnlinked) New() protoreflect.Message {
+	return new(fastReflection_TokenPairUnlinked)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Interface() protoreflect.ProtoMessage {
-	return (*MaxMessageBodySizeUpdated)(x)
+func (x *fastReflection_TokenPairUnlinked) Interface() protoreflect.ProtoMessage {
+	return (*TokenPairUnlinked)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -8754,10 +8926,22 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.NewMaxMessageBodySize != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.NewMaxMessageBodySize)
-		if !f(fd_MaxMessageBodySizeUpdated_new_max_message_body_size, value) {
+func (x *fastReflection_TokenPairUnlinked) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.LocalToken != "" {
+		value := protoreflect.ValueOfString(x.LocalToken)
+		if !f(fd_TokenPairUnlinked_local_token, value) {
+			return
+		}
+	}
+	if x.RemoteDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.RemoteDomain)
+		if !f(fd_TokenPairUnlinked_remote_domain, value) {
+			return
+		}
+	}
+	if len(x.RemoteToken) != 0 {
+		value := protoreflect.ValueOfBytes(x.RemoteToken)
+		if !f(fd_TokenPairUnlinked_remote_token, value) {
 			return
 		}
 	}
@@ -8774,15 +8958,19 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_TokenPairUnlinked) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
-		return x.NewMaxMessageBodySize != uint64(0)
+	case "circle.cctp.v1.TokenPairUnlinked.local_token":
+		return x.LocalToken != ""
+	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
+		return x.RemoteDomain != uint32(0)
+	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
+		return len(x.RemoteToken) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8792,15 +8980,19 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_TokenPairUnlinked) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
-		x.NewMaxMessageBodySize = uint64(0)
+	case "circle.cctp.v1.TokenPairUnlinked.local_token":
+		x.LocalToken = ""
+	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
+		x.RemoteDomain = uint32(0)
+	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
+		x.RemoteToken = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8810,16 +9002,22 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenPairUnlinked) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
-		value := x.NewMaxMessageBodySize
-		return protoreflect.ValueOfUint64(value)
+	case "circle.cctp.v1.TokenPairUnlinked.local_token":
+		value := x.LocalToken
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
+		value := x.RemoteDomain
+		return protoreflect.ValueOfUint32(value)
+	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
+		value := x.RemoteToken
+		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -8833,15 +9031,19 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_TokenPairUnlinked) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
-		x.NewMaxMessageBodySize = value.Uint()
+	case "circle.cctp.v1.TokenPairUnlinked.local_token":
+		x.LocalToken = value.Interface().(string)
+	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
+		x.RemoteDomain = uint32(value.Uint())
+	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
+		x.RemoteToken = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -8855,40 +9057,48 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MaxMessageBodySizeUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenPairUnlinked) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
-		panic(fmt.Errorf("field new_max_message_body_size of message circle.cctp.v1.MaxMessageBodySizeUpdated is not mutable"))
+	case "circle.cctp.v1.TokenPairUnlinked.local_token":
+		panic(fmt.Errorf("field local_token of message circle.cctp.v1.TokenPairUnlinked is not mutable"))
+	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
+		panic(fmt.Errorf("field remote_domain of message circle.cctp.v1.TokenPairUnlinked is not mutable"))
+	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
+		panic(fmt.Errorf("field remote_token of message circle.cctp.v1.TokenPairUnlinked is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_MaxMessageBodySizeUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_TokenPairUnlinked) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
-		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.cctp.v1.TokenPairUnlinked.local_token":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.TokenPairUnlinked.remote_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "circle.cctp.v1.TokenPairUnlinked.remote_token":
+		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.TokenPairUnlinked"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.TokenPairUnlinked does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_MaxMessageBodySizeUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_TokenPairUnlinked) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MaxMessageBodySizeUpdated", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.TokenPairUnlinked", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -8896,7 +9106,7 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_MaxMessageBodySizeUpdated) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_TokenPairUnlinked) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -8907,7 +9117,7 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_MaxMessageBodySizeUpdated) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_TokenPairUnlinked) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -8919,7 +9129,7 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_MaxMessageBodySizeUpdated) IsValid() bool {
+func (x *fastReflection_TokenPairUnlinked) IsValid() bool {
 	return x != nil
 }
 
@@ -8929,9 +9139,9 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_TokenPairUnlinked) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*MaxMessageBodySizeUpdated)
+		x := input.Message.Interface().(*TokenPairUnlinked)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8943,8 +9153,16 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.NewMaxMessageBodySize != 0 {
-			n += 1 + runtime.Sov(uint64(x.NewMaxMessageBodySize))
+		l = len(x.LocalToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RemoteDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemoteDomain))
+		}
+		l = len(x.RemoteToken)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -8956,7 +9174,7 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*MaxMessageBodySizeUpdated)
+		x := input.Message.Interface().(*TokenPairUnlinked)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -8975,10 +9193,24 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if x.NewMaxMessageBodySize != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewMaxMessageBodySize))
+		if len(x.RemoteToken) > 0 {
+			i -= len(x.RemoteToken)
+			copy(dAtA[i:], x.RemoteToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoteToken)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x1a
+		}
+		if x.RemoteDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemoteDomain))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.LocalToken) > 0 {
+			i -= len(x.LocalToken)
+			copy(dAtA[i:], x.LocalToken)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.LocalToken)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -8991,7 +9223,7 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*MaxMessageBodySizeUpdated)
+		x := input.Message.Interface().(*TokenPairUnlinked)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9023,17 +9255,49 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MaxMessageBodySizeUpdated: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPairUnlinked: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MaxMessageBodySizeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: TokenPairUnlinked: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.LocalToken = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewMaxMessageBodySize", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
 				}
-				x.NewMaxMessageBodySize = 0
+				x.RemoteDomain = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9043,11 +9307,45 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Me
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.NewMaxMessageBodySize |= uint64(b&0x7F) << shift
+					x.RemoteDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemoteToken = append(x.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
+				if x.RemoteToken == nil {
+					x.RemoteToken = []byte{}
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -9084,27 +9382,25 @@ func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_RemoteTokenMessengerAdded                        protoreflect.MessageDescriptor
-	fd_RemoteTokenMessengerAdded_domain                 protoreflect.FieldDescriptor
-	fd_RemoteTokenMessengerAdded_remote_token_messenger protoreflect.FieldDescriptor
+	md_MessageSent         protoreflect.MessageDescriptor
+	fd_MessageSent_message protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_RemoteTokenMessengerAdded = File_circle_cctp_v1_events_proto.Messages().ByName("RemoteTokenMessengerAdded")
-	fd_RemoteTokenMessengerAdded_domain = md_RemoteTokenMessengerAdded.Fields().ByName("domain")
-	fd_RemoteTokenMessengerAdded_remote_token_messenger = md_RemoteTokenMessengerAdded.Fields().ByName("remote_token_messenger")
+	md_MessageSent = File_circle_cctp_v1_events_proto.Messages().ByName("MessageSent")
+	fd_MessageSent_message = md_MessageSent.Fields().ByName("message")
 }
 
-var _ protoreflect.Message = (*fastReflection_RemoteTokenMessengerAdded)(nil)
+var _ protoreflect.Message = (*fastReflection_MessageSent)(nil)
 
-type fastReflection_RemoteTokenMessengerAdded RemoteTokenMessengerAdded
+type fastReflection_MessageSent MessageSent
 
-func (x *RemoteTokenMessengerAdded) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RemoteTokenMessengerAdded)(x)
+func (x *MessageSent) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MessageSent)(x)
 }
 
-func (x *RemoteTokenMessengerAdded) slowProtoReflect() protoreflect.Message {
+func (x *MessageSent) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9116,43 +9412,43 @@ func (x *RemoteTokenMessengerAdded) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RemoteTokenMessengerAdded_messageType fastReflection_RemoteTokenMessengerAdded_messageType
-var _ protoreflect.MessageType = fastReflection_RemoteTokenMessengerAdded_messageType{}
+var _fastReflection_MessageSent_messageType fastReflection_MessageSent_messageType
+var _ protoreflect.MessageType = fastReflection_MessageSent_messageType{}
 
-type fastReflection_RemoteTokenMessengerAdded_messageType struct{}
+type fastReflection_MessageSent_messageType struct{}
 
-func (x fastReflection_RemoteTokenMessengerAdded_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RemoteTokenMessengerAdded)(nil)
+func (x fastReflection_MessageSent_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MessageSent)(nil)
 }
-func (x fastReflection_RemoteTokenMessengerAdded_messageType) New() protoreflect.Message {
-	return new(fastReflection_RemoteTokenMessengerAdded)
+func (x fastReflection_MessageSent_messageType) New() protoreflect.Message {
+	return new(fastReflection_MessageSent)
 }
-func (x fastReflection_RemoteTokenMessengerAdded_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RemoteTokenMessengerAdded
+func (x fastReflection_MessageSent_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageSent
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RemoteTokenMessengerAdded) Descriptor() protoreflect.MessageDescriptor {
-	return md_RemoteTokenMessengerAdded
+func (x *fastReflection_MessageSent) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageSent
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RemoteTokenMessengerAdded) Type() protoreflect.MessageType {
-	return _fastReflection_RemoteTokenMessengerAdded_messageType
+func (x *fastReflection_MessageSent) Type() protoreflect.MessageType {
+	return _fastReflection_MessageSent_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RemoteTokenMessengerAdded) New() protoreflect.Message {
-	return new(fastReflection_RemoteTokenMessengerAdded)
+func (x *fastReflection_MessageSent) New() protoreflect.Message {
+	return new(fastReflection_MessageSent)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RemoteTokenMessengerAdded) Interface() protoreflect.ProtoMessage {
-	return (*RemoteTokenMessengerAdded)(x)
+func (x *fastReflection_MessageSent) Interface() protoreflect.ProtoMessage {
+	return (*MessageSent)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9160,16 +9456,10 @@ func (x *fastReflection_RemoteTokenMessengerAdded) Interface() protoreflect.Prot
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RemoteTokenMessengerAdded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Domain != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Domain)
-		if !f(fd_RemoteTokenMessengerAdded_domain, value) {
-			return
-		}
-	}
-	if len(x.RemoteTokenMessenger) != 0 {
-		value := protoreflect.ValueOfBytes(x.RemoteTokenMessenger)
-		if !f(fd_RemoteTokenMessengerAdded_remote_token_messenger, value) {
+func (x *fastReflection_MessageSent) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if len(x.Message) != 0 {
+		value := protoreflect.ValueOfBytes(x.Message)
+		if !f(fd_MessageSent_message, value) {
 			return
 		}
 	}
@@ -9186,17 +9476,15 @@ func (x *fastReflection_RemoteTokenMessengerAdded) Range(f func(protoreflect.Fie
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RemoteTokenMessengerAdded) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MessageSent) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
-		return x.Domain != uint32(0)
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
-		return len(x.RemoteTokenMessenger) != 0
+	case "circle.cctp.v1.MessageSent.message":
+		return len(x.Message) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9206,17 +9494,15 @@ func (x *fastReflection_RemoteTokenMessengerAdded) Has(fd protoreflect.FieldDesc
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RemoteTokenMessengerAdded) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MessageSent) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
-		x.Domain = uint32(0)
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
-		x.RemoteTokenMessenger = nil
+	case "circle.cctp.v1.MessageSent.message":
+		x.Message = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9226,19 +9512,16 @@ func (x *fastReflection_RemoteTokenMessengerAdded) Clear(fd protoreflect.FieldDe
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RemoteTokenMessengerAdded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageSent) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
-		value := x.Domain
-		return protoreflect.ValueOfUint32(value)
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
-		value := x.RemoteTokenMessenger
+	case "circle.cctp.v1.MessageSent.message":
+		value := x.Message
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9252,17 +9535,15 @@ func (x *fastReflection_RemoteTokenMessengerAdded) Get(descriptor protoreflect.F
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RemoteTokenMessengerAdded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MessageSent) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
-		x.Domain = uint32(value.Uint())
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
-		x.RemoteTokenMessenger = value.Bytes()
+	case "circle.cctp.v1.MessageSent.message":
+		x.Message = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9276,44 +9557,40 @@ func (x *fastReflection_RemoteTokenMessengerAdded) Set(fd protoreflect.FieldDesc
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RemoteTokenMessengerAdded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageSent) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
-		panic(fmt.Errorf("field domain of message circle.cctp.v1.RemoteTokenMessengerAdded is not mutable"))
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
-		panic(fmt.Errorf("field remote_token_messenger of message circle.cctp.v1.RemoteTokenMessengerAdded is not mutable"))
+	case "circle.cctp.v1.MessageSent.message":
+		panic(fmt.Errorf("field message of message circle.cctp.v1.MessageSent is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RemoteTokenMessengerAdded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageSent) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
-		return protoreflect.ValueOfUint32(uint32(0))
-	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
+	case "circle.cctp.v1.MessageSent.message":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageSent"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageSent does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RemoteTokenMessengerAdded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MessageSent) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.RemoteTokenMessengerAdded", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MessageSent", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9321,7 +9598,7 @@ func (x *fastReflection_RemoteTokenMessengerAdded) WhichOneof(d protoreflect.One
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RemoteTokenMessengerAdded) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MessageSent) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9332,7 +9609,7 @@ func (x *fastReflection_RemoteTokenMessengerAdded) GetUnknown() protoreflect.Raw
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RemoteTokenMessengerAdded) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MessageSent) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9344,7 +9621,7 @@ func (x *fastReflection_RemoteTokenMessengerAdded) SetUnknown(fields protoreflec
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RemoteTokenMessengerAdded) IsValid() bool {
+func (x *fastReflection_MessageSent) IsValid() bool {
 	return x != nil
 }
 
@@ -9354,9 +9631,9 @@ func (x *fastReflection_RemoteTokenMessengerAdded) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MessageSent) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RemoteTokenMessengerAdded)
+		x := input.Message.Interface().(*MessageSent)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9368,10 +9645,7 @@ func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Me
 		var n int
 		var l int
 		_ = l
-		if x.Domain != 0 {
-			n += 1 + runtime.Sov(uint64(x.Domain))
-		}
-		l = len(x.RemoteTokenMessenger)
+		l = len(x.Message)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -9385,7 +9659,7 @@ func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Me
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RemoteTokenMessengerAdded)
+		x := input.Message.Interface().(*MessageSent)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9404,17 +9678,12 @@ func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Me
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RemoteTokenMessenger) > 0 {
-			i -= len(x.RemoteTokenMessenger)
-			copy(dAtA[i:], x.RemoteTokenMessenger)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoteTokenMessenger)))
-			i--
-			dAtA[i] = 0x12
-		}
-		if x.Domain != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Domain))
+		if len(x.Message) > 0 {
+			i -= len(x.Message)
+			copy(dAtA[i:], x.Message)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Message)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9427,7 +9696,7 @@ func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Me
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RemoteTokenMessengerAdded)
+		x := input.Message.Interface().(*MessageSent)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9459,34 +9728,15 @@ func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Me
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTokenMessengerAdded: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageSent: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTokenMessengerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageSent: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
-				}
-				x.Domain = 0
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					x.Domain |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-			case 2:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 				}
 				var byteLen int
 				for shift := uint(0); ; shift += 7 {
@@ -9513,9 +9763,9 @@ func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Me
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RemoteTokenMessenger = append(x.RemoteTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
-				if x.RemoteTokenMessenger == nil {
-					x.RemoteTokenMessenger = []byte{}
+				x.Message = append(x.Message[:0], dAtA[iNdEx:postIndex]...)
+				if x.Message == nil {
+					x.Message = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -9554,27 +9804,33 @@ func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Me
 }
 
 var (
-	md_RemoteTokenMessengerRemoved                        protoreflect.MessageDescriptor
-	fd_RemoteTokenMessengerRemoved_domain                 protoreflect.FieldDescriptor
-	fd_RemoteTokenMessengerRemoved_remote_token_messenger protoreflect.FieldDescriptor
+	md_MessageReceived               protoreflect.MessageDescriptor
+	fd_MessageReceived_caller        protoreflect.FieldDescriptor
+	fd_MessageReceived_source_domain protoreflect.FieldDescriptor
+	fd_MessageReceived_nonce         protoreflect.FieldDescriptor
+	fd_MessageReceived_sender        protoreflect.FieldDescriptor
+	fd_MessageReceived_message_body  protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_RemoteTokenMessengerRemoved = File_circle_cctp_v1_events_proto.Messages().ByName("RemoteTokenMessengerRemoved")
-	fd_RemoteTokenMessengerRemoved_domain = md_RemoteTokenMessengerRemoved.Fields().ByName("domain")
-	fd_RemoteTokenMessengerRemoved_remote_token_messenger = md_RemoteTokenMessengerRemoved.Fields().ByName("remote_token_messenger")
+	md_MessageReceived = File_circle_cctp_v1_events_proto.Messages().ByName("MessageReceived")
+	fd_MessageReceived_caller = md_MessageReceived.Fields().ByName("caller")
+	fd_MessageReceived_source_domain = md_MessageReceived.Fields().ByName("source_domain")
+	fd_MessageReceived_nonce = md_MessageReceived.Fields().ByName("nonce")
+	fd_MessageReceived_sender = md_MessageReceived.Fields().ByName("sender")
+	fd_MessageReceived_message_body = md_MessageReceived.Fields().ByName("message_body")
 }
 
-var _ protoreflect.Message = (*fastReflection_RemoteTokenMessengerRemoved)(nil)
+var _ protoreflect.Message = (*fastReflection_MessageReceived)(nil)
 
-type fastReflection_RemoteTokenMessengerRemoved RemoteTokenMessengerRemoved
+type fastReflection_MessageReceived MessageReceived
 
-func (x *RemoteTokenMessengerRemoved) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RemoteTokenMessengerRemoved)(x)
+func (x *MessageReceived) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MessageReceived)(x)
 }
 
-func (x *RemoteTokenMessengerRemoved) slowProtoReflect() protoreflect.Message {
+func (x *MessageReceived) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -9586,43 +9842,43 @@ func (x *RemoteTokenMessengerRemoved) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_RemoteTokenMessengerRemoved_messageType fastReflection_RemoteTokenMessengerRemoved_messageType
-var _ protoreflect.MessageType = fastReflection_RemoteTokenMessengerRemoved_messageType{}
+var _fastReflection_MessageReceived_messageType fastReflection_MessageReceived_messageType
+var _ protoreflect.MessageType = fastReflection_MessageReceived_messageType{}
 
-type fastReflection_RemoteTokenMessengerRemoved_messageType struct{}
+type fastReflection_MessageReceived_messageType struct{}
 
-func (x fastReflection_RemoteTokenMessengerRemoved_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RemoteTokenMessengerRemoved)(nil)
+func (x fastReflection_MessageReceived_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MessageReceived)(nil)
 }
-func (x fastReflection_RemoteTokenMessengerRemoved_messageType) New() protoreflect.Message {
-	return new(fastReflection_RemoteTokenMessengerRemoved)
+func (x fastReflection_MessageReceived_messageType) New() protoreflect.Message {
+	return new(fastReflection_MessageReceived)
 }
-func (x fastReflection_RemoteTokenMessengerRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_RemoteTokenMessengerRemoved
+func (x fastReflection_MessageReceived_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageReceived
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Descriptor() protoreflect.MessageDescriptor {
-	return md_RemoteTokenMessengerRemoved
+func (x *fastReflection_MessageReceived) Descriptor() protoreflect.MessageDescriptor {
+	return md_MessageReceived
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Type() protoreflect.MessageType {
-	return _fastReflection_RemoteTokenMessengerRemoved_messageType
+func (x *fastReflection_MessageReceived) Type() protoreflect.MessageType {
+	return _fastReflection_MessageReceived_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_RemoteTokenMessengerRemoved) New() protoreflect.Message {
-	return new(fastReflection_RemoteTokenMessengerRemoved)
+func (x *fastReflection_MessageReceived) New() protoreflect.Message {
+	return new(fastReflection_MessageReceived)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Interface() protoreflect.ProtoMessage {
-	return (*RemoteTokenMessengerRemoved)(x)
+func (x *fastReflection_MessageReceived) Interface() protoreflect.ProtoMessage {
+	return (*MessageReceived)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -9630,16 +9886,34 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) Interface() protoreflect.Pr
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Domain != uint32(0) {
-		value := protoreflect.ValueOfUint32(x.Domain)
-		if !f(fd_RemoteTokenMessengerRemoved_domain, value) {
+func (x *fastReflection_MessageReceived) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Caller != "" {
+		value := protoreflect.ValueOfString(x.Caller)
+		if !f(fd_MessageReceived_caller, value) {
 			return
 		}
 	}
-	if len(x.RemoteTokenMessenger) != 0 {
-		value := protoreflect.ValueOfBytes(x.RemoteTokenMessenger)
-		if !f(fd_RemoteTokenMessengerRemoved_remote_token_messenger, value) {
+	if x.SourceDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SourceDomain)
+		if !f(fd_MessageReceived_source_domain, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_MessageReceived_nonce, value) {
+			return
+		}
+	}
+	if len(x.Sender) != 0 {
+		value := protoreflect.ValueOfBytes(x.Sender)
+		if !f(fd_MessageReceived_sender, value) {
+			return
+		}
+	}
+	if len(x.MessageBody) != 0 {
+		value := protoreflect.ValueOfBytes(x.MessageBody)
+		if !f(fd_MessageReceived_message_body, value) {
 			return
 		}
 	}
@@ -9656,17 +9930,23 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) Range(f func(protoreflect.F
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MessageReceived) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
-		return x.Domain != uint32(0)
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
-		return len(x.RemoteTokenMessenger) != 0
+	case "circle.cctp.v1.MessageReceived.caller":
+		return x.Caller != ""
+	case "circle.cctp.v1.MessageReceived.source_domain":
+		return x.SourceDomain != uint32(0)
+	case "circle.cctp.v1.MessageReceived.nonce":
+		return x.Nonce != uint64(0)
+	case "circle.cctp.v1.MessageReceived.sender":
+		return len(x.Sender) != 0
+	case "circle.cctp.v1.MessageReceived.message_body":
+		return len(x.MessageBody) != 0
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9676,17 +9956,23 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) Has(fd protoreflect.FieldDe
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MessageReceived) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
-		x.Domain = uint32(0)
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
-		x.RemoteTokenMessenger = nil
+	case "circle.cctp.v1.MessageReceived.caller":
+		x.Caller = ""
+	case "circle.cctp.v1.MessageReceived.source_domain":
+		x.SourceDomain = uint32(0)
+	case "circle.cctp.v1.MessageReceived.nonce":
+		x.Nonce = uint64(0)
+	case "circle.cctp.v1.MessageReceived.sender":
+		x.Sender = nil
+	case "circle.cctp.v1.MessageReceived.message_body":
+		x.MessageBody = nil
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9696,19 +9982,28 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) Clear(fd protoreflect.Field
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageReceived) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
-		value := x.Domain
+	case "circle.cctp.v1.MessageReceived.caller":
+		value := x.Caller
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.MessageReceived.source_domain":
+		value := x.SourceDomain
 		return protoreflect.ValueOfUint32(value)
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
-		value := x.RemoteTokenMessenger
+	case "circle.cctp.v1.MessageReceived.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "circle.cctp.v1.MessageReceived.sender":
+		value := x.Sender
+		return protoreflect.ValueOfBytes(value)
+	case "circle.cctp.v1.MessageReceived.message_body":
+		value := x.MessageBody
 		return protoreflect.ValueOfBytes(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -9722,17 +10017,23 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) Get(descriptor protoreflect
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MessageReceived) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
-		x.Domain = uint32(value.Uint())
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
-		x.RemoteTokenMessenger = value.Bytes()
+	case "circle.cctp.v1.MessageReceived.caller":
+		x.Caller = value.Interface().(string)
+	case "circle.cctp.v1.MessageReceived.source_domain":
+		x.SourceDomain = uint32(value.Uint())
+	case "circle.cctp.v1.MessageReceived.nonce":
+		x.Nonce = value.Uint()
+	case "circle.cctp.v1.MessageReceived.sender":
+		x.Sender = value.Bytes()
+	case "circle.cctp.v1.MessageReceived.message_body":
+		x.MessageBody = value.Bytes()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -9746,44 +10047,56 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) Set(fd protoreflect.FieldDe
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RemoteTokenMessengerRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageReceived) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
-		panic(fmt.Errorf("field domain of message circle.cctp.v1.RemoteTokenMessengerRemoved is not mutable"))
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
-		panic(fmt.Errorf("field remote_token_messenger of message circle.cctp.v1.RemoteTokenMessengerRemoved is not mutable"))
+	case "circle.cctp.v1.MessageReceived.caller":
+		panic(fmt.Errorf("field caller of message circle.cctp.v1.MessageReceived is not mutable"))
+	case "circle.cctp.v1.MessageReceived.source_domain":
+		panic(fmt.Errorf("field source_domain of message circle.cctp.v1.MessageReceived is not mutable"))
+	case "circle.cctp.v1.MessageReceived.nonce":
+		panic(fmt.Errorf("field nonce of message circle.cctp.v1.MessageReceived is not mutable"))
+	case "circle.cctp.v1.MessageReceived.sender":
+		panic(fmt.Errorf("field sender of message circle.cctp.v1.MessageReceived is not mutable"))
+	case "circle.cctp.v1.MessageReceived.message_body":
+		panic(fmt.Errorf("field message_body of message circle.cctp.v1.MessageReceived is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_RemoteTokenMessengerRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MessageReceived) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
+	case "circle.cctp.v1.MessageReceived.caller":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.MessageReceived.source_domain":
 		return protoreflect.ValueOfUint32(uint32(0))
-	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
+	case "circle.cctp.v1.MessageReceived.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.cctp.v1.MessageReceived.sender":
+		return protoreflect.ValueOfBytes(nil)
+	case "circle.cctp.v1.MessageReceived.message_body":
 		return protoreflect.ValueOfBytes(nil)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MessageReceived"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MessageReceived does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_RemoteTokenMessengerRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MessageReceived) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.RemoteTokenMessengerRemoved", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MessageReceived", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -9791,7 +10104,7 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) WhichOneof(d protoreflect.O
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_RemoteTokenMessengerRemoved) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MessageReceived) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -9802,7 +10115,7 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) GetUnknown() protoreflect.R
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_RemoteTokenMessengerRemoved) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MessageReceived) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -9814,7 +10127,7 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) SetUnknown(fields protorefl
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_RemoteTokenMessengerRemoved) IsValid() bool {
+func (x *fastReflection_MessageReceived) IsValid() bool {
 	return x != nil
 }
 
@@ -9824,9 +10137,9 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MessageReceived) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*RemoteTokenMessengerRemoved)
+		x := input.Message.Interface().(*MessageReceived)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9838,10 +10151,21 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 		var n int
 		var l int
 		_ = l
-		if x.Domain != 0 {
-			n += 1 + runtime.Sov(uint64(x.Domain))
+		l = len(x.Caller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		l = len(x.RemoteTokenMessenger)
+		if x.SourceDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.SourceDomain))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		l = len(x.Sender)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MessageBody)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
@@ -9855,7 +10179,7 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*RemoteTokenMessengerRemoved)
+		x := input.Message.Interface().(*MessageReceived)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9874,17 +10198,36 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.RemoteTokenMessenger) > 0 {
-			i -= len(x.RemoteTokenMessenger)
-			copy(dAtA[i:], x.RemoteTokenMessenger)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoteTokenMessenger)))
+		if len(x.MessageBody) > 0 {
+			i -= len(x.MessageBody)
+			copy(dAtA[i:], x.MessageBody)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MessageBody)))
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x2a
 		}
-		if x.Domain != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.Domain))
+		if len(x.Sender) > 0 {
+			i -= len(x.Sender)
+			copy(dAtA[i:], x.Sender)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Sender)))
 			i--
-			dAtA[i] = 0x8
+			dAtA[i] = 0x22
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SourceDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SourceDomain))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Caller) > 0 {
+			i -= len(x.Caller)
+			copy(dAtA[i:], x.Caller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Caller)))
+			i--
+			dAtA[i] = 0xa
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -9897,7 +10240,7 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*RemoteTokenMessengerRemoved)
+		x := input.Message.Interface().(*MessageReceived)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -9929,17 +10272,17 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTokenMessengerRemoved: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageReceived: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTokenMessengerRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MessageReceived: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 				}
-				x.Domain = 0
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9949,16 +10292,29 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.Domain |= uint32(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Caller = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
 				}
-				var byteLen int
+				x.SourceDomain = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -9968,7 +10324,45 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					x.SourceDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
@@ -9983,9 +10377,43 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.RemoteTokenMessenger = append(x.RemoteTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
-				if x.RemoteTokenMessenger == nil {
-					x.RemoteTokenMessenger = []byte{}
+				x.Sender = append(x.Sender[:0], dAtA[iNdEx:postIndex]...)
+				if x.Sender == nil {
+					x.Sender = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MessageBody", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MessageBody = append(x.MessageBody[:0], dAtA[iNdEx:postIndex]...)
+				if x.MessageBody == nil {
+					x.MessageBody = []byte{}
 				}
 				iNdEx = postIndex
 			default:
@@ -10024,27 +10452,25 @@ func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.
 }
 
 var (
-	md_SetBurnLimitPerMessage                        protoreflect.MessageDescriptor
-	fd_SetBurnLimitPerMessage_token                  protoreflect.FieldDescriptor
-	fd_SetBurnLimitPerMessage_burn_limit_per_message protoreflect.FieldDescriptor
+	md_MaxMessageBodySizeUpdated                           protoreflect.MessageDescriptor
+	fd_MaxMessageBodySizeUpdated_new_max_message_body_size protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_circle_cctp_v1_events_proto_init()
-	md_SetBurnLimitPerMessage = File_circle_cctp_v1_events_proto.Messages().ByName("SetBurnLimitPerMessage")
-	fd_SetBurnLimitPerMessage_token = md_SetBurnLimitPerMessage.Fields().ByName("token")
-	fd_SetBurnLimitPerMessage_burn_limit_per_message = md_SetBurnLimitPerMessage.Fields().ByName("burn_limit_per_message")
+	md_MaxMessageBodySizeUpdated = File_circle_cctp_v1_events_proto.Messages().ByName("MaxMessageBodySizeUpdated")
+	fd_MaxMessageBodySizeUpdated_new_max_message_body_size = md_MaxMessageBodySizeUpdated.Fields().ByName("new_max_message_body_size")
 }
 
-var _ protoreflect.Message = (*fastReflection_SetBurnLimitPerMessage)(nil)
+var _ protoreflect.Message = (*fastReflection_MaxMessageBodySizeUpdated)(nil)
 
-type fastReflection_SetBurnLimitPerMessage SetBurnLimitPerMessage
+type fastReflection_MaxMessageBodySizeUpdated MaxMessageBodySizeUpdated
 
-func (x *SetBurnLimitPerMessage) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_SetBurnLimitPerMessage)(x)
+func (x *MaxMessageBodySizeUpdated) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MaxMessageBodySizeUpdated)(x)
 }
 
-func (x *SetBurnLimitPerMessage) slowProtoReflect() protoreflect.Message {
+func (x *MaxMessageBodySizeUpdated) slowProtoReflect() protoreflect.Message {
 	mi := &file_circle_cctp_v1_events_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -10056,43 +10482,43 @@ func (x *SetBurnLimitPerMessage) slowProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-var _fastReflection_SetBurnLimitPerMessage_messageType fastReflection_SetBurnLimitPerMessage_messageType
-var _ protoreflect.MessageType = fastReflection_SetBurnLimitPerMessage_messageType{}
+var _fastReflection_MaxMessageBodySizeUpdated_messageType fastReflection_MaxMessageBodySizeUpdated_messageType
+var _ protoreflect.MessageType = fastReflection_MaxMessageBodySizeUpdated_messageType{}
 
-type fastReflection_SetBurnLimitPerMessage_messageType struct{}
+type fastReflection_MaxMessageBodySizeUpdated_messageType struct{}
 
-func (x fastReflection_SetBurnLimitPerMessage_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_SetBurnLimitPerMessage)(nil)
+func (x fastReflection_MaxMessageBodySizeUpdated_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MaxMessageBodySizeUpdated)(nil)
 }
-func (x fastReflection_SetBurnLimitPerMessage_messageType) New() protoreflect.Message {
-	return new(fastReflection_SetBurnLimitPerMessage)
+func (x fastReflection_MaxMessageBodySizeUpdated_messageType) New() protoreflect.Message {
+	return new(fastReflection_MaxMessageBodySizeUpdated)
 }
-func (x fastReflection_SetBurnLimitPerMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_SetBurnLimitPerMessage
+func (x fastReflection_MaxMessageBodySizeUpdated_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MaxMessageBodySizeUpdated
 }
 
 // Descriptor returns message descriptor, which contains only the protobuf
 // type information for the message.
-func (x *fastReflection_SetBurnLimitPerMessage) Descriptor() protoreflect.MessageDescriptor {
-	return md_SetBurnLimitPerMessage
+func (x *fastReflection_MaxMessageBodySizeUpdated) Descriptor() protoreflect.MessageDescriptor {
+	return md_MaxMessageBodySizeUpdated
 }
 
 // Type returns the message type, which encapsulates both Go and protobuf
 // type information. If the Go type information is not needed,
 // it is recommended that the message descriptor be used instead.
-func (x *fastReflection_SetBurnLimitPerMessage) Type() protoreflect.MessageType {
-	return _fastReflection_SetBurnLimitPerMessage_messageType
+func (x *fastReflection_MaxMessageBodySizeUpdated) Type() protoreflect.MessageType {
+	return _fastReflection_MaxMessageBodySizeUpdated_messageType
 }
 
 // New returns a newly allocated and mutable empty message.
-func (x *fastReflection_SetBurnLimitPerMessage) New() protoreflect.Message {
-	return new(fastReflection_SetBurnLimitPerMessage)
+func (x *fastReflection_MaxMessageBodySizeUpdated) New() protoreflect.Message {
+	return new(fastReflection_MaxMessageBodySizeUpdated)
 }
 
 // Interface unwraps the message reflection interface and
 // returns the underlying ProtoMessage interface.
-func (x *fastReflection_SetBurnLimitPerMessage) Interface() protoreflect.ProtoMessage {
-	return (*SetBurnLimitPerMessage)(x)
+func (x *fastReflection_MaxMessageBodySizeUpdated) Interface() protoreflect.ProtoMessage {
+	return (*MaxMessageBodySizeUpdated)(x)
 }
 
 // Range iterates over every populated field in an undefined order,
@@ -10100,16 +10526,10 @@ func (x *fastReflection_SetBurnLimitPerMessage) Interface() protoreflect.ProtoMe
 // Range returns immediately if f returns false.
 // While iterating, mutating operations may only be performed
 // on the current field descriptor.
-func (x *fastReflection_SetBurnLimitPerMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Token != "" {
-		value := protoreflect.ValueOfString(x.Token)
-		if !f(fd_SetBurnLimitPerMessage_token, value) {
-			return
-		}
-	}
-	if x.BurnLimitPerMessage != "" {
-		value := protoreflect.ValueOfString(x.BurnLimitPerMessage)
-		if !f(fd_SetBurnLimitPerMessage_burn_limit_per_message, value) {
+func (x *fastReflection_MaxMessageBodySizeUpdated) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.NewMaxMessageBodySize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.NewMaxMessageBodySize)
+		if !f(fd_MaxMessageBodySizeUpdated_new_max_message_body_size, value) {
 			return
 		}
 	}
@@ -10126,17 +10546,15 @@ func (x *fastReflection_SetBurnLimitPerMessage) Range(f func(protoreflect.FieldD
 // In other cases (aside from the nullable cases above),
 // a proto3 scalar field is populated if it contains a non-zero value, and
 // a repeated field is populated if it is non-empty.
-func (x *fastReflection_SetBurnLimitPerMessage) Has(fd protoreflect.FieldDescriptor) bool {
+func (x *fastReflection_MaxMessageBodySizeUpdated) Has(fd protoreflect.FieldDescriptor) bool {
 	switch fd.FullName() {
-	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
-		return x.Token != ""
-	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
-		return x.BurnLimitPerMessage != ""
+	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
+		return x.NewMaxMessageBodySize != uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10146,17 +10564,15 @@ func (x *fastReflection_SetBurnLimitPerMessage) Has(fd protoreflect.FieldDescrip
 // associated with the given field number.
 //
 // Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SetBurnLimitPerMessage) Clear(fd protoreflect.FieldDescriptor) {
+func (x *fastReflection_MaxMessageBodySizeUpdated) Clear(fd protoreflect.FieldDescriptor) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
-		x.Token = ""
-	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
-		x.BurnLimitPerMessage = ""
+	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
+		x.NewMaxMessageBodySize = uint64(0)
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10166,19 +10582,16 @@ func (x *fastReflection_SetBurnLimitPerMessage) Clear(fd protoreflect.FieldDescr
 // the default value of a bytes scalar is guaranteed to be a copy.
 // For unpopulated composite types, it returns an empty, read-only view
 // of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_SetBurnLimitPerMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MaxMessageBodySizeUpdated) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
 	switch descriptor.FullName() {
-	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
-		value := x.Token
-		return protoreflect.ValueOfString(value)
-	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
-		value := x.BurnLimitPerMessage
-		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
+		value := x.NewMaxMessageBodySize
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", descriptor.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", descriptor.FullName()))
 	}
 }
 
@@ -10192,17 +10605,15 @@ func (x *fastReflection_SetBurnLimitPerMessage) Get(descriptor protoreflect.Fiel
 // empty, read-only value, then it panics.
 //
 // Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SetBurnLimitPerMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+func (x *fastReflection_MaxMessageBodySizeUpdated) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
 	switch fd.FullName() {
-	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
-		x.Token = value.Interface().(string)
-	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
-		x.BurnLimitPerMessage = value.Interface().(string)
+	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
+		x.NewMaxMessageBodySize = value.Uint()
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
@@ -10216,44 +10627,40 @@ func (x *fastReflection_SetBurnLimitPerMessage) Set(fd protoreflect.FieldDescrip
 // It panics if the field does not contain a composite type.
 //
 // Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SetBurnLimitPerMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MaxMessageBodySizeUpdated) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
-		panic(fmt.Errorf("field token of message circle.cctp.v1.SetBurnLimitPerMessage is not mutable"))
-	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
-		panic(fmt.Errorf("field burn_limit_per_message of message circle.cctp.v1.SetBurnLimitPerMessage is not mutable"))
+	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
+		panic(fmt.Errorf("field new_max_message_body_size of message circle.cctp.v1.MaxMessageBodySizeUpdated is not mutable"))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
 // NewField returns a new value that is assignable to the field
 // for the given descriptor. For scalars, this returns the default value.
 // For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_SetBurnLimitPerMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+func (x *fastReflection_MaxMessageBodySizeUpdated) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
-	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
-		return protoreflect.ValueOfString("")
-	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
-		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.MaxMessageBodySizeUpdated.new_max_message_body_size":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MaxMessageBodySizeUpdated"))
 		}
-		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+		panic(fmt.Errorf("message circle.cctp.v1.MaxMessageBodySizeUpdated does not contain field %s", fd.FullName()))
 	}
 }
 
 // WhichOneof reports which field within the oneof is populated,
 // returning nil if none are populated.
 // It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_SetBurnLimitPerMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+func (x *fastReflection_MaxMessageBodySizeUpdated) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
 	switch d.FullName() {
 	default:
-		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.SetBurnLimitPerMessage", d.FullName()))
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MaxMessageBodySizeUpdated", d.FullName()))
 	}
 	panic("unreachable")
 }
@@ -10261,7 +10668,7 @@ func (x *fastReflection_SetBurnLimitPerMessage) WhichOneof(d protoreflect.OneofD
 // GetUnknown retrieves the entire list of unknown fields.
 // The caller may only mutate the contents of the RawFields
 // if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_SetBurnLimitPerMessage) GetUnknown() protoreflect.RawFields {
+func (x *fastReflection_MaxMessageBodySizeUpdated) GetUnknown() protoreflect.RawFields {
 	return x.unknownFields
 }
 
@@ -10272,7 +10679,7 @@ func (x *fastReflection_SetBurnLimitPerMessage) GetUnknown() protoreflect.RawFie
 // An empty RawFields may be passed to clear the fields.
 //
 // SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_SetBurnLimitPerMessage) SetUnknown(fields protoreflect.RawFields) {
+func (x *fastReflection_MaxMessageBodySizeUpdated) SetUnknown(fields protoreflect.RawFields) {
 	x.unknownFields = fields
 }
 
@@ -10284,7 +10691,7 @@ func (x *fastReflection_SetBurnLimitPerMessage) SetUnknown(fields protoreflect.R
 // message type, but the details are implementation dependent.
 // Validity is not part of the protobuf data model, and may not
 // be preserved in marshaling or other operations.
-func (x *fastReflection_SetBurnLimitPerMessage) IsValid() bool {
+func (x *fastReflection_MaxMessageBodySizeUpdated) IsValid() bool {
 	return x != nil
 }
 
@@ -10294,9 +10701,9 @@ func (x *fastReflection_SetBurnLimitPerMessage) IsValid() bool {
 // The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
-func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Methods {
+func (x *fastReflection_MaxMessageBodySizeUpdated) ProtoMethods() *protoiface.Methods {
 	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
-		x := input.Message.Interface().(*SetBurnLimitPerMessage)
+		x := input.Message.Interface().(*MaxMessageBodySizeUpdated)
 		if x == nil {
 			return protoiface.SizeOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10308,13 +10715,8 @@ func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Metho
 		var n int
 		var l int
 		_ = l
-		l = len(x.Token)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
-		}
-		l = len(x.BurnLimitPerMessage)
-		if l > 0 {
-			n += 1 + l + runtime.Sov(uint64(l))
+		if x.NewMaxMessageBodySize != 0 {
+			n += 1 + runtime.Sov(uint64(x.NewMaxMessageBodySize))
 		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
@@ -10326,7 +10728,7 @@ func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Metho
 	}
 
 	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
-		x := input.Message.Interface().(*SetBurnLimitPerMessage)
+		x := input.Message.Interface().(*MaxMessageBodySizeUpdated)
 		if x == nil {
 			return protoiface.MarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10345,19 +10747,10 @@ func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Metho
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
-		if len(x.BurnLimitPerMessage) > 0 {
-			i -= len(x.BurnLimitPerMessage)
-			copy(dAtA[i:], x.BurnLimitPerMessage)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnLimitPerMessage)))
+		if x.NewMaxMessageBodySize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NewMaxMessageBodySize))
 			i--
-			dAtA[i] = 0x12
-		}
-		if len(x.Token) > 0 {
-			i -= len(x.Token)
-			copy(dAtA[i:], x.Token)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x8
 		}
 		if input.Buf != nil {
 			input.Buf = append(input.Buf, dAtA...)
@@ -10370,7 +10763,7 @@ func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Metho
 		}, nil
 	}
 	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*SetBurnLimitPerMessage)
+		x := input.Message.Interface().(*MaxMessageBodySizeUpdated)
 		if x == nil {
 			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
@@ -10402,49 +10795,17 @@ func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Metho
 			fieldNum := int32(wire >> 3)
 			wireType := int(wire & 0x7)
 			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SetBurnLimitPerMessage: wiretype end group for non-group")
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MaxMessageBodySizeUpdated: wiretype end group for non-group")
 			}
 			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SetBurnLimitPerMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MaxMessageBodySizeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 			}
 			switch fieldNum {
 			case 1:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
-				}
-				var stringLen uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.Token = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
-			case 2:
-				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnLimitPerMessage", wireType)
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NewMaxMessageBodySize", wireType)
 				}
-				var stringLen uint64
+				x.NewMaxMessageBodySize = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -10454,24 +10815,11 @@ func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Metho
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					stringLen |= uint64(b&0x7F) << shift
+					x.NewMaxMessageBodySize |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				intStringLen := int(stringLen)
-				if intStringLen < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				postIndex := iNdEx + intStringLen
-				if postIndex < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
-				}
-				if postIndex > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-				}
-				x.BurnLimitPerMessage = string(dAtA[iNdEx:postIndex])
-				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -10507,1069 +10855,6680 @@ func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Metho
 	}
 }
 
-//
-// Copyright (c) 2023, © Circle Internet Financial, LTD.
-//
-// Licensed under the Apache License, Version 2.0 (the "License");
-// you may not use this file except in compliance with the License.
-// You may obtain a copy of the License at
-//
-// http://www.apache.org/licenses/LICENSE-2.0
-//
-// Unless required by applicable law or agreed to in writing, software
-// distributed under the License is distributed on an "AS IS" BASIS,
-// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
-// See the License for the specific language governing permissions and
-// limitations under the License.
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: circle/cctp/v1/events.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_RemoteTokenMessengerAdded                        protoreflect.MessageDescriptor
+	fd_RemoteTokenMessengerAdded_domain                 protoreflect.FieldDescriptor
+	fd_RemoteTokenMessengerAdded_remote_token_messenger protoreflect.FieldDescriptor
 )
 
-// *
-// Emitted when an attester is enabled
-// @param attester newly enabled attester
-type AttesterEnabled struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
-}
-
-func (x *AttesterEnabled) Reset() {
-	*x = AttesterEnabled{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
-}
-
-func (x *AttesterEnabled) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*AttesterEnabled) ProtoMessage() {}
-
-// Deprecated: Use AttesterEnabled.ProtoReflect.Descriptor instead.
-func (*AttesterEnabled) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{0}
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_RemoteTokenMessengerAdded = File_circle_cctp_v1_events_proto.Messages().ByName("RemoteTokenMessengerAdded")
+	fd_RemoteTokenMessengerAdded_domain = md_RemoteTokenMessengerAdded.Fields().ByName("domain")
+	fd_RemoteTokenMessengerAdded_remote_token_messenger = md_RemoteTokenMessengerAdded.Fields().ByName("remote_token_messenger")
 }
 
-func (x *AttesterEnabled) GetAttester() string {
-	if x != nil {
-		return x.Attester
-	}
-	return ""
-}
+var _ protoreflect.Message = (*fastReflection_RemoteTokenMessengerAdded)(nil)
 
-// *
-// Emitted when an attester is disabled
-// @param attester newly disabled attester
-type AttesterDisabled struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_RemoteTokenMessengerAdded RemoteTokenMessengerAdded
 
-	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+func (x *RemoteTokenMessengerAdded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RemoteTokenMessengerAdded)(x)
 }
 
-func (x *AttesterDisabled) Reset() {
-	*x = AttesterDisabled{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[1]
+func (x *RemoteTokenMessengerAdded) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *AttesterDisabled) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_RemoteTokenMessengerAdded_messageType fastReflection_RemoteTokenMessengerAdded_messageType
+var _ protoreflect.MessageType = fastReflection_RemoteTokenMessengerAdded_messageType{}
 
-func (*AttesterDisabled) ProtoMessage() {}
+type fastReflection_RemoteTokenMessengerAdded_messageType struct{}
 
-// Deprecated: Use AttesterDisabled.ProtoReflect.Descriptor instead.
-func (*AttesterDisabled) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{1}
+func (x fastReflection_RemoteTokenMessengerAdded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RemoteTokenMessengerAdded)(nil)
 }
-
-func (x *AttesterDisabled) GetAttester() string {
-	if x != nil {
-		return x.Attester
-	}
-	return ""
+func (x fastReflection_RemoteTokenMessengerAdded_messageType) New() protoreflect.Message {
+	return new(fastReflection_RemoteTokenMessengerAdded)
 }
-
-// *
-// Emitted when threshold number of attestations (m in m/n multisig) is updated
-// @param old_signature_threshold old signature threshold
-// @param new_signature_threshold new signature threshold
-type SignatureThresholdUpdated struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	OldSignatureThreshold uint64 `protobuf:"varint,1,opt,name=old_signature_threshold,json=oldSignatureThreshold,proto3" json:"old_signature_threshold,omitempty"`
-	NewSignatureThreshold uint64 `protobuf:"varint,2,opt,name=new_signature_threshold,json=newSignatureThreshold,proto3" json:"new_signature_threshold,omitempty"`
+func (x fastReflection_RemoteTokenMessengerAdded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteTokenMessengerAdded
 }
 
-func (x *SignatureThresholdUpdated) Reset() {
-	*x = SignatureThresholdUpdated{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[2]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RemoteTokenMessengerAdded) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteTokenMessengerAdded
 }
 
-func (x *SignatureThresholdUpdated) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RemoteTokenMessengerAdded) Type() protoreflect.MessageType {
+	return _fastReflection_RemoteTokenMessengerAdded_messageType
 }
 
-func (*SignatureThresholdUpdated) ProtoMessage() {}
-
-// Deprecated: Use SignatureThresholdUpdated.ProtoReflect.Descriptor instead.
-func (*SignatureThresholdUpdated) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{2}
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RemoteTokenMessengerAdded) New() protoreflect.Message {
+	return new(fastReflection_RemoteTokenMessengerAdded)
 }
 
-func (x *SignatureThresholdUpdated) GetOldSignatureThreshold() uint64 {
-	if x != nil {
-		return x.OldSignatureThreshold
-	}
-	return 0
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RemoteTokenMessengerAdded) Interface() protoreflect.ProtoMessage {
+	return (*RemoteTokenMessengerAdded)(x)
 }
 
-func (x *SignatureThresholdUpdated) GetNewSignatureThreshold() uint64 {
-	if x != nil {
-		return x.NewSignatureThreshold
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RemoteTokenMessengerAdded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Domain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Domain)
+		if !f(fd_RemoteTokenMessengerAdded_domain, value) {
+			return
+		}
+	}
+	if len(x.RemoteTokenMessenger) != 0 {
+		value := protoreflect.ValueOfBytes(x.RemoteTokenMessenger)
+		if !f(fd_RemoteTokenMessengerAdded_remote_token_messenger, value) {
+			return
+		}
 	}
-	return 0
 }
 
-// *
-// Emitted when owner address is updated
-// @param previous_owner representing the address of the previous owner
-// @param new_owner representing the address of the new owner
-type OwnerUpdated struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RemoteTokenMessengerAdded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
+		return x.Domain != uint32(0)
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
+		return len(x.RemoteTokenMessenger) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTokenMessengerAdded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
+		x.Domain = uint32(0)
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
+		x.RemoteTokenMessenger = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RemoteTokenMessengerAdded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
+		value := x.Domain
+		return protoreflect.ValueOfUint32(value)
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
+		value := x.RemoteTokenMessenger
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTokenMessengerAdded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
+		x.Domain = uint32(value.Uint())
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
+		x.RemoteTokenMessenger = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTokenMessengerAdded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
+		panic(fmt.Errorf("field domain of message circle.cctp.v1.RemoteTokenMessengerAdded is not mutable"))
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
+		panic(fmt.Errorf("field remote_token_messenger of message circle.cctp.v1.RemoteTokenMessengerAdded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RemoteTokenMessengerAdded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "circle.cctp.v1.RemoteTokenMessengerAdded.remote_token_messenger":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerAdded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerAdded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RemoteTokenMessengerAdded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.RemoteTokenMessengerAdded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RemoteTokenMessengerAdded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTokenMessengerAdded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RemoteTokenMessengerAdded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RemoteTokenMessengerAdded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RemoteTokenMessengerAdded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Domain != 0 {
+			n += 1 + runtime.Sov(uint64(x.Domain))
+		}
+		l = len(x.RemoteTokenMessenger)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RemoteTokenMessengerAdded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RemoteTokenMessenger) > 0 {
+			i -= len(x.RemoteTokenMessenger)
+			copy(dAtA[i:], x.RemoteTokenMessenger)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoteTokenMessenger)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Domain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Domain))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RemoteTokenMessengerAdded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTokenMessengerAdded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTokenMessengerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				}
+				x.Domain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Domain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemoteTokenMessenger = append(x.RemoteTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
+				if x.RemoteTokenMessenger == nil {
+					x.RemoteTokenMessenger = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RemoteTokenMessengerRemoved                        protoreflect.MessageDescriptor
+	fd_RemoteTokenMessengerRemoved_domain                 protoreflect.FieldDescriptor
+	fd_RemoteTokenMessengerRemoved_remote_token_messenger protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_RemoteTokenMessengerRemoved = File_circle_cctp_v1_events_proto.Messages().ByName("RemoteTokenMessengerRemoved")
+	fd_RemoteTokenMessengerRemoved_domain = md_RemoteTokenMessengerRemoved.Fields().ByName("domain")
+	fd_RemoteTokenMessengerRemoved_remote_token_messenger = md_RemoteTokenMessengerRemoved.Fields().ByName("remote_token_messenger")
+}
+
+var _ protoreflect.Message = (*fastReflection_RemoteTokenMessengerRemoved)(nil)
+
+type fastReflection_RemoteTokenMessengerRemoved RemoteTokenMessengerRemoved
+
+func (x *RemoteTokenMessengerRemoved) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RemoteTokenMessengerRemoved)(x)
+}
+
+func (x *RemoteTokenMessengerRemoved) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RemoteTokenMessengerRemoved_messageType fastReflection_RemoteTokenMessengerRemoved_messageType
+var _ protoreflect.MessageType = fastReflection_RemoteTokenMessengerRemoved_messageType{}
+
+type fastReflection_RemoteTokenMessengerRemoved_messageType struct{}
+
+func (x fastReflection_RemoteTokenMessengerRemoved_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RemoteTokenMessengerRemoved)(nil)
+}
+func (x fastReflection_RemoteTokenMessengerRemoved_messageType) New() protoreflect.Message {
+	return new(fastReflection_RemoteTokenMessengerRemoved)
+}
+func (x fastReflection_RemoteTokenMessengerRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteTokenMessengerRemoved
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Descriptor() protoreflect.MessageDescriptor {
+	return md_RemoteTokenMessengerRemoved
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Type() protoreflect.MessageType {
+	return _fastReflection_RemoteTokenMessengerRemoved_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_RemoteTokenMessengerRemoved) New() protoreflect.Message {
+	return new(fastReflection_RemoteTokenMessengerRemoved)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Interface() protoreflect.ProtoMessage {
+	return (*RemoteTokenMessengerRemoved)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Domain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Domain)
+		if !f(fd_RemoteTokenMessengerRemoved_domain, value) {
+			return
+		}
+	}
+	if len(x.RemoteTokenMessenger) != 0 {
+		value := protoreflect.ValueOfBytes(x.RemoteTokenMessenger)
+		if !f(fd_RemoteTokenMessengerRemoved_remote_token_messenger, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
+		return x.Domain != uint32(0)
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
+		return len(x.RemoteTokenMessenger) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
+		x.Domain = uint32(0)
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
+		x.RemoteTokenMessenger = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
+		value := x.Domain
+		return protoreflect.ValueOfUint32(value)
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
+		value := x.RemoteTokenMessenger
+		return protoreflect.ValueOfBytes(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
+		x.Domain = uint32(value.Uint())
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
+		x.RemoteTokenMessenger = value.Bytes()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTokenMessengerRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
+		panic(fmt.Errorf("field domain of message circle.cctp.v1.RemoteTokenMessengerRemoved is not mutable"))
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
+		panic(fmt.Errorf("field remote_token_messenger of message circle.cctp.v1.RemoteTokenMessengerRemoved is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_RemoteTokenMessengerRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "circle.cctp.v1.RemoteTokenMessengerRemoved.remote_token_messenger":
+		return protoreflect.ValueOfBytes(nil)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.RemoteTokenMessengerRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.RemoteTokenMessengerRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_RemoteTokenMessengerRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.RemoteTokenMessengerRemoved", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_RemoteTokenMessengerRemoved) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_RemoteTokenMessengerRemoved) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_RemoteTokenMessengerRemoved) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_RemoteTokenMessengerRemoved) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*RemoteTokenMessengerRemoved)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Domain != 0 {
+			n += 1 + runtime.Sov(uint64(x.Domain))
+		}
+		l = len(x.RemoteTokenMessenger)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*RemoteTokenMessengerRemoved)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.RemoteTokenMessenger) > 0 {
+			i -= len(x.RemoteTokenMessenger)
+			copy(dAtA[i:], x.RemoteTokenMessenger)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemoteTokenMessenger)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Domain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Domain))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*RemoteTokenMessengerRemoved)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTokenMessengerRemoved: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: RemoteTokenMessengerRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+				}
+				x.Domain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Domain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemoteTokenMessenger = append(x.RemoteTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
+				if x.RemoteTokenMessenger == nil {
+					x.RemoteTokenMessenger = []byte{}
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_SetBurnLimitPerMessage                        protoreflect.MessageDescriptor
+	fd_SetBurnLimitPerMessage_token                  protoreflect.FieldDescriptor
+	fd_SetBurnLimitPerMessage_burn_limit_per_message protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_SetBurnLimitPerMessage = File_circle_cctp_v1_events_proto.Messages().ByName("SetBurnLimitPerMessage")
+	fd_SetBurnLimitPerMessage_token = md_SetBurnLimitPerMessage.Fields().ByName("token")
+	fd_SetBurnLimitPerMessage_burn_limit_per_message = md_SetBurnLimitPerMessage.Fields().ByName("burn_limit_per_message")
+}
+
+var _ protoreflect.Message = (*fastReflection_SetBurnLimitPerMessage)(nil)
+
+type fastReflection_SetBurnLimitPerMessage SetBurnLimitPerMessage
+
+func (x *SetBurnLimitPerMessage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_SetBurnLimitPerMessage)(x)
+}
+
+func (x *SetBurnLimitPerMessage) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_SetBurnLimitPerMessage_messageType fastReflection_SetBurnLimitPerMessage_messageType
+var _ protoreflect.MessageType = fastReflection_SetBurnLimitPerMessage_messageType{}
+
+type fastReflection_SetBurnLimitPerMessage_messageType struct{}
+
+func (x fastReflection_SetBurnLimitPerMessage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_SetBurnLimitPerMessage)(nil)
+}
+func (x fastReflection_SetBurnLimitPerMessage_messageType) New() protoreflect.Message {
+	return new(fastReflection_SetBurnLimitPerMessage)
+}
+func (x fastReflection_SetBurnLimitPerMessage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_SetBurnLimitPerMessage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_SetBurnLimitPerMessage) Descriptor() protoreflect.MessageDescriptor {
+	return md_SetBurnLimitPerMessage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_SetBurnLimitPerMessage) Type() protoreflect.MessageType {
+	return _fastReflection_SetBurnLimitPerMessage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_SetBurnLimitPerMessage) New() protoreflect.Message {
+	return new(fastReflection_SetBurnLimitPerMessage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_SetBurnLimitPerMessage) Interface() protoreflect.ProtoMessage {
+	return (*SetBurnLimitPerMessage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_SetBurnLimitPerMessage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Token != "" {
+		value := protoreflect.ValueOfString(x.Token)
+		if !f(fd_SetBurnLimitPerMessage_token, value) {
+			return
+		}
+	}
+	if x.BurnLimitPerMessage != "" {
+		value := protoreflect.ValueOfString(x.BurnLimitPerMessage)
+		if !f(fd_SetBurnLimitPerMessage_burn_limit_per_message, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_SetBurnLimitPerMessage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
+		return x.Token != ""
+	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
+		return x.BurnLimitPerMessage != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SetBurnLimitPerMessage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
+		x.Token = ""
+	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
+		x.BurnLimitPerMessage = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_SetBurnLimitPerMessage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
+		value := x.Token
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
+		value := x.BurnLimitPerMessage
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SetBurnLimitPerMessage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
+		x.Token = value.Interface().(string)
+	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
+		x.BurnLimitPerMessage = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SetBurnLimitPerMessage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
+		panic(fmt.Errorf("field token of message circle.cctp.v1.SetBurnLimitPerMessage is not mutable"))
+	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
+		panic(fmt.Errorf("field burn_limit_per_message of message circle.cctp.v1.SetBurnLimitPerMessage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_SetBurnLimitPerMessage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.SetBurnLimitPerMessage.token":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.SetBurnLimitPerMessage.burn_limit_per_message":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.SetBurnLimitPerMessage"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.SetBurnLimitPerMessage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_SetBurnLimitPerMessage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.SetBurnLimitPerMessage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_SetBurnLimitPerMessage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_SetBurnLimitPerMessage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_SetBurnLimitPerMessage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_SetBurnLimitPerMessage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*SetBurnLimitPerMessage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Token)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.BurnLimitPerMessage)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*SetBurnLimitPerMessage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BurnLimitPerMessage) > 0 {
+			i -= len(x.BurnLimitPerMessage)
+			copy(dAtA[i:], x.BurnLimitPerMessage)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnLimitPerMessage)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Token) > 0 {
+			i -= len(x.Token)
+			copy(dAtA[i:], x.Token)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Token)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*SetBurnLimitPerMessage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SetBurnLimitPerMessage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: SetBurnLimitPerMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Token = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnLimitPerMessage", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnLimitPerMessage = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForwardingSet                    protoreflect.MessageDescriptor
+	fd_ForwardingSet_address            protoreflect.FieldDescriptor
+	fd_ForwardingSet_destination_domain protoreflect.FieldDescriptor
+	fd_ForwardingSet_mint_recipient     protoreflect.FieldDescriptor
+	fd_ForwardingSet_destination_caller protoreflect.FieldDescriptor
+	fd_ForwardingSet_fee                protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_ForwardingSet = File_circle_cctp_v1_events_proto.Messages().ByName("ForwardingSet")
+	fd_ForwardingSet_address = md_ForwardingSet.Fields().ByName("address")
+	fd_ForwardingSet_destination_domain = md_ForwardingSet.Fields().ByName("destination_domain")
+	fd_ForwardingSet_mint_recipient = md_ForwardingSet.Fields().ByName("mint_recipient")
+	fd_ForwardingSet_destination_caller = md_ForwardingSet.Fields().ByName("destination_caller")
+	fd_ForwardingSet_fee = md_ForwardingSet.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardingSet)(nil)
+
+type fastReflection_ForwardingSet ForwardingSet
+
+func (x *ForwardingSet) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardingSet)(x)
+}
+
+func (x *ForwardingSet) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardingSet_messageType fastReflection_ForwardingSet_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardingSet_messageType{}
+
+type fastReflection_ForwardingSet_messageType struct{}
+
+func (x fastReflection_ForwardingSet_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardingSet)(nil)
+}
+func (x fastReflection_ForwardingSet_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardingSet)
+}
+func (x fastReflection_ForwardingSet_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardingSet
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardingSet) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardingSet
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardingSet) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardingSet_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardingSet) New() protoreflect.Message {
+	return new(fastReflection_ForwardingSet)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardingSet) Interface() protoreflect.ProtoMessage {
+	return (*ForwardingSet)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardingSet) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ForwardingSet_address, value) {
+			return
+		}
+	}
+	if x.DestinationDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DestinationDomain)
+		if !f(fd_ForwardingSet_destination_domain, value) {
+			return
+		}
+	}
+	if len(x.MintRecipient) != 0 {
+		value := protoreflect.ValueOfBytes(x.MintRecipient)
+		if !f(fd_ForwardingSet_mint_recipient, value) {
+			return
+		}
+	}
+	if len(x.DestinationCaller) != 0 {
+		value := protoreflect.ValueOfBytes(x.DestinationCaller)
+		if !f(fd_ForwardingSet_destination_caller, value) {
+			return
+		}
+	}
+	if x.Fee != "" {
+		value := protoreflect.ValueOfString(x.Fee)
+		if !f(fd_ForwardingSet_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardingSet) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingSet.address":
+		return x.Address != ""
+	case "circle.cctp.v1.ForwardingSet.destination_domain":
+		return x.DestinationDomain != uint32(0)
+	case "circle.cctp.v1.ForwardingSet.mint_recipient":
+		return len(x.MintRecipient) != 0
+	case "circle.cctp.v1.ForwardingSet.destination_caller":
+		return len(x.DestinationCaller) != 0
+	case "circle.cctp.v1.ForwardingSet.fee":
+		return x.Fee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingSet"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingSet) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingSet.address":
+		x.Address = ""
+	case "circle.cctp.v1.ForwardingSet.destination_domain":
+		x.DestinationDomain = uint32(0)
+	case "circle.cctp.v1.ForwardingSet.mint_recipient":
+		x.MintRecipient = nil
+	case "circle.cctp.v1.ForwardingSet.destination_caller":
+		x.DestinationCaller = nil
+	case "circle.cctp.v1.ForwardingSet.fee":
+		x.Fee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingSet"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardingSet) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.ForwardingSet.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.ForwardingSet.destination_domain":
+		value := x.DestinationDomain
+		return protoreflect.ValueOfUint32(value)
+	case "circle.cctp.v1.ForwardingSet.mint_recipient":
+		value := x.MintRecipient
+		return protoreflect.ValueOfBytes(value)
+	case "circle.cctp.v1.ForwardingSet.destination_caller":
+		value := x.DestinationCaller
+		return protoreflect.ValueOfBytes(value)
+	case "circle.cctp.v1.ForwardingSet.fee":
+		value := x.Fee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingSet"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingSet does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingSet) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingSet.address":
+		x.Address = value.Interface().(string)
+	case "circle.cctp.v1.ForwardingSet.destination_domain":
+		x.DestinationDomain = uint32(value.Uint())
+	case "circle.cctp.v1.ForwardingSet.mint_recipient":
+		x.MintRecipient = value.Bytes()
+	case "circle.cctp.v1.ForwardingSet.destination_caller":
+		x.DestinationCaller = value.Bytes()
+	case "circle.cctp.v1.ForwardingSet.fee":
+		x.Fee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingSet"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingSet) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingSet.address":
+		panic(fmt.Errorf("field address of message circle.cctp.v1.ForwardingSet is not mutable"))
+	case "circle.cctp.v1.ForwardingSet.destination_domain":
+		panic(fmt.Errorf("field destination_domain of message circle.cctp.v1.ForwardingSet is not mutable"))
+	case "circle.cctp.v1.ForwardingSet.mint_recipient":
+		panic(fmt.Errorf("field mint_recipient of message circle.cctp.v1.ForwardingSet is not mutable"))
+	case "circle.cctp.v1.ForwardingSet.destination_caller":
+		panic(fmt.Errorf("field destination_caller of message circle.cctp.v1.ForwardingSet is not mutable"))
+	case "circle.cctp.v1.ForwardingSet.fee":
+		panic(fmt.Errorf("field fee of message circle.cctp.v1.ForwardingSet is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingSet"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardingSet) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingSet.address":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.ForwardingSet.destination_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "circle.cctp.v1.ForwardingSet.mint_recipient":
+		return protoreflect.ValueOfBytes(nil)
+	case "circle.cctp.v1.ForwardingSet.destination_caller":
+		return protoreflect.ValueOfBytes(nil)
+	case "circle.cctp.v1.ForwardingSet.fee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingSet"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingSet does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardingSet) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.ForwardingSet", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardingSet) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingSet) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardingSet) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardingSet) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardingSet)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.DestinationDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.DestinationDomain))
+		}
+		l = len(x.MintRecipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.DestinationCaller)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Fee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardingSet)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fee) > 0 {
+			i -= len(x.Fee)
+			copy(dAtA[i:], x.Fee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fee)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.DestinationCaller) > 0 {
+			i -= len(x.DestinationCaller)
+			copy(dAtA[i:], x.DestinationCaller)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.DestinationCaller)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.MintRecipient) > 0 {
+			i -= len(x.MintRecipient)
+			copy(dAtA[i:], x.MintRecipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MintRecipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.DestinationDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DestinationDomain))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardingSet)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardingSet: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardingSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				}
+				x.DestinationDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DestinationDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MintRecipient = append(x.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+				if x.MintRecipient == nil {
+					x.MintRecipient = []byte{}
+				}
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DestinationCaller = append(x.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+				if x.DestinationCaller == nil {
+					x.DestinationCaller = []byte{}
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ForwardingRemoved         protoreflect.MessageDescriptor
+	fd_ForwardingRemoved_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_ForwardingRemoved = File_circle_cctp_v1_events_proto.Messages().ByName("ForwardingRemoved")
+	fd_ForwardingRemoved_address = md_ForwardingRemoved.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_ForwardingRemoved)(nil)
+
+type fastReflection_ForwardingRemoved ForwardingRemoved
+
+func (x *ForwardingRemoved) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ForwardingRemoved)(x)
+}
+
+func (x *ForwardingRemoved) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ForwardingRemoved_messageType fastReflection_ForwardingRemoved_messageType
+var _ protoreflect.MessageType = fastReflection_ForwardingRemoved_messageType{}
+
+type fastReflection_ForwardingRemoved_messageType struct{}
+
+func (x fastReflection_ForwardingRemoved_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ForwardingRemoved)(nil)
+}
+func (x fastReflection_ForwardingRemoved_messageType) New() protoreflect.Message {
+	return new(fastReflection_ForwardingRemoved)
+}
+func (x fastReflection_ForwardingRemoved_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardingRemoved
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ForwardingRemoved) Descriptor() protoreflect.MessageDescriptor {
+	return md_ForwardingRemoved
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ForwardingRemoved) Type() protoreflect.MessageType {
+	return _fastReflection_ForwardingRemoved_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ForwardingRemoved) New() protoreflect.Message {
+	return new(fastReflection_ForwardingRemoved)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ForwardingRemoved) Interface() protoreflect.ProtoMessage {
+	return (*ForwardingRemoved)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ForwardingRemoved) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ForwardingRemoved_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ForwardingRemoved) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingRemoved.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingRemoved) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingRemoved.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ForwardingRemoved) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.ForwardingRemoved.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingRemoved does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingRemoved) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingRemoved.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingRemoved) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingRemoved.address":
+		panic(fmt.Errorf("field address of message circle.cctp.v1.ForwardingRemoved is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ForwardingRemoved) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ForwardingRemoved.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ForwardingRemoved"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ForwardingRemoved does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ForwardingRemoved) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.ForwardingRemoved", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ForwardingRemoved) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ForwardingRemoved) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ForwardingRemoved) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ForwardingRemoved) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ForwardingRemoved)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardingRemoved)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ForwardingRemoved)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardingRemoved: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ForwardingRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Account32Registered         protoreflect.MessageDescriptor
+	fd_Account32Registered_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_Account32Registered = File_circle_cctp_v1_events_proto.Messages().ByName("Account32Registered")
+	fd_Account32Registered_address = md_Account32Registered.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_Account32Registered)(nil)
+
+type fastReflection_Account32Registered Account32Registered
+
+func (x *Account32Registered) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Account32Registered)(x)
+}
+
+func (x *Account32Registered) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Account32Registered_messageType fastReflection_Account32Registered_messageType
+var _ protoreflect.MessageType = fastReflection_Account32Registered_messageType{}
+
+type fastReflection_Account32Registered_messageType struct{}
+
+func (x fastReflection_Account32Registered_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Account32Registered)(nil)
+}
+func (x fastReflection_Account32Registered_messageType) New() protoreflect.Message {
+	return new(fastReflection_Account32Registered)
+}
+func (x fastReflection_Account32Registered_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Account32Registered
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Account32Registered) Descriptor() protoreflect.MessageDescriptor {
+	return md_Account32Registered
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Account32Registered) Type() protoreflect.MessageType {
+	return _fastReflection_Account32Registered_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Account32Registered) New() protoreflect.Message {
+	return new(fastReflection_Account32Registered)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Account32Registered) Interface() protoreflect.ProtoMessage {
+	return (*Account32Registered)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Account32Registered) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Account32Registered_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Account32Registered) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.Account32Registered.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Account32Registered"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.Account32Registered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account32Registered) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.Account32Registered.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Account32Registered"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.Account32Registered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Account32Registered) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.Account32Registered.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Account32Registered"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.Account32Registered does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account32Registered) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.Account32Registered.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Account32Registered"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.Account32Registered does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account32Registered) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.Account32Registered.address":
+		panic(fmt.Errorf("field address of message circle.cctp.v1.Account32Registered is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Account32Registered"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.Account32Registered does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Account32Registered) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.Account32Registered.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.Account32Registered"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.Account32Registered does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Account32Registered) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.Account32Registered", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Account32Registered) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Account32Registered) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Account32Registered) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Account32Registered) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Account32Registered)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Account32Registered)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Account32Registered)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Account32Registered: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Account32Registered: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ContractCallbackEnabled         protoreflect.MessageDescriptor
+	fd_ContractCallbackEnabled_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_ContractCallbackEnabled = File_circle_cctp_v1_events_proto.Messages().ByName("ContractCallbackEnabled")
+	fd_ContractCallbackEnabled_address = md_ContractCallbackEnabled.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_ContractCallbackEnabled)(nil)
+
+type fastReflection_ContractCallbackEnabled ContractCallbackEnabled
+
+func (x *ContractCallbackEnabled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractCallbackEnabled)(x)
+}
+
+func (x *ContractCallbackEnabled) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ContractCallbackEnabled_messageType fastReflection_ContractCallbackEnabled_messageType
+var _ protoreflect.MessageType = fastReflection_ContractCallbackEnabled_messageType{}
+
+type fastReflection_ContractCallbackEnabled_messageType struct{}
+
+func (x fastReflection_ContractCallbackEnabled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractCallbackEnabled)(nil)
+}
+func (x fastReflection_ContractCallbackEnabled_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractCallbackEnabled)
+}
+func (x fastReflection_ContractCallbackEnabled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractCallbackEnabled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ContractCallbackEnabled) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractCallbackEnabled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ContractCallbackEnabled) Type() protoreflect.MessageType {
+	return _fastReflection_ContractCallbackEnabled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ContractCallbackEnabled) New() protoreflect.Message {
+	return new(fastReflection_ContractCallbackEnabled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ContractCallbackEnabled) Interface() protoreflect.ProtoMessage {
+	return (*ContractCallbackEnabled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ContractCallbackEnabled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ContractCallbackEnabled_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ContractCallbackEnabled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackEnabled.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackEnabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractCallbackEnabled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackEnabled.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackEnabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ContractCallbackEnabled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.ContractCallbackEnabled.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackEnabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackEnabled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractCallbackEnabled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackEnabled.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackEnabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractCallbackEnabled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackEnabled.address":
+		panic(fmt.Errorf("field address of message circle.cctp.v1.ContractCallbackEnabled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackEnabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ContractCallbackEnabled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackEnabled.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackEnabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackEnabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ContractCallbackEnabled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.ContractCallbackEnabled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ContractCallbackEnabled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractCallbackEnabled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ContractCallbackEnabled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ContractCallbackEnabled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ContractCallbackEnabled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ContractCallbackEnabled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ContractCallbackEnabled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractCallbackEnabled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractCallbackEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ContractCallbackDisabled         protoreflect.MessageDescriptor
+	fd_ContractCallbackDisabled_address protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_ContractCallbackDisabled = File_circle_cctp_v1_events_proto.Messages().ByName("ContractCallbackDisabled")
+	fd_ContractCallbackDisabled_address = md_ContractCallbackDisabled.Fields().ByName("address")
+}
+
+var _ protoreflect.Message = (*fastReflection_ContractCallbackDisabled)(nil)
+
+type fastReflection_ContractCallbackDisabled ContractCallbackDisabled
+
+func (x *ContractCallbackDisabled) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ContractCallbackDisabled)(x)
+}
+
+func (x *ContractCallbackDisabled) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ContractCallbackDisabled_messageType fastReflection_ContractCallbackDisabled_messageType
+var _ protoreflect.MessageType = fastReflection_ContractCallbackDisabled_messageType{}
+
+type fastReflection_ContractCallbackDisabled_messageType struct{}
+
+func (x fastReflection_ContractCallbackDisabled_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ContractCallbackDisabled)(nil)
+}
+func (x fastReflection_ContractCallbackDisabled_messageType) New() protoreflect.Message {
+	return new(fastReflection_ContractCallbackDisabled)
+}
+func (x fastReflection_ContractCallbackDisabled_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractCallbackDisabled
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ContractCallbackDisabled) Descriptor() protoreflect.MessageDescriptor {
+	return md_ContractCallbackDisabled
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ContractCallbackDisabled) Type() protoreflect.MessageType {
+	return _fastReflection_ContractCallbackDisabled_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ContractCallbackDisabled) New() protoreflect.Message {
+	return new(fastReflection_ContractCallbackDisabled)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ContractCallbackDisabled) Interface() protoreflect.ProtoMessage {
+	return (*ContractCallbackDisabled)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ContractCallbackDisabled) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ContractCallbackDisabled_address, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ContractCallbackDisabled) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackDisabled.address":
+		return x.Address != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackDisabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractCallbackDisabled) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackDisabled.address":
+		x.Address = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackDisabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ContractCallbackDisabled) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.ContractCallbackDisabled.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackDisabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackDisabled does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractCallbackDisabled) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackDisabled.address":
+		x.Address = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackDisabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractCallbackDisabled) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackDisabled.address":
+		panic(fmt.Errorf("field address of message circle.cctp.v1.ContractCallbackDisabled is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackDisabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ContractCallbackDisabled) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.ContractCallbackDisabled.address":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.ContractCallbackDisabled"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.ContractCallbackDisabled does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ContractCallbackDisabled) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.ContractCallbackDisabled", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ContractCallbackDisabled) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ContractCallbackDisabled) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ContractCallbackDisabled) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ContractCallbackDisabled) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ContractCallbackDisabled)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ContractCallbackDisabled)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ContractCallbackDisabled)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractCallbackDisabled: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ContractCallbackDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MintForwarded               protoreflect.MessageDescriptor
+	fd_MintForwarded_address       protoreflect.FieldDescriptor
+	fd_MintForwarded_source_domain protoreflect.FieldDescriptor
+	fd_MintForwarded_source_nonce  protoreflect.FieldDescriptor
+	fd_MintForwarded_nonce         protoreflect.FieldDescriptor
+	fd_MintForwarded_amount        protoreflect.FieldDescriptor
+	fd_MintForwarded_fee           protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_MintForwarded = File_circle_cctp_v1_events_proto.Messages().ByName("MintForwarded")
+	fd_MintForwarded_address = md_MintForwarded.Fields().ByName("address")
+	fd_MintForwarded_source_domain = md_MintForwarded.Fields().ByName("source_domain")
+	fd_MintForwarded_source_nonce = md_MintForwarded.Fields().ByName("source_nonce")
+	fd_MintForwarded_nonce = md_MintForwarded.Fields().ByName("nonce")
+	fd_MintForwarded_amount = md_MintForwarded.Fields().ByName("amount")
+	fd_MintForwarded_fee = md_MintForwarded.Fields().ByName("fee")
+}
+
+var _ protoreflect.Message = (*fastReflection_MintForwarded)(nil)
+
+type fastReflection_MintForwarded MintForwarded
+
+func (x *MintForwarded) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintForwarded)(x)
+}
+
+func (x *MintForwarded) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MintForwarded_messageType fastReflection_MintForwarded_messageType
+var _ protoreflect.MessageType = fastReflection_MintForwarded_messageType{}
+
+type fastReflection_MintForwarded_messageType struct{}
+
+func (x fastReflection_MintForwarded_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintForwarded)(nil)
+}
+func (x fastReflection_MintForwarded_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintForwarded)
+}
+func (x fastReflection_MintForwarded_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintForwarded
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MintForwarded) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintForwarded
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MintForwarded) Type() protoreflect.MessageType {
+	return _fastReflection_MintForwarded_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MintForwarded) New() protoreflect.Message {
+	return new(fastReflection_MintForwarded)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MintForwarded) Interface() protoreflect.ProtoMessage {
+	return (*MintForwarded)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MintForwarded) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MintForwarded_address, value) {
+			return
+		}
+	}
+	if x.SourceDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SourceDomain)
+		if !f(fd_MintForwarded_source_domain, value) {
+			return
+		}
+	}
+	if x.SourceNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SourceNonce)
+		if !f(fd_MintForwarded_source_nonce, value) {
+			return
+		}
+	}
+	if x.Nonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Nonce)
+		if !f(fd_MintForwarded_nonce, value) {
+			return
+		}
+	}
+	if x.Amount != "" {
+		value := protoreflect.ValueOfString(x.Amount)
+		if !f(fd_MintForwarded_amount, value) {
+			return
+		}
+	}
+	if x.Fee != "" {
+		value := protoreflect.ValueOfString(x.Fee)
+		if !f(fd_MintForwarded_fee, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MintForwarded) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwarded.address":
+		return x.Address != ""
+	case "circle.cctp.v1.MintForwarded.source_domain":
+		return x.SourceDomain != uint32(0)
+	case "circle.cctp.v1.MintForwarded.source_nonce":
+		return x.SourceNonce != uint64(0)
+	case "circle.cctp.v1.MintForwarded.nonce":
+		return x.Nonce != uint64(0)
+	case "circle.cctp.v1.MintForwarded.amount":
+		return x.Amount != ""
+	case "circle.cctp.v1.MintForwarded.fee":
+		return x.Fee != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwarded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintForwarded) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwarded.address":
+		x.Address = ""
+	case "circle.cctp.v1.MintForwarded.source_domain":
+		x.SourceDomain = uint32(0)
+	case "circle.cctp.v1.MintForwarded.source_nonce":
+		x.SourceNonce = uint64(0)
+	case "circle.cctp.v1.MintForwarded.nonce":
+		x.Nonce = uint64(0)
+	case "circle.cctp.v1.MintForwarded.amount":
+		x.Amount = ""
+	case "circle.cctp.v1.MintForwarded.fee":
+		x.Fee = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwarded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MintForwarded) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.MintForwarded.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.MintForwarded.source_domain":
+		value := x.SourceDomain
+		return protoreflect.ValueOfUint32(value)
+	case "circle.cctp.v1.MintForwarded.source_nonce":
+		value := x.SourceNonce
+		return protoreflect.ValueOfUint64(value)
+	case "circle.cctp.v1.MintForwarded.nonce":
+		value := x.Nonce
+		return protoreflect.ValueOfUint64(value)
+	case "circle.cctp.v1.MintForwarded.amount":
+		value := x.Amount
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.MintForwarded.fee":
+		value := x.Fee
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwarded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwarded does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintForwarded) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwarded.address":
+		x.Address = value.Interface().(string)
+	case "circle.cctp.v1.MintForwarded.source_domain":
+		x.SourceDomain = uint32(value.Uint())
+	case "circle.cctp.v1.MintForwarded.source_nonce":
+		x.SourceNonce = value.Uint()
+	case "circle.cctp.v1.MintForwarded.nonce":
+		x.Nonce = value.Uint()
+	case "circle.cctp.v1.MintForwarded.amount":
+		x.Amount = value.Interface().(string)
+	case "circle.cctp.v1.MintForwarded.fee":
+		x.Fee = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwarded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintForwarded) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwarded.address":
+		panic(fmt.Errorf("field address of message circle.cctp.v1.MintForwarded is not mutable"))
+	case "circle.cctp.v1.MintForwarded.source_domain":
+		panic(fmt.Errorf("field source_domain of message circle.cctp.v1.MintForwarded is not mutable"))
+	case "circle.cctp.v1.MintForwarded.source_nonce":
+		panic(fmt.Errorf("field source_nonce of message circle.cctp.v1.MintForwarded is not mutable"))
+	case "circle.cctp.v1.MintForwarded.nonce":
+		panic(fmt.Errorf("field nonce of message circle.cctp.v1.MintForwarded is not mutable"))
+	case "circle.cctp.v1.MintForwarded.amount":
+		panic(fmt.Errorf("field amount of message circle.cctp.v1.MintForwarded is not mutable"))
+	case "circle.cctp.v1.MintForwarded.fee":
+		panic(fmt.Errorf("field fee of message circle.cctp.v1.MintForwarded is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwarded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MintForwarded) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwarded.address":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.MintForwarded.source_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "circle.cctp.v1.MintForwarded.source_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.cctp.v1.MintForwarded.nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.cctp.v1.MintForwarded.amount":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.MintForwarded.fee":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwarded"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwarded does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MintForwarded) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MintForwarded", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MintForwarded) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintForwarded) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MintForwarded) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MintForwarded) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MintForwarded)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SourceDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.SourceDomain))
+		}
+		if x.SourceNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.SourceNonce))
+		}
+		if x.Nonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.Nonce))
+		}
+		l = len(x.Amount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Fee)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MintForwarded)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Fee) > 0 {
+			i -= len(x.Fee)
+			copy(dAtA[i:], x.Fee)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Fee)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if len(x.Amount) > 0 {
+			i -= len(x.Amount)
+			copy(dAtA[i:], x.Amount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Amount)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.Nonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Nonce))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.SourceNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SourceNonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SourceDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SourceDomain))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MintForwarded)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintForwarded: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintForwarded: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+				}
+				x.SourceDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SourceDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceNonce", wireType)
+				}
+				x.SourceNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SourceNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				}
+				x.Nonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Nonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Fee = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_MintForwardingFailed               protoreflect.MessageDescriptor
+	fd_MintForwardingFailed_address       protoreflect.FieldDescriptor
+	fd_MintForwardingFailed_source_domain protoreflect.FieldDescriptor
+	fd_MintForwardingFailed_source_nonce  protoreflect.FieldDescriptor
+	fd_MintForwardingFailed_reason        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_circle_cctp_v1_events_proto_init()
+	md_MintForwardingFailed = File_circle_cctp_v1_events_proto.Messages().ByName("MintForwardingFailed")
+	fd_MintForwardingFailed_address = md_MintForwardingFailed.Fields().ByName("address")
+	fd_MintForwardingFailed_source_domain = md_MintForwardingFailed.Fields().ByName("source_domain")
+	fd_MintForwardingFailed_source_nonce = md_MintForwardingFailed.Fields().ByName("source_nonce")
+	fd_MintForwardingFailed_reason = md_MintForwardingFailed.Fields().ByName("reason")
+}
+
+var _ protoreflect.Message = (*fastReflection_MintForwardingFailed)(nil)
+
+type fastReflection_MintForwardingFailed MintForwardingFailed
+
+func (x *MintForwardingFailed) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MintForwardingFailed)(x)
+}
+
+func (x *MintForwardingFailed) slowProtoReflect() protoreflect.Message {
+	mi := &file_circle_cctp_v1_events_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_MintForwardingFailed_messageType fastReflection_MintForwardingFailed_messageType
+var _ protoreflect.MessageType = fastReflection_MintForwardingFailed_messageType{}
+
+type fastReflection_MintForwardingFailed_messageType struct{}
+
+func (x fastReflection_MintForwardingFailed_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MintForwardingFailed)(nil)
+}
+func (x fastReflection_MintForwardingFailed_messageType) New() protoreflect.Message {
+	return new(fastReflection_MintForwardingFailed)
+}
+func (x fastReflection_MintForwardingFailed_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintForwardingFailed
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MintForwardingFailed) Descriptor() protoreflect.MessageDescriptor {
+	return md_MintForwardingFailed
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MintForwardingFailed) Type() protoreflect.MessageType {
+	return _fastReflection_MintForwardingFailed_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MintForwardingFailed) New() protoreflect.Message {
+	return new(fastReflection_MintForwardingFailed)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MintForwardingFailed) Interface() protoreflect.ProtoMessage {
+	return (*MintForwardingFailed)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MintForwardingFailed) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_MintForwardingFailed_address, value) {
+			return
+		}
+	}
+	if x.SourceDomain != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SourceDomain)
+		if !f(fd_MintForwardingFailed_source_domain, value) {
+			return
+		}
+	}
+	if x.SourceNonce != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SourceNonce)
+		if !f(fd_MintForwardingFailed_source_nonce, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_MintForwardingFailed_reason, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MintForwardingFailed) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwardingFailed.address":
+		return x.Address != ""
+	case "circle.cctp.v1.MintForwardingFailed.source_domain":
+		return x.SourceDomain != uint32(0)
+	case "circle.cctp.v1.MintForwardingFailed.source_nonce":
+		return x.SourceNonce != uint64(0)
+	case "circle.cctp.v1.MintForwardingFailed.reason":
+		return x.Reason != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwardingFailed"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwardingFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintForwardingFailed) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwardingFailed.address":
+		x.Address = ""
+	case "circle.cctp.v1.MintForwardingFailed.source_domain":
+		x.SourceDomain = uint32(0)
+	case "circle.cctp.v1.MintForwardingFailed.source_nonce":
+		x.SourceNonce = uint64(0)
+	case "circle.cctp.v1.MintForwardingFailed.reason":
+		x.Reason = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwardingFailed"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwardingFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MintForwardingFailed) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "circle.cctp.v1.MintForwardingFailed.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "circle.cctp.v1.MintForwardingFailed.source_domain":
+		value := x.SourceDomain
+		return protoreflect.ValueOfUint32(value)
+	case "circle.cctp.v1.MintForwardingFailed.source_nonce":
+		value := x.SourceNonce
+		return protoreflect.ValueOfUint64(value)
+	case "circle.cctp.v1.MintForwardingFailed.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwardingFailed"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwardingFailed does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintForwardingFailed) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwardingFailed.address":
+		x.Address = value.Interface().(string)
+	case "circle.cctp.v1.MintForwardingFailed.source_domain":
+		x.SourceDomain = uint32(value.Uint())
+	case "circle.cctp.v1.MintForwardingFailed.source_nonce":
+		x.SourceNonce = value.Uint()
+	case "circle.cctp.v1.MintForwardingFailed.reason":
+		x.Reason = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwardingFailed"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwardingFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintForwardingFailed) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwardingFailed.address":
+		panic(fmt.Errorf("field address of message circle.cctp.v1.MintForwardingFailed is not mutable"))
+	case "circle.cctp.v1.MintForwardingFailed.source_domain":
+		panic(fmt.Errorf("field source_domain of message circle.cctp.v1.MintForwardingFailed is not mutable"))
+	case "circle.cctp.v1.MintForwardingFailed.source_nonce":
+		panic(fmt.Errorf("field source_nonce of message circle.cctp.v1.MintForwardingFailed is not mutable"))
+	case "circle.cctp.v1.MintForwardingFailed.reason":
+		panic(fmt.Errorf("field reason of message circle.cctp.v1.MintForwardingFailed is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwardingFailed"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwardingFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MintForwardingFailed) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "circle.cctp.v1.MintForwardingFailed.address":
+		return protoreflect.ValueOfString("")
+	case "circle.cctp.v1.MintForwardingFailed.source_domain":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "circle.cctp.v1.MintForwardingFailed.source_nonce":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "circle.cctp.v1.MintForwardingFailed.reason":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: circle.cctp.v1.MintForwardingFailed"))
+		}
+		panic(fmt.Errorf("message circle.cctp.v1.MintForwardingFailed does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MintForwardingFailed) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in circle.cctp.v1.MintForwardingFailed", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MintForwardingFailed) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MintForwardingFailed) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MintForwardingFailed) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MintForwardingFailed) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MintForwardingFailed)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.SourceDomain != 0 {
+			n += 1 + runtime.Sov(uint64(x.SourceDomain))
+		}
+		if x.SourceNonce != 0 {
+			n += 1 + runtime.Sov(uint64(x.SourceNonce))
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MintForwardingFailed)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if x.SourceNonce != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SourceNonce))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.SourceDomain != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SourceDomain))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MintForwardingFailed)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintForwardingFailed: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MintForwardingFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+				}
+				x.SourceDomain = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SourceDomain |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SourceNonce", wireType)
+				}
+				x.SourceNonce = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SourceNonce |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: circle/cctp/v1/events.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// *
+// Emitted when an attester is enabled
+// @param attester newly enabled attester
+// @param operator operator label of the attester
+type AttesterEnabled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
+}
+
+func (x *AttesterEnabled) Reset() {
+	*x = AttesterEnabled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterEnabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterEnabled) ProtoMessage() {}
+
+// Deprecated: Use AttesterEnabled.ProtoReflect.Descriptor instead.
+func (*AttesterEnabled) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *AttesterEnabled) GetAttester() string {
+	if x != nil {
+		return x.Attester
+	}
+	return ""
+}
+
+func (x *AttesterEnabled) GetOperator() string {
+	if x != nil {
+		return x.Operator
+	}
+	return ""
+}
+
+// *
+// Emitted when an attester is disabled
+// @param attester newly disabled attester
+// @param reason reason for disabling the attester
+type AttesterDisabled struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (x *AttesterDisabled) Reset() {
+	*x = AttesterDisabled{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterDisabled) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterDisabled) ProtoMessage() {}
+
+// Deprecated: Use AttesterDisabled.ProtoReflect.Descriptor instead.
+func (*AttesterDisabled) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AttesterDisabled) GetAttester() string {
+	if x != nil {
+		return x.Attester
+	}
+	return ""
+}
+
+func (x *AttesterDisabled) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// *
+// Emitted when threshold number of attestations (m in m/n multisig) is updated
+// @param old_signature_threshold old signature threshold
+// @param new_signature_threshold new signature threshold
+type SignatureThresholdUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldSignatureThreshold uint64 `protobuf:"varint,1,opt,name=old_signature_threshold,json=oldSignatureThreshold,proto3" json:"old_signature_threshold,omitempty"`
+	NewSignatureThreshold uint64 `protobuf:"varint,2,opt,name=new_signature_threshold,json=newSignatureThreshold,proto3" json:"new_signature_threshold,omitempty"`
+}
+
+func (x *SignatureThresholdUpdated) Reset() {
+	*x = SignatureThresholdUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SignatureThresholdUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignatureThresholdUpdated) ProtoMessage() {}
+
+// Deprecated: Use SignatureThresholdUpdated.ProtoReflect.Descriptor instead.
+func (*SignatureThresholdUpdated) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *SignatureThresholdUpdated) GetOldSignatureThreshold() uint64 {
+	if x != nil {
+		return x.OldSignatureThreshold
+	}
+	return 0
+}
+
+func (x *SignatureThresholdUpdated) GetNewSignatureThreshold() uint64 {
+	if x != nil {
+		return x.NewSignatureThreshold
+	}
+	return 0
+}
+
+// *
+// Emitted when owner address is updated
+// @param previous_owner representing the address of the previous owner
+// @param new_owner representing the address of the new owner
+type OwnerUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
 	NewOwner      string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (x *OwnerUpdated) Reset() {
-	*x = OwnerUpdated{}
+func (x *OwnerUpdated) Reset() {
+	*x = OwnerUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OwnerUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnerUpdated) ProtoMessage() {}
+
+// Deprecated: Use OwnerUpdated.ProtoReflect.Descriptor instead.
+func (*OwnerUpdated) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *OwnerUpdated) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *OwnerUpdated) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+// *
+// Emitted when starting the two stage transfer ownership process
+// @param previousOwner representing the address of the previous owner
+// @param newOwner representing the address of the new owner
+// @param expires_at_height last block height at which the transfer can be accepted, 0 if it never expires
+type OwnershipTransferStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousOwner   string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner        string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	ExpiresAtHeight int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (x *OwnershipTransferStarted) Reset() {
+	*x = OwnershipTransferStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OwnershipTransferStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OwnershipTransferStarted) ProtoMessage() {}
+
+// Deprecated: Use OwnershipTransferStarted.ProtoReflect.Descriptor instead.
+func (*OwnershipTransferStarted) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *OwnershipTransferStarted) GetPreviousOwner() string {
+	if x != nil {
+		return x.PreviousOwner
+	}
+	return ""
+}
+
+func (x *OwnershipTransferStarted) GetNewOwner() string {
+	if x != nil {
+		return x.NewOwner
+	}
+	return ""
+}
+
+func (x *OwnershipTransferStarted) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.ExpiresAtHeight
+	}
+	return 0
+}
+
+// *
+// Emitted when starting the two stage transfer of the attester manager role
+// @param previous_attester_manager representing the address of the previous attester manager
+// @param new_attester_manager representing the address of the new attester manager
+// @param expires_at_height last block height at which the transfer can be accepted, 0 if it never expires
+type AttesterManagerTransferStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousAttesterManager string `protobuf:"bytes,1,opt,name=previous_attester_manager,json=previousAttesterManager,proto3" json:"previous_attester_manager,omitempty"`
+	NewAttesterManager      string `protobuf:"bytes,2,opt,name=new_attester_manager,json=newAttesterManager,proto3" json:"new_attester_manager,omitempty"`
+	ExpiresAtHeight         int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (x *AttesterManagerTransferStarted) Reset() {
+	*x = AttesterManagerTransferStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterManagerTransferStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterManagerTransferStarted) ProtoMessage() {}
+
+// Deprecated: Use AttesterManagerTransferStarted.ProtoReflect.Descriptor instead.
+func (*AttesterManagerTransferStarted) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AttesterManagerTransferStarted) GetPreviousAttesterManager() string {
+	if x != nil {
+		return x.PreviousAttesterManager
+	}
+	return ""
+}
+
+func (x *AttesterManagerTransferStarted) GetNewAttesterManager() string {
+	if x != nil {
+		return x.NewAttesterManager
+	}
+	return ""
+}
+
+func (x *AttesterManagerTransferStarted) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.ExpiresAtHeight
+	}
+	return 0
+}
+
+// *
+// Emitted when starting the two stage transfer of the pauser role
+// @param previous_pauser representing the address of the previous pauser
+// @param new_pauser representing the address of the new pauser
+// @param expires_at_height last block height at which the transfer can be accepted, 0 if it never expires
+type PauserTransferStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousPauser  string `protobuf:"bytes,1,opt,name=previous_pauser,json=previousPauser,proto3" json:"previous_pauser,omitempty"`
+	NewPauser       string `protobuf:"bytes,2,opt,name=new_pauser,json=newPauser,proto3" json:"new_pauser,omitempty"`
+	ExpiresAtHeight int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (x *PauserTransferStarted) Reset() {
+	*x = PauserTransferStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauserTransferStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauserTransferStarted) ProtoMessage() {}
+
+// Deprecated: Use PauserTransferStarted.ProtoReflect.Descriptor instead.
+func (*PauserTransferStarted) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PauserTransferStarted) GetPreviousPauser() string {
+	if x != nil {
+		return x.PreviousPauser
+	}
+	return ""
+}
+
+func (x *PauserTransferStarted) GetNewPauser() string {
+	if x != nil {
+		return x.NewPauser
+	}
+	return ""
+}
+
+func (x *PauserTransferStarted) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.ExpiresAtHeight
+	}
+	return 0
+}
+
+// *
+// Emitted when starting the two stage transfer of the token controller role
+// @param previous_token_controller representing the address of the previous token controller
+// @param new_token_controller representing the address of the new token controller
+// @param expires_at_height last block height at which the transfer can be accepted, 0 if it never expires
+type TokenControllerTransferStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousTokenController string `protobuf:"bytes,1,opt,name=previous_token_controller,json=previousTokenController,proto3" json:"previous_token_controller,omitempty"`
+	NewTokenController      string `protobuf:"bytes,2,opt,name=new_token_controller,json=newTokenController,proto3" json:"new_token_controller,omitempty"`
+	ExpiresAtHeight         int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (x *TokenControllerTransferStarted) Reset() {
+	*x = TokenControllerTransferStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenControllerTransferStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenControllerTransferStarted) ProtoMessage() {}
+
+// Deprecated: Use TokenControllerTransferStarted.ProtoReflect.Descriptor instead.
+func (*TokenControllerTransferStarted) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TokenControllerTransferStarted) GetPreviousTokenController() string {
+	if x != nil {
+		return x.PreviousTokenController
+	}
+	return ""
+}
+
+func (x *TokenControllerTransferStarted) GetNewTokenController() string {
+	if x != nil {
+		return x.NewTokenController
+	}
+	return ""
+}
+
+func (x *TokenControllerTransferStarted) GetExpiresAtHeight() int64 {
+	if x != nil {
+		return x.ExpiresAtHeight
+	}
+	return 0
+}
+
+// *
+// Emitted when pauser address is updated
+// @param previous_pauser representing the address of the previous pauser
+// @param new_pauser representing the address of the new pauser
+type PauserUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousPauser string `protobuf:"bytes,1,opt,name=previous_pauser,json=previousPauser,proto3" json:"previous_pauser,omitempty"`
+	NewPauser      string `protobuf:"bytes,2,opt,name=new_pauser,json=newPauser,proto3" json:"new_pauser,omitempty"`
+}
+
+func (x *PauserUpdated) Reset() {
+	*x = PauserUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PauserUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauserUpdated) ProtoMessage() {}
+
+// Deprecated: Use PauserUpdated.ProtoReflect.Descriptor instead.
+func (*PauserUpdated) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *PauserUpdated) GetPreviousPauser() string {
+	if x != nil {
+		return x.PreviousPauser
+	}
+	return ""
+}
+
+func (x *PauserUpdated) GetNewPauser() string {
+	if x != nil {
+		return x.NewPauser
+	}
+	return ""
+}
+
+// *
+// Emitted when attester manager address is updated
+// @param previous_attester_manager representing the address of the previous
+// attester manager
+// @param new_attester_manager representing the address of the new attester
+// manager
+type AttesterManagerUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousAttesterManager string `protobuf:"bytes,1,opt,name=previous_attester_manager,json=previousAttesterManager,proto3" json:"previous_attester_manager,omitempty"`
+	NewAttesterManager      string `protobuf:"bytes,2,opt,name=new_attester_manager,json=newAttesterManager,proto3" json:"new_attester_manager,omitempty"`
+}
+
+func (x *AttesterManagerUpdated) Reset() {
+	*x = AttesterManagerUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttesterManagerUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttesterManagerUpdated) ProtoMessage() {}
+
+// Deprecated: Use AttesterManagerUpdated.ProtoReflect.Descriptor instead.
+func (*AttesterManagerUpdated) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AttesterManagerUpdated) GetPreviousAttesterManager() string {
+	if x != nil {
+		return x.PreviousAttesterManager
+	}
+	return ""
+}
+
+func (x *AttesterManagerUpdated) GetNewAttesterManager() string {
+	if x != nil {
+		return x.NewAttesterManager
+	}
+	return ""
+}
+
+// *
+// Emitted when token controller address is updated
+// @param previous_token_controller representing the address of the previous
+// token controller
+// @param new_token_controller representing the address of the new token
+// controller
+type TokenControllerUpdated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PreviousTokenController string `protobuf:"bytes,1,opt,name=previous_token_controller,json=previousTokenController,proto3" json:"previous_token_controller,omitempty"`
+	NewTokenController      string `protobuf:"bytes,2,opt,name=new_token_controller,json=newTokenController,proto3" json:"new_token_controller,omitempty"`
+}
+
+func (x *TokenControllerUpdated) Reset() {
+	*x = TokenControllerUpdated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenControllerUpdated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenControllerUpdated) ProtoMessage() {}
+
+// Deprecated: Use TokenControllerUpdated.ProtoReflect.Descriptor instead.
+func (*TokenControllerUpdated) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *TokenControllerUpdated) GetPreviousTokenController() string {
+	if x != nil {
+		return x.PreviousTokenController
+	}
+	return ""
+}
+
+func (x *TokenControllerUpdated) GetNewTokenController() string {
+	if x != nil {
+		return x.NewTokenController
+	}
+	return ""
+}
+
+// *
+// Emitted when burning and minting tokens is paused
+type BurningAndMintingPausedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *BurningAndMintingPausedEvent) Reset() {
+	*x = BurningAndMintingPausedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[3]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OwnerUpdated) String() string {
+func (x *BurningAndMintingPausedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OwnerUpdated) ProtoMessage() {}
+func (*BurningAndMintingPausedEvent) ProtoMessage() {}
 
-// Deprecated: Use OwnerUpdated.ProtoReflect.Descriptor instead.
-func (*OwnerUpdated) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use BurningAndMintingPausedEvent.ProtoReflect.Descriptor instead.
+func (*BurningAndMintingPausedEvent) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *OwnerUpdated) GetPreviousOwner() string {
-	if x != nil {
-		return x.PreviousOwner
-	}
-	return ""
+// *
+// Emitted when burning and minting tokens is unpaused
+type BurningAndMintingUnpausedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *OwnerUpdated) GetNewOwner() string {
-	if x != nil {
-		return x.NewOwner
+func (x *BurningAndMintingUnpausedEvent) Reset() {
+	*x = BurningAndMintingUnpausedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
+}
+
+func (x *BurningAndMintingUnpausedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BurningAndMintingUnpausedEvent) ProtoMessage() {}
+
+// Deprecated: Use BurningAndMintingUnpausedEvent.ProtoReflect.Descriptor instead.
+func (*BurningAndMintingUnpausedEvent) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{12}
 }
 
 // *
-// Emitted when starting the two stage transfer ownership process
-// @param previousOwner representing the address of the previous owner
-// @param newOwner representing the address of the new owner
-type OwnershipTransferStarted struct {
+// Emitted when sending and receiving messages is paused
+type SendingAndReceivingPausedEvent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
-	NewOwner      string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
 }
 
-func (x *OwnershipTransferStarted) Reset() {
-	*x = OwnershipTransferStarted{}
+func (x *SendingAndReceivingPausedEvent) Reset() {
+	*x = SendingAndReceivingPausedEvent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[4]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *OwnershipTransferStarted) String() string {
+func (x *SendingAndReceivingPausedEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OwnershipTransferStarted) ProtoMessage() {}
+func (*SendingAndReceivingPausedEvent) ProtoMessage() {}
 
-// Deprecated: Use OwnershipTransferStarted.ProtoReflect.Descriptor instead.
-func (*OwnershipTransferStarted) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SendingAndReceivingPausedEvent.ProtoReflect.Descriptor instead.
+func (*SendingAndReceivingPausedEvent) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *OwnershipTransferStarted) GetPreviousOwner() string {
-	if x != nil {
-		return x.PreviousOwner
-	}
-	return ""
+// *
+// Emitted when sending and receiving messages is paused
+type SendingAndReceivingUnpausedEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *OwnershipTransferStarted) GetNewOwner() string {
-	if x != nil {
-		return x.NewOwner
+func (x *SendingAndReceivingUnpausedEvent) Reset() {
+	*x = SendingAndReceivingUnpausedEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
+}
+
+func (x *SendingAndReceivingUnpausedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendingAndReceivingUnpausedEvent) ProtoMessage() {}
+
+// Deprecated: Use SendingAndReceivingUnpausedEvent.ProtoReflect.Descriptor instead.
+func (*SendingAndReceivingUnpausedEvent) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{14}
 }
 
 // *
-// Emitted when pauser address is updated
-// @param previous_pauser representing the address of the previous pauser
-// @param new_pauser representing the address of the new pauser
-type PauserUpdated struct {
+// Emitted when a DepositForBurn message is sent
+// @param nonce unique nonce reserved by message
+// @param burn_token address of token burnt on source domain
+// @param amount deposit amount
+// @param depositor address where deposit is transferred from
+// @param mint_recipient address receiving minted tokens on destination domain
+// as bytes32
+// @param destination_domain destination domain
+// @param destination_token_messenger address of TokenMessenger on destination
+// domain as bytes32
+// @param destination_caller authorized caller as bytes32 of receiveMessage() on
+// destination domain, if not equal to bytes32(0). If equal to bytes32(0), any
+// address can call receiveMessage().
+type DepositForBurn struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PreviousPauser string `protobuf:"bytes,1,opt,name=previous_pauser,json=previousPauser,proto3" json:"previous_pauser,omitempty"`
-	NewPauser      string `protobuf:"bytes,2,opt,name=new_pauser,json=newPauser,proto3" json:"new_pauser,omitempty"`
+	Nonce                     uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	BurnToken                 string `protobuf:"bytes,2,opt,name=burn_token,json=burnToken,proto3" json:"burn_token,omitempty"`
+	Amount                    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Depositor                 string `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	MintRecipient             []byte `protobuf:"bytes,5,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	DestinationDomain         uint32 `protobuf:"varint,6,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	DestinationTokenMessenger []byte `protobuf:"bytes,7,opt,name=destination_token_messenger,json=destinationTokenMessenger,proto3" json:"destination_token_messenger,omitempty"`
+	DestinationCaller         []byte `protobuf:"bytes,8,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
 }
 
-func (x *PauserUpdated) Reset() {
-	*x = PauserUpdated{}
+func (x *DepositForBurn) Reset() {
+	*x = DepositForBurn{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[5]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PauserUpdated) String() string {
+func (x *DepositForBurn) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PauserUpdated) ProtoMessage() {}
+func (*DepositForBurn) ProtoMessage() {}
 
-// Deprecated: Use PauserUpdated.ProtoReflect.Descriptor instead.
-func (*PauserUpdated) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use DepositForBurn.ProtoReflect.Descriptor instead.
+func (*DepositForBurn) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *PauserUpdated) GetPreviousPauser() string {
+func (x *DepositForBurn) GetNonce() uint64 {
 	if x != nil {
-		return x.PreviousPauser
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *DepositForBurn) GetBurnToken() string {
+	if x != nil {
+		return x.BurnToken
 	}
 	return ""
 }
 
-func (x *PauserUpdated) GetNewPauser() string {
+func (x *DepositForBurn) GetAmount() string {
 	if x != nil {
-		return x.NewPauser
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *DepositForBurn) GetDepositor() string {
+	if x != nil {
+		return x.Depositor
 	}
 	return ""
 }
 
+func (x *DepositForBurn) GetMintRecipient() []byte {
+	if x != nil {
+		return x.MintRecipient
+	}
+	return nil
+}
+
+func (x *DepositForBurn) GetDestinationDomain() uint32 {
+	if x != nil {
+		return x.DestinationDomain
+	}
+	return 0
+}
+
+func (x *DepositForBurn) GetDestinationTokenMessenger() []byte {
+	if x != nil {
+		return x.DestinationTokenMessenger
+	}
+	return nil
+}
+
+func (x *DepositForBurn) GetDestinationCaller() []byte {
+	if x != nil {
+		return x.DestinationCaller
+	}
+	return nil
+}
+
 // *
-// Emitted when attester manager address is updated
-// @param previous_attester_manager representing the address of the previous
-// attester manager
-// @param new_attester_manager representing the address of the new attester
-// manager
-type AttesterManagerUpdated struct {
+// Emitted when tokens are minted
+// @param mint_recipient recipient address of minted tokens
+// @param amount amount of minted tokens
+// @param mint_token contract address of minted token
+type MintAndWithdraw struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PreviousAttesterManager string `protobuf:"bytes,1,opt,name=previous_attester_manager,json=previousAttesterManager,proto3" json:"previous_attester_manager,omitempty"`
-	NewAttesterManager      string `protobuf:"bytes,2,opt,name=new_attester_manager,json=newAttesterManager,proto3" json:"new_attester_manager,omitempty"`
+	MintRecipient []byte `protobuf:"bytes,1,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	MintToken     string `protobuf:"bytes,3,opt,name=mint_token,json=mintToken,proto3" json:"mint_token,omitempty"`
 }
 
-func (x *AttesterManagerUpdated) Reset() {
-	*x = AttesterManagerUpdated{}
+func (x *MintAndWithdraw) Reset() {
+	*x = MintAndWithdraw{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[6]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AttesterManagerUpdated) String() string {
+func (x *MintAndWithdraw) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AttesterManagerUpdated) ProtoMessage() {}
+func (*MintAndWithdraw) ProtoMessage() {}
 
-// Deprecated: Use AttesterManagerUpdated.ProtoReflect.Descriptor instead.
-func (*AttesterManagerUpdated) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use MintAndWithdraw.ProtoReflect.Descriptor instead.
+func (*MintAndWithdraw) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *MintAndWithdraw) GetMintRecipient() []byte {
+	if x != nil {
+		return x.MintRecipient
+	}
+	return nil
 }
 
-func (x *AttesterManagerUpdated) GetPreviousAttesterManager() string {
+func (x *MintAndWithdraw) GetAmount() string {
 	if x != nil {
-		return x.PreviousAttesterManager
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *AttesterManagerUpdated) GetNewAttesterManager() string {
+func (x *MintAndWithdraw) GetMintToken() string {
 	if x != nil {
-		return x.NewAttesterManager
+		return x.MintToken
 	}
 	return ""
 }
 
 // *
-// Emitted when token controller address is updated
-// @param previous_token_controller representing the address of the previous
-// token controller
-// @param new_token_controller representing the address of the new token
-// controller
-type TokenControllerUpdated struct {
+// Emitted when a token pair is linked
+// @param local_token local token to support
+// @param remote_domain remote domain
+// @param remote_token token on `remoteDomain` corresponding to `localToken`
+type TokenPairLinked struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PreviousTokenController string `protobuf:"bytes,1,opt,name=previous_token_controller,json=previousTokenController,proto3" json:"previous_token_controller,omitempty"`
-	NewTokenController      string `protobuf:"bytes,2,opt,name=new_token_controller,json=newTokenController,proto3" json:"new_token_controller,omitempty"`
+	LocalToken   string `protobuf:"bytes,1,opt,name=local_token,json=localToken,proto3" json:"local_token,omitempty"`
+	RemoteDomain uint32 `protobuf:"varint,2,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	RemoteToken  []byte `protobuf:"bytes,3,opt,name=remote_token,json=remoteToken,proto3" json:"remote_token,omitempty"`
 }
 
-func (x *TokenControllerUpdated) Reset() {
-	*x = TokenControllerUpdated{}
+func (x *TokenPairLinked) Reset() {
+	*x = TokenPairLinked{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[7]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenControllerUpdated) String() string {
+func (x *TokenPairLinked) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenControllerUpdated) ProtoMessage() {}
+func (*TokenPairLinked) ProtoMessage() {}
 
-// Deprecated: Use TokenControllerUpdated.ProtoReflect.Descriptor instead.
-func (*TokenControllerUpdated) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use TokenPairLinked.ProtoReflect.Descriptor instead.
+func (*TokenPairLinked) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *TokenControllerUpdated) GetPreviousTokenController() string {
+func (x *TokenPairLinked) GetLocalToken() string {
 	if x != nil {
-		return x.PreviousTokenController
+		return x.LocalToken
 	}
 	return ""
 }
 
-func (x *TokenControllerUpdated) GetNewTokenController() string {
+func (x *TokenPairLinked) GetRemoteDomain() uint32 {
 	if x != nil {
-		return x.NewTokenController
+		return x.RemoteDomain
 	}
-	return ""
-}
-
-// *
-// Emitted when burning and minting tokens is paused
-type BurningAndMintingPausedEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+	return 0
 }
 
-func (x *BurningAndMintingPausedEvent) Reset() {
-	*x = BurningAndMintingPausedEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[8]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TokenPairLinked) GetRemoteToken() []byte {
+	if x != nil {
+		return x.RemoteToken
 	}
-}
-
-func (x *BurningAndMintingPausedEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*BurningAndMintingPausedEvent) ProtoMessage() {}
-
-// Deprecated: Use BurningAndMintingPausedEvent.ProtoReflect.Descriptor instead.
-func (*BurningAndMintingPausedEvent) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{8}
+	return nil
 }
 
 // *
-// Emitted when burning and minting tokens is unpaused
-type BurningAndMintingUnpausedEvent struct {
+// Emitted when a token pair is unlinked
+// @param local_token local token address
+// @param remote_domain remote domain
+// @param remote_token token on `remoteDomain` unlinked from `localToken`
+type TokenPairUnlinked struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	LocalToken   string `protobuf:"bytes,1,opt,name=local_token,json=localToken,proto3" json:"local_token,omitempty"`
+	RemoteDomain uint32 `protobuf:"varint,2,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
+	RemoteToken  []byte `protobuf:"bytes,3,opt,name=remote_token,json=remoteToken,proto3" json:"remote_token,omitempty"`
 }
 
-func (x *BurningAndMintingUnpausedEvent) Reset() {
-	*x = BurningAndMintingUnpausedEvent{}
+func (x *TokenPairUnlinked) Reset() {
+	*x = TokenPairUnlinked{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[9]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BurningAndMintingUnpausedEvent) String() string {
+func (x *TokenPairUnlinked) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BurningAndMintingUnpausedEvent) ProtoMessage() {}
-
-// Deprecated: Use BurningAndMintingUnpausedEvent.ProtoReflect.Descriptor instead.
-func (*BurningAndMintingUnpausedEvent) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{9}
-}
+func (*TokenPairUnlinked) ProtoMessage() {}
 
-// *
-// Emitted when sending and receiving messages is paused
-type SendingAndReceivingPausedEvent struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+// Deprecated: Use TokenPairUnlinked.ProtoReflect.Descriptor instead.
+func (*TokenPairUnlinked) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SendingAndReceivingPausedEvent) Reset() {
-	*x = SendingAndReceivingPausedEvent{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[10]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TokenPairUnlinked) GetLocalToken() string {
+	if x != nil {
+		return x.LocalToken
 	}
+	return ""
 }
 
-func (x *SendingAndReceivingPausedEvent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TokenPairUnlinked) GetRemoteDomain() uint32 {
+	if x != nil {
+		return x.RemoteDomain
+	}
+	return 0
 }
 
-func (*SendingAndReceivingPausedEvent) ProtoMessage() {}
-
-// Deprecated: Use SendingAndReceivingPausedEvent.ProtoReflect.Descriptor instead.
-func (*SendingAndReceivingPausedEvent) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{10}
+func (x *TokenPairUnlinked) GetRemoteToken() []byte {
+	if x != nil {
+		return x.RemoteToken
+	}
+	return nil
 }
 
 // *
-// Emitted when sending and receiving messages is paused
-type SendingAndReceivingUnpausedEvent struct {
+// Emitted when a new message is dispatched
+// @param message Raw bytes of message
+type MessageSent struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Message []byte `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *SendingAndReceivingUnpausedEvent) Reset() {
-	*x = SendingAndReceivingUnpausedEvent{}
+func (x *MessageSent) Reset() {
+	*x = MessageSent{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[11]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendingAndReceivingUnpausedEvent) String() string {
+func (x *MessageSent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendingAndReceivingUnpausedEvent) ProtoMessage() {}
+func (*MessageSent) ProtoMessage() {}
 
-// Deprecated: Use SendingAndReceivingUnpausedEvent.ProtoReflect.Descriptor instead.
-func (*SendingAndReceivingUnpausedEvent) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use MessageSent.ProtoReflect.Descriptor instead.
+func (*MessageSent) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MessageSent) GetMessage() []byte {
+	if x != nil {
+		return x.Message
+	}
+	return nil
 }
 
 // *
-// Emitted when a DepositForBurn message is sent
-// @param nonce unique nonce reserved by message
-// @param burn_token address of token burnt on source domain
-// @param amount deposit amount
-// @param depositor address where deposit is transferred from
-// @param mint_recipient address receiving minted tokens on destination domain
-// as bytes32
-// @param destination_domain destination domain
-// @param destination_token_messenger address of TokenMessenger on destination
-// domain as bytes32
-// @param destination_caller authorized caller as bytes32 of receiveMessage() on
-// destination domain, if not equal to bytes32(0). If equal to bytes32(0), any
-// address can call receiveMessage().
-type DepositForBurn struct {
+// Emitted when a new message is received
+// @param caller caller (msg.sender) on destination domain
+// @param source_domain the source domain this message originated from
+// @param nonce the nonce unique to this message
+// @param sender the sender of this message
+// @param message_body message body bytes
+type MessageReceived struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Nonce                     uint64 `protobuf:"varint,1,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	BurnToken                 string `protobuf:"bytes,2,opt,name=burn_token,json=burnToken,proto3" json:"burn_token,omitempty"`
-	Amount                    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Depositor                 string `protobuf:"bytes,4,opt,name=depositor,proto3" json:"depositor,omitempty"`
-	MintRecipient             []byte `protobuf:"bytes,5,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
-	DestinationDomain         uint32 `protobuf:"varint,6,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
-	DestinationTokenMessenger []byte `protobuf:"bytes,7,opt,name=destination_token_messenger,json=destinationTokenMessenger,proto3" json:"destination_token_messenger,omitempty"`
-	DestinationCaller         []byte `protobuf:"bytes,8,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	Caller       string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Nonce        uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Sender       []byte `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
+	MessageBody  []byte `protobuf:"bytes,5,opt,name=message_body,json=messageBody,proto3" json:"message_body,omitempty"`
 }
 
-func (x *DepositForBurn) Reset() {
-	*x = DepositForBurn{}
+func (x *MessageReceived) Reset() {
+	*x = MessageReceived{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[12]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DepositForBurn) String() string {
+func (x *MessageReceived) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DepositForBurn) ProtoMessage() {}
-
-// Deprecated: Use DepositForBurn.ProtoReflect.Descriptor instead.
-func (*DepositForBurn) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *DepositForBurn) GetNonce() uint64 {
-	if x != nil {
-		return x.Nonce
-	}
-	return 0
-}
-
-func (x *DepositForBurn) GetBurnToken() string {
-	if x != nil {
-		return x.BurnToken
-	}
-	return ""
-}
+func (*MessageReceived) ProtoMessage() {}
 
-func (x *DepositForBurn) GetAmount() string {
-	if x != nil {
-		return x.Amount
-	}
-	return ""
+// Deprecated: Use MessageReceived.ProtoReflect.Descriptor instead.
+func (*MessageReceived) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *DepositForBurn) GetDepositor() string {
+func (x *MessageReceived) GetCaller() string {
 	if x != nil {
-		return x.Depositor
+		return x.Caller
 	}
 	return ""
 }
 
-func (x *DepositForBurn) GetMintRecipient() []byte {
+func (x *MessageReceived) GetSourceDomain() uint32 {
 	if x != nil {
-		return x.MintRecipient
+		return x.SourceDomain
 	}
-	return nil
+	return 0
 }
 
-func (x *DepositForBurn) GetDestinationDomain() uint32 {
+func (x *MessageReceived) GetNonce() uint64 {
 	if x != nil {
-		return x.DestinationDomain
+		return x.Nonce
 	}
 	return 0
 }
 
-func (x *DepositForBurn) GetDestinationTokenMessenger() []byte {
+func (x *MessageReceived) GetSender() []byte {
 	if x != nil {
-		return x.DestinationTokenMessenger
+		return x.Sender
 	}
 	return nil
 }
 
-func (x *DepositForBurn) GetDestinationCaller() []byte {
+func (x *MessageReceived) GetMessageBody() []byte {
 	if x != nil {
-		return x.DestinationCaller
+		return x.MessageBody
 	}
 	return nil
 }
 
 // *
-// Emitted when tokens are minted
-// @param mint_recipient recipient address of minted tokens
-// @param amount amount of minted tokens
-// @param mint_token contract address of minted token
-type MintAndWithdraw struct {
+// Emitted when max message body size is updated
+// @param new_max_message_body_size new maximum message body size, in bytes
+type MaxMessageBodySizeUpdated struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MintRecipient []byte `protobuf:"bytes,1,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
-	Amount        string `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
-	MintToken     string `protobuf:"bytes,3,opt,name=mint_token,json=mintToken,proto3" json:"mint_token,omitempty"`
+	NewMaxMessageBodySize uint64 `protobuf:"varint,1,opt,name=new_max_message_body_size,json=newMaxMessageBodySize,proto3" json:"new_max_message_body_size,omitempty"`
 }
 
-func (x *MintAndWithdraw) Reset() {
-	*x = MintAndWithdraw{}
+func (x *MaxMessageBodySizeUpdated) Reset() {
+	*x = MaxMessageBodySizeUpdated{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[13]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MintAndWithdraw) String() string {
+func (x *MaxMessageBodySizeUpdated) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MintAndWithdraw) ProtoMessage() {}
+func (*MaxMessageBodySizeUpdated) ProtoMessage() {}
 
-// Deprecated: Use MintAndWithdraw.ProtoReflect.Descriptor instead.
-func (*MintAndWithdraw) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use MaxMessageBodySizeUpdated.ProtoReflect.Descriptor instead.
+func (*MaxMessageBodySizeUpdated) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *MintAndWithdraw) GetMintRecipient() []byte {
+func (x *MaxMessageBodySizeUpdated) GetNewMaxMessageBodySize() uint64 {
 	if x != nil {
-		return x.MintRecipient
+		return x.NewMaxMessageBodySize
 	}
-	return nil
+	return 0
 }
 
-func (x *MintAndWithdraw) GetAmount() string {
+// *
+// Emitted when a RemoteTokenMessenger is added
+// @param domain remote domain
+// @param remote_token_messenger RemoteTokenMessenger on domain
+type RemoteTokenMessengerAdded struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Domain               uint32 `protobuf:"varint,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	RemoteTokenMessenger []byte `protobuf:"bytes,2,opt,name=remote_token_messenger,json=remoteTokenMessenger,proto3" json:"remote_token_messenger,omitempty"`
+}
+
+func (x *RemoteTokenMessengerAdded) Reset() {
+	*x = RemoteTokenMessengerAdded{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoteTokenMessengerAdded) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteTokenMessengerAdded) ProtoMessage() {}
+
+// Deprecated: Use RemoteTokenMessengerAdded.ProtoReflect.Descriptor instead.
+func (*RemoteTokenMessengerAdded) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *RemoteTokenMessengerAdded) GetDomain() uint32 {
 	if x != nil {
-		return x.Amount
+		return x.Domain
 	}
-	return ""
+	return 0
 }
 
-func (x *MintAndWithdraw) GetMintToken() string {
+func (x *RemoteTokenMessengerAdded) GetRemoteTokenMessenger() []byte {
 	if x != nil {
-		return x.MintToken
+		return x.RemoteTokenMessenger
 	}
-	return ""
+	return nil
 }
 
 // *
-// Emitted when a token pair is linked
-// @param local_token local token to support
-// @param remote_domain remote domain
-// @param remote_token token on `remoteDomain` corresponding to `localToken`
-type TokenPairLinked struct {
+// Emitted when a RemoteTokenMessenger is removed
+// @param domain remote domain
+// @param remote_token_messenger RemoteTokenMessenger on domain
+type RemoteTokenMessengerRemoved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LocalToken   string `protobuf:"bytes,1,opt,name=local_token,json=localToken,proto3" json:"local_token,omitempty"`
-	RemoteDomain uint32 `protobuf:"varint,2,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
-	RemoteToken  []byte `protobuf:"bytes,3,opt,name=remote_token,json=remoteToken,proto3" json:"remote_token,omitempty"`
+	Domain               uint32 `protobuf:"varint,1,opt,name=domain,proto3" json:"domain,omitempty"`
+	RemoteTokenMessenger []byte `protobuf:"bytes,2,opt,name=remote_token_messenger,json=remoteTokenMessenger,proto3" json:"remote_token_messenger,omitempty"`
 }
 
-func (x *TokenPairLinked) Reset() {
-	*x = TokenPairLinked{}
+func (x *RemoteTokenMessengerRemoved) Reset() {
+	*x = RemoteTokenMessengerRemoved{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[14]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenPairLinked) String() string {
+func (x *RemoteTokenMessengerRemoved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenPairLinked) ProtoMessage() {}
-
-// Deprecated: Use TokenPairLinked.ProtoReflect.Descriptor instead.
-func (*TokenPairLinked) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{14}
-}
+func (*RemoteTokenMessengerRemoved) ProtoMessage() {}
 
-func (x *TokenPairLinked) GetLocalToken() string {
-	if x != nil {
-		return x.LocalToken
-	}
-	return ""
+// Deprecated: Use RemoteTokenMessengerRemoved.ProtoReflect.Descriptor instead.
+func (*RemoteTokenMessengerRemoved) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *TokenPairLinked) GetRemoteDomain() uint32 {
+func (x *RemoteTokenMessengerRemoved) GetDomain() uint32 {
 	if x != nil {
-		return x.RemoteDomain
+		return x.Domain
 	}
 	return 0
 }
 
-func (x *TokenPairLinked) GetRemoteToken() []byte {
+func (x *RemoteTokenMessengerRemoved) GetRemoteTokenMessenger() []byte {
 	if x != nil {
-		return x.RemoteToken
+		return x.RemoteTokenMessenger
 	}
 	return nil
 }
 
 // *
-// Emitted when a token pair is unlinked
-// @param local_token local token address
-// @param remote_domain remote domain
-// @param remote_token token on `remoteDomain` unlinked from `localToken`
-type TokenPairUnlinked struct {
+// Emitted when max burn amount per message is updated
+// @param local_token
+// @param old_amount old max burn amount
+// @param new_amount new max burn amount
+type SetBurnLimitPerMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LocalToken   string `protobuf:"bytes,1,opt,name=local_token,json=localToken,proto3" json:"local_token,omitempty"`
-	RemoteDomain uint32 `protobuf:"varint,2,opt,name=remote_domain,json=remoteDomain,proto3" json:"remote_domain,omitempty"`
-	RemoteToken  []byte `protobuf:"bytes,3,opt,name=remote_token,json=remoteToken,proto3" json:"remote_token,omitempty"`
+	Token               string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	BurnLimitPerMessage string `protobuf:"bytes,2,opt,name=burn_limit_per_message,json=burnLimitPerMessage,proto3" json:"burn_limit_per_message,omitempty"`
 }
 
-func (x *TokenPairUnlinked) Reset() {
-	*x = TokenPairUnlinked{}
+func (x *SetBurnLimitPerMessage) Reset() {
+	*x = SetBurnLimitPerMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[15]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenPairUnlinked) String() string {
+func (x *SetBurnLimitPerMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenPairUnlinked) ProtoMessage() {}
+func (*SetBurnLimitPerMessage) ProtoMessage() {}
 
-// Deprecated: Use TokenPairUnlinked.ProtoReflect.Descriptor instead.
-func (*TokenPairUnlinked) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use SetBurnLimitPerMessage.ProtoReflect.Descriptor instead.
+func (*SetBurnLimitPerMessage) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *TokenPairUnlinked) GetLocalToken() string {
+func (x *SetBurnLimitPerMessage) GetToken() string {
 	if x != nil {
-		return x.LocalToken
+		return x.Token
 	}
 	return ""
 }
 
-func (x *TokenPairUnlinked) GetRemoteDomain() uint32 {
-	if x != nil {
-		return x.RemoteDomain
-	}
-	return 0
-}
-
-func (x *TokenPairUnlinked) GetRemoteToken() []byte {
+func (x *SetBurnLimitPerMessage) GetBurnLimitPerMessage() string {
 	if x != nil {
-		return x.RemoteToken
+		return x.BurnLimitPerMessage
 	}
-	return nil
+	return ""
 }
 
 // *
-// Emitted when a new message is dispatched
-// @param message Raw bytes of message
-type MessageSent struct {
+// Emitted when a Forwarding is set
+// @param address the forwarding noble account
+// @param destination_domain the domain mints are forwarded to
+// @param mint_recipient the recipient on destination_domain
+// @param destination_caller the caller allowed to receive forwarded messages
+// @param fee the amount paid from each forwarded mint to its relayer
+type ForwardingSet struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Message []byte `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	MintRecipient     []byte `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	DestinationCaller []byte `protobuf:"bytes,4,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	Fee               string `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *MessageSent) Reset() {
-	*x = MessageSent{}
+func (x *ForwardingSet) Reset() {
+	*x = ForwardingSet{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[16]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageSent) String() string {
+func (x *ForwardingSet) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageSent) ProtoMessage() {}
+func (*ForwardingSet) ProtoMessage() {}
 
-// Deprecated: Use MessageSent.ProtoReflect.Descriptor instead.
-func (*MessageSent) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ForwardingSet.ProtoReflect.Descriptor instead.
+func (*ForwardingSet) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *MessageSent) GetMessage() []byte {
+func (x *ForwardingSet) GetAddress() string {
 	if x != nil {
-		return x.Message
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ForwardingSet) GetDestinationDomain() uint32 {
+	if x != nil {
+		return x.DestinationDomain
+	}
+	return 0
+}
+
+func (x *ForwardingSet) GetMintRecipient() []byte {
+	if x != nil {
+		return x.MintRecipient
 	}
 	return nil
 }
 
+func (x *ForwardingSet) GetDestinationCaller() []byte {
+	if x != nil {
+		return x.DestinationCaller
+	}
+	return nil
+}
+
+func (x *ForwardingSet) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
+}
+
 // *
-// Emitted when a new message is received
-// @param caller caller (msg.sender) on destination domain
-// @param source_domain the source domain this message originated from
-// @param nonce the nonce unique to this message
-// @param sender the sender of this message
-// @param message_body message body bytes
-type MessageReceived struct {
+// Emitted when a Forwarding is removed
+// @param address the noble account that no longer forwards
+type ForwardingRemoved struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Caller       string `protobuf:"bytes,1,opt,name=caller,proto3" json:"caller,omitempty"`
-	SourceDomain uint32 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
-	Nonce        uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	Sender       []byte `protobuf:"bytes,4,opt,name=sender,proto3" json:"sender,omitempty"`
-	MessageBody  []byte `protobuf:"bytes,5,opt,name=message_body,json=messageBody,proto3" json:"message_body,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *MessageReceived) Reset() {
-	*x = MessageReceived{}
+func (x *ForwardingRemoved) Reset() {
+	*x = ForwardingRemoved{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[17]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MessageReceived) String() string {
+func (x *ForwardingRemoved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageReceived) ProtoMessage() {}
+func (*ForwardingRemoved) ProtoMessage() {}
 
-// Deprecated: Use MessageReceived.ProtoReflect.Descriptor instead.
-func (*MessageReceived) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ForwardingRemoved.ProtoReflect.Descriptor instead.
+func (*ForwardingRemoved) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *MessageReceived) GetCaller() string {
+func (x *ForwardingRemoved) GetAddress() string {
 	if x != nil {
-		return x.Caller
+		return x.Address
 	}
 	return ""
 }
 
-func (x *MessageReceived) GetSourceDomain() uint32 {
-	if x != nil {
-		return x.SourceDomain
-	}
-	return 0
+// *
+// Emitted when a 32-byte account is registered as a recipient
+// @param address the registered noble account
+type Account32Registered struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *MessageReceived) GetNonce() uint64 {
-	if x != nil {
-		return x.Nonce
+func (x *Account32Registered) Reset() {
+	*x = Account32Registered{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *MessageReceived) GetSender() []byte {
-	if x != nil {
-		return x.Sender
-	}
-	return nil
+func (x *Account32Registered) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MessageReceived) GetMessageBody() []byte {
+func (*Account32Registered) ProtoMessage() {}
+
+// Deprecated: Use Account32Registered.ProtoReflect.Descriptor instead.
+func (*Account32Registered) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Account32Registered) GetAddress() string {
 	if x != nil {
-		return x.MessageBody
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
 // *
-// Emitted when max message body size is updated
-// @param new_max_message_body_size new maximum message body size, in bytes
-type MaxMessageBodySizeUpdated struct {
+// Emitted when a contract opts in to callbacks
+// @param address the contract address
+type ContractCallbackEnabled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	NewMaxMessageBodySize uint64 `protobuf:"varint,1,opt,name=new_max_message_body_size,json=newMaxMessageBodySize,proto3" json:"new_max_message_body_size,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *MaxMessageBodySizeUpdated) Reset() {
-	*x = MaxMessageBodySizeUpdated{}
+func (x *ContractCallbackEnabled) Reset() {
+	*x = ContractCallbackEnabled{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[18]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MaxMessageBodySizeUpdated) String() string {
+func (x *ContractCallbackEnabled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MaxMessageBodySizeUpdated) ProtoMessage() {}
+func (*ContractCallbackEnabled) ProtoMessage() {}
 
-// Deprecated: Use MaxMessageBodySizeUpdated.ProtoReflect.Descriptor instead.
-func (*MaxMessageBodySizeUpdated) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ContractCallbackEnabled.ProtoReflect.Descriptor instead.
+func (*ContractCallbackEnabled) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MaxMessageBodySizeUpdated) GetNewMaxMessageBodySize() uint64 {
+func (x *ContractCallbackEnabled) GetAddress() string {
 	if x != nil {
-		return x.NewMaxMessageBodySize
+		return x.Address
 	}
-	return 0
+	return ""
 }
 
 // *
-// Emitted when a RemoteTokenMessenger is added
-// @param domain remote domain
-// @param remote_token_messenger RemoteTokenMessenger on domain
-type RemoteTokenMessengerAdded struct {
+// Emitted when a contract opts out of callbacks
+// @param address the contract address
+type ContractCallbackDisabled struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Domain               uint32 `protobuf:"varint,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	RemoteTokenMessenger []byte `protobuf:"bytes,2,opt,name=remote_token_messenger,json=remoteTokenMessenger,proto3" json:"remote_token_messenger,omitempty"`
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *RemoteTokenMessengerAdded) Reset() {
-	*x = RemoteTokenMessengerAdded{}
+func (x *ContractCallbackDisabled) Reset() {
+	*x = ContractCallbackDisabled{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[19]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoteTokenMessengerAdded) String() string {
+func (x *ContractCallbackDisabled) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteTokenMessengerAdded) ProtoMessage() {}
-
-// Deprecated: Use RemoteTokenMessengerAdded.ProtoReflect.Descriptor instead.
-func (*RemoteTokenMessengerAdded) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{19}
-}
+func (*ContractCallbackDisabled) ProtoMessage() {}
 
-func (x *RemoteTokenMessengerAdded) GetDomain() uint32 {
-	if x != nil {
-		return x.Domain
-	}
-	return 0
+// Deprecated: Use ContractCallbackDisabled.ProtoReflect.Descriptor instead.
+func (*ContractCallbackDisabled) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *RemoteTokenMessengerAdded) GetRemoteTokenMessenger() []byte {
+func (x *ContractCallbackDisabled) GetAddress() string {
 	if x != nil {
-		return x.RemoteTokenMessenger
+		return x.Address
 	}
-	return nil
+	return ""
 }
 
 // *
-// Emitted when a RemoteTokenMessenger is removed
-// @param domain remote domain
-// @param remote_token_messenger RemoteTokenMessenger on domain
-type RemoteTokenMessengerRemoved struct {
+// Emitted when a received mint is forwarded to another domain
+// @param address the forwarding noble account
+// @param source_domain the domain of the received message
+// @param source_nonce the nonce of the received message
+// @param nonce the nonce of the forwarded message
+// @param amount the amount forwarded
+// @param fee the amount paid to the relayer
+type MintForwarded struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Domain               uint32 `protobuf:"varint,1,opt,name=domain,proto3" json:"domain,omitempty"`
-	RemoteTokenMessenger []byte `protobuf:"bytes,2,opt,name=remote_token_messenger,json=remoteTokenMessenger,proto3" json:"remote_token_messenger,omitempty"`
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	SourceNonce  uint64 `protobuf:"varint,3,opt,name=source_nonce,json=sourceNonce,proto3" json:"source_nonce,omitempty"`
+	Nonce        uint64 `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Amount       string `protobuf:"bytes,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Fee          string `protobuf:"bytes,6,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *RemoteTokenMessengerRemoved) Reset() {
-	*x = RemoteTokenMessengerRemoved{}
+func (x *MintForwarded) Reset() {
+	*x = MintForwarded{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[20]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoteTokenMessengerRemoved) String() string {
+func (x *MintForwarded) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoteTokenMessengerRemoved) ProtoMessage() {}
+func (*MintForwarded) ProtoMessage() {}
 
-// Deprecated: Use RemoteTokenMessengerRemoved.ProtoReflect.Descriptor instead.
-func (*RemoteTokenMessengerRemoved) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use MintForwarded.ProtoReflect.Descriptor instead.
+func (*MintForwarded) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RemoteTokenMessengerRemoved) GetDomain() uint32 {
+func (x *MintForwarded) GetAddress() string {
 	if x != nil {
-		return x.Domain
+		return x.Address
+	}
+	return ""
+}
+
+func (x *MintForwarded) GetSourceDomain() uint32 {
+	if x != nil {
+		return x.SourceDomain
 	}
 	return 0
 }
 
-func (x *RemoteTokenMessengerRemoved) GetRemoteTokenMessenger() []byte {
+func (x *MintForwarded) GetSourceNonce() uint64 {
 	if x != nil {
-		return x.RemoteTokenMessenger
+		return x.SourceNonce
 	}
-	return nil
+	return 0
+}
+
+func (x *MintForwarded) GetNonce() uint64 {
+	if x != nil {
+		return x.Nonce
+	}
+	return 0
+}
+
+func (x *MintForwarded) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *MintForwarded) GetFee() string {
+	if x != nil {
+		return x.Fee
+	}
+	return ""
 }
 
 // *
-// Emitted when max burn amount per message is updated
-// @param local_token
-// @param old_amount old max burn amount
-// @param new_amount new max burn amount
-type SetBurnLimitPerMessage struct {
+// Emitted when a received mint cannot be forwarded, leaving the funds with the
+// forwarding account
+// @param address the forwarding noble account
+// @param source_domain the domain of the received message
+// @param source_nonce the nonce of the received message
+// @param reason why the mint was not forwarded
+type MintForwardingFailed struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token               string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	BurnLimitPerMessage string `protobuf:"bytes,2,opt,name=burn_limit_per_message,json=burnLimitPerMessage,proto3" json:"burn_limit_per_message,omitempty"`
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	SourceNonce  uint64 `protobuf:"varint,3,opt,name=source_nonce,json=sourceNonce,proto3" json:"source_nonce,omitempty"`
+	Reason       string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *SetBurnLimitPerMessage) Reset() {
-	*x = SetBurnLimitPerMessage{}
+func (x *MintForwardingFailed) Reset() {
+	*x = MintForwardingFailed{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_circle_cctp_v1_events_proto_msgTypes[21]
+		mi := &file_circle_cctp_v1_events_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SetBurnLimitPerMessage) String() string {
+func (x *MintForwardingFailed) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SetBurnLimitPerMessage) ProtoMessage() {}
+func (*MintForwardingFailed) ProtoMessage() {}
 
-// Deprecated: Use SetBurnLimitPerMessage.ProtoReflect.Descriptor instead.
-func (*SetBurnLimitPerMessage) Descriptor() ([]byte, []int) {
-	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use MintForwardingFailed.ProtoReflect.Descriptor instead.
+func (*MintForwardingFailed) Descriptor() ([]byte, []int) {
+	return file_circle_cctp_v1_events_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SetBurnLimitPerMessage) GetToken() string {
+func (x *MintForwardingFailed) GetAddress() string {
 	if x != nil {
-		return x.Token
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SetBurnLimitPerMessage) GetBurnLimitPerMessage() string {
+func (x *MintForwardingFailed) GetSourceDomain() uint32 {
 	if x != nil {
-		return x.BurnLimitPerMessage
+		return x.SourceDomain
+	}
+	return 0
+}
+
+func (x *MintForwardingFailed) GetSourceNonce() uint64 {
+	if x != nil {
+		return x.SourceNonce
+	}
+	return 0
+}
+
+func (x *MintForwardingFailed) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
@@ -11581,163 +17540,252 @@ var file_circle_cctp_v1_events_proto_rawDesc = []byte{
 	0x2f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x63,
 	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x1a, 0x14, 0x67,
 	0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x2d, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x45,
+	0x6f, 0x74, 0x6f, 0x22, 0x49, 0x0a, 0x0f, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x45,
 	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
 	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x22, 0x2e, 0x0a, 0x10, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69,
-	0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x65, 0x72, 0x22, 0x8b, 0x01, 0x0a, 0x19, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65,
-	0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72,
-	0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x15, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54,
-	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x6e, 0x65, 0x77, 0x5f,
-	0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68,
-	0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6e, 0x65, 0x77, 0x53, 0x69,
-	0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
-	0x22, 0x52, 0x0a, 0x0c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x18, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
-	0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64,
-	0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x6f, 0x77, 0x6e,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
-	0x75, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x77, 0x5f, 0x6f,
-	0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x77, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x22, 0x57, 0x0a, 0x0d, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
-	0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d,
-	0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x22, 0x86, 0x01,
-	0x0a, 0x16, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65,
-	0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x46,
+	0x0a, 0x10, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x8b, 0x01, 0x0a, 0x19, 0x53, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x17, 0x6f, 0x6c, 0x64, 0x5f, 0x73, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6f, 0x6c, 0x64, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x17,
+	0x6e, 0x65, 0x77, 0x5f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x74, 0x68,
+	0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6e,
+	0x65, 0x77, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73,
+	0x68, 0x6f, 0x6c, 0x64, 0x22, 0x52, 0x0a, 0x0c, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x6e,
+	0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x22, 0x8a, 0x01, 0x0a, 0x18, 0x4f, 0x77, 0x6e,
+	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x6e, 0x65, 0x77, 0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x65, 0x77, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x48,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0xba, 0x01, 0x0a, 0x1e, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65,
+	0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76,
 	0x69, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61,
 	0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x65,
 	0x76, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e,
 	0x61, 0x67, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x74, 0x74, 0x65,
 	0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4d,
-	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x16, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x30, 0x0a,
-	0x14, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x77,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22,
-	0x1e, 0x0a, 0x1c, 0x42, 0x75, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x4d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22,
-	0x20, 0x0a, 0x1e, 0x42, 0x75, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x4d, 0x69, 0x6e,
-	0x74, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x52,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x76,
-	0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x20, 0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6e,
-	0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73,
-	0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0xdf, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f,
-	0x73, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x42, 0x75, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
-	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06,
-	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69,
-	0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73,
-	0x69, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x69,
-	0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64,
-	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x1b, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x19, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x4d, 0x69,
-	0x6e, 0x74, 0x41, 0x6e, 0x64, 0x57, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x25, 0x0a,
+	0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65,
+	0x73, 0x5f, 0x61, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x22, 0x8b, 0x01, 0x0a, 0x15, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x50,
+	0x61, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x70, 0x61, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x50, 0x61,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f,
+	0x61, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74,
+	0x22, 0xba, 0x01, 0x0a, 0x1e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f,
+	0x6c, 0x6c, 0x65, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12,
+	0x30, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6e,
+	0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65,
+	0x72, 0x12, 0x2a, 0x0a, 0x11, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x5f,
+	0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x57, 0x0a,
+	0x0d, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x70, 0x61, 0x75, 0x73, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75,
+	0x73, 0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77,
+	0x50, 0x61, 0x75, 0x73, 0x65, 0x72, 0x22, 0x86, 0x01, 0x0a, 0x16, 0x41, 0x74, 0x74, 0x65, 0x73,
+	0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x12, 0x30, 0x0a,
+	0x14, 0x6e, 0x65, 0x77, 0x5f, 0x61, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x6d, 0x61,
+	0x6e, 0x61, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x77,
+	0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x72, 0x4d, 0x61, 0x6e, 0x61, 0x67, 0x65, 0x72, 0x22,
+	0x86, 0x01, 0x0a, 0x16, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c,
+	0x6c, 0x65, 0x72, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x3a, 0x0a, 0x19, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x70,
+	0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f, 0x6e, 0x74,
+	0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x14, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x6e, 0x65, 0x77, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x6f,
+	0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x6c, 0x65, 0x72, 0x22, 0x1e, 0x0a, 0x1c, 0x42, 0x75, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x75,
+	0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x42, 0x75, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x4d, 0x69, 0x6e, 0x74, 0x69, 0x6e, 0x67, 0x55, 0x6e, 0x70,
+	0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x69, 0x6e,
+	0x67, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x22, 0x0a, 0x20,
+	0x53, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x41, 0x6e, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76,
+	0x69, 0x6e, 0x67, 0x55, 0x6e, 0x70, 0x61, 0x75, 0x73, 0x65, 0x64, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x22, 0xdf, 0x02, 0x0a, 0x0e, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x46, 0x6f, 0x72, 0x42,
+	0x75, 0x72, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x72,
+	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x75, 0x72, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a,
 	0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f,
-	0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e,
-	0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
-	0x69, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x6d, 0x69, 0x6e, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7a, 0x0a, 0x0f, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a,
-	0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23,
-	0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x6f, 0x6d,
-	0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74,
-	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x7c, 0x0a, 0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
-	0x61, 0x69, 0x72, 0x55, 0x6e, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d,
-	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69,
-	0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x27, 0x0a, 0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53,
-	0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x01,
-	0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e,
-	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x22,
-	0x55, 0x0a, 0x19, 0x4d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64,
-	0x79, 0x53, 0x69, 0x7a, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x19,
-	0x6e, 0x65, 0x77, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f,
-	0x62, 0x6f, 0x64, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x15, 0x6e, 0x65, 0x77, 0x4d, 0x61, 0x78, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f,
-	0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x69, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x64,
-	0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x72,
-	0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65,
-	0x72, 0x22, 0x6b, 0x0a, 0x1b, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64,
-	0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f,
-	0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67,
-	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x22, 0x82,
-	0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x42, 0x75, 0x72, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50,
-	0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x52, 0x0a, 0x16, 0x62, 0x75, 0x72, 0x6e, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x65,
-	0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
-	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x13,
-	0x62, 0x75, 0x72, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x42, 0xb6, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69, 0x72, 0x63,
-	0x6c, 0x65, 0x2e, 0x63, 0x63, 0x74, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75,
-	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f,
-	0x6e, 0x6f, 0x62, 0x6c, 0x65, 0x2d, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63,
-	0x69, 0x72, 0x63, 0x6c, 0x65, 0x2f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x63,
-	0x74, 0x70, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0e, 0x43, 0x69, 0x72,
-	0x63, 0x6c, 0x65, 0x2e, 0x43, 0x63, 0x74, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x43, 0x69,
-	0x72, 0x63, 0x6c, 0x65, 0x5c, 0x43, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x43,
-	0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c, 0x43, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x43, 0x69, 0x72, 0x63,
-	0x6c, 0x65, 0x3a, 0x3a, 0x43, 0x63, 0x74, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x1b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x19, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e,
+	0x67, 0x65, 0x72, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x22, 0x8e, 0x01, 0x0a, 0x0f, 0x4d, 0x69, 0x6e, 0x74, 0x41, 0x6e, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x0a,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x69, 0x6e, 0x74, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x74, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x7a, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72,
+	0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74,
+	0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x7c, 0x0a, 0x11, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x55, 0x6e, 0x6c, 0x69,
+	0x6e, 0x6b, 0x65, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x27, 0x0a,
+	0x0b, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x6c, 0x6c,
+	0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x5f, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0b, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x55, 0x0a, 0x19, 0x4d, 0x61, 0x78, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x19, 0x6e, 0x65, 0x77, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x73, 0x69,
+	0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x6e, 0x65, 0x77, 0x4d, 0x61, 0x78,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x6f, 0x64, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x22,
+	0x69, 0x0a, 0x19, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x41, 0x64, 0x64, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x22, 0x6b, 0x0a, 0x1b, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67,
+	0x65, 0x72, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x64, 0x6f, 0x6d, 0x61, 0x69,
+	0x6e, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x65,
+	0x73, 0x73, 0x65, 0x6e, 0x67, 0x65, 0x72, 0x22, 0x82, 0x01, 0x0a, 0x16, 0x53, 0x65, 0x74, 0x42,
+	0x75, 0x72, 0x6e, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x50, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x52, 0x0a, 0x16, 0x62, 0x75, 0x72, 0x6e,
+	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x13, 0x62, 0x75, 0x72, 0x6e, 0x4c, 0x69, 0x6d,
+	0x69, 0x74, 0x50, 0x65, 0x72, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xdf, 0x01, 0x0a,
+	0x0d, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x74, 0x5f,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x0d, 0x6d, 0x69, 0x6e, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2d,
+	0x0a, 0x12, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x11, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x12, 0x2f, 0x0a,
+	0x03, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x2d,
+	0x0a, 0x11, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x2f, 0x0a,
+	0x13, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x33, 0x32, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x65, 0x72, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x33,
+	0x0a, 0x17, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x22, 0x34, 0x0a, 0x18, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x43,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x0d, 0x4d, 0x69,
+	0x6e, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x12, 0x35, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49,
+	0x6e, 0x74, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2f, 0x0a, 0x03, 0x66, 0x65,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f,
+	0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61,
+	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x90, 0x01, 0x0a, 0x14,
+	0x4d, 0x69, 0x6e, 0x74, 0x46, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x44, 0x6f, 0x6d,
+	0x61, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x6e, 0x6f,
+	0x6e, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x4e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x42, 0xb6,
+	0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x63, 0x63,
+	0x74, 0x70, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x50, 0x72, 0x6f,
+	0x74, 0x6f, 0x50, 0x01, 0x5a, 0x39, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x66, 0x69, 0x6e, 0x2f, 0x6e, 0x6f, 0x62, 0x6c, 0x65,
+	0x2d, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x63, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x2f, 0x63, 0x63, 0x74, 0x70, 0x2f, 0x76, 0x31, 0x3b, 0x63, 0x63, 0x74, 0x70, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x43, 0x43, 0x58, 0xaa, 0x02, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x2e, 0x43,
+	0x63, 0x74, 0x70, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x5c,
+	0x43, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65,
+	0x5c, 0x43, 0x63, 0x74, 0x70, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x43, 0x69, 0x72, 0x63, 0x6c, 0x65, 0x3a, 0x3a, 0x43,
+	0x63, 0x74, 0x70, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -11752,30 +17800,40 @@ func file_circle_cctp_v1_events_proto_rawDescGZIP() []byte {
 	return file_circle_cctp_v1_events_proto_rawDescData
 }
 
-var file_circle_cctp_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_circle_cctp_v1_events_proto_msgTypes = make([]protoimpl.MessageInfo, 32)
 var file_circle_cctp_v1_events_proto_goTypes = []interface{}{
 	(*AttesterEnabled)(nil),                  // 0: circle.cctp.v1.AttesterEnabled
 	(*AttesterDisabled)(nil),                 // 1: circle.cctp.v1.AttesterDisabled
 	(*SignatureThresholdUpdated)(nil),        // 2: circle.cctp.v1.SignatureThresholdUpdated
 	(*OwnerUpdated)(nil),                     // 3: circle.cctp.v1.OwnerUpdated
 	(*OwnershipTransferStarted)(nil),         // 4: circle.cctp.v1.OwnershipTransferStarted
-	(*PauserUpdated)(nil),                    // 5: circle.cctp.v1.PauserUpdated
-	(*AttesterManagerUpdated)(nil),           // 6: circle.cctp.v1.AttesterManagerUpdated
-	(*TokenControllerUpdated)(nil),           // 7: circle.cctp.v1.TokenControllerUpdated
-	(*BurningAndMintingPausedEvent)(nil),     // 8: circle.cctp.v1.BurningAndMintingPausedEvent
-	(*BurningAndMintingUnpausedEvent)(nil),   // 9: circle.cctp.v1.BurningAndMintingUnpausedEvent
-	(*SendingAndReceivingPausedEvent)(nil),   // 10: circle.cctp.v1.SendingAndReceivingPausedEvent
-	(*SendingAndReceivingUnpausedEvent)(nil), // 11: circle.cctp.v1.SendingAndReceivingUnpausedEvent
-	(*DepositForBurn)(nil),                   // 12: circle.cctp.v1.DepositForBurn
-	(*MintAndWithdraw)(nil),                  // 13: circle.cctp.v1.MintAndWithdraw
-	(*TokenPairLinked)(nil),                  // 14: circle.cctp.v1.TokenPairLinked
-	(*TokenPairUnlinked)(nil),                // 15: circle.cctp.v1.TokenPairUnlinked
-	(*MessageSent)(nil),                      // 16: circle.cctp.v1.MessageSent
-	(*MessageReceived)(nil),                  // 17: circle.cctp.v1.MessageReceived
-	(*MaxMessageBodySizeUpdated)(nil),        // 18: circle.cctp.v1.MaxMessageBodySizeUpdated
-	(*RemoteTokenMessengerAdded)(nil),        // 19: circle.cctp.v1.RemoteTokenMessengerAdded
-	(*RemoteTokenMessengerRemoved)(nil),      // 20: circle.cctp.v1.RemoteTokenMessengerRemoved
-	(*SetBurnLimitPerMessage)(nil),           // 21: circle.cctp.v1.SetBurnLimitPerMessage
+	(*AttesterManagerTransferStarted)(nil),   // 5: circle.cctp.v1.AttesterManagerTransferStarted
+	(*PauserTransferStarted)(nil),            // 6: circle.cctp.v1.PauserTransferStarted
+	(*TokenControllerTransferStarted)(nil),   // 7: circle.cctp.v1.TokenControllerTransferStarted
+	(*PauserUpdated)(nil),                    // 8: circle.cctp.v1.PauserUpdated
+	(*AttesterManagerUpdated)(nil),           // 9: circle.cctp.v1.AttesterManagerUpdated
+	(*TokenControllerUpdated)(nil),           // 10: circle.cctp.v1.TokenControllerUpdated
+	(*BurningAndMintingPausedEvent)(nil),     // 11: circle.cctp.v1.BurningAndMintingPausedEvent
+	(*BurningAndMintingUnpausedEvent)(nil),   // 12: circle.cctp.v1.BurningAndMintingUnpausedEvent
+	(*SendingAndReceivingPausedEvent)(nil),   // 13: circle.cctp.v1.SendingAndReceivingPausedEvent
+	(*SendingAndReceivingUnpausedEvent)(nil), // 14: circle.cctp.v1.SendingAndReceivingUnpausedEvent
+	(*DepositForBurn)(nil),                   // 15: circle.cctp.v1.DepositForBurn
+	(*MintAndWithdraw)(nil),                  // 16: circle.cctp.v1.MintAndWithdraw
+	(*TokenPairLinked)(nil),                  // 17: circle.cctp.v1.TokenPairLinked
+	(*TokenPairUnlinked)(nil),                // 18: circle.cctp.v1.TokenPairUnlinked
+	(*MessageSent)(nil),                      // 19: circle.cctp.v1.MessageSent
+	(*MessageReceived)(nil),                  // 20: circle.cctp.v1.MessageReceived
+	(*MaxMessageBodySizeUpdated)(nil),        // 21: circle.cctp.v1.MaxMessageBodySizeUpdated
+	(*RemoteTokenMessengerAdded)(nil),        // 22: circle.cctp.v1.RemoteTokenMessengerAdded
+	(*RemoteTokenMessengerRemoved)(nil),      // 23: circle.cctp.v1.RemoteTokenMessengerRemoved
+	(*SetBurnLimitPerMessage)(nil),           // 24: circle.cctp.v1.SetBurnLimitPerMessage
+	(*ForwardingSet)(nil),                    // 25: circle.cctp.v1.ForwardingSet
+	(*ForwardingRemoved)(nil),                // 26: circle.cctp.v1.ForwardingRemoved
+	(*Account32Registered)(nil),              // 27: circle.cctp.v1.Account32Registered
+	(*ContractCallbackEnabled)(nil),          // 28: circle.cctp.v1.ContractCallbackEnabled
+	(*ContractCallbackDisabled)(nil),         // 29: circle.cctp.v1.ContractCallbackDisabled
+	(*MintForwarded)(nil),                    // 30: circle.cctp.v1.MintForwarded
+	(*MintForwardingFailed)(nil),             // 31: circle.cctp.v1.MintForwardingFailed
 }
 var file_circle_cctp_v1_events_proto_depIdxs = []int32{
 	0, // [0:0] is the sub-list for method output_type
@@ -11852,7 +17910,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PauserUpdated); i {
+			switch v := v.(*AttesterManagerTransferStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11864,7 +17922,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttesterManagerUpdated); i {
+			switch v := v.(*PauserTransferStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11876,7 +17934,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenControllerUpdated); i {
+			switch v := v.(*TokenControllerTransferStarted); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11888,7 +17946,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BurningAndMintingPausedEvent); i {
+			switch v := v.(*PauserUpdated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11900,7 +17958,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BurningAndMintingUnpausedEvent); i {
+			switch v := v.(*AttesterManagerUpdated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11912,7 +17970,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendingAndReceivingPausedEvent); i {
+			switch v := v.(*TokenControllerUpdated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11924,7 +17982,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendingAndReceivingUnpausedEvent); i {
+			switch v := v.(*BurningAndMintingPausedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11936,7 +17994,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DepositForBurn); i {
+			switch v := v.(*BurningAndMintingUnpausedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11948,7 +18006,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MintAndWithdraw); i {
+			switch v := v.(*SendingAndReceivingPausedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11960,7 +18018,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenPairLinked); i {
+			switch v := v.(*SendingAndReceivingUnpausedEvent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11972,7 +18030,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TokenPairUnlinked); i {
+			switch v := v.(*DepositForBurn); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11984,7 +18042,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageSent); i {
+			switch v := v.(*MintAndWithdraw); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -11996,7 +18054,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageReceived); i {
+			switch v := v.(*TokenPairLinked); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12008,7 +18066,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MaxMessageBodySizeUpdated); i {
+			switch v := v.(*TokenPairUnlinked); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12020,7 +18078,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteTokenMessengerAdded); i {
+			switch v := v.(*MessageSent); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12032,7 +18090,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoteTokenMessengerRemoved); i {
+			switch v := v.(*MessageReceived); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -12044,6 +18102,42 @@ func file_circle_cctp_v1_events_proto_init() {
 			}
 		}
 		file_circle_cctp_v1_events_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MaxMessageBodySizeUpdated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteTokenMessengerAdded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoteTokenMessengerRemoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SetBurnLimitPerMessage); i {
 			case 0:
 				return &v.state
@@ -12055,6 +18149,90 @@ func file_circle_cctp_v1_events_proto_init() {
 				return nil
 			}
 		}
+		file_circle_cctp_v1_events_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardingSet); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ForwardingRemoved); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Account32Registered); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractCallbackEnabled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContractCallbackDisabled); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintForwarded); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_circle_cctp_v1_events_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MintForwardingFailed); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -12062,7 +18240,7 @@ func file_circle_cctp_v1_events_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_circle_cctp_v1_events_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   22,
+			NumMessages:   32,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -35,6 +35,8 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Query_Roles_FullMethodName                             = "/circle.cctp.v1.Query/Roles"
+	Query_PendingRoles_FullMethodName                      = "/circle.cctp.v1.Query/PendingRoles"
+	Query_RoleHistory_FullMethodName                       = "/circle.cctp.v1.Query/RoleHistory"
 	Query_Attester_FullMethodName                          = "/circle.cctp.v1.Query/Attester"
 	Query_Attesters_FullMethodName                         = "/circle.cctp.v1.Query/Attesters"
 	Query_PerMessageBurnLimit_FullMethodName               = "/circle.cctp.v1.Query/PerMessageBurnLimit"
@@ -53,6 +55,9 @@ const (
 	Query_BurnMessageVersion_FullMethodName                = "/circle.cctp.v1.Query/BurnMessageVersion"
 	Query_LocalMessageVersion_FullMethodName               = "/circle.cctp.v1.Query/LocalMessageVersion"
 	Query_LocalDomain_FullMethodName                       = "/circle.cctp.v1.Query/LocalDomain"
+	Query_AttesterHistory_FullMethodName                   = "/circle.cctp.v1.Query/AttesterHistory"
+	Query_DestinationCaller_FullMethodName                 = "/circle.cctp.v1.Query/DestinationCaller"
+	Query_Forwarding_FullMethodName                        = "/circle.cctp.v1.Query/Forwarding"
 )
 
 // QueryClient is the client API for Query service.
@@ -60,9 +65,13 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type QueryClient interface {
 	Roles(ctx context.Context, in *QueryRolesRequest, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+	// Queries the role transfers awaiting acceptance
+	PendingRoles(ctx context.Context, in *QueryPendingRolesRequest, opts ...grpc.CallOption) (*QueryPendingRolesResponse, error)
+	// Queries the history of role holders
+	RoleHistory(ctx context.Context, in *QueryRoleHistoryRequest, opts ...grpc.CallOption) (*QueryRoleHistoryResponse, error)
 	// Queries an Attester by index
 	Attester(ctx context.Context, in *QueryGetAttesterRequest, opts ...grpc.CallOption) (*QueryGetAttesterResponse, error)
-	// Queries a list of Attesters
+	// Queries a list of enabled Attesters
 	Attesters(ctx context.Context, in *QueryAllAttestersRequest, opts ...grpc.CallOption) (*QueryAllAttestersResponse, error)
 	// Queries a PerMessageBurnLimit by index
 	PerMessageBurnLimit(ctx context.Context, in *QueryGetPerMessageBurnLimitRequest, opts ...grpc.CallOption) (*QueryGetPerMessageBurnLimitResponse, error)
@@ -93,6 +102,12 @@ type QueryClient interface {
 	BurnMessageVersion(ctx context.Context, in *QueryBurnMessageVersionRequest, opts ...grpc.CallOption) (*QueryBurnMessageVersionResponse, error)
 	LocalMessageVersion(ctx context.Context, in *QueryLocalMessageVersionRequest, opts ...grpc.CallOption) (*QueryLocalMessageVersionResponse, error)
 	LocalDomain(ctx context.Context, in *QueryLocalDomainRequest, opts ...grpc.CallOption) (*QueryLocalDomainResponse, error)
+	// Queries the history of changes to the attester set
+	AttesterHistory(ctx context.Context, in *QueryAttesterHistoryRequest, opts ...grpc.CallOption) (*QueryAttesterHistoryResponse, error)
+	// Renders the destination caller of a message as a Noble address
+	DestinationCaller(ctx context.Context, in *QueryDestinationCallerRequest, opts ...grpc.CallOption) (*QueryDestinationCallerResponse, error)
+	// Queries the Forwarding registered by an address
+	Forwarding(ctx context.Context, in *QueryGetForwardingRequest, opts ...grpc.CallOption) (*QueryGetForwardingResponse, error)
 }
 
 type queryClient struct {
@@ -112,6 +127,24 @@ func (c *queryClient) Roles(ctx context.Context, in *QueryRolesRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) PendingRoles(ctx context.Context, in *QueryPendingRolesRequest, opts ...grpc.CallOption) (*QueryPendingRolesResponse, error) {
+	out := new(QueryPendingRolesResponse)
+	err := c.cc.Invoke(ctx, Query_PendingRoles_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoleHistory(ctx context.Context, in *QueryRoleHistoryRequest, opts ...grpc.CallOption) (*QueryRoleHistoryResponse, error) {
+	out := new(QueryRoleHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_RoleHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Attester(ctx context.Context, in *QueryGetAttesterRequest, opts ...grpc.CallOption) (*QueryGetAttesterResponse, error) {
 	out := new(QueryGetAttesterResponse)
 	err := c.cc.Invoke(ctx, Query_Attester_FullMethodName, in, out, opts...)
@@ -274,14 +307,45 @@ func (c *queryClient) LocalDomain(ctx context.Context, in *QueryLocalDomainReque
 	return out, nil
 }
 
+func (c *queryClient) AttesterHistory(ctx context.Context, in *QueryAttesterHistoryRequest, opts ...grpc.CallOption) (*QueryAttesterHistoryResponse, error) {
+	out := new(QueryAttesterHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_AttesterHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DestinationCaller(ctx context.Context, in *QueryDestinationCallerRequest, opts ...grpc.CallOption) (*QueryDestinationCallerResponse, error) {
+	out := new(QueryDestinationCallerResponse)
+	err := c.cc.Invoke(ctx, Query_DestinationCaller_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Forwarding(ctx context.Context, in *QueryGetForwardingRequest, opts ...grpc.CallOption) (*QueryGetForwardingResponse, error) {
+	out := new(QueryGetForwardingResponse)
+	err := c.cc.Invoke(ctx, Query_Forwarding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
 type QueryServer interface {
 	Roles(context.Context, *QueryRolesRequest) (*QueryRolesResponse, error)
+	// Queries the role transfers awaiting acceptance
+	PendingRoles(context.Context, *QueryPendingRolesRequest) (*QueryPendingRolesResponse, error)
+	// Queries the history of role holders
+	RoleHistory(context.Context, *QueryRoleHistoryRequest) (*QueryRoleHistoryResponse, error)
 	// Queries an Attester by index
 	Attester(context.Context, *QueryGetAttesterRequest) (*QueryGetAttesterResponse, error)
-	// Queries a list of Attesters
+	// Queries a list of enabled Attesters
 	Attesters(context.Context, *QueryAllAttestersRequest) (*QueryAllAttestersResponse, error)
 	// Queries a PerMessageBurnLimit by index
 	PerMessageBurnLimit(context.Context, *QueryGetPerMessageBurnLimitRequest) (*QueryGetPerMessageBurnLimitResponse, error)
@@ -312,6 +376,12 @@ type QueryServer interface {
 	BurnMessageVersion(context.Context, *QueryBurnMessageVersionRequest) (*QueryBurnMessageVersionResponse, error)
 	LocalMessageVersion(context.Context, *QueryLocalMessageVersionRequest) (*QueryLocalMessageVersionResponse, error)
 	LocalDomain(context.Context, *QueryLocalDomainRequest) (*QueryLocalDomainResponse, error)
+	// Queries the history of changes to the attester set
+	AttesterHistory(context.Context, *QueryAttesterHistoryRequest) (*QueryAttesterHistoryResponse, error)
+	// Renders the destination caller of a message as a Noble address
+	DestinationCaller(context.Context, *QueryDestinationCallerRequest) (*QueryDestinationCallerResponse, error)
+	// Queries the Forwarding registered by an address
+	Forwarding(context.Context, *QueryGetForwardingRequest) (*QueryGetForwardingResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -322,6 +392,12 @@ type UnimplementedQueryServer struct {
 func (UnimplementedQueryServer) Roles(context.Context, *QueryRolesRequest) (*QueryRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
 }
+func (UnimplementedQueryServer) PendingRoles(context.Context, *QueryPendingRolesRequest) (*QueryPendingRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRoles not implemented")
+}
+func (UnimplementedQueryServer) RoleHistory(context.Context, *QueryRoleHistoryRequest) (*QueryRoleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleHistory not implemented")
+}
 func (UnimplementedQueryServer) Attester(context.Context, *QueryGetAttesterRequest) (*QueryGetAttesterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Attester not implemented")
 }
@@ -376,6 +452,15 @@ func (UnimplementedQueryServer) LocalMessageVersion(context.Context, *QueryLocal
 func (UnimplementedQueryServer) LocalDomain(context.Context, *QueryLocalDomainRequest) (*QueryLocalDomainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LocalDomain not implemented")
 }
+func (UnimplementedQueryServer) AttesterHistory(context.Context, *QueryAttesterHistoryRequest) (*QueryAttesterHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttesterHistory not implemented")
+}
+func (UnimplementedQueryServer) DestinationCaller(context.Context, *QueryDestinationCallerRequest) (*QueryDestinationCallerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestinationCaller not implemented")
+}
+func (UnimplementedQueryServer) Forwarding(context.Context, *QueryGetForwardingRequest) (*QueryGetForwardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Forwarding not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -407,6 +492,42 @@ func _Query_Roles_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PendingRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingRoles(ctx, req.(*QueryPendingRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RoleHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleHistory(ctx, req.(*QueryRoleHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Attester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryGetAttesterRequest)
 	if err := dec(in); err != nil {
@@ -731,6 +852,60 @@ func _Query_LocalDomain_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttesterHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttesterHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttesterHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AttesterHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttesterHistory(ctx, req.(*QueryAttesterHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DestinationCaller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDestinationCallerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DestinationCaller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_DestinationCaller_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DestinationCaller(ctx, req.(*QueryDestinationCallerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Forwarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetForwardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Forwarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Forwarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Forwarding(ctx, req.(*QueryGetForwardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -742,6 +917,14 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Roles",
 			Handler:    _Query_Roles_Handler,
 		},
+		{
+			MethodName: "PendingRoles",
+			Handler:    _Query_PendingRoles_Handler,
+		},
+		{
+			MethodName: "RoleHistory",
+			Handler:    _Query_RoleHistory_Handler,
+		},
 		{
 			MethodName: "Attester",
 			Handler:    _Query_Attester_Handler,
@@ -814,6 +997,18 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LocalDomain",
 			Handler:    _Query_LocalDomain_Handler,
 		},
+		{
+			MethodName: "AttesterHistory",
+			Handler:    _Query_AttesterHistory_Handler,
+		},
+		{
+			MethodName: "DestinationCaller",
+			Handler:    _Query_DestinationCaller_Handler,
+		},
+		{
+			MethodName: "Forwarding",
+			Handler:    _Query_Forwarding_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "circle/cctp/v1/query.proto",
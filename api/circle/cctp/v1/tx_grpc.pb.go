@@ -35,6 +35,9 @@ const _ = grpc.SupportPackageIsVersion7
 
 const (
 	Msg_AcceptOwner_FullMethodName                        = "/circle.cctp.v1.Msg/AcceptOwner"
+	Msg_AcceptAttesterManager_FullMethodName              = "/circle.cctp.v1.Msg/AcceptAttesterManager"
+	Msg_AcceptPauser_FullMethodName                       = "/circle.cctp.v1.Msg/AcceptPauser"
+	Msg_AcceptTokenController_FullMethodName              = "/circle.cctp.v1.Msg/AcceptTokenController"
 	Msg_AddRemoteTokenMessenger_FullMethodName            = "/circle.cctp.v1.Msg/AddRemoteTokenMessenger"
 	Msg_DepositForBurn_FullMethodName                     = "/circle.cctp.v1.Msg/DepositForBurn"
 	Msg_DepositForBurnWithCaller_FullMethodName           = "/circle.cctp.v1.Msg/DepositForBurnWithCaller"
@@ -59,6 +62,11 @@ const (
 	Msg_UpdateMaxMessageBodySize_FullMethodName           = "/circle.cctp.v1.Msg/UpdateMaxMessageBodySize"
 	Msg_SetMaxBurnAmountPerMessage_FullMethodName         = "/circle.cctp.v1.Msg/SetMaxBurnAmountPerMessage"
 	Msg_UpdateSignatureThreshold_FullMethodName           = "/circle.cctp.v1.Msg/UpdateSignatureThreshold"
+	Msg_SetForwarding_FullMethodName                      = "/circle.cctp.v1.Msg/SetForwarding"
+	Msg_RemoveForwarding_FullMethodName                   = "/circle.cctp.v1.Msg/RemoveForwarding"
+	Msg_RegisterAccount32_FullMethodName                  = "/circle.cctp.v1.Msg/RegisterAccount32"
+	Msg_EnableContractCallback_FullMethodName             = "/circle.cctp.v1.Msg/EnableContractCallback"
+	Msg_DisableContractCallback_FullMethodName            = "/circle.cctp.v1.Msg/DisableContractCallback"
 )
 
 // MsgClient is the client API for Msg service.
@@ -66,6 +74,9 @@ const (
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type MsgClient interface {
 	AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ...grpc.CallOption) (*MsgAcceptOwnerResponse, error)
+	AcceptAttesterManager(ctx context.Context, in *MsgAcceptAttesterManager, opts ...grpc.CallOption) (*MsgAcceptAttesterManagerResponse, error)
+	AcceptPauser(ctx context.Context, in *MsgAcceptPauser, opts ...grpc.CallOption) (*MsgAcceptPauserResponse, error)
+	AcceptTokenController(ctx context.Context, in *MsgAcceptTokenController, opts ...grpc.CallOption) (*MsgAcceptTokenControllerResponse, error)
 	AddRemoteTokenMessenger(ctx context.Context, in *MsgAddRemoteTokenMessenger, opts ...grpc.CallOption) (*MsgAddRemoteTokenMessengerResponse, error)
 	DepositForBurn(ctx context.Context, in *MsgDepositForBurn, opts ...grpc.CallOption) (*MsgDepositForBurnResponse, error)
 	DepositForBurnWithCaller(ctx context.Context, in *MsgDepositForBurnWithCaller, opts ...grpc.CallOption) (*MsgDepositForBurnWithCallerResponse, error)
@@ -90,6 +101,11 @@ type MsgClient interface {
 	UpdateMaxMessageBodySize(ctx context.Context, in *MsgUpdateMaxMessageBodySize, opts ...grpc.CallOption) (*MsgUpdateMaxMessageBodySizeResponse, error)
 	SetMaxBurnAmountPerMessage(ctx context.Context, in *MsgSetMaxBurnAmountPerMessage, opts ...grpc.CallOption) (*MsgSetMaxBurnAmountPerMessageResponse, error)
 	UpdateSignatureThreshold(ctx context.Context, in *MsgUpdateSignatureThreshold, opts ...grpc.CallOption) (*MsgUpdateSignatureThresholdResponse, error)
+	SetForwarding(ctx context.Context, in *MsgSetForwarding, opts ...grpc.CallOption) (*MsgSetForwardingResponse, error)
+	RemoveForwarding(ctx context.Context, in *MsgRemoveForwarding, opts ...grpc.CallOption) (*MsgRemoveForwardingResponse, error)
+	RegisterAccount32(ctx context.Context, in *MsgRegisterAccount32, opts ...grpc.CallOption) (*MsgRegisterAccount32Response, error)
+	EnableContractCallback(ctx context.Context, in *MsgEnableContractCallback, opts ...grpc.CallOption) (*MsgEnableContractCallbackResponse, error)
+	DisableContractCallback(ctx context.Context, in *MsgDisableContractCallback, opts ...grpc.CallOption) (*MsgDisableContractCallbackResponse, error)
 }
 
 type msgClient struct {
@@ -109,6 +125,33 @@ func (c *msgClient) AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ..
 	return out, nil
 }
 
+func (c *msgClient) AcceptAttesterManager(ctx context.Context, in *MsgAcceptAttesterManager, opts ...grpc.CallOption) (*MsgAcceptAttesterManagerResponse, error) {
+	out := new(MsgAcceptAttesterManagerResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptAttesterManager_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPauser(ctx context.Context, in *MsgAcceptPauser, opts ...grpc.CallOption) (*MsgAcceptPauserResponse, error) {
+	out := new(MsgAcceptPauserResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptPauser_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptTokenController(ctx context.Context, in *MsgAcceptTokenController, opts ...grpc.CallOption) (*MsgAcceptTokenControllerResponse, error) {
+	out := new(MsgAcceptTokenControllerResponse)
+	err := c.cc.Invoke(ctx, Msg_AcceptTokenController_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) AddRemoteTokenMessenger(ctx context.Context, in *MsgAddRemoteTokenMessenger, opts ...grpc.CallOption) (*MsgAddRemoteTokenMessengerResponse, error) {
 	out := new(MsgAddRemoteTokenMessengerResponse)
 	err := c.cc.Invoke(ctx, Msg_AddRemoteTokenMessenger_FullMethodName, in, out, opts...)
@@ -325,11 +368,59 @@ func (c *msgClient) UpdateSignatureThreshold(ctx context.Context, in *MsgUpdateS
 	return out, nil
 }
 
+func (c *msgClient) SetForwarding(ctx context.Context, in *MsgSetForwarding, opts ...grpc.CallOption) (*MsgSetForwardingResponse, error) {
+	out := new(MsgSetForwardingResponse)
+	err := c.cc.Invoke(ctx, Msg_SetForwarding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveForwarding(ctx context.Context, in *MsgRemoveForwarding, opts ...grpc.CallOption) (*MsgRemoveForwardingResponse, error) {
+	out := new(MsgRemoveForwardingResponse)
+	err := c.cc.Invoke(ctx, Msg_RemoveForwarding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterAccount32(ctx context.Context, in *MsgRegisterAccount32, opts ...grpc.CallOption) (*MsgRegisterAccount32Response, error) {
+	out := new(MsgRegisterAccount32Response)
+	err := c.cc.Invoke(ctx, Msg_RegisterAccount32_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableContractCallback(ctx context.Context, in *MsgEnableContractCallback, opts ...grpc.CallOption) (*MsgEnableContractCallbackResponse, error) {
+	out := new(MsgEnableContractCallbackResponse)
+	err := c.cc.Invoke(ctx, Msg_EnableContractCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableContractCallback(ctx context.Context, in *MsgDisableContractCallback, opts ...grpc.CallOption) (*MsgDisableContractCallbackResponse, error) {
+	out := new(MsgDisableContractCallbackResponse)
+	err := c.cc.Invoke(ctx, Msg_DisableContractCallback_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
 type MsgServer interface {
 	AcceptOwner(context.Context, *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error)
+	AcceptAttesterManager(context.Context, *MsgAcceptAttesterManager) (*MsgAcceptAttesterManagerResponse, error)
+	AcceptPauser(context.Context, *MsgAcceptPauser) (*MsgAcceptPauserResponse, error)
+	AcceptTokenController(context.Context, *MsgAcceptTokenController) (*MsgAcceptTokenControllerResponse, error)
 	AddRemoteTokenMessenger(context.Context, *MsgAddRemoteTokenMessenger) (*MsgAddRemoteTokenMessengerResponse, error)
 	DepositForBurn(context.Context, *MsgDepositForBurn) (*MsgDepositForBurnResponse, error)
 	DepositForBurnWithCaller(context.Context, *MsgDepositForBurnWithCaller) (*MsgDepositForBurnWithCallerResponse, error)
@@ -354,6 +445,11 @@ type MsgServer interface {
 	UpdateMaxMessageBodySize(context.Context, *MsgUpdateMaxMessageBodySize) (*MsgUpdateMaxMessageBodySizeResponse, error)
 	SetMaxBurnAmountPerMessage(context.Context, *MsgSetMaxBurnAmountPerMessage) (*MsgSetMaxBurnAmountPerMessageResponse, error)
 	UpdateSignatureThreshold(context.Context, *MsgUpdateSignatureThreshold) (*MsgUpdateSignatureThresholdResponse, error)
+	SetForwarding(context.Context, *MsgSetForwarding) (*MsgSetForwardingResponse, error)
+	RemoveForwarding(context.Context, *MsgRemoveForwarding) (*MsgRemoveForwardingResponse, error)
+	RegisterAccount32(context.Context, *MsgRegisterAccount32) (*MsgRegisterAccount32Response, error)
+	EnableContractCallback(context.Context, *MsgEnableContractCallback) (*MsgEnableContractCallbackResponse, error)
+	DisableContractCallback(context.Context, *MsgDisableContractCallback) (*MsgDisableContractCallbackResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -364,6 +460,15 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) AcceptOwner(context.Context, *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwner not implemented")
 }
+func (UnimplementedMsgServer) AcceptAttesterManager(context.Context, *MsgAcceptAttesterManager) (*MsgAcceptAttesterManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAttesterManager not implemented")
+}
+func (UnimplementedMsgServer) AcceptPauser(context.Context, *MsgAcceptPauser) (*MsgAcceptPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPauser not implemented")
+}
+func (UnimplementedMsgServer) AcceptTokenController(context.Context, *MsgAcceptTokenController) (*MsgAcceptTokenControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTokenController not implemented")
+}
 func (UnimplementedMsgServer) AddRemoteTokenMessenger(context.Context, *MsgAddRemoteTokenMessenger) (*MsgAddRemoteTokenMessengerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddRemoteTokenMessenger not implemented")
 }
@@ -436,6 +541,21 @@ func (UnimplementedMsgServer) SetMaxBurnAmountPerMessage(context.Context, *MsgSe
 func (UnimplementedMsgServer) UpdateSignatureThreshold(context.Context, *MsgUpdateSignatureThreshold) (*MsgUpdateSignatureThresholdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignatureThreshold not implemented")
 }
+func (UnimplementedMsgServer) SetForwarding(context.Context, *MsgSetForwarding) (*MsgSetForwardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForwarding not implemented")
+}
+func (UnimplementedMsgServer) RemoveForwarding(context.Context, *MsgRemoveForwarding) (*MsgRemoveForwardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveForwarding not implemented")
+}
+func (UnimplementedMsgServer) RegisterAccount32(context.Context, *MsgRegisterAccount32) (*MsgRegisterAccount32Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount32 not implemented")
+}
+func (UnimplementedMsgServer) EnableContractCallback(context.Context, *MsgEnableContractCallback) (*MsgEnableContractCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableContractCallback not implemented")
+}
+func (UnimplementedMsgServer) DisableContractCallback(context.Context, *MsgDisableContractCallback) (*MsgDisableContractCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableContractCallback not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -467,6 +587,60 @@ func _Msg_AcceptOwner_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcceptAttesterManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAttesterManager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAttesterManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptAttesterManager_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAttesterManager(ctx, req.(*MsgAcceptAttesterManager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptPauser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPauser(ctx, req.(*MsgAcceptPauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptTokenController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptTokenController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptTokenController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_AcceptTokenController_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptTokenController(ctx, req.(*MsgAcceptTokenController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddRemoteTokenMessenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddRemoteTokenMessenger)
 	if err := dec(in); err != nil {
@@ -899,6 +1073,96 @@ func _Msg_UpdateSignatureThreshold_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetForwarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetForwarding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetForwarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetForwarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetForwarding(ctx, req.(*MsgSetForwarding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveForwarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveForwarding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveForwarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RemoveForwarding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveForwarding(ctx, req.(*MsgRemoveForwarding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAccount32_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccount32)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccount32(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterAccount32_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccount32(ctx, req.(*MsgRegisterAccount32))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableContractCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableContractCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableContractCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_EnableContractCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableContractCallback(ctx, req.(*MsgEnableContractCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableContractCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableContractCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableContractCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DisableContractCallback_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableContractCallback(ctx, req.(*MsgDisableContractCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -910,6 +1174,18 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptOwner",
 			Handler:    _Msg_AcceptOwner_Handler,
 		},
+		{
+			MethodName: "AcceptAttesterManager",
+			Handler:    _Msg_AcceptAttesterManager_Handler,
+		},
+		{
+			MethodName: "AcceptPauser",
+			Handler:    _Msg_AcceptPauser_Handler,
+		},
+		{
+			MethodName: "AcceptTokenController",
+			Handler:    _Msg_AcceptTokenController_Handler,
+		},
 		{
 			MethodName: "AddRemoteTokenMessenger",
 			Handler:    _Msg_AddRemoteTokenMessenger_Handler,
@@ -1006,6 +1282,26 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSignatureThreshold",
 			Handler:    _Msg_UpdateSignatureThreshold_Handler,
 		},
+		{
+			MethodName: "SetForwarding",
+			Handler:    _Msg_SetForwarding_Handler,
+		},
+		{
+			MethodName: "RemoveForwarding",
+			Handler:    _Msg_RemoveForwarding_Handler,
+		},
+		{
+			MethodName: "RegisterAccount32",
+			Handler:    _Msg_RegisterAccount32_Handler,
+		},
+		{
+			MethodName: "EnableContractCallback",
+			Handler:    _Msg_EnableContractCallback_Handler,
+		},
+		{
+			MethodName: "DisableContractCallback",
+			Handler:    _Msg_DisableContractCallback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "circle/cctp/v1/tx.proto",
@@ -0,0 +1,197 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// cctp-relayer relays CCTP messages sent on a CometBFT chain running the cctp
+// module to Noble, using the reference implementation in the relayer package.
+package main
+
+import (
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/x/tx/signing"
+	"github.com/circlefin/noble-cctp/relayer"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/std"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagSourceNode         = "source-node"
+	flagAttestationAPI     = "attestation-api"
+	flagProgressFile       = "progress-file"
+	flagStartHeight        = "start-height"
+	flagBatchSize          = "batch-size"
+	flagDestinationDomain  = "destination-domain"
+	flagAttestationTimeout = "attestation-timeout"
+	flagBech32Prefix       = "bech32-prefix"
+)
+
+func main() {
+	if err := rootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func rootCmd() *cobra.Command {
+	home, _ := os.UserHomeDir()
+	defaultHome := filepath.Join(home, ".cctp-relayer")
+
+	cmd := &cobra.Command{
+		Use:   "cctp-relayer",
+		Short: "Relay CCTP messages to Noble",
+		Long: `Relay CCTP messages to Noble.
+
+Messages are read from the MessageSent events of the source chain, attested by
+the attestation API, and received on the chain reached through --node, signed
+by the key given with --from. Progress is saved after every block.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		PersistentPreRunE: func(cmd *cobra.Command, _ []string) error {
+			prefix, _ := cmd.Flags().GetString(flagBech32Prefix)
+			config := sdk.GetConfig()
+			config.SetBech32PrefixForAccount(prefix, prefix+sdk.PrefixPublic)
+			config.SetBech32PrefixForValidator(prefix+sdk.PrefixValidator+sdk.PrefixOperator, prefix+sdk.PrefixValidator+sdk.PrefixOperator+sdk.PrefixPublic)
+
+			clientCtx, err := newClientContext(prefix, defaultHome)
+			if err != nil {
+				return err
+			}
+
+			return client.SetCmdClientContextHandler(clientCtx, cmd)
+		},
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			factory, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			sourceNode, _ := cmd.Flags().GetString(flagSourceNode)
+			if sourceNode == "" {
+				sourceNode = clientCtx.NodeURI
+			}
+			sourceClient, err := rpchttp.New(sourceNode, "/websocket")
+			if err != nil {
+				return err
+			}
+			if err := sourceClient.Start(); err != nil {
+				return err
+			}
+			defer func() { _ = sourceClient.Stop() }()
+
+			config := relayer.DefaultConfig(clientCtx.GetFromAddress().String())
+			config.StartHeight, _ = cmd.Flags().GetInt64(flagStartHeight)
+			config.BatchSize, _ = cmd.Flags().GetInt(flagBatchSize)
+			config.DestinationDomain, _ = cmd.Flags().GetUint32(flagDestinationDomain)
+			config.AttestationTimeout, _ = cmd.Flags().GetDuration(flagAttestationTimeout)
+
+			attestationAPI, _ := cmd.Flags().GetString(flagAttestationAPI)
+			progressFile, _ := cmd.Flags().GetString(flagProgressFile)
+			if progressFile == "" {
+				progressFile = filepath.Join(clientCtx.HomeDir, "progress.json")
+			}
+			if err := os.MkdirAll(filepath.Dir(progressFile), 0o755); err != nil {
+				return err
+			}
+
+			r, err := relayer.NewRelayer(
+				config,
+				log.NewLogger(cmd.ErrOrStderr()),
+				relayer.NewCometSource(sourceClient),
+				relayer.NewIrisClient(attestationAPI, nil),
+				relayer.NewTxSubmitter(clientCtx, factory),
+				relayer.NewFileProgressStore(progressFile),
+			)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			if err := r.Run(ctx); err != nil && ctx.Err() == nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flags.FlagHome, defaultHome, "Directory for the keyring and progress file")
+	cmd.Flags().String(flagSourceNode, "", "CometBFT RPC address of the source chain (defaults to --node)")
+	cmd.Flags().String(flagAttestationAPI, "https://iris-api.circle.com", "Base URL of the attestation API")
+	cmd.Flags().String(flagProgressFile, "", "File storing the last relayed height (defaults to progress.json in --home)")
+	cmd.Flags().Int64(flagStartHeight, 0, "Height to start from when no progress is saved (defaults to the latest height)")
+	cmd.Flags().Int(flagBatchSize, 10, "Maximum number of messages received in one transaction")
+	cmd.Flags().Uint32(flagDestinationDomain, types.NobleDomainId, "Domain messages are relayed to")
+	cmd.Flags().Duration(flagAttestationTimeout, time.Hour, "How long a message is waited on to be attested before stopping")
+	cmd.Flags().String(flagBech32Prefix, "noble", "Bech32 account prefix of the destination chain")
+
+	return cmd
+}
+
+// newClientContext returns a client.Context able to sign and broadcast
+// MsgReceiveMessage transactions.
+func newClientContext(prefix string, home string) (client.Context, error) {
+	registry, err := codectypes.NewInterfaceRegistryWithOptions(codectypes.InterfaceRegistryOptions{
+		ProtoFiles: proto.HybridResolver,
+		SigningOptions: signing.Options{
+			AddressCodec:          addresscodec.NewBech32Codec(prefix),
+			ValidatorAddressCodec: addresscodec.NewBech32Codec(prefix + sdk.PrefixValidator + sdk.PrefixOperator),
+		},
+	})
+	if err != nil {
+		return client.Context{}, err
+	}
+
+	std.RegisterInterfaces(registry)
+	authtypes.RegisterInterfaces(registry)
+	types.RegisterInterfaces(registry)
+
+	cdc := codec.NewProtoCodec(registry)
+	amino := codec.NewLegacyAmino()
+	std.RegisterLegacyAminoCodec(amino)
+	types.RegisterLegacyAminoCodec(amino)
+
+	return client.Context{}.
+		WithCodec(cdc).
+		WithInterfaceRegistry(registry).
+		WithLegacyAmino(amino).
+		WithTxConfig(authtx.NewTxConfig(cdc, authtx.DefaultSignModes)).
+		WithAccountRetriever(authtypes.AccountRetriever{}).
+		WithBroadcastMode(flags.BroadcastSync).
+		WithHomeDir(home).
+		WithInput(os.Stdin), nil
+}
@@ -0,0 +1,33 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// cctp encodes, decodes and hashes CCTP messages and verifies attestations
+// without contacting a node.
+package main
+
+import (
+	"os"
+
+	"github.com/circlefin/noble-cctp/x/cctp/client/cli"
+)
+
+func main() {
+	cmd := cli.GetOfflineCmd()
+	cmd.SilenceUsage = true
+	if err := cmd.Execute(); err != nil {
+		os.Exit(1)
+	}
+}
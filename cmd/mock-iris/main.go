@@ -0,0 +1,137 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// mock-iris is a local stand-in for Circle's Iris attestation API. It watches
+// a CometBFT node for MessageSent events, signs each message with test
+// attester keys, and serves attestations at GET /attestations/{hash}.
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/testutil/iris"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagListen        = "listen"
+	flagNode          = "node"
+	flagAttesterKeys  = "attester-keys"
+	flagAttesterSeed  = "attester-seed"
+	flagAttesterCount = "attester-count"
+)
+
+func main() {
+	if err := rootCmd().Execute(); err != nil {
+		os.Exit(1)
+	}
+}
+
+func rootCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mock-iris",
+		Short: "Serve attestations for CCTP messages sent from a local node",
+		Long: `Serve attestations for CCTP messages sent from a local node.
+
+Attester keys are either provided as a comma separated list of hex encoded
+private keys, or derived from a seed. The public keys of the signers are printed
+on startup so they can be enabled on the destination chain. The number of
+signers must equal the destination chain's signature threshold.`,
+		Args:         cobra.NoArgs,
+		SilenceUsage: true,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			listen, _ := cmd.Flags().GetString(flagListen)
+			node, _ := cmd.Flags().GetString(flagNode)
+
+			signers, err := readSigners(cmd)
+			if err != nil {
+				return err
+			}
+			for _, key := range signers.Sorted() {
+				cmd.Printf("attester %s (address %s)\n", key.PublicKey(), key.Address())
+			}
+
+			feed, err := iris.NewCometFeed(node)
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			server := iris.NewServer(signers)
+			httpServer := &http.Server{Addr: listen, Handler: server.Handler()}
+
+			errs := make(chan error, 2)
+			go func() { errs <- server.Run(ctx, feed) }()
+			go func() { errs <- httpServer.ListenAndServe() }()
+
+			cmd.Printf("serving attestations on %s, watching %s\n", listen, node)
+
+			select {
+			case <-ctx.Done():
+			case err = <-errs:
+			}
+			_ = httpServer.Shutdown(context.Background())
+
+			if err != nil && !errors.Is(err, context.Canceled) && !errors.Is(err, http.ErrServerClosed) {
+				return err
+			}
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flagListen, "localhost:8080", "Address to serve the attestation API on")
+	cmd.Flags().String(flagNode, "tcp://localhost:26657", "CometBFT RPC address of the source chain")
+	cmd.Flags().String(flagAttesterKeys, "", "Comma separated list of hex encoded attester private keys")
+	cmd.Flags().String(flagAttesterSeed, "mock-iris", "Seed used to derive attester keys when no keys are provided")
+	cmd.Flags().Int(flagAttesterCount, 1, "Number of attester keys to derive from the seed")
+
+	return cmd
+}
+
+func readSigners(cmd *cobra.Command) (attester.Set, error) {
+	keys, _ := cmd.Flags().GetString(flagAttesterKeys)
+	if keys == "" {
+		seed, _ := cmd.Flags().GetString(flagAttesterSeed)
+		count, _ := cmd.Flags().GetInt(flagAttesterCount)
+		if count <= 0 {
+			return nil, fmt.Errorf("attester count must be positive")
+		}
+
+		return attester.DeterministicSet([]byte(seed), count), nil
+	}
+
+	var signers attester.Set
+	for _, key := range strings.Split(keys, ",") {
+		privateKey, err := crypto.HexToECDSA(strings.TrimPrefix(strings.TrimSpace(key), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("invalid attester key: %w", err)
+		}
+		signers = append(signers, attester.Key{PrivateKey: privateKey})
+	}
+
+	return signers, nil
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrAttestationPending is returned by an AttestationSource when a message
+// is known but not yet attested, or not yet observed at all.
+var ErrAttestationPending = errors.New("attestation pending")
+
+// AttestationSource fetches the attestation for a sent message.
+type AttestationSource interface {
+	Attestation(ctx context.Context, message []byte) ([]byte, error)
+}
+
+// AttestationSourceFunc adapts a function to an AttestationSource.
+type AttestationSourceFunc func(ctx context.Context, message []byte) ([]byte, error)
+
+func (f AttestationSourceFunc) Attestation(ctx context.Context, message []byte) ([]byte, error) {
+	return f(ctx, message)
+}
+
+var _ AttestationSource = &IrisClient{}
+
+// IrisClient fetches attestations from Circle's Iris API, or any service
+// exposing the same GET /attestations/{hash} endpoint such as mock-iris.
+type IrisClient struct {
+	baseURL string
+	client  *http.Client
+}
+
+// NewIrisClient returns an IrisClient for the API at baseURL, for example
+// https://iris-api.circle.com.
+func NewIrisClient(baseURL string, client *http.Client) *IrisClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	return &IrisClient{baseURL: strings.TrimSuffix(baseURL, "/"), client: client}
+}
+
+type irisResponse struct {
+	Attestation string `json:"attestation"`
+	Status      string `json:"status"`
+}
+
+func (c *IrisClient) Attestation(ctx context.Context, message []byte) ([]byte, error) {
+	url := fmt.Sprintf("%s/attestations/%s", c.baseURL, crypto.Keccak256Hash(message).Hex())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	switch res.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		// the attestation service has not observed the message yet
+		return nil, ErrAttestationPending
+	default:
+		return nil, fmt.Errorf("unexpected attestation service response: %s", res.Status)
+	}
+
+	var body irisResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, fmt.Errorf("unable to decode attestation response: %w", err)
+	}
+	if body.Status != "complete" {
+		return nil, ErrAttestationPending
+	}
+
+	attestation, err := hexutil.Decode(body.Attestation)
+	if err != nil {
+		return nil, fmt.Errorf("invalid attestation %s: %w", body.Attestation, err)
+	}
+
+	return attestation, nil
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package relayer
+
+import (
+	"context"
+	"sync"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	cmttypes "github.com/cometbft/cometbft/types"
+)
+
+// Source provides the CCTP messages sent in each block of a chain.
+type Source interface {
+	// LatestHeight returns the height of the latest committed block.
+	LatestHeight(ctx context.Context) (int64, error)
+	// Messages returns the bytes of every message sent in the block at height.
+	Messages(ctx context.Context, height int64) ([][]byte, error)
+}
+
+// BlockNotifier is optionally implemented by a Source that can push new block
+// heights, letting the relayer react without waiting for its poll interval.
+type BlockNotifier interface {
+	NewBlocks(ctx context.Context) (<-chan int64, error)
+}
+
+var (
+	_ Source        = &CometSource{}
+	_ BlockNotifier = &CometSource{}
+)
+
+// CometSource reads MessageSent events from a CometBFT node.
+type CometSource struct {
+	client rpcclient.Client
+}
+
+// NewCometSource returns a CometSource using client. The client must be
+// started before NewBlocks is used.
+func NewCometSource(client rpcclient.Client) *CometSource {
+	return &CometSource{client: client}
+}
+
+func (s *CometSource) LatestHeight(ctx context.Context) (int64, error) {
+	status, err := s.client.Status(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return status.SyncInfo.LatestBlockHeight, nil
+}
+
+func (s *CometSource) Messages(ctx context.Context, height int64) ([][]byte, error) {
+	results, err := s.client.BlockResults(ctx, &height)
+	if err != nil {
+		return nil, err
+	}
+
+	var messages [][]byte
+	for _, result := range results.TxsResults {
+		// events of failed transactions are not committed to state
+		if result.Code != abci.CodeTypeOK {
+			continue
+		}
+		messages = append(messages, types.MessageSentEvents(result.Events)...)
+	}
+
+	return messages, nil
+}
+
+func (s *CometSource) NewBlocks(ctx context.Context) (<-chan int64, error) {
+	events, err := s.client.Subscribe(ctx, "cctp-relayer", cmttypes.QueryForEvent(cmttypes.EventNewBlockHeader).String())
+	if err != nil {
+		return nil, err
+	}
+
+	heights := make(chan int64)
+	go func() {
+		defer close(heights)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				data, ok := event.Data.(cmttypes.EventDataNewBlockHeader)
+				if !ok {
+					continue
+				}
+
+				select {
+				case heights <- data.Header.Height:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return heights, nil
+}
+
+var _ Source = &MemorySource{}
+
+// MemorySource is an in-memory Source, useful for tests and in-process chains.
+type MemorySource struct {
+	mu     sync.Mutex
+	blocks [][][]byte
+}
+
+// NewMemorySource returns an empty MemorySource. Heights start at 1.
+func NewMemorySource() *MemorySource {
+	return &MemorySource{}
+}
+
+// AddBlock appends a block containing messages and returns its height.
+func (s *MemorySource) AddBlock(messages ...[]byte) int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.blocks = append(s.blocks, messages)
+	return int64(len(s.blocks))
+}
+
+func (s *MemorySource) LatestHeight(_ context.Context) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return int64(len(s.blocks)), nil
+}
+
+func (s *MemorySource) Messages(_ context.Context, height int64) ([][]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if height < 1 || height > int64(len(s.blocks)) {
+		return nil, nil
+	}
+
+	return s.blocks[height-1], nil
+}
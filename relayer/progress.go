@@ -0,0 +1,115 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package relayer
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// ProgressStore persists the height of the last fully relayed block so a
+// restarted relayer resumes where it stopped.
+type ProgressStore interface {
+	// Load returns the last relayed height, or false if none was saved.
+	Load() (int64, bool, error)
+	Save(height int64) error
+}
+
+var _ ProgressStore = &FileProgressStore{}
+
+// FileProgressStore stores progress as JSON in a single file.
+type FileProgressStore struct {
+	path string
+}
+
+// NewFileProgressStore returns a FileProgressStore backed by path. The file
+// is created on the first Save.
+func NewFileProgressStore(path string) *FileProgressStore {
+	return &FileProgressStore{path: path}
+}
+
+type progress struct {
+	Height int64 `json:"height"`
+}
+
+func (s *FileProgressStore) Load() (int64, bool, error) {
+	bz, err := os.ReadFile(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+
+	var p progress
+	if err := json.Unmarshal(bz, &p); err != nil {
+		return 0, false, err
+	}
+
+	return p.Height, true, nil
+}
+
+func (s *FileProgressStore) Save(height int64) error {
+	bz, err := json.Marshal(progress{Height: height})
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so a crash never leaves a partial file
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(bz); err != nil {
+		_ = tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), s.path)
+}
+
+var _ ProgressStore = &MemoryProgressStore{}
+
+// MemoryProgressStore keeps progress in memory only.
+type MemoryProgressStore struct {
+	mu     sync.Mutex
+	height int64
+	saved  bool
+}
+
+func (s *MemoryProgressStore) Load() (int64, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.height, s.saved, nil
+}
+
+func (s *MemoryProgressStore) Save(height int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.height, s.saved = height, true
+	return nil
+}
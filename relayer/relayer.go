@@ -0,0 +1,315 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package relayer is a reference implementation of a relayer delivering CCTP
+// messages to Noble.
+//
+// The relayer walks the blocks of a source chain, decodes MessageSent events,
+// fetches the attestation for each message bound for the destination domain,
+// and submits them in batches of MsgReceiveMessage. Progress is persisted
+// after every block so a restarted relayer resumes where it stopped, and
+// messages that were already received are skipped, so relaying the same
+// block twice is harmless.
+package relayer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/log"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Config holds the tunable parameters of a Relayer.
+type Config struct {
+	// Caller is the bech32 address submitting MsgReceiveMessage. Messages
+	// restricted to a different destination caller are skipped.
+	Caller string
+	// DestinationDomain is the domain messages are relayed to.
+	DestinationDomain uint32
+	// StartHeight is the first height relayed when no progress is saved.
+	// Zero starts at the latest height of the source chain.
+	StartHeight int64
+	// BatchSize is the maximum number of messages submitted together.
+	BatchSize int
+	// PollInterval is how often the source chain is checked for new blocks.
+	PollInterval time.Duration
+	// AttestationInterval is how often a pending attestation is requested.
+	AttestationInterval time.Duration
+	// AttestationTimeout is how long a message is waited on to be attested
+	// before the relayer stops.
+	AttestationTimeout time.Duration
+	// MaxRetries is the number of times a failed submission is retried
+	// before the relayer stops.
+	MaxRetries int
+	// RetryInterval is the delay between submission retries.
+	RetryInterval time.Duration
+}
+
+// DefaultConfig returns a Config relaying to Noble as caller.
+func DefaultConfig(caller string) Config {
+	return Config{
+		Caller:              caller,
+		DestinationDomain:   types.NobleDomainId,
+		BatchSize:           10,
+		PollInterval:        time.Second,
+		AttestationInterval: 2 * time.Second,
+		AttestationTimeout:  time.Hour,
+		MaxRetries:          3,
+		RetryInterval:       2 * time.Second,
+	}
+}
+
+// Relayer relays messages from a Source to a Submitter.
+type Relayer struct {
+	config       Config
+	caller       []byte
+	logger       log.Logger
+	source       Source
+	attestations AttestationSource
+	submitter    Submitter
+	progress     ProgressStore
+}
+
+// NewRelayer returns a Relayer, validating config.
+func NewRelayer(
+	config Config,
+	logger log.Logger,
+	source Source,
+	attestations AttestationSource,
+	submitter Submitter,
+	progress ProgressStore,
+) (*Relayer, error) {
+	_, callerBz, err := bech32.DecodeAndConvert(config.Caller)
+	if err != nil {
+		return nil, fmt.Errorf("invalid caller %s: %w", config.Caller, err)
+	}
+	caller, err := types.Bytes32FromAccAddress(callerBz)
+	if err != nil {
+		return nil, fmt.Errorf("invalid caller %s: %w", config.Caller, err)
+	}
+	if config.BatchSize <= 0 {
+		return nil, errors.New("batch size must be positive")
+	}
+	if config.AttestationTimeout <= 0 {
+		return nil, errors.New("attestation timeout must be positive")
+	}
+
+	return &Relayer{
+		config:       config,
+		caller:       caller,
+		logger:       logger.With("module", "cctp-relayer"),
+		source:       source,
+		attestations: attestations,
+		submitter:    submitter,
+		progress:     progress,
+	}, nil
+}
+
+// Run relays blocks as they are produced until ctx is cancelled or a
+// submission keeps failing.
+func (r *Relayer) Run(ctx context.Context) error {
+	var newBlocks <-chan int64
+	if notifier, ok := r.source.(BlockNotifier); ok {
+		heights, err := notifier.NewBlocks(ctx)
+		if err != nil {
+			r.logger.Error("unable to subscribe to new blocks, polling instead", "err", err)
+		} else {
+			newBlocks = heights
+		}
+	}
+
+	ticker := time.NewTicker(r.config.PollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := r.Sync(ctx); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		case _, ok := <-newBlocks:
+			if !ok {
+				newBlocks = nil
+			}
+		}
+	}
+}
+
+// Sync relays every block from the saved progress up to the latest height of
+// the source chain.
+func (r *Relayer) Sync(ctx context.Context) error {
+	latest, err := r.source.LatestHeight(ctx)
+	if err != nil {
+		return fmt.Errorf("unable to get latest height: %w", err)
+	}
+
+	height, found, err := r.progress.Load()
+	if err != nil {
+		return fmt.Errorf("unable to load progress: %w", err)
+	}
+	switch {
+	case found:
+		height++
+	case r.config.StartHeight > 0:
+		height = r.config.StartHeight
+	default:
+		height = latest
+	}
+
+	for ; height <= latest; height++ {
+		if err := r.relayBlock(ctx, height); err != nil {
+			return fmt.Errorf("unable to relay block %d: %w", height, err)
+		}
+		if err := r.progress.Save(height); err != nil {
+			return fmt.Errorf("unable to save progress: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *Relayer) relayBlock(ctx context.Context, height int64) error {
+	messages, err := r.source.Messages(ctx, height)
+	if err != nil {
+		return err
+	}
+
+	var msgs []*types.MsgReceiveMessage
+	for _, bz := range messages {
+		message, err := new(types.Message).Parse(bz)
+		if err != nil {
+			r.logger.Error("skipping malformed message", "height", height, "err", err)
+			continue
+		}
+		if !r.shouldRelay(message) {
+			continue
+		}
+
+		attestation, err := r.awaitAttestation(ctx, bz)
+		if err != nil {
+			return err
+		}
+
+		msgs = append(msgs, &types.MsgReceiveMessage{
+			From:        r.config.Caller,
+			Message:     bz,
+			Attestation: attestation,
+		})
+	}
+
+	for start := 0; start < len(msgs); start += r.config.BatchSize {
+		end := min(start+r.config.BatchSize, len(msgs))
+		if err := r.submit(ctx, msgs[start:end]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// shouldRelay reports whether message is addressed to the destination domain
+// and can be received by the configured caller.
+func (r *Relayer) shouldRelay(message *types.Message) bool {
+	if message.DestinationDomain != r.config.DestinationDomain {
+		return false
+	}
+
+	if !bytes.Equal(message.DestinationCaller, make([]byte, types.DestinationCallerLen)) &&
+		!bytes.Equal(message.DestinationCaller, r.caller) {
+		r.logger.Info("skipping message for another destination caller",
+			"source_domain", message.SourceDomain, "nonce", message.Nonce)
+		return false
+	}
+
+	return true
+}
+
+// awaitAttestation polls the attestation source until message is attested,
+// for at most the attestation timeout.
+func (r *Relayer) awaitAttestation(ctx context.Context, message []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, r.config.AttestationTimeout)
+	defer cancel()
+
+	for {
+		attestation, err := r.attestations.Attestation(ctx, message)
+		if err == nil {
+			return attestation, nil
+		}
+		if !errors.Is(err, ErrAttestationPending) {
+			r.logger.Error("unable to fetch attestation", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("message %x not attested: %w", crypto.Keccak256(message), ctx.Err())
+		case <-time.After(r.config.AttestationInterval):
+		}
+	}
+}
+
+// submit delivers a batch, retrying failed submissions. A batch rejected
+// because one of its nonces was already used is split up and resubmitted
+// message by message, so messages delivered by someone else are skipped
+// without blocking the rest.
+func (r *Relayer) submit(ctx context.Context, msgs []*types.MsgReceiveMessage) error {
+	var err error
+	for attempt := 0; attempt <= r.config.MaxRetries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(r.config.RetryInterval):
+			}
+		}
+
+		err = r.submitter.Submit(ctx, msgs)
+		switch {
+		case err == nil:
+			r.logger.Info("relayed messages", "count", len(msgs))
+			return nil
+		case IsNonceAlreadyUsed(err) && len(msgs) == 1:
+			r.logger.Info("message already received", "err", err)
+			return nil
+		case IsNonceAlreadyUsed(err):
+			for _, msg := range msgs {
+				if err := r.submit(ctx, []*types.MsgReceiveMessage{msg}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		r.logger.Error("unable to submit messages", "attempt", attempt+1, "err", err)
+	}
+
+	return err
+}
+
+// IsNonceAlreadyUsed reports whether err was returned because a message was
+// already received. Errors returned by a node, including those of simulations
+// run by CalculateGas, are matched on their ABCI codespace and code.
+func IsNonceAlreadyUsed(err error) bool {
+	return errors.Is(err, types.ErrNonceAlreadyUsed)
+}
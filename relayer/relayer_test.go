@@ -0,0 +1,450 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package relayer_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	"github.com/circlefin/noble-cctp/relayer"
+	"github.com/circlefin/noble-cctp/simapp"
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/testutil/iris"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Relays messages in batches and saves progress
+ * Skips messages that were already received
+ * Skips messages for another destination caller or domain
+ * Resumes from saved progress
+ * Waits for pending attestations
+ * Stops waiting for an attestation after the timeout
+ * Retries failed submissions
+ * Stops after too many failed submissions
+ * Relays new blocks until cancelled
+ * Iris client reports pending attestations
+ * Simulating an already received message returns ErrNonceAlreadyUsed
+ */
+
+// chain is an in-process destination chain backed by the cctp keeper.
+type chain struct {
+	keeper *keeper.Keeper
+	ctx    sdk.Context
+	server types.MsgServer
+
+	mu      sync.Mutex
+	batches [][]*types.MsgReceiveMessage
+	failing int
+}
+
+var _ relayer.Submitter = &chain{}
+
+// Submit receives msgs atomically, like the messages of one transaction.
+func (c *chain) Submit(_ context.Context, msgs []*types.MsgReceiveMessage) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.failing > 0 {
+		c.failing--
+		return errors.New("connection refused")
+	}
+
+	cacheCtx, write := c.ctx.CacheContext()
+	for _, msg := range msgs {
+		if _, err := c.server.ReceiveMessage(cacheCtx, msg); err != nil {
+			return err
+		}
+	}
+	write()
+
+	c.batches = append(c.batches, msgs)
+	return nil
+}
+
+func (c *chain) received(nonce uint64) bool {
+	return c.keeper.GetUsedNonce(c.ctx, types.Nonce{SourceDomain: 0, Nonce: nonce})
+}
+
+type fixture struct {
+	chain   *chain
+	iris    *iris.Server
+	client  *relayer.IrisClient
+	source  *relayer.MemorySource
+	config  relayer.Config
+	account sample.Account
+}
+
+func setup(t *testing.T) *fixture {
+	k, goCtx := keepertest.CctpKeeper()
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signers := attester.GenerateSet(2)
+	for _, a := range signers.Attesters() {
+		k.SetAttester(ctx, a)
+	}
+	k.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: uint32(len(signers))})
+
+	irisServer := iris.NewServer(signers)
+	httpServer := httptest.NewServer(irisServer.Handler())
+	t.Cleanup(httpServer.Close)
+
+	account := sample.TestAccount()
+	config := relayer.DefaultConfig(account.Address)
+	config.StartHeight = 1
+	config.PollInterval = time.Millisecond
+	config.AttestationInterval = time.Millisecond
+	config.RetryInterval = time.Millisecond
+
+	return &fixture{
+		chain:   &chain{keeper: k, ctx: ctx, server: keeper.NewMsgServerImpl(k)},
+		iris:    irisServer,
+		client:  relayer.NewIrisClient(httpServer.URL, httpServer.Client()),
+		source:  relayer.NewMemorySource(),
+		config:  config,
+		account: account,
+	}
+}
+
+func (f *fixture) relayer(t *testing.T, progress relayer.ProgressStore) *relayer.Relayer {
+	r, err := relayer.NewRelayer(f.config, log.NewNopLogger(), f.source, f.client, f.chain, progress)
+	require.NoError(t, err)
+	return r
+}
+
+// message returns an attested message sent from domain 0.
+func (f *fixture) message(t *testing.T, nonce uint64, destinationDomain uint32, destinationCaller []byte) []byte {
+	recipient := make([]byte, 32)
+	_, _ = rand.Read(recipient)
+
+	bz, err := (&types.Message{
+		Version:           types.NobleMessageVersion,
+		SourceDomain:      0,
+		DestinationDomain: destinationDomain,
+		Nonce:             nonce,
+		Sender:            make([]byte, 32),
+		Recipient:         recipient,
+		DestinationCaller: destinationCaller,
+		MessageBody:       []byte("hello noble"),
+	}).Bytes()
+	require.NoError(t, err)
+
+	f.iris.Attest(bz)
+	return bz
+}
+
+func hashOf(message []byte) common.Hash {
+	return crypto.Keccak256Hash(message)
+}
+
+func TestRelayerRelaysMessagesInBatches(t *testing.T) {
+	f := setup(t)
+	f.config.BatchSize = 2
+	noCaller := make([]byte, types.DestinationCallerLen)
+
+	f.source.AddBlock(
+		f.message(t, 0, types.NobleDomainId, noCaller),
+		f.message(t, 1, types.NobleDomainId, noCaller),
+		f.message(t, 2, types.NobleDomainId, noCaller),
+	)
+	f.source.AddBlock()
+	f.source.AddBlock(f.message(t, 3, types.NobleDomainId, noCaller))
+
+	progress := &relayer.MemoryProgressStore{}
+	require.NoError(t, f.relayer(t, progress).Sync(context.Background()))
+
+	for nonce := uint64(0); nonce < 4; nonce++ {
+		require.True(t, f.chain.received(nonce))
+	}
+	require.Len(t, f.chain.batches, 3)
+	require.Len(t, f.chain.batches[0], 2)
+	require.Equal(t, f.account.Address, f.chain.batches[0][0].From)
+
+	height, found, err := progress.Load()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(3), height)
+}
+
+func TestRelayerSkipsReceivedMessages(t *testing.T) {
+	f := setup(t)
+	noCaller := make([]byte, types.DestinationCallerLen)
+
+	messages := [][]byte{
+		f.message(t, 0, types.NobleDomainId, noCaller),
+		f.message(t, 1, types.NobleDomainId, noCaller),
+		f.message(t, 2, types.NobleDomainId, noCaller),
+	}
+	f.source.AddBlock(messages...)
+
+	// another relayer already delivered the second message
+	attestation, found := f.iris.Attestation(hashOf(messages[1]))
+	require.True(t, found)
+	require.NoError(t, f.chain.Submit(context.Background(), []*types.MsgReceiveMessage{
+		{From: sample.AccAddress(), Message: messages[1], Attestation: attestation},
+	}))
+
+	require.NoError(t, f.relayer(t, &relayer.MemoryProgressStore{}).Sync(context.Background()))
+
+	require.True(t, f.chain.received(0))
+	require.True(t, f.chain.received(2))
+	// the pre-delivered batch plus the two remaining messages one by one
+	require.Len(t, f.chain.batches, 3)
+}
+
+func TestRelayerFiltersMessages(t *testing.T) {
+	f := setup(t)
+
+	ourCaller := make([]byte, types.DestinationCallerLen)
+	copy(ourCaller[12:], f.account.AddressBz)
+	otherCaller := make([]byte, types.DestinationCallerLen)
+	copy(otherCaller[12:], sample.TestAccount().AddressBz)
+	// a 32-byte caller that only shares our last 20 bytes
+	longCaller := bytes.Repeat([]byte{1}, types.DestinationCallerLen)
+	copy(longCaller[12:], f.account.AddressBz)
+
+	f.source.AddBlock(
+		f.message(t, 0, types.NobleDomainId, ourCaller),
+		f.message(t, 1, types.NobleDomainId, otherCaller),
+		f.message(t, 2, 0, make([]byte, types.DestinationCallerLen)),
+		f.message(t, 3, types.NobleDomainId, longCaller),
+		[]byte("not a message"),
+	)
+
+	require.NoError(t, f.relayer(t, &relayer.MemoryProgressStore{}).Sync(context.Background()))
+
+	require.True(t, f.chain.received(0))
+	require.False(t, f.chain.received(1))
+	require.False(t, f.chain.received(2))
+	require.False(t, f.chain.received(3))
+	require.Len(t, f.chain.batches, 1)
+}
+
+func TestRelayerResumesFromProgress(t *testing.T) {
+	f := setup(t)
+	noCaller := make([]byte, types.DestinationCallerLen)
+	path := filepath.Join(t.TempDir(), "progress.json")
+
+	f.source.AddBlock(f.message(t, 0, types.NobleDomainId, noCaller))
+	require.NoError(t, f.relayer(t, relayer.NewFileProgressStore(path)).Sync(context.Background()))
+
+	// a restarted relayer only relays the new block
+	f.source.AddBlock(f.message(t, 1, types.NobleDomainId, noCaller))
+	require.NoError(t, f.relayer(t, relayer.NewFileProgressStore(path)).Sync(context.Background()))
+
+	require.True(t, f.chain.received(1))
+	require.Len(t, f.chain.batches, 2)
+
+	height, found, err := relayer.NewFileProgressStore(path).Load()
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(2), height)
+}
+
+func TestRelayerWaitsForPendingAttestation(t *testing.T) {
+	f := setup(t)
+	noCaller := make([]byte, types.DestinationCallerLen)
+
+	message := f.message(t, 0, types.NobleDomainId, noCaller)
+	f.source.AddBlock(message)
+
+	attestations := 0
+	source := relayer.AttestationSourceFunc(func(_ context.Context, message []byte) ([]byte, error) {
+		attestations++
+		if attestations < 3 {
+			return nil, relayer.ErrAttestationPending
+		}
+		attestation, _ := f.iris.Attestation(hashOf(message))
+		return attestation, nil
+	})
+
+	r, err := relayer.NewRelayer(f.config, log.NewNopLogger(), f.source, source, f.chain, &relayer.MemoryProgressStore{})
+	require.NoError(t, err)
+	require.NoError(t, r.Sync(context.Background()))
+
+	require.Equal(t, 3, attestations)
+	require.True(t, f.chain.received(0))
+}
+
+func TestRelayerAttestationTimeout(t *testing.T) {
+	f := setup(t)
+	f.config.AttestationTimeout = 10 * time.Millisecond
+
+	message := f.message(t, 0, types.NobleDomainId, make([]byte, types.DestinationCallerLen))
+	f.source.AddBlock(message)
+
+	source := relayer.AttestationSourceFunc(func(context.Context, []byte) ([]byte, error) {
+		return nil, relayer.ErrAttestationPending
+	})
+
+	r, err := relayer.NewRelayer(f.config, log.NewNopLogger(), f.source, source, f.chain, &relayer.MemoryProgressStore{})
+	require.NoError(t, err)
+	err = r.Sync(context.Background())
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.ErrorContains(t, err, hashOf(message).Hex()[2:])
+	require.False(t, f.chain.received(0))
+
+	f.config.AttestationTimeout = 0
+	_, err = relayer.NewRelayer(f.config, log.NewNopLogger(), f.source, source, f.chain, &relayer.MemoryProgressStore{})
+	require.ErrorContains(t, err, "attestation timeout must be positive")
+}
+
+func TestRelayerRetriesFailedSubmissions(t *testing.T) {
+	f := setup(t)
+	f.source.AddBlock(f.message(t, 0, types.NobleDomainId, make([]byte, types.DestinationCallerLen)))
+	f.chain.failing = f.config.MaxRetries
+
+	require.NoError(t, f.relayer(t, &relayer.MemoryProgressStore{}).Sync(context.Background()))
+	require.True(t, f.chain.received(0))
+}
+
+func TestRelayerStopsAfterFailedSubmissions(t *testing.T) {
+	f := setup(t)
+	f.source.AddBlock(f.message(t, 0, types.NobleDomainId, make([]byte, types.DestinationCallerLen)))
+	f.chain.failing = f.config.MaxRetries + 1
+
+	progress := &relayer.MemoryProgressStore{}
+	err := f.relayer(t, progress).Sync(context.Background())
+	require.ErrorContains(t, err, "connection refused")
+	require.False(t, f.chain.received(0))
+
+	_, found, err := progress.Load()
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestRelayerRunRelaysNewBlocks(t *testing.T) {
+	f := setup(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errs := make(chan error, 1)
+	go func() { errs <- f.relayer(t, &relayer.MemoryProgressStore{}).Run(ctx) }()
+
+	f.source.AddBlock(f.message(t, 0, types.NobleDomainId, make([]byte, types.DestinationCallerLen)))
+	require.Eventually(t, func() bool {
+		f.chain.mu.Lock()
+		defer f.chain.mu.Unlock()
+		return f.chain.received(0)
+	}, time.Second, time.Millisecond)
+
+	cancel()
+	require.ErrorIs(t, <-errs, context.Canceled)
+}
+
+func TestIrisClientPendingAttestation(t *testing.T) {
+	f := setup(t)
+
+	_, err := f.client.Attestation(context.Background(), []byte("unknown message"))
+	require.ErrorIs(t, err, relayer.ErrAttestationPending)
+
+	f.iris.MarkPending([]byte("pending message"))
+	_, err = f.client.Attestation(context.Background(), []byte("pending message"))
+	require.ErrorIs(t, err, relayer.ErrAttestationPending)
+
+	message := f.message(t, 0, types.NobleDomainId, make([]byte, types.DestinationCallerLen))
+	attestation, err := f.client.Attestation(context.Background(), message)
+	require.NoError(t, err)
+	expected, _ := f.iris.Attestation(hashOf(message))
+	require.Equal(t, expected, attestation)
+}
+
+func TestIsNonceAlreadyUsed(t *testing.T) {
+	require.True(t, relayer.IsNonceAlreadyUsed(errorsmod.Wrap(types.ErrNonceAlreadyUsed, "source domain: 0, nonce: 1")))
+	// errors returned by a node only carry the codespace, code and log
+	require.True(t, relayer.IsNonceAlreadyUsed(errorsmod.ABCIError(types.ModuleName, types.ErrNonceAlreadyUsed.ABCICode(), "failed to execute message")))
+	require.False(t, relayer.IsNonceAlreadyUsed(errorsmod.ABCIError(types.ModuleName, types.ErrSignatureVerification.ABCICode(), "nonce already used")))
+	require.False(t, relayer.IsNonceAlreadyUsed(nil))
+}
+
+// appNode serves the ABCI queries of a client from an in-process app.
+type appNode struct {
+	client.CometRPC
+	app *simapp.SimApp
+}
+
+func (n appNode) ABCIQuery(ctx context.Context, path string, data cmtbytes.HexBytes) (*coretypes.ResultABCIQuery, error) {
+	res, err := n.app.Query(ctx, &abci.RequestQuery{Path: path, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	return &coretypes.ResultABCIQuery{Response: *res}, nil
+}
+
+func TestCalculateGasNonceAlreadyUsed(t *testing.T) {
+	account := sample.TestAccount()
+	app := simapp.Setup(t, banktypes.Balance{
+		Address: account.Address,
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1_000_000)),
+	})
+	ctx := app.NewTestContext()
+	// another relayer already received the first message
+	app.CCTPKeeper.SetUsedNonce(ctx, types.Nonce{SourceDomain: 0, Nonce: 1})
+
+	clientCtx := client.Context{}.WithClient(appNode{app: app})
+	factory := tx.Factory{}.
+		WithTxConfig(app.TxConfig()).
+		WithChainID("noble-1").
+		WithAccountNumber(app.AccountKeeper.GetAccount(ctx, sdk.AccAddress(account.AddressBz)).GetAccountNumber()).
+		WithGasAdjustment(1.5).
+		WithSimulateAndExecute(true)
+
+	receive := func(nonce uint64) *types.MsgReceiveMessage {
+		message, err := (&types.Message{
+			Version:           types.NobleMessageVersion,
+			SourceDomain:      0,
+			DestinationDomain: types.NobleDomainId,
+			Nonce:             nonce,
+			Sender:            make([]byte, 32),
+			Recipient:         make([]byte, 32),
+			DestinationCaller: make([]byte, types.DestinationCallerLen),
+			MessageBody:       []byte("hello noble"),
+		}).Bytes()
+		require.NoError(t, err)
+		return &types.MsgReceiveMessage{From: account.Address, Message: message, Attestation: make([]byte, 65)}
+	}
+
+	_, err := relayer.CalculateGas(context.Background(), clientCtx, factory, receive(1))
+	require.True(t, relayer.IsNonceAlreadyUsed(err))
+
+	// a message that was not received yet only fails its attestation
+	_, err = relayer.CalculateGas(context.Background(), clientCtx, factory, receive(2))
+	require.ErrorContains(t, err, "no attesters found")
+	require.False(t, relayer.IsNonceAlreadyUsed(err))
+}
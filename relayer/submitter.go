@@ -0,0 +1,164 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package relayer
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// Submitter delivers a batch of MsgReceiveMessage to the destination chain.
+// A batch must be applied atomically: either every message is received or
+// none is, as is the case for the messages of a single transaction.
+type Submitter interface {
+	Submit(ctx context.Context, msgs []*types.MsgReceiveMessage) error
+}
+
+var _ Submitter = &TxSubmitter{}
+
+// TxSubmitter signs and broadcasts each batch as one transaction and waits
+// for it to be included in a block.
+type TxSubmitter struct {
+	clientCtx    client.Context
+	factory      tx.Factory
+	pollInterval time.Duration
+	timeout      time.Duration
+}
+
+// NewTxSubmitter returns a TxSubmitter signing with clientCtx.FromName.
+func NewTxSubmitter(clientCtx client.Context, factory tx.Factory) *TxSubmitter {
+	return &TxSubmitter{
+		clientCtx:    clientCtx,
+		factory:      factory,
+		pollInterval: time.Second,
+		timeout:      time.Minute,
+	}
+}
+
+func (s *TxSubmitter) Submit(ctx context.Context, msgs []*types.MsgReceiveMessage) error {
+	sdkMsgs := make([]sdk.Msg, len(msgs))
+	for i, msg := range msgs {
+		sdkMsgs[i] = msg
+	}
+
+	// refresh account number and sequence for every transaction
+	factory, err := s.factory.Prepare(s.clientCtx)
+	if err != nil {
+		return err
+	}
+	if factory.SimulateAndExecute() {
+		gas, err := CalculateGas(ctx, s.clientCtx, factory, sdkMsgs...)
+		if err != nil {
+			return err
+		}
+		factory = factory.WithGas(gas)
+	}
+
+	builder, err := factory.BuildUnsignedTx(sdkMsgs...)
+	if err != nil {
+		return err
+	}
+	if err := tx.Sign(ctx, factory, s.clientCtx.FromName, builder, true); err != nil {
+		return err
+	}
+	txBytes, err := s.clientCtx.TxConfig.TxEncoder()(builder.GetTx())
+	if err != nil {
+		return err
+	}
+
+	res, err := s.clientCtx.BroadcastTxSync(txBytes)
+	if err != nil {
+		return err
+	}
+	if res.Code != 0 {
+		return errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+	}
+
+	return s.waitForTx(ctx, res.TxHash)
+}
+
+// waitForTx polls the node until the transaction is included in a block and
+// returns its execution error, if any.
+func (s *TxSubmitter) waitForTx(ctx context.Context, hash string) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(s.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("transaction %s not included in a block: %w", hash, ctx.Err())
+		case <-ticker.C:
+		}
+
+		res, err := authtx.QueryTx(s.clientCtx, hash)
+		if err != nil {
+			// not indexed yet
+			continue
+		}
+		if res.Code != 0 {
+			return errorsmod.ABCIError(res.Codespace, res.Code, res.RawLog)
+		}
+
+		return nil
+	}
+}
+
+// CalculateGas simulates msgs and returns the adjusted gas they use, like
+// tx.CalculateGas. It queries the /app/simulate path of the node rather than
+// the Simulate gRPC service, which drops the codespace and code of a failed
+// simulation, so that its errors can be matched like those of a broadcast.
+func CalculateGas(ctx context.Context, clientCtx client.Context, factory tx.Factory, msgs ...sdk.Msg) (uint64, error) {
+	txBytes, err := factory.BuildSimTx(msgs...)
+	if err != nil {
+		return 0, err
+	}
+
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return 0, err
+	}
+	res, err := node.ABCIQuery(ctx, "/app/simulate", txBytes)
+	if err != nil {
+		return 0, err
+	}
+	if !res.Response.IsOK() {
+		return 0, errorsmod.ABCIError(res.Response.Codespace, res.Response.Code, res.Response.Log)
+	}
+
+	var simRes struct {
+		GasInfo struct {
+			GasUsed uint64 `json:"gas_used,string"`
+		} `json:"gas_info"`
+	}
+	if err := json.Unmarshal(res.Response.Value, &simRes); err != nil {
+		return 0, fmt.Errorf("unable to decode simulation response: %w", err)
+	}
+
+	return uint64(factory.GasAdjustment() * float64(simRes.GasInfo.GasUsed)), nil
+}
@@ -0,0 +1,52 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simapp
+
+import (
+	cctpante "github.com/circlefin/noble-cctp/x/cctp/ante"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// RelayFeeOptions bounds the relay transactions whose fees SimApp waives or
+// pays from the cctp relay fee budget.
+var RelayFeeOptions = cctpante.RelayFeeOptions{
+	MaxGas:      500_000,
+	MaxFee:      sdk.NewCoins(sdk.NewInt64Coin(Denom, 10_000)),
+	MaxMessages: 10,
+}
+
+// NewAnteHandler returns the default ante chain of the SDK, with its fee
+// decorator wrapped by the cctp RelayFeeDecorator.
+func NewAnteHandler(app *SimApp) sdk.AnteHandler {
+	feeDecorator := ante.NewDeductFeeDecorator(app.AccountKeeper, app.BankKeeper, nil, nil)
+
+	return sdk.ChainAnteDecorators(
+		ante.NewSetUpContextDecorator(),
+		ante.NewExtensionOptionsDecorator(nil),
+		ante.NewValidateBasicDecorator(),
+		ante.NewTxTimeoutHeightDecorator(),
+		ante.NewValidateMemoDecorator(app.AccountKeeper),
+		ante.NewConsumeGasForTxSizeDecorator(app.AccountKeeper),
+		cctpante.NewRelayFeeDecorator(app.CCTPKeeper, app.BankKeeper, feeDecorator, RelayFeeOptions),
+		ante.NewSetPubKeyDecorator(app.AccountKeeper),
+		ante.NewValidateSigCountDecorator(app.AccountKeeper),
+		ante.NewSigGasConsumeDecorator(app.AccountKeeper, ante.DefaultSigVerificationGasConsumer),
+		ante.NewSigVerificationDecorator(app.AccountKeeper, app.txConfig.SignModeHandler()),
+		ante.NewIncrementSequenceDecorator(app.AccountKeeper),
+	)
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package simapp is a minimal chain embedding the cctp module, used by
+// integration tests and simulations.
+package simapp
+
+import (
+	"io"
+
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	cctpkeeper "github.com/circlefin/noble-cctp/x/cctp/keeper"
+	fiattokenfactorykeeper "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/keeper"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	consensuskeeper "github.com/cosmos/cosmos-sdk/x/consensus/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+)
+
+var _ runtime.AppI = (*SimApp)(nil)
+
+// SimApp extends an ABCI application built with depinject.
+type SimApp struct {
+	*runtime.App
+	legacyAmino       *codec.LegacyAmino
+	appCodec          codec.Codec
+	txConfig          client.TxConfig
+	interfaceRegistry codectypes.InterfaceRegistry
+
+	AccountKeeper          authkeeper.AccountKeeper
+	BankKeeper             bankkeeper.Keeper
+	StakingKeeper          *stakingkeeper.Keeper
+	ConsensusParamsKeeper  consensuskeeper.Keeper
+	FiatTokenFactoryKeeper *fiattokenfactorykeeper.Keeper
+	CCTPKeeper             *cctpkeeper.Keeper
+
+	sm *module.SimulationManager
+}
+
+// NewSimApp returns a reference to an initialized SimApp.
+func NewSimApp(
+	logger log.Logger,
+	db dbm.DB,
+	traceStore io.Writer,
+	loadLatest bool,
+	appOpts servertypes.AppOptions,
+	baseAppOptions ...func(*baseapp.BaseApp),
+) (*SimApp, error) {
+	app := &SimApp{}
+	var appBuilder *runtime.AppBuilder
+
+	if err := depinject.Inject(
+		depinject.Configs(AppConfig, depinject.Supply(logger, appOpts)),
+		&appBuilder,
+		&app.appCodec,
+		&app.legacyAmino,
+		&app.txConfig,
+		&app.interfaceRegistry,
+		&app.AccountKeeper,
+		&app.BankKeeper,
+		&app.StakingKeeper,
+		&app.ConsensusParamsKeeper,
+		&app.FiatTokenFactoryKeeper,
+		&app.CCTPKeeper,
+	); err != nil {
+		return nil, err
+	}
+
+	app.App = appBuilder.Build(db, traceStore, baseAppOptions...)
+	app.SetAnteHandler(NewAnteHandler(app))
+
+	app.sm = module.NewSimulationManagerFromAppModules(app.ModuleManager.Modules, nil)
+	app.sm.RegisterStoreDecoders()
+
+	if err := app.Load(loadLatest); err != nil {
+		return nil, err
+	}
+
+	return app, nil
+}
+
+// LegacyAmino returns SimApp's amino codec.
+func (app *SimApp) LegacyAmino() *codec.LegacyAmino {
+	return app.legacyAmino
+}
+
+// AppCodec returns SimApp's app codec.
+func (app *SimApp) AppCodec() codec.Codec {
+	return app.appCodec
+}
+
+// InterfaceRegistry returns SimApp's InterfaceRegistry.
+func (app *SimApp) InterfaceRegistry() codectypes.InterfaceRegistry {
+	return app.interfaceRegistry
+}
+
+// TxConfig returns SimApp's TxConfig.
+func (app *SimApp) TxConfig() client.TxConfig {
+	return app.txConfig
+}
+
+// GetKey returns the KVStoreKey for the provided store key.
+func (app *SimApp) GetKey(storeKey string) *storetypes.KVStoreKey {
+	sk := app.UnsafeFindStoreKey(storeKey)
+	kvStoreKey, ok := sk.(*storetypes.KVStoreKey)
+	if !ok {
+		return nil
+	}
+	return kvStoreKey
+}
+
+// SimulationManager implements the SimulationApp interface.
+func (app *SimApp) SimulationManager() *module.SimulationManager {
+	return app.sm
+}
@@ -0,0 +1,217 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simapp_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/simapp"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * A consumer module burns from its module account
+ * A consumer module sends messages with and without a destination caller
+ * A consumer module receives a message addressed to it as destination caller
+ * Failed deposits and sends reserve no nonce and burn nothing
+ */
+
+// CCTPKeeper is the keeper interface a module composing with CCTP declares.
+type CCTPKeeper interface {
+	DepositForBurn(ctx context.Context, from sdk.AccAddress, amount math.Int, destinationDomain uint32, mintRecipient []byte, burnToken string, destinationCaller []byte) (uint64, error)
+	SendMessage(ctx context.Context, from sdk.AccAddress, destinationDomain uint32, recipient []byte, messageBody []byte, destinationCaller []byte) (uint64, error)
+	ReceiveMessage(ctx context.Context, caller sdk.AccAddress, message []byte, attestation []byte, keyType types.KeyType) error
+	RegisterAccount32(ctx context.Context, address sdk.AccAddress) error
+}
+
+var _ CCTPKeeper = (*keeper.Keeper)(nil)
+
+// consumer is a mock module acting through CCTP with its module account.
+type consumer struct {
+	cctp    CCTPKeeper
+	address sdk.AccAddress
+}
+
+func setupConsumer(t *testing.T) (*roundTrip, consumer) {
+	r := setupRoundTrip(t)
+	c := consumer{cctp: r.app.CCTPKeeper, address: authtypes.NewModuleAddress("consumer")}
+
+	// fund the module account from the user
+	coins := sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 500_000))
+	require.NoError(t, r.app.BankKeeper.SendCoins(r.ctx, r.user, c.address, coins))
+
+	return r, c
+}
+
+// sent returns the messages emitted in ctx.
+func sent(t *testing.T, ctx sdk.Context) []*types.Message {
+	var messages []*types.Message
+	for _, bz := range types.MessageSentEvents(ctx.EventManager().ABCIEvents()) {
+		message, err := new(types.Message).Parse(bz)
+		require.NoError(t, err)
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+func TestConsumerDepositForBurn(t *testing.T) {
+	r, c := setupConsumer(t)
+	initialSupply := r.supply()
+
+	mintRecipient := append(make([]byte, 12), []byte("consumer recipient 0")...)
+	var nonce uint64
+	ctx, err := r.exec(func(ctx sdk.Context) (err error) {
+		nonce, err = c.cctp.DepositForBurn(ctx, c.address, math.NewInt(200_000), remoteDomain, mintRecipient, simapp.Denom, nil)
+		return err
+	})
+	require.NoError(t, err)
+
+	messages := sent(t, ctx)
+	require.Len(t, messages, 1)
+	require.Equal(t, nonce, messages[0].Nonce)
+	require.Equal(t, remoteTokenMessenger, messages[0].Recipient)
+	require.Equal(t, make([]byte, types.DestinationCallerLen), messages[0].DestinationCaller)
+
+	burnMessage, err := new(types.BurnMessage).Parse(messages[0].MessageBody)
+	require.NoError(t, err)
+	require.Equal(t, c.address.Bytes(), burnMessage.MessageSender[12:])
+	require.Equal(t, mintRecipient, burnMessage.MintRecipient)
+
+	require.Equal(t, math.NewInt(300_000), r.balance(c.address))
+	require.Equal(t, initialSupply.SubRaw(200_000), r.supply())
+
+	// the module account can not burn more than it holds
+	_, err = r.exec(func(ctx sdk.Context) error {
+		_, err := c.cctp.DepositForBurn(ctx, c.address, math.NewInt(400_000), remoteDomain, mintRecipient, simapp.Denom, nil)
+		return err
+	})
+	require.ErrorContains(t, err, "insufficient funds")
+	require.Equal(t, math.NewInt(300_000), r.balance(c.address))
+}
+
+func TestConsumerSendMessage(t *testing.T) {
+	r, c := setupConsumer(t)
+
+	recipient := append(make([]byte, 12), []byte("consumer recipient 0")...)
+	caller := append(make([]byte, 12), []byte("consumer caller 7890")...)
+	ctx, err := r.exec(func(ctx sdk.Context) error {
+		if _, err := c.cctp.SendMessage(ctx, c.address, remoteDomain, recipient, []byte("hello"), nil); err != nil {
+			return err
+		}
+		_, err := c.cctp.SendMessage(ctx, c.address, remoteDomain, recipient, []byte("hello"), caller)
+		return err
+	})
+	require.NoError(t, err)
+
+	messages := sent(t, ctx)
+	require.Len(t, messages, 2)
+	for _, message := range messages {
+		require.Equal(t, c.address.Bytes(), message.Sender[12:])
+		require.Equal(t, recipient, message.Recipient)
+		require.Equal(t, []byte("hello"), message.MessageBody)
+	}
+	require.Equal(t, make([]byte, types.DestinationCallerLen), messages[0].DestinationCaller)
+	require.Equal(t, caller, messages[1].DestinationCaller)
+	require.Equal(t, messages[0].Nonce+1, messages[1].Nonce)
+
+	// a destination caller must be a nonzero 32-byte address
+	for _, invalid := range [][]byte{caller[12:], make([]byte, types.DestinationCallerLen)} {
+		_, err = r.exec(func(ctx sdk.Context) error {
+			_, err := c.cctp.SendMessage(ctx, c.address, remoteDomain, recipient, []byte("hello"), invalid)
+			return err
+		})
+		require.ErrorIs(t, err, types.ErrInvalidDestinationCaller)
+	}
+}
+
+func TestConsumerFailuresReserveNoNonce(t *testing.T) {
+	r, c := setupConsumer(t)
+	initialSupply := r.supply()
+	nonce, _ := r.app.CCTPKeeper.GetNextAvailableNonce(r.ctx)
+
+	// calls outside of a transaction are not reverted when they fail
+	recipient := append(make([]byte, 12), []byte("consumer recipient 0")...)
+	_, err := c.cctp.SendMessage(r.ctx, c.address, remoteDomain, make([]byte, 32), []byte("hello"), nil)
+	require.ErrorIs(t, err, types.ErrInvalidRecipient)
+	_, err = c.cctp.SendMessage(r.ctx, c.address, remoteDomain, recipient[12:], []byte("hello"), nil)
+	require.ErrorIs(t, err, types.ErrParsingMessage)
+	_, err = c.cctp.DepositForBurn(r.ctx, c.address, math.NewInt(200_000), remoteDomain, recipient[12:], simapp.Denom, nil)
+	require.ErrorIs(t, err, types.ErrInvalidMintRecipient)
+	_, err = c.cctp.DepositForBurn(r.ctx, c.address, math.NewInt(200_000), remoteDomain, recipient, simapp.Denom, recipient[12:])
+	require.ErrorIs(t, err, types.ErrInvalidDestinationCaller)
+
+	r.app.CCTPKeeper.SetMaxMessageBodySize(r.ctx, types.MaxMessageBodySize{Amount: 4})
+	_, err = c.cctp.SendMessage(r.ctx, c.address, remoteDomain, recipient, []byte("hello"), nil)
+	require.ErrorIs(t, err, types.ErrMessageBodyTooLarge)
+	_, err = c.cctp.DepositForBurn(r.ctx, c.address, math.NewInt(200_000), remoteDomain, recipient, simapp.Denom, nil)
+	require.ErrorIs(t, err, types.ErrMessageBodyTooLarge)
+
+	next, _ := r.app.CCTPKeeper.GetNextAvailableNonce(r.ctx)
+	require.Equal(t, nonce, next)
+	require.Equal(t, math.NewInt(500_000), r.balance(c.address))
+	require.Equal(t, initialSupply, r.supply())
+}
+
+func TestConsumerReceiveMessage(t *testing.T) {
+	r, c := setupConsumer(t)
+
+	// the remote domain sends a message that only the consumer may receive
+	sender := append(make([]byte, 12), []byte("remote sender 567890")...)
+	destinationCaller := make([]byte, types.DestinationCallerLen)
+	copy(destinationCaller[12:], c.address)
+	message, err := (&types.Message{
+		Version:           types.NobleMessageVersion,
+		SourceDomain:      remoteDomain,
+		DestinationDomain: types.NobleDomainId,
+		Nonce:             1,
+		Sender:            sender,
+		Recipient:         destinationCaller,
+		DestinationCaller: destinationCaller,
+		MessageBody:       []byte("hello"),
+	}).Bytes()
+	require.NoError(t, err)
+	attestation := r.attesters.Attest(message)
+
+	// another caller is rejected
+	_, err = r.exec(func(ctx sdk.Context) error {
+		return c.cctp.ReceiveMessage(ctx, r.user, message, attestation, types.KeyType_KEY_TYPE_SECP256K1)
+	})
+	require.ErrorIs(t, err, types.ErrDestinationCallerMismatch)
+
+	ctx, err := r.exec(func(ctx sdk.Context) error {
+		return c.cctp.ReceiveMessage(ctx, c.address, message, attestation, types.KeyType_KEY_TYPE_SECP256K1)
+	})
+	require.NoError(t, err)
+	require.True(t, r.app.CCTPKeeper.GetUsedNonce(r.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: 1}))
+
+	var received bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "circle.cctp.v1.MessageReceived" {
+			caller, found := event.GetAttribute("caller")
+			require.True(t, found)
+			require.Equal(t, `"`+c.address.String()+`"`, caller.Value)
+			received = true
+		}
+	}
+	require.True(t, received)
+}
@@ -0,0 +1,141 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simapp_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Forward a received mint to another domain
+ * Fall back when the forward cannot complete
+ * Fall back when the mint does not cover the fee
+ */
+
+const forwardDomain = 3
+
+var forwardTokenMessenger = append(make([]byte, 12), []byte("forward messenger 03")...)
+
+// setupForwarding registers a forwarding of the mints to a fresh account.
+func setupForwarding(t *testing.T, fee int64) (*roundTrip, sdk.AccAddress, []byte) {
+	r := setupRoundTrip(t)
+	r.app.CCTPKeeper.SetRemoteTokenMessenger(r.ctx, types.RemoteTokenMessenger{DomainId: forwardDomain, Address: forwardTokenMessenger})
+
+	account := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	finalRecipient := append(make([]byte, 12), []byte("final recipient 8901")...)
+	_, err := r.server.SetForwarding(r.ctx, &types.MsgSetForwarding{
+		From:              account.String(),
+		DestinationDomain: forwardDomain,
+		MintRecipient:     finalRecipient,
+		Fee:               math.NewInt(fee),
+	})
+	require.NoError(t, err)
+
+	return r, account, finalRecipient
+}
+
+// relay receives message from relayerAddress and returns the emitted events.
+func (r *roundTrip) relay(t *testing.T, relayerAddress sdk.AccAddress, message []byte) sdk.Events {
+	ctx, err := r.exec(func(ctx sdk.Context) error {
+		_, err := r.server.ReceiveMessage(ctx, &types.MsgReceiveMessage{
+			From:        relayerAddress.String(),
+			Message:     message,
+			Attestation: r.attesters.Attest(message),
+		})
+		return err
+	})
+	require.NoError(t, err)
+	return ctx.EventManager().Events()
+}
+
+func TestForwardMint(t *testing.T) {
+	r, account, finalRecipient := setupForwarding(t, 100)
+	initialSupply := r.supply()
+	relayerAddress := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	events := r.relay(t, relayerAddress, returnMessage(t, 1, math.NewInt(1_000), account))
+
+	// the mint is burned again, less the fee paid to the relayer
+	require.True(t, r.balance(account).IsZero())
+	require.Equal(t, math.NewInt(100), r.balance(relayerAddress))
+	require.True(t, r.balance(types.ModuleAddress).IsZero())
+	require.Equal(t, initialSupply.AddRaw(100), r.supply())
+
+	messages := types.MessageSentEvents(events.ToABCIEvents())
+	require.Len(t, messages, 1)
+	message, err := new(types.Message).Parse(messages[0])
+	require.NoError(t, err)
+	require.Equal(t, uint32(forwardDomain), message.DestinationDomain)
+	require.Equal(t, forwardTokenMessenger, message.Recipient)
+
+	burnMessage, err := new(types.BurnMessage).Parse(message.MessageBody)
+	require.NoError(t, err)
+	require.Equal(t, finalRecipient, burnMessage.MintRecipient)
+	require.Equal(t, math.NewInt(900), burnMessage.Amount)
+	require.Equal(t, account.Bytes(), burnMessage.MessageSender[12:])
+
+	forwarded := eventsOfType(events, "circle.cctp.v1.MintForwarded")
+	require.Len(t, forwarded, 1)
+	require.Empty(t, eventsOfType(events, "circle.cctp.v1.MintForwardingFailed"))
+}
+
+func TestForwardMintFallback(t *testing.T) {
+	r, account, _ := setupForwarding(t, 100)
+	r.app.CCTPKeeper.DeleteRemoteTokenMessenger(r.ctx, forwardDomain)
+	initialSupply := r.supply()
+	relayerAddress := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	events := r.relay(t, relayerAddress, returnMessage(t, 1, math.NewInt(1_000), account))
+
+	// the receive succeeds, leaving the funds with the mint recipient
+	require.Equal(t, math.NewInt(1_000), r.balance(account))
+	require.True(t, r.balance(relayerAddress).IsZero())
+	require.Equal(t, initialSupply.AddRaw(1_000), r.supply())
+	require.Empty(t, types.MessageSentEvents(events.ToABCIEvents()))
+
+	failed := eventsOfType(events, "circle.cctp.v1.MintForwardingFailed")
+	require.Len(t, failed, 1)
+	reason, found := failed[0].GetAttribute("reason")
+	require.True(t, found)
+	require.Contains(t, reason.Value, "remote token messenger not found")
+}
+
+func TestForwardMintFeeNotCovered(t *testing.T) {
+	r, account, _ := setupForwarding(t, 1_000)
+	relayerAddress := sdk.MustAccAddressFromBech32(sample.AccAddress())
+
+	events := r.relay(t, relayerAddress, returnMessage(t, 1, math.NewInt(1_000), account))
+
+	require.Equal(t, math.NewInt(1_000), r.balance(account))
+	require.True(t, r.balance(relayerAddress).IsZero())
+	require.Len(t, eventsOfType(events, "circle.cctp.v1.MintForwardingFailed"), 1)
+}
+
+func eventsOfType(events sdk.Events, eventType string) (result []sdk.Event) {
+	for _, event := range events {
+		if event.Type == eventType {
+			result = append(result, event)
+		}
+	}
+	return result
+}
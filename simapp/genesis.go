@@ -0,0 +1,61 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simapp
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	cctptypes "github.com/circlefin/noble-cctp/x/cctp/types"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// Denom is the fiat token factory minting denom used by SimApp.
+const Denom = "uusdc"
+
+// USDCGenesis updates genesis so that Denom is the fiat token factory minting
+// denom, with bank metadata, and the cctp module account is registered as a
+// minter of it with an effectively unlimited allowance.
+func USDCGenesis(cdc codec.JSONCodec, genesis map[string]json.RawMessage) {
+	var bankGenesis banktypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[banktypes.ModuleName], &bankGenesis)
+	bankGenesis.DenomMetadata = append(bankGenesis.DenomMetadata, banktypes.Metadata{
+		Description: "USD Coin",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: Denom, Exponent: 0},
+			{Denom: "usdc", Exponent: 6},
+		},
+		Base:    Denom,
+		Display: "usdc",
+		Name:    "usdc",
+		Symbol:  "USDC",
+	})
+	genesis[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+
+	var fiatTokenFactoryGenesis fiattokenfactorytypes.GenesisState
+	cdc.MustUnmarshalJSON(genesis[fiattokenfactorytypes.ModuleName], &fiatTokenFactoryGenesis)
+	fiatTokenFactoryGenesis.MintingDenom = &fiattokenfactorytypes.MintingDenom{Denom: Denom}
+	fiatTokenFactoryGenesis.Paused = &fiattokenfactorytypes.Paused{Paused: false}
+	fiatTokenFactoryGenesis.MintersList = append(fiatTokenFactoryGenesis.MintersList, fiattokenfactorytypes.Minters{
+		Address:   cctptypes.ModuleAddress.String(),
+		Allowance: sdk.NewCoin(Denom, math.NewIntWithDecimal(1, 30)),
+	})
+	genesis[fiattokenfactorytypes.ModuleName] = cdc.MustMarshalJSON(&fiatTokenFactoryGenesis)
+}
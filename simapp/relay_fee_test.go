@@ -0,0 +1,172 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simapp_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/simapp"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Relay fee is paid from the budget
+ * Relay with a used nonce is rejected in CheckTx
+ * Competing relay of the same message is rejected in CheckTx
+ * Replayed relay of a message that fails to execute is paid by the relayer
+ * Relay with too many messages is paid by the relayer
+ */
+
+// relayTx signs a transaction of msgs from a relayer without any balance.
+func relayTx(t *testing.T, r *roundTrip, fee sdk.Coins, msgs ...sdk.Msg) []byte {
+	key := secp256k1.GenPrivKey()
+	relayer := sdk.AccAddress(key.PubKey().Address())
+	account := r.app.AccountKeeper.NewAccountWithAddress(r.ctx, relayer)
+	r.app.AccountKeeper.SetAccount(r.ctx, account)
+
+	for _, msg := range msgs {
+		msg.(*types.MsgReceiveMessage).From = relayer.String()
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(1)), r.app.TxConfig(), msgs, fee, 200_000, "",
+		[]uint64{account.GetAccountNumber()}, []uint64{0}, key,
+	)
+	require.NoError(t, err)
+	bz, err := r.app.TxConfig().TxEncoder()(tx)
+	require.NoError(t, err)
+	return bz
+}
+
+func (r *roundTrip) receiveMsg(t *testing.T, nonce uint64) *types.MsgReceiveMessage {
+	message := returnMessage(t, nonce, math.NewInt(1_000), r.user)
+	return &types.MsgReceiveMessage{Message: message, Attestation: r.attesters.Attest(message)}
+}
+
+// deliver executes tx in a new block and returns its result.
+func (r *roundTrip) deliver(t *testing.T, tx []byte) *abci.ExecTxResult {
+	res, err := r.app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: r.app.LastBlockHeight() + 1,
+		Txs:    [][]byte{tx},
+	})
+	require.NoError(t, err)
+	_, err = r.app.Commit()
+	require.NoError(t, err)
+	r.ctx = r.app.NewTestContext()
+	return res.TxResults[0]
+}
+
+func TestRelayFeePaidFromBudget(t *testing.T) {
+	r := setupRoundTrip(t)
+	budget := sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1_000))
+	require.NoError(t, r.app.BankKeeper.SendCoinsFromAccountToModule(r.ctx, r.user, types.RelayFeeBudgetName, budget))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 100))
+	tx := relayTx(t, r, fee, r.receiveMsg(t, 1))
+
+	res, err := r.app.CheckTx(&abci.RequestCheckTx{Tx: tx, Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code, res.Log)
+
+	result := r.deliver(t, tx)
+	require.Equal(t, uint32(0), result.Code, result.Log)
+	require.True(t, r.app.CCTPKeeper.GetUsedNonce(r.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: 1}))
+	require.Equal(t, math.NewInt(900), r.balance(authtypes.NewModuleAddress(types.RelayFeeBudgetName)))
+}
+
+func TestRelayFeeUsedNonceRejectedInCheckTx(t *testing.T) {
+	r := setupRoundTrip(t)
+	r.app.CCTPKeeper.SetUsedNonce(r.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: 1})
+
+	res, err := r.app.CheckTx(&abci.RequestCheckTx{Tx: relayTx(t, r, nil, r.receiveMsg(t, 1)), Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.Equal(t, types.ErrNonceAlreadyUsed.ABCICode(), res.Code, res.Log)
+}
+
+func TestRelayFeeCompetingRelayRejectedInCheckTx(t *testing.T) {
+	r := setupRoundTrip(t)
+	msg := r.receiveMsg(t, 1)
+
+	res, err := r.app.CheckTx(&abci.RequestCheckTx{Tx: relayTx(t, r, nil, msg), Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), res.Code, res.Log)
+
+	competing := &types.MsgReceiveMessage{Message: msg.Message, Attestation: msg.Attestation}
+	res, err = r.app.CheckTx(&abci.RequestCheckTx{Tx: relayTx(t, r, nil, competing), Type: abci.CheckTxType_New})
+	require.NoError(t, err)
+	require.Equal(t, types.ErrNonceAlreadyUsed.ABCICode(), res.Code, res.Log)
+
+	// the nonce is only marked as used in the CheckTx state
+	require.False(t, r.app.CCTPKeeper.GetUsedNonce(r.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: 1}))
+}
+
+func TestRelayFeeFailingMessageReplayed(t *testing.T) {
+	r := setupRoundTrip(t)
+	budget := sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1_000))
+	require.NoError(t, r.app.BankKeeper.SendCoinsFromAccountToModule(r.ctx, r.user, types.RelayFeeBudgetName, budget))
+
+	// the message is attested, but its mint recipient is blacklisted
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	r.app.FiatTokenFactoryKeeper.SetBlacklisted(r.ctx, fiattokenfactorytypes.Blacklisted{AddressBz: recipient})
+	message := returnMessage(t, 1, math.NewInt(1_000), recipient)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 100))
+
+	for range 3 {
+		msg := &types.MsgReceiveMessage{Message: message, Attestation: r.attesters.Attest(message)}
+		tx := relayTx(t, r, fee, msg)
+
+		res, err := r.app.CheckTx(&abci.RequestCheckTx{Tx: tx, Type: abci.CheckTxType_New})
+		require.NoError(t, err)
+		require.Equal(t, fiattokenfactorytypes.ErrMint.ABCICode(), res.Code, res.Log)
+
+		// the relayer, without any balance, pays the fee itself
+		result := r.deliver(t, tx)
+		require.NotEqual(t, uint32(0), result.Code)
+		require.Contains(t, result.Log, "insufficient funds")
+	}
+
+	require.False(t, r.app.CCTPKeeper.GetUsedNonce(r.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: 1}))
+	require.Equal(t, math.NewInt(1_000), r.balance(authtypes.NewModuleAddress(types.RelayFeeBudgetName)))
+}
+
+func TestRelayFeeTooManyMessages(t *testing.T) {
+	r := setupRoundTrip(t)
+	budget := sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1_000))
+	require.NoError(t, r.app.BankKeeper.SendCoinsFromAccountToModule(r.ctx, r.user, types.RelayFeeBudgetName, budget))
+
+	msgs := make([]sdk.Msg, simapp.RelayFeeOptions.MaxMessages+1)
+	for i := range msgs {
+		msgs[i] = r.receiveMsg(t, uint64(i+1))
+	}
+	fee := sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 100))
+
+	// the relayer, without any balance, pays the fee itself
+	result := r.deliver(t, relayTx(t, r, fee, msgs...))
+	require.NotEqual(t, uint32(0), result.Code)
+	require.Contains(t, result.Log, "insufficient funds")
+	require.Equal(t, math.NewInt(1_000), r.balance(authtypes.NewModuleAddress(types.RelayFeeBudgetName)))
+}
@@ -0,0 +1,339 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simapp_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/simapp"
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Deposit, attest and receive round-trip
+ * Bank sends to the module account are blocked
+ * Deposit with insufficient balance
+ * Receive to a blacklisted recipient
+ * Receive with an exhausted minter allowance
+ * Deposit while the fiat token factory is paused
+ */
+
+const remoteDomain = 0
+
+var (
+	remoteToken          = append(make([]byte, 12), []byte("remote usdc token 20")...)
+	remoteTokenMessenger = append(make([]byte, 12), []byte("remote messenger 020")...)
+)
+
+type roundTrip struct {
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	server    types.MsgServer
+	attesters attester.Set
+	user      sdk.AccAddress
+}
+
+func setupRoundTrip(t *testing.T) *roundTrip {
+	user := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	app := simapp.Setup(t, banktypes.Balance{
+		Address: user.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1_000_000)),
+	})
+	ctx := app.NewTestContext()
+
+	attesters := attester.GenerateSet(2)
+	for _, a := range attesters.Attesters() {
+		app.CCTPKeeper.SetAttester(ctx, a)
+	}
+	app.CCTPKeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+	app.CCTPKeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{DomainId: remoteDomain, Address: remoteTokenMessenger})
+	app.CCTPKeeper.SetTokenPair(ctx, types.TokenPair{RemoteDomain: remoteDomain, RemoteToken: remoteToken, LocalToken: simapp.Denom})
+	app.CCTPKeeper.SetPerMessageBurnLimit(ctx, types.PerMessageBurnLimit{Denom: simapp.Denom, Amount: math.NewInt(1_000_000)})
+
+	return &roundTrip{
+		app:       app,
+		ctx:       ctx,
+		server:    keeper.NewMsgServerImpl(app.CCTPKeeper),
+		attesters: attesters,
+		user:      user,
+	}
+}
+
+func (r *roundTrip) balance(address sdk.AccAddress) math.Int {
+	return r.app.BankKeeper.GetBalance(r.ctx, address, simapp.Denom).Amount
+}
+
+func (r *roundTrip) supply() math.Int {
+	return r.app.BankKeeper.GetSupply(r.ctx, simapp.Denom).Amount
+}
+
+// exec runs fn in a cached context that is only written on success, as a
+// failed transaction would be reverted.
+func (r *roundTrip) exec(fn func(ctx sdk.Context) error) (sdk.Context, error) {
+	ctx, write := r.ctx.CacheContext()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	if err := fn(ctx); err != nil {
+		return ctx, err
+	}
+	write()
+	return ctx, nil
+}
+
+// deposit burns amount from the user and returns the emitted message.
+func (r *roundTrip) deposit(t *testing.T, amount int64, mintRecipient []byte) (*types.Message, error) {
+	ctx, err := r.exec(func(ctx sdk.Context) error {
+		_, err := r.server.DepositForBurn(ctx, &types.MsgDepositForBurn{
+			From:              r.user.String(),
+			Amount:            math.NewInt(amount),
+			DestinationDomain: remoteDomain,
+			MintRecipient:     mintRecipient,
+			BurnToken:         simapp.Denom,
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	messages := types.MessageSentEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, messages, 1)
+	message, err := new(types.Message).Parse(messages[0])
+	require.NoError(t, err)
+	return message, nil
+}
+
+// returnMessage builds the message the remote domain emits when it burns
+// amount back to recipient on Noble.
+func returnMessage(t *testing.T, nonce uint64, amount math.Int, recipient sdk.AccAddress) []byte {
+	mintRecipient := make([]byte, 32)
+	copy(mintRecipient[32-len(recipient):], recipient)
+
+	body, err := (&types.BurnMessage{
+		Version:       types.MessageBodyVersion,
+		BurnToken:     remoteToken,
+		MintRecipient: mintRecipient,
+		Amount:        amount,
+		MessageSender: mintRecipient,
+	}).Bytes()
+	require.NoError(t, err)
+
+	message, err := (&types.Message{
+		Version:           types.NobleMessageVersion,
+		SourceDomain:      remoteDomain,
+		DestinationDomain: types.NobleDomainId,
+		Nonce:             nonce,
+		Sender:            remoteTokenMessenger,
+		Recipient:         types.PaddedModuleAddress,
+		DestinationCaller: make([]byte, types.DestinationCallerLen),
+		MessageBody:       body,
+	}).Bytes()
+	require.NoError(t, err)
+
+	return message
+}
+
+func (r *roundTrip) receive(message []byte) error {
+	_, err := r.exec(func(ctx sdk.Context) error {
+		_, err := r.server.ReceiveMessage(ctx, &types.MsgReceiveMessage{
+			From:        sample.AccAddress(),
+			Message:     message,
+			Attestation: r.attesters.Attest(message),
+		})
+		return err
+	})
+	return err
+}
+
+func TestRoundTrip(t *testing.T) {
+	r := setupRoundTrip(t)
+	initialSupply := r.supply()
+
+	recipient := make([]byte, 32)
+	recipient[31] = 1
+	sent, err := r.deposit(t, 400_000, recipient)
+	require.NoError(t, err)
+
+	burnMessage, err := new(types.BurnMessage).Parse(sent.MessageBody)
+	require.NoError(t, err)
+	require.Equal(t, uint32(types.NobleDomainId), sent.SourceDomain)
+	require.Equal(t, uint32(remoteDomain), sent.DestinationDomain)
+	require.Equal(t, remoteTokenMessenger, sent.Recipient)
+	require.Equal(t, crypto.Keccak256([]byte(simapp.Denom)), burnMessage.BurnToken)
+	require.Equal(t, recipient, burnMessage.MintRecipient)
+	require.Equal(t, r.user.Bytes(), burnMessage.MessageSender[12:])
+	require.Equal(t, math.NewInt(400_000), burnMessage.Amount)
+
+	require.Equal(t, math.NewInt(600_000), r.balance(r.user))
+	require.True(t, r.balance(types.ModuleAddress).IsZero())
+	require.Equal(t, initialSupply.SubRaw(400_000), r.supply())
+
+	message := returnMessage(t, sent.Nonce, burnMessage.Amount, r.user)
+	require.NoError(t, r.receive(message))
+
+	require.Equal(t, math.NewInt(1_000_000), r.balance(r.user))
+	require.True(t, r.balance(types.ModuleAddress).IsZero())
+	require.Equal(t, initialSupply, r.supply())
+	require.True(t, r.app.CCTPKeeper.GetUsedNonce(r.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: sent.Nonce}))
+
+	minter, found := r.app.FiatTokenFactoryKeeper.GetMinters(r.ctx, types.ModuleAddress.String())
+	require.True(t, found)
+	require.Equal(t, math.NewIntWithDecimal(1, 30).SubRaw(400_000), minter.Allowance.Amount)
+
+	msg, broken := keeper.AllInvariants(r.app.CCTPKeeper)(r.ctx)
+	require.False(t, broken, msg)
+
+	// the same message can not be received twice
+	require.ErrorContains(t, r.receive(message), "nonce already used")
+	require.Equal(t, math.NewInt(1_000_000), r.balance(r.user))
+}
+
+func TestRoundTrip32ByteRecipient(t *testing.T) {
+	r := setupRoundTrip(t)
+
+	recipient := sdk.AccAddress(address.Module("icahost", []byte("connection-0"), []byte("owner")))
+	require.Len(t, recipient, 32)
+
+	// unregistered, the mint is rejected rather than truncated to the last 20 bytes
+	message := returnMessage(t, 1, math.NewInt(1_000), recipient)
+	require.ErrorIs(t, r.receive(message), types.ErrInvalidMintRecipient)
+	require.True(t, r.balance(recipient[12:]).IsZero())
+	require.False(t, r.app.CCTPKeeper.GetUsedNonce(r.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: 1}))
+
+	// the module controlling the account registers it, as it can not sign
+	require.NoError(t, r.app.CCTPKeeper.RegisterAccount32(r.ctx, recipient))
+	require.NoError(t, r.receive(message))
+	require.Equal(t, math.NewInt(1_000), r.balance(recipient))
+	require.True(t, r.balance(recipient[12:]).IsZero())
+}
+
+func TestRoundTrip32ByteSender(t *testing.T) {
+	r := setupRoundTrip(t)
+
+	sender := sdk.AccAddress(address.Module("wasm", []byte("contract")))
+	require.NoError(t, r.app.BankKeeper.SendCoins(r.ctx, r.user, sender, sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1_000))))
+	r.user = sender
+
+	recipient := make([]byte, 32)
+	recipient[31] = 1
+	sent, err := r.deposit(t, 1_000, recipient)
+	require.NoError(t, err)
+
+	burnMessage, err := new(types.BurnMessage).Parse(sent.MessageBody)
+	require.NoError(t, err)
+	require.Equal(t, sender.Bytes(), burnMessage.MessageSender)
+
+	originalMessage, err := sent.Bytes()
+	require.NoError(t, err)
+	_, err = r.exec(func(ctx sdk.Context) error {
+		_, err := r.server.ReplaceDepositForBurn(ctx, &types.MsgReplaceDepositForBurn{
+			From:                 sender.String(),
+			OriginalMessage:      originalMessage,
+			OriginalAttestation:  r.attesters.Attest(originalMessage),
+			NewDestinationCaller: make([]byte, types.DestinationCallerLen),
+			NewMintRecipient:     recipient,
+		})
+		return err
+	})
+	require.NoError(t, err)
+}
+
+func TestRoundTripModuleAccountBlocked(t *testing.T) {
+	r := setupRoundTrip(t)
+
+	// sends to the module account would break the module balance invariant
+	_, err := bankkeeper.NewMsgServerImpl(r.app.BankKeeper).Send(r.ctx, &banktypes.MsgSend{
+		FromAddress: r.user.String(),
+		ToAddress:   types.ModuleAddress.String(),
+		Amount:      sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1)),
+	})
+	require.ErrorContains(t, err, "not allowed to receive funds")
+	require.True(t, r.balance(types.ModuleAddress).IsZero())
+
+	// deposits are still sent to the module account before being burned
+	_, err = r.deposit(t, 1_000, crypto.Keccak256([]byte("recipient")))
+	require.NoError(t, err)
+
+	msg, broken := keeper.AllInvariants(r.app.CCTPKeeper)(r.ctx)
+	require.False(t, broken, msg)
+}
+
+func TestRoundTripInsufficientBalance(t *testing.T) {
+	r := setupRoundTrip(t)
+	initialSupply := r.supply()
+
+	r.app.CCTPKeeper.SetPerMessageBurnLimit(r.ctx, types.PerMessageBurnLimit{Denom: simapp.Denom, Amount: math.NewInt(2_000_000)})
+
+	_, err := r.deposit(t, 1_000_001, crypto.Keccak256([]byte("recipient")))
+	require.ErrorContains(t, err, "insufficient funds")
+
+	require.Equal(t, math.NewInt(1_000_000), r.balance(r.user))
+	require.Equal(t, initialSupply, r.supply())
+}
+
+func TestRoundTripBlacklistedRecipient(t *testing.T) {
+	r := setupRoundTrip(t)
+	initialSupply := r.supply()
+
+	recipient := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	r.app.FiatTokenFactoryKeeper.SetBlacklisted(r.ctx, fiattokenfactorytypes.Blacklisted{AddressBz: recipient})
+
+	err := r.receive(returnMessage(t, 1, math.NewInt(1_000), recipient))
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrMint)
+
+	require.True(t, r.balance(recipient).IsZero())
+	require.Equal(t, initialSupply, r.supply())
+}
+
+func TestRoundTripMinterAllowanceExhausted(t *testing.T) {
+	r := setupRoundTrip(t)
+	initialSupply := r.supply()
+
+	r.app.FiatTokenFactoryKeeper.SetMinters(r.ctx, fiattokenfactorytypes.Minters{
+		Address:   types.ModuleAddress.String(),
+		Allowance: sdk.NewInt64Coin(simapp.Denom, 999),
+	})
+
+	err := r.receive(returnMessage(t, 1, math.NewInt(1_000), r.user))
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrMint)
+
+	require.Equal(t, math.NewInt(1_000_000), r.balance(r.user))
+	require.Equal(t, initialSupply, r.supply())
+}
+
+func TestRoundTripFiatTokenFactoryPaused(t *testing.T) {
+	r := setupRoundTrip(t)
+	initialSupply := r.supply()
+
+	r.app.FiatTokenFactoryKeeper.SetPaused(r.ctx, fiattokenfactorytypes.Paused{Paused: true})
+
+	_, err := r.deposit(t, 1_000, crypto.Keccak256([]byte("recipient")))
+	require.ErrorIs(t, err, fiattokenfactorytypes.ErrPaused)
+
+	require.Equal(t, math.NewInt(1_000_000), r.balance(r.user))
+	require.Equal(t, initialSupply, r.supply())
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simapp_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/circlefin/noble-cctp/simapp"
+	cctpsimulation "github.com/circlefin/noble-cctp/x/cctp/simulation"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	"github.com/stretchr/testify/require"
+)
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// TestAppSimulation runs a short simulation on every test run. Pass -Enabled
+// to use the standard simulator flags instead, e.g.
+// go test ./simapp -run TestAppSimulation -Enabled -NumBlocks=500 -Seed=7.
+func TestAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = "cctp-sim"
+	if !simcli.FlagEnabledValue {
+		config.NumBlocks = 30
+		config.BlockSize = 50
+		config.Commit = true
+	}
+
+	app, err := simapp.NewSimApp(log.NewNopLogger(), dbm.NewMemDB(), nil, true, simtestutil.EmptyAppOptions{}, baseapp.SetChainID(config.ChainID))
+	require.NoError(t, err)
+
+	_, _, err = simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		appStateFn(app),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		nil,
+		config,
+		app.AppCodec(),
+	)
+	require.NoError(t, err)
+}
+
+// appStateFn extends the randomized genesis with USDCGenesis and gives every
+// simulated account a balance of the linked denom, so that burns and mints
+// are actually executed rather than skipped.
+func appStateFn(app *simapp.SimApp) simtypes.AppStateFn {
+	cdc := app.AppCodec()
+	return simtestutil.AppStateFnWithExtendedCb(cdc, app.SimulationManager(), app.DefaultGenesis(), func(rawState map[string]json.RawMessage) {
+		var authGenesis authtypes.GenesisState
+		cdc.MustUnmarshalJSON(rawState[authtypes.ModuleName], &authGenesis)
+		accounts, err := authtypes.UnpackAccounts(authGenesis.Accounts)
+		if err != nil {
+			panic(err)
+		}
+		users := make(map[string]bool)
+		for _, account := range accounts {
+			if _, ok := account.(sdk.ModuleAccountI); !ok {
+				users[account.GetAddress().String()] = true
+			}
+		}
+
+		var bankGenesis banktypes.GenesisState
+		cdc.MustUnmarshalJSON(rawState[banktypes.ModuleName], &bankGenesis)
+		funds := sdk.NewCoins(sdk.NewInt64Coin(cctpsimulation.Denom, 1_000_000_000_000))
+		for i := range bankGenesis.Balances {
+			if !users[bankGenesis.Balances[i].Address] {
+				continue
+			}
+			bankGenesis.Balances[i].Coins = bankGenesis.Balances[i].Coins.Add(funds...)
+			bankGenesis.Supply = bankGenesis.Supply.Add(funds...)
+		}
+		rawState[banktypes.ModuleName] = cdc.MustMarshalJSON(&bankGenesis)
+
+		simapp.USDCGenesis(cdc, rawState)
+	})
+}
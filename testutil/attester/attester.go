@@ -0,0 +1,183 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package attester provides a local stand-in for Circle's attestation
+// service, so that tests can produce valid and deliberately malformed
+// attestations without any network access.
+package attester
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Key is a single secp256k1 attester key.
+type Key struct {
+	PrivateKey *ecdsa.PrivateKey
+}
+
+// Address returns the Ethereum style address of the attester, which is used
+// to order signatures inside an attestation.
+func (k Key) Address() common.Address {
+	return crypto.PubkeyToAddress(k.PrivateKey.PublicKey)
+}
+
+// PublicKey returns the hex encoded uncompressed public key of the attester,
+// as it is stored in the CCTP module.
+func (k Key) PublicKey() string {
+	return hex.EncodeToString(crypto.FromECDSAPub(&k.PrivateKey.PublicKey))
+}
+
+// Attester returns the attester as it is stored in the CCTP module.
+func (k Key) Attester() types.Attester {
+	return types.Attester{Attester: k.PublicKey()}
+}
+
+// Sign returns the 65 byte signature of the keccak256 hash of message, with
+// a v-value of either 0 or 1.
+func (k Key) Sign(message []byte) []byte {
+	signature, err := crypto.Sign(crypto.Keccak256(message), k.PrivateKey)
+	if err != nil {
+		panic(fmt.Sprintf("failed to sign message: %s", err))
+	}
+
+	return signature
+}
+
+// Set is a set of attester keys.
+type Set []Key
+
+// NewSet wraps existing private keys in a Set.
+func NewSet(privateKeys ...*ecdsa.PrivateKey) Set {
+	set := make(Set, len(privateKeys))
+	for i, privateKey := range privateKeys {
+		set[i] = Key{PrivateKey: privateKey}
+	}
+
+	return set
+}
+
+// GenerateSet returns a set of n randomly generated attester keys.
+func GenerateSet(n int) Set {
+	set := make(Set, n)
+	for i := range set {
+		privateKey, err := crypto.GenerateKey()
+		if err != nil {
+			panic(fmt.Sprintf("failed to generate key: %s", err))
+		}
+		set[i] = Key{PrivateKey: privateKey}
+	}
+
+	return set
+}
+
+// DeterministicSet returns a set of n attester keys derived from seed. The
+// same seed always produces the same keys, which is useful for reproducible
+// tests and simulations.
+func DeterministicSet(seed []byte, n int) Set {
+	set := make(Set, n)
+	for i := range set {
+		material := crypto.Keccak256(seed, []byte(fmt.Sprintf("/%d", i)))
+		privateKey, err := crypto.ToECDSA(material)
+		if err != nil {
+			panic(fmt.Sprintf("failed to derive key: %s", err))
+		}
+		set[i] = Key{PrivateKey: privateKey}
+	}
+
+	return set
+}
+
+// Attesters returns the set as it is stored in the CCTP module.
+func (s Set) Attesters() []types.Attester {
+	attesters := make([]types.Attester, len(s))
+	for i, key := range s {
+		attesters[i] = key.Attester()
+	}
+
+	return attesters
+}
+
+// Sorted returns a copy of the set, sorted by increasing attester address.
+func (s Set) Sorted() Set {
+	sorted := make(Set, len(s))
+	copy(sorted, s)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Address().Bytes(), sorted[j].Address().Bytes()) < 0
+	})
+
+	return sorted
+}
+
+// Attest returns a valid attestation of message, signed by every key in the
+// set and ordered by increasing attester address.
+func (s Set) Attest(message []byte) []byte {
+	return s.Sorted().attest(message)
+}
+
+// AttestUnsorted returns an attestation of message whose signatures are in
+// strictly decreasing order of attester address. For sets of two or more
+// keys this is always rejected.
+func (s Set) AttestUnsorted(message []byte) []byte {
+	sorted := s.Sorted()
+
+	reversed := make(Set, len(sorted))
+	for i, key := range sorted {
+		reversed[len(sorted)-1-i] = key
+	}
+
+	return reversed.attest(message)
+}
+
+// AttestWithDuplicate returns an attestation of message in which the
+// signature of the last attester (by address) appears twice. The resulting
+// attestation is one signature longer than the set.
+func (s Set) AttestWithDuplicate(message []byte) []byte {
+	sorted := s.Sorted()
+	if len(sorted) == 0 {
+		return nil
+	}
+
+	return append(sorted, sorted[len(sorted)-1]).attest(message)
+}
+
+// AttestLegacy returns a valid attestation of message in which each
+// signature uses a legacy v-value of 27 or 28, as produced by some signers.
+func (s Set) AttestLegacy(message []byte) []byte {
+	attestation := s.Attest(message)
+	for i := types.SignatureLength - 1; i < len(attestation); i += types.SignatureLength {
+		attestation[i] += 27
+	}
+
+	return attestation
+}
+
+func (s Set) attest(message []byte) []byte {
+	attestation := make([]byte, 0, len(s)*types.SignatureLength)
+	for _, key := range s {
+		attestation = append(attestation, key.Sign(message)...)
+	}
+
+	return attestation
+}
@@ -0,0 +1,120 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attester
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// BLSSet is a set of BLS12-381 attester secret keys.
+type BLSSet []*big.Int
+
+// DeterministicBLSSet returns a set of n BLS12-381 attester keys derived
+// from seed.
+func DeterministicBLSSet(seed []byte, n int) BLSSet {
+	set := make(BLSSet, n)
+	for i := range set {
+		var secret fr.Element
+		secret.SetBytes(crypto.Keccak256(seed, []byte(fmt.Sprintf("/bls/%d", i))))
+		set[i] = secret.BigInt(new(big.Int))
+	}
+
+	return set
+}
+
+// PublicKey returns the compressed G1 public key of the i-th key in the set.
+func (s BLSSet) PublicKey(i int) []byte {
+	var publicKey bls12381.G1Affine
+	publicKey.ScalarMultiplicationBase(s[i])
+	bz := publicKey.Bytes()
+
+	return bz[:]
+}
+
+// Attesters returns the set as it is stored in the CCTP module.
+func (s BLSSet) Attesters() []types.Attester {
+	attesters := make([]types.Attester, len(s))
+	for i := range s {
+		attesters[i] = types.Attester{
+			Attester: hex.EncodeToString(s.PublicKey(i)),
+			KeyType:  types.KeyType_KEY_TYPE_BLS12_381,
+		}
+	}
+
+	return attesters
+}
+
+// Sorted returns a copy of the set, sorted by increasing public key. This is
+// the order of the signer bitmap of an attestation.
+func (s BLSSet) Sorted() BLSSet {
+	sorted := make(BLSSet, len(s))
+	copy(sorted, s)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted.PublicKey(i), sorted.PublicKey(j)) < 0
+	})
+
+	return sorted
+}
+
+// Attest returns a valid attestation of message, signed by every key in the
+// set. The set must be exactly the enabled BLS12-381 attesters.
+func (s BLSSet) Attest(message []byte) []byte {
+	signers := make([]int, len(s))
+	for i := range signers {
+		signers[i] = i
+	}
+
+	return s.AttestBy(message, signers...)
+}
+
+// AttestBy returns an attestation of message signed by the keys at the given
+// indices of the sorted set. The set must be exactly the enabled BLS12-381
+// attesters.
+func (s BLSSet) AttestBy(message []byte, signers ...int) []byte {
+	sorted := s.Sorted()
+
+	hash, err := bls12381.HashToG2(crypto.Keccak256(message), types.BLSDomainSeparationTag)
+	if err != nil {
+		panic(fmt.Sprintf("failed to hash message: %s", err))
+	}
+
+	bitmap := make([]byte, (len(sorted)+7)/8)
+	var aggregate bls12381.G2Jac
+	for _, i := range signers {
+		bitmap[i/8] |= 1 << (i % 8)
+
+		var signature bls12381.G2Affine
+		signature.ScalarMultiplication(&hash, sorted[i])
+		aggregate.AddMixed(&signature)
+	}
+
+	var signature bls12381.G2Affine
+	signature.FromJacobian(&aggregate)
+	bz := signature.Bytes()
+
+	return append(bitmap, bz[:]...)
+}
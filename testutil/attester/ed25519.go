@@ -0,0 +1,81 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package attester
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"fmt"
+	"sort"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Ed25519Set is a set of ed25519 attester keys.
+type Ed25519Set []ed25519.PrivateKey
+
+// DeterministicEd25519Set returns a set of n ed25519 attester keys derived
+// from seed.
+func DeterministicEd25519Set(seed []byte, n int) Ed25519Set {
+	set := make(Ed25519Set, n)
+	for i := range set {
+		set[i] = ed25519.NewKeyFromSeed(crypto.Keccak256(seed, []byte(fmt.Sprintf("/ed25519/%d", i))))
+	}
+
+	return set
+}
+
+// Attesters returns the set as it is stored in the CCTP module.
+func (s Ed25519Set) Attesters() []types.Attester {
+	attesters := make([]types.Attester, len(s))
+	for i, key := range s {
+		attesters[i] = types.Attester{
+			Attester: hex.EncodeToString(key.Public().(ed25519.PublicKey)),
+			KeyType:  types.KeyType_KEY_TYPE_ED25519,
+		}
+	}
+
+	return attesters
+}
+
+// Sorted returns a copy of the set, sorted by increasing public key.
+func (s Ed25519Set) Sorted() Ed25519Set {
+	sorted := make(Ed25519Set, len(s))
+	copy(sorted, s)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return bytes.Compare(sorted[i].Public().(ed25519.PublicKey), sorted[j].Public().(ed25519.PublicKey)) < 0
+	})
+
+	return sorted
+}
+
+// Attest returns a valid attestation of message, signed by every key in the
+// set and ordered by increasing public key.
+func (s Ed25519Set) Attest(message []byte) []byte {
+	digest := crypto.Keccak256(message)
+
+	attestation := make([]byte, 0, len(s)*types.Ed25519AttestationEntry)
+	for _, key := range s.Sorted() {
+		attestation = append(attestation, key.Public().(ed25519.PublicKey)...)
+		attestation = append(attestation, ed25519.Sign(key, digest)...)
+	}
+
+	return attestation
+}
@@ -0,0 +1,123 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package iris
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	rpchttp "github.com/cometbft/cometbft/rpc/client/http"
+	cmttypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+)
+
+// Feed is a source of sent CCTP messages.
+type Feed interface {
+	// Messages streams the bytes of every observed MessageSent event until
+	// ctx is cancelled.
+	Messages(ctx context.Context) (<-chan []byte, error)
+}
+
+var _ Feed = &MemoryFeed{}
+
+// MemoryFeed is an in-memory Feed, useful for tests that do not run a node.
+type MemoryFeed struct {
+	messages chan []byte
+}
+
+// NewMemoryFeed returns a MemoryFeed that buffers up to size unread messages.
+func NewMemoryFeed(size int) *MemoryFeed {
+	return &MemoryFeed{messages: make(chan []byte, size)}
+}
+
+// Publish adds a message to the feed.
+func (f *MemoryFeed) Publish(message []byte) {
+	f.messages <- message
+}
+
+// Close stops the feed once all published messages have been read.
+func (f *MemoryFeed) Close() {
+	close(f.messages)
+}
+
+func (f *MemoryFeed) Messages(_ context.Context) (<-chan []byte, error) {
+	return f.messages, nil
+}
+
+var _ Feed = &CometFeed{}
+
+// CometFeed streams MessageSent events from a CometBFT node over websocket.
+type CometFeed struct {
+	client *rpchttp.HTTP
+}
+
+// NewCometFeed returns a CometFeed connected to the node RPC at address,
+// for example tcp://localhost:26657.
+func NewCometFeed(address string) (*CometFeed, error) {
+	client, err := rpchttp.New(address, "/websocket")
+	if err != nil {
+		return nil, err
+	}
+
+	return &CometFeed{client: client}, nil
+}
+
+func (f *CometFeed) Messages(ctx context.Context) (<-chan []byte, error) {
+	if err := f.client.Start(); err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("tm.event='Tx' AND %s.message EXISTS", proto.MessageName(&types.MessageSent{}))
+	events, err := f.client.Subscribe(ctx, "mock-iris", query)
+	if err != nil {
+		_ = f.client.Stop()
+		return nil, err
+	}
+
+	messages := make(chan []byte)
+	go func() {
+		defer close(messages)
+		defer func() { _ = f.client.Stop() }()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				data, ok := event.Data.(cmttypes.EventDataTx)
+				if !ok {
+					continue
+				}
+
+				for _, message := range types.MessageSentEvents(data.Result.Events) {
+					select {
+					case messages <- message:
+					case <-ctx.Done():
+						return
+					}
+				}
+			}
+		}
+	}()
+
+	return messages, nil
+}
@@ -0,0 +1,173 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package iris provides a local stand-in for Circle's Iris attestation API.
+// It observes MessageSent events, signs them with configured test attester
+// keys, and serves the resulting attestations over the same HTTP interface
+// relayers use in production.
+package iris
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+const (
+	// StatusComplete is the status of an attestation that is ready to be used.
+	StatusComplete = "complete"
+	// StatusPendingConfirmations is the status of a message that has been
+	// observed but not yet attested.
+	StatusPendingConfirmations = "pending_confirmations"
+)
+
+// AttestationResponse is the JSON body returned by GET /attestations/{hash}.
+type AttestationResponse struct {
+	Attestation string `json:"attestation"`
+	Status      string `json:"status"`
+}
+
+// ErrorResponse is the JSON body returned when a request fails.
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// Server signs observed messages and serves their attestations.
+type Server struct {
+	signers attester.Set
+
+	mu           sync.RWMutex
+	attestations map[common.Hash][]byte
+	pending      map[common.Hash]struct{}
+}
+
+// NewServer returns a Server that attests messages with every key in
+// signers. The number of signers must equal the signature threshold
+// configured on the destination chain.
+func NewServer(signers attester.Set) *Server {
+	return &Server{
+		signers:      signers,
+		attestations: make(map[common.Hash][]byte),
+		pending:      make(map[common.Hash]struct{}),
+	}
+}
+
+// Attest signs message, stores the attestation, and returns the message hash.
+func (s *Server) Attest(message []byte) common.Hash {
+	hash := crypto.Keccak256Hash(message)
+	attestation := s.signers.Attest(message)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.attestations[hash] = attestation
+	delete(s.pending, hash)
+
+	return hash
+}
+
+// MarkPending records that a message has been observed without attesting it,
+// so that relayers can exercise their polling logic.
+func (s *Server) MarkPending(message []byte) common.Hash {
+	hash := crypto.Keccak256Hash(message)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.attestations[hash]; !found {
+		s.pending[hash] = struct{}{}
+	}
+
+	return hash
+}
+
+// Attestation returns the stored attestation for a message hash.
+func (s *Server) Attestation(hash common.Hash) (attestation []byte, found bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	attestation, found = s.attestations[hash]
+	return attestation, found
+}
+
+// Run attests every message received from feed until ctx is cancelled or
+// the feed is closed.
+func (s *Server) Run(ctx context.Context, feed Feed) error {
+	messages, err := feed.Messages(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case message, ok := <-messages:
+			if !ok {
+				return nil
+			}
+			s.Attest(message)
+		}
+	}
+}
+
+// Handler returns the HTTP handler serving the attestation API.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /attestations/{hash}", s.handleAttestation)
+	return mux
+}
+
+func (s *Server) handleAttestation(w http.ResponseWriter, r *http.Request) {
+	bz, err := hexutil.Decode(r.PathValue("hash"))
+	if err != nil || len(bz) != common.HashLength {
+		writeJSON(w, http.StatusBadRequest, ErrorResponse{Error: "Invalid message hash"})
+		return
+	}
+	hash := common.BytesToHash(bz)
+
+	s.mu.RLock()
+	attestation, found := s.attestations[hash]
+	_, pending := s.pending[hash]
+	s.mu.RUnlock()
+
+	switch {
+	case found:
+		writeJSON(w, http.StatusOK, AttestationResponse{
+			Attestation: hexutil.Encode(attestation),
+			Status:      StatusComplete,
+		})
+	case pending:
+		writeJSON(w, http.StatusOK, AttestationResponse{
+			Attestation: "PENDING",
+			Status:      StatusPendingConfirmations,
+		})
+	default:
+		writeJSON(w, http.StatusNotFound, ErrorResponse{Error: "Message hash not found"})
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
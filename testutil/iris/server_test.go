@@ -0,0 +1,106 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package iris_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/testutil/iris"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestServerAttestsMessagesFromFeed(t *testing.T) {
+	signers := attester.GenerateSet(2)
+	server := iris.NewServer(signers)
+	httpServer := httptest.NewServer(server.Handler())
+	defer httpServer.Close()
+
+	feed := iris.NewMemoryFeed(1)
+	message := []byte("a message sent from noble")
+	feed.Publish(message)
+	feed.Close()
+
+	require.NoError(t, server.Run(context.Background(), feed))
+
+	res := getAttestation(t, httpServer.URL, hexutil.Encode(crypto.Keccak256(message)), http.StatusOK)
+	require.Equal(t, iris.StatusComplete, res.Status)
+
+	attestation, err := hexutil.Decode(res.Attestation)
+	require.NoError(t, err)
+	require.NoError(t, keeper.VerifyAttestationSignatures(message, attestation, signers.Attesters(), uint32(len(signers))))
+}
+
+func TestServerPendingAttestation(t *testing.T) {
+	server := iris.NewServer(attester.GenerateSet(1))
+	httpServer := httptest.NewServer(server.Handler())
+	defer httpServer.Close()
+
+	message := []byte("a message that is not yet attested")
+	hash := server.MarkPending(message)
+
+	res := getAttestation(t, httpServer.URL, hash.Hex(), http.StatusOK)
+	require.Equal(t, iris.StatusPendingConfirmations, res.Status)
+	require.Equal(t, "PENDING", res.Attestation)
+
+	server.Attest(message)
+
+	res = getAttestation(t, httpServer.URL, hash.Hex(), http.StatusOK)
+	require.Equal(t, iris.StatusComplete, res.Status)
+}
+
+func TestServerUnknownAndInvalidHash(t *testing.T) {
+	server := iris.NewServer(attester.GenerateSet(1))
+	httpServer := httptest.NewServer(server.Handler())
+	defer httpServer.Close()
+
+	getAttestation(t, httpServer.URL, hexutil.Encode(crypto.Keccak256([]byte("unknown"))), http.StatusNotFound)
+	getAttestation(t, httpServer.URL, "0x1234", http.StatusBadRequest)
+}
+
+func TestServerRunStopsOnCancel(t *testing.T) {
+	server := iris.NewServer(attester.GenerateSet(1))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	err := server.Run(ctx, iris.NewMemoryFeed(0))
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func getAttestation(t *testing.T, url string, hash string, status int) (res iris.AttestationResponse) {
+	t.Helper()
+
+	resp, err := http.Get(url + "/attestations/" + hash)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	require.Equal(t, status, resp.StatusCode)
+	if status == http.StatusOK {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&res))
+	}
+
+	return res
+}
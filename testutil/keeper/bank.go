@@ -30,6 +30,10 @@ type MockBankKeeper struct{}
 
 var _ types.BankKeeper = MockBankKeeper{}
 
+func (MockBankKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return nil
+}
+
 func (MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
 	return nil
 }
@@ -37,14 +41,26 @@ func (MockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAd
 func (MockBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	return sdk.Coin{
 		Denom:  "uusdc",
-		Amount: math.Int{},
+		Amount: math.ZeroInt(),
 	}
 }
 
+func (MockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (MockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return addr.Equals(types.ModuleAddress)
+}
+
 type MockErrBankKeeper struct{}
 
 var _ types.BankKeeper = MockErrBankKeeper{}
 
+func (MockErrBankKeeper) SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return errors.New("intentional error")
+}
+
 func (MockErrBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
 	return errors.New("intentional error")
 }
@@ -52,6 +68,14 @@ func (MockErrBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, sende
 func (MockErrBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
 	return sdk.Coin{
 		Denom:  "uusdc",
-		Amount: math.Int{},
+		Amount: math.ZeroInt(),
 	}
 }
+
+func (MockErrBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return sdk.Coins{}
+}
+
+func (MockErrBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return addr.Equals(types.ModuleAddress)
+}
@@ -52,6 +52,7 @@ func CctpKeeperWithKey(key *storetypes.KVStoreKey) (*keeper.Keeper, context.Cont
 		cdc,
 		logger,
 		runtime.NewKVStoreService(key),
+		types.DefaultLocalDomain(),
 		MockBankKeeper{},
 		MockFiatTokenfactoryKeeper{},
 	)
@@ -76,6 +77,7 @@ func CctpKeeperWithErrBank() (*keeper.Keeper, context.Context) {
 		cdc,
 		logger,
 		runtime.NewKVStoreService(key),
+		types.DefaultLocalDomain(),
 		MockErrBankKeeper{},
 		MockFiatTokenfactoryKeeper{},
 	)
@@ -100,6 +102,7 @@ func CctpKeeperWithErrFTF() (*keeper.Keeper, context.Context) {
 		cdc,
 		logger,
 		runtime.NewKVStoreService(key),
+		types.DefaultLocalDomain(),
 		MockBankKeeper{},
 		MockErrFiatTokenfactoryKeeper{},
 	)
@@ -108,3 +111,28 @@ func CctpKeeperWithErrFTF() (*keeper.Keeper, context.Context) {
 
 	return k, ctx
 }
+
+func CctpKeeperWithKeepers(bank types.BankKeeper, fiattokenfactory types.FiatTokenfactoryKeeper) (*keeper.Keeper, context.Context) {
+	logger := log.NewNopLogger()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	stateStore := store.NewCommitMultiStore(db.NewMemDB(), logger, metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	_ = stateStore.LoadLatestVersion()
+
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	k := keeper.NewKeeper(
+		cdc,
+		logger,
+		runtime.NewKVStoreService(key),
+		types.DefaultLocalDomain(),
+		bank,
+		fiattokenfactory,
+	)
+
+	ctx := sdk.NewContext(stateStore, cmtproto.Header{}, false, logger)
+
+	return k, ctx
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	cctpTypes "github.com/circlefin/noble-cctp/x/cctp/types"
 	ftftypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -41,6 +42,10 @@ func (MockFiatTokenfactoryKeeper) GetMintingDenom(ctx context.Context) (val ftft
 	return ftftypes.MintingDenom{Denom: "uusdc"}
 }
 
+func (MockFiatTokenfactoryKeeper) GetMinters(ctx context.Context, address string) (val ftftypes.Minters, found bool) {
+	return ftftypes.Minters{Address: address, Allowance: sdk.NewCoin("uusdc", math.NewIntWithDecimal(1, 18))}, true
+}
+
 type MockErrFiatTokenfactoryKeeper struct{}
 
 var _ cctpTypes.FiatTokenfactoryKeeper = MockErrFiatTokenfactoryKeeper{}
@@ -56,3 +61,7 @@ func (k MockErrFiatTokenfactoryKeeper) Mint(ctx sdk.Context, msg *ftftypes.MsgMi
 func (k MockErrFiatTokenfactoryKeeper) GetMintingDenom(ctx context.Context) (val ftftypes.MintingDenom) {
 	return ftftypes.MintingDenom{Denom: "uusdc"}
 }
+
+func (k MockErrFiatTokenfactoryKeeper) GetMinters(ctx context.Context, address string) (val ftftypes.Minters, found bool) {
+	return ftftypes.Minters{}, false
+}
@@ -0,0 +1,126 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sample
+
+import (
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Mainnet USDC and TokenMessenger contracts, plus made-up accounts, used to
+// build synthetic messages.
+var (
+	ethereumUSDC            = padded("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+	ethereumTokenMessenger  = padded("0xBd3fa81B58Ba92a82136038B25aDec7066af3155")
+	avalancheUSDC           = padded("0xB97EF9Ef8734C71904D8002F8b6Bc66Dd9c48a6E")
+	avalancheTokenMessenger = padded("0x6B25532e1060CE10cc3B0A99e5683b91BFDe6982")
+
+	ethereumAccount = padded("0xfCE4cE85e1F74C01e0ecccd8BbC4606f83D3FC90")
+	nobleAccount    = padded("0x57d4eaf1091577a6b7d121202afbd2808134f118")
+)
+
+func padded(address string) []byte {
+	return common.LeftPadBytes(common.FromHex(address), 32)
+}
+
+func mustBytes(t interface{ Bytes() ([]byte, error) }) []byte {
+	bz, err := t.Bytes()
+	if err != nil {
+		panic(err)
+	}
+	return bz
+}
+
+// BurnMessages returns synthetic encoded burn messages to and from Noble, for
+// use as test vectors and fuzz seeds. They are not captured from mainnet.
+func BurnMessages() [][]byte {
+	return [][]byte{
+		// Ethereum -> Noble
+		mustBytes(&types.BurnMessage{
+			BurnToken:     ethereumUSDC,
+			MintRecipient: nobleAccount,
+			Amount:        math.NewInt(1_000_000),
+			MessageSender: ethereumAccount,
+		}),
+		// Avalanche -> Noble
+		mustBytes(&types.BurnMessage{
+			BurnToken:     avalancheUSDC,
+			MintRecipient: nobleAccount,
+			Amount:        math.NewInt(250_000_000_000),
+			MessageSender: ethereumAccount,
+		}),
+		// Noble -> Ethereum
+		mustBytes(&types.BurnMessage{
+			BurnToken:     crypto.Keccak256([]byte("uusdc")),
+			MintRecipient: ethereumAccount,
+			Amount:        math.NewInt(42),
+			MessageSender: nobleAccount,
+		}),
+	}
+}
+
+// Messages returns synthetic encoded messages to and from Noble, with made-up
+// nonces, for use as test vectors and fuzz seeds. They are not captured from
+// mainnet.
+func Messages() [][]byte {
+	burnMessages := BurnMessages()
+	return [][]byte{
+		mustBytes(&types.Message{
+			Version:           0,
+			SourceDomain:      0,
+			DestinationDomain: types.NobleDomainId,
+			Nonce:             149_612,
+			Sender:            ethereumTokenMessenger,
+			Recipient:         types.PaddedModuleAddress,
+			DestinationCaller: make([]byte, types.DestinationCallerLen),
+			MessageBody:       burnMessages[0],
+		}),
+		mustBytes(&types.Message{
+			Version:           0,
+			SourceDomain:      1,
+			DestinationDomain: types.NobleDomainId,
+			Nonce:             98_311,
+			Sender:            avalancheTokenMessenger,
+			Recipient:         types.PaddedModuleAddress,
+			DestinationCaller: nobleAccount,
+			MessageBody:       burnMessages[1],
+		}),
+		mustBytes(&types.Message{
+			Version:           0,
+			SourceDomain:      types.NobleDomainId,
+			DestinationDomain: 0,
+			Nonce:             52_087,
+			Sender:            types.PaddedModuleAddress,
+			Recipient:         ethereumTokenMessenger,
+			DestinationCaller: make([]byte, types.DestinationCallerLen),
+			MessageBody:       burnMessages[2],
+		}),
+		// generic message with an arbitrary body
+		mustBytes(&types.Message{
+			Version:           0,
+			SourceDomain:      types.NobleDomainId,
+			DestinationDomain: 0,
+			Nonce:             52_088,
+			Sender:            nobleAccount,
+			Recipient:         ethereumAccount,
+			DestinationCaller: ethereumAccount,
+			MessageBody:       []byte("hello from noble"),
+		}),
+	}
+}
@@ -0,0 +1,83 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package bindings defines the JSON messages and queries that CosmWasm
+// contracts exchange with the CCTP module.
+package bindings
+
+import "cosmossdk.io/math"
+
+// CCTPMsg is the custom message a contract dispatches. Exactly one field must
+// be set.
+type CCTPMsg struct {
+	DepositForBurn        *DepositForBurn        `json:"deposit_for_burn,omitempty"`
+	SendMessage           *SendMessage           `json:"send_message,omitempty"`
+	ReplaceDepositForBurn *ReplaceDepositForBurn `json:"replace_deposit_for_burn,omitempty"`
+	ReplaceMessage        *ReplaceMessage        `json:"replace_message,omitempty"`
+	ReceiveMessage        *ReceiveMessage        `json:"receive_message,omitempty"`
+
+	EnableContractCallback  *struct{} `json:"enable_contract_callback,omitempty"`
+	DisableContractCallback *struct{} `json:"disable_contract_callback,omitempty"`
+}
+
+// DepositForBurn burns amount of burn_token from the contract and mints it to
+// mint_recipient on the destination domain. If destination_caller is set, only
+// that caller may receive the message.
+type DepositForBurn struct {
+	Amount            math.Int `json:"amount"`
+	DestinationDomain uint32   `json:"destination_domain"`
+	MintRecipient     []byte   `json:"mint_recipient"`
+	BurnToken         string   `json:"burn_token"`
+	DestinationCaller []byte   `json:"destination_caller,omitempty"`
+}
+
+// SendMessage sends message_body to recipient on the destination domain. If
+// destination_caller is set, only that caller may receive the message.
+type SendMessage struct {
+	DestinationDomain uint32 `json:"destination_domain"`
+	Recipient         []byte `json:"recipient"`
+	MessageBody       []byte `json:"message_body"`
+	DestinationCaller []byte `json:"destination_caller,omitempty"`
+}
+
+// ReplaceDepositForBurn replaces the destination caller and mint recipient of
+// a deposit previously sent by the contract.
+type ReplaceDepositForBurn struct {
+	OriginalMessage      []byte `json:"original_message"`
+	OriginalAttestation  []byte `json:"original_attestation"`
+	NewDestinationCaller []byte `json:"new_destination_caller"`
+	NewMintRecipient     []byte `json:"new_mint_recipient"`
+	KeyType              string `json:"key_type,omitempty"`
+}
+
+// ReplaceMessage replaces the body and destination caller of a message
+// previously sent by the contract.
+type ReplaceMessage struct {
+	OriginalMessage      []byte `json:"original_message"`
+	OriginalAttestation  []byte `json:"original_attestation"`
+	NewMessageBody       []byte `json:"new_message_body"`
+	NewDestinationCaller []byte `json:"new_destination_caller"`
+	KeyType              string `json:"key_type,omitempty"`
+}
+
+// ReceiveMessage receives an attested message from another domain, with the
+// contract as caller.
+type ReceiveMessage struct {
+	Message              []byte `json:"message"`
+	Attestation          []byte `json:"attestation"`
+	KeyType              string `json:"key_type,omitempty"`
+	AllowAlreadyReceived bool   `json:"allow_already_received,omitempty"`
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bindings
+
+import "cosmossdk.io/math"
+
+// CCTPQuery is the custom query a contract sends. Exactly one field must be
+// set.
+type CCTPQuery struct {
+	TokenPair            *TokenPairQuery            `json:"token_pair,omitempty"`
+	RemoteTokenMessenger *RemoteTokenMessengerQuery `json:"remote_token_messenger,omitempty"`
+	NextAvailableNonce   *NextAvailableNonceQuery   `json:"next_available_nonce,omitempty"`
+	UsedNonce            *UsedNonceQuery            `json:"used_nonce,omitempty"`
+	PerMessageBurnLimit  *PerMessageBurnLimitQuery  `json:"per_message_burn_limit,omitempty"`
+	LocalDomain          *LocalDomainQuery          `json:"local_domain,omitempty"`
+}
+
+type TokenPairQuery struct {
+	RemoteDomain uint32 `json:"remote_domain"`
+	RemoteToken  []byte `json:"remote_token"`
+}
+
+type TokenPairResponse struct {
+	RemoteDomain uint32 `json:"remote_domain"`
+	RemoteToken  []byte `json:"remote_token"`
+	LocalToken   string `json:"local_token"`
+}
+
+type RemoteTokenMessengerQuery struct {
+	DomainId uint32 `json:"domain_id"`
+}
+
+type RemoteTokenMessengerResponse struct {
+	DomainId uint32 `json:"domain_id"`
+	Address  []byte `json:"address"`
+}
+
+type NextAvailableNonceQuery struct{}
+
+type NextAvailableNonceResponse struct {
+	Nonce uint64 `json:"nonce"`
+}
+
+type UsedNonceQuery struct {
+	SourceDomain uint32 `json:"source_domain"`
+	Nonce        uint64 `json:"nonce"`
+}
+
+type UsedNonceResponse struct {
+	Used bool `json:"used"`
+}
+
+type PerMessageBurnLimitQuery struct {
+	Denom string `json:"denom"`
+}
+
+type PerMessageBurnLimitResponse struct {
+	Denom  string   `json:"denom"`
+	Amount math.Int `json:"amount"`
+}
+
+type LocalDomainQuery struct{}
+
+type LocalDomainResponse struct {
+	DomainId           uint32 `json:"domain_id"`
+	MessageVersion     uint32 `json:"message_version"`
+	MessageBodyVersion uint32 `json:"message_body_version"`
+}
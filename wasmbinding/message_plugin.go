@@ -0,0 +1,161 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package wasmbinding lets CosmWasm contracts use the CCTP module through
+// custom messages and queries, without building protobuf messages by hand. A
+// chain embedding wasmd wires it in with:
+//
+//	wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
+//		Custom: wasmbinding.CustomMessageEncoder,
+//	})
+//	wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+//		Custom: wasmbinding.CustomQuerier(app.CCTPKeeper),
+//	})
+//
+// Contracts receiving messages or mints are called back once a wasm keeper is
+// set with app.CCTPKeeper.SetWasmKeeper(wasm, gasLimit), where wasm wraps
+// app.WasmKeeper as a types.WasmKeeper, and they dispatch
+// enable_contract_callback.
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	"github.com/circlefin/noble-cctp/wasmbinding/bindings"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CustomMessageEncoder converts a bindings.CCTPMsg dispatched by a contract
+// into the CCTP message it describes, signed by the contract.
+func CustomMessageEncoder(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) {
+	var cctpMsg bindings.CCTPMsg
+	if err := json.Unmarshal(msg, &cctpMsg); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+	}
+
+	from := sender.String()
+	switch {
+	case cctpMsg.DepositForBurn != nil:
+		return []sdk.Msg{encodeDepositForBurn(from, cctpMsg.DepositForBurn)}, nil
+	case cctpMsg.SendMessage != nil:
+		return []sdk.Msg{encodeSendMessage(from, cctpMsg.SendMessage)}, nil
+	case cctpMsg.ReplaceDepositForBurn != nil:
+		m := cctpMsg.ReplaceDepositForBurn
+		keyType, err := parseKeyType(m.KeyType)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{&types.MsgReplaceDepositForBurn{
+			From:                 from,
+			OriginalMessage:      m.OriginalMessage,
+			OriginalAttestation:  m.OriginalAttestation,
+			NewDestinationCaller: m.NewDestinationCaller,
+			NewMintRecipient:     m.NewMintRecipient,
+			KeyType:              keyType,
+		}}, nil
+	case cctpMsg.ReplaceMessage != nil:
+		m := cctpMsg.ReplaceMessage
+		keyType, err := parseKeyType(m.KeyType)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{&types.MsgReplaceMessage{
+			From:                 from,
+			OriginalMessage:      m.OriginalMessage,
+			OriginalAttestation:  m.OriginalAttestation,
+			NewMessageBody:       m.NewMessageBody,
+			NewDestinationCaller: m.NewDestinationCaller,
+			KeyType:              keyType,
+		}}, nil
+	case cctpMsg.ReceiveMessage != nil:
+		m := cctpMsg.ReceiveMessage
+		keyType, err := parseKeyType(m.KeyType)
+		if err != nil {
+			return nil, err
+		}
+		return []sdk.Msg{&types.MsgReceiveMessage{
+			From:                 from,
+			Message:              m.Message,
+			Attestation:          m.Attestation,
+			KeyType:              keyType,
+			AllowAlreadyReceived: m.AllowAlreadyReceived,
+		}}, nil
+	case cctpMsg.EnableContractCallback != nil:
+		return []sdk.Msg{&types.MsgEnableContractCallback{From: from}}, nil
+	case cctpMsg.DisableContractCallback != nil:
+		return []sdk.Msg{&types.MsgDisableContractCallback{From: from}}, nil
+	default:
+		return nil, errors.Wrap(sdkerrors.ErrUnknownRequest, "unknown cctp message variant")
+	}
+}
+
+func encodeDepositForBurn(from string, m *bindings.DepositForBurn) sdk.Msg {
+	if len(m.DestinationCaller) == 0 {
+		return &types.MsgDepositForBurn{
+			From:              from,
+			Amount:            m.Amount,
+			DestinationDomain: m.DestinationDomain,
+			MintRecipient:     m.MintRecipient,
+			BurnToken:         m.BurnToken,
+		}
+	}
+
+	return &types.MsgDepositForBurnWithCaller{
+		From:              from,
+		Amount:            m.Amount,
+		DestinationDomain: m.DestinationDomain,
+		MintRecipient:     m.MintRecipient,
+		BurnToken:         m.BurnToken,
+		DestinationCaller: m.DestinationCaller,
+	}
+}
+
+func encodeSendMessage(from string, m *bindings.SendMessage) sdk.Msg {
+	if len(m.DestinationCaller) == 0 {
+		return &types.MsgSendMessage{
+			From:              from,
+			DestinationDomain: m.DestinationDomain,
+			Recipient:         m.Recipient,
+			MessageBody:       m.MessageBody,
+		}
+	}
+
+	return &types.MsgSendMessageWithCaller{
+		From:              from,
+		DestinationDomain: m.DestinationDomain,
+		Recipient:         m.Recipient,
+		MessageBody:       m.MessageBody,
+		DestinationCaller: m.DestinationCaller,
+	}
+}
+
+// parseKeyType parses the name of a key type, e.g. KEY_TYPE_ED25519. An empty
+// name is secp256k1.
+func parseKeyType(name string) (types.KeyType, error) {
+	if name == "" {
+		return types.KeyType_KEY_TYPE_SECP256K1, nil
+	}
+
+	keyType, found := types.KeyType_value[name]
+	if !found {
+		return 0, errors.Wrapf(sdkerrors.ErrInvalidRequest, "unknown key type %s", name)
+	}
+
+	return types.KeyType(keyType), nil
+}
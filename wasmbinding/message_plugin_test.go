@@ -0,0 +1,337 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmbinding_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/simapp"
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/wasmbinding"
+	"github.com/circlefin/noble-cctp/wasmbinding/bindings"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Deposit for burn
+ * Deposit for burn with caller
+ * Send message, with and without caller
+ * Replace message
+ * Replace deposit for burn
+ * Receive message
+ * Enable and disable contract callback
+ * Invalid messages
+ */
+
+const remoteDomain = 0
+
+var (
+	remoteToken          = append(make([]byte, 12), []byte("remote usdc token 20")...)
+	remoteTokenMessenger = append(make([]byte, 12), []byte("remote messenger 020")...)
+)
+
+// The plugins must match wasmkeeper.MessageEncoders.Custom and
+// wasmkeeper.CustomQuerier to be wired into a wasm keeper.
+var (
+	_ func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error) = wasmbinding.CustomMessageEncoder
+	_ func(ctx sdk.Context, request json.RawMessage) ([]byte, error)      = wasmbinding.CustomQuerier(nil)
+)
+
+// testContract stands in for a CosmWasm contract: its messages are encoded
+// and dispatched the way wasmd handles custom messages and queries.
+type testContract struct {
+	app       *simapp.SimApp
+	ctx       sdk.Context
+	attesters attester.Set
+	address   sdk.AccAddress
+}
+
+func setupContract(t *testing.T) *testContract {
+	contract := sdk.AccAddress(address.Module("wasm", []byte("cctp contract")))
+	app := simapp.Setup(t, banktypes.Balance{
+		Address: contract.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1_000_000)),
+	})
+	ctx := app.NewTestContext()
+
+	attesters := attester.GenerateSet(2)
+	for _, a := range attesters.Attesters() {
+		app.CCTPKeeper.SetAttester(ctx, a)
+	}
+	app.CCTPKeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+	app.CCTPKeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{DomainId: remoteDomain, Address: remoteTokenMessenger})
+	app.CCTPKeeper.SetTokenPair(ctx, types.TokenPair{RemoteDomain: remoteDomain, RemoteToken: remoteToken, LocalToken: simapp.Denom})
+	app.CCTPKeeper.SetPerMessageBurnLimit(ctx, types.PerMessageBurnLimit{Denom: simapp.Denom, Amount: math.NewInt(1_000_000)})
+
+	return &testContract{
+		app:       app,
+		ctx:       ctx,
+		attesters: attesters,
+		address:   contract,
+	}
+}
+
+// execute encodes msg as sent by the contract and runs the resulting messages
+// through the message router, returning the messages sent to remote domains.
+// Like wasmkeeper.SDKMessageHandler, each message is validated and must only
+// be signed by the contract.
+func (c *testContract) execute(t *testing.T, msg bindings.CCTPMsg) ([]*types.Message, error) {
+	bz, err := json.Marshal(msg)
+	require.NoError(t, err)
+
+	msgs, err := wasmbinding.CustomMessageEncoder(c.address, bz)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, write := c.ctx.CacheContext()
+	var events []abci.Event
+	for _, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return nil, err
+			}
+		}
+		signers, _, err := c.app.AppCodec().GetMsgV1Signers(msg)
+		require.NoError(t, err)
+		for _, signer := range signers {
+			require.Equal(t, c.address, sdk.AccAddress(signer))
+		}
+
+		handler := c.app.MsgServiceRouter().Handler(msg)
+		require.NotNil(t, handler)
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, res.Events...)
+	}
+	write()
+
+	var sent []*types.Message
+	for _, bz := range types.MessageSentEvents(events) {
+		message, err := new(types.Message).Parse(bz)
+		require.NoError(t, err)
+		sent = append(sent, message)
+	}
+	return sent, nil
+}
+
+// query sends query as the contract and decodes the response into res.
+func (c *testContract) query(t *testing.T, query bindings.CCTPQuery, res any) error {
+	bz, err := json.Marshal(query)
+	require.NoError(t, err)
+
+	bz, err = wasmbinding.CustomQuerier(c.app.CCTPKeeper)(c.ctx, bz)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(bz, res)
+}
+
+func (c *testContract) balance() math.Int {
+	return c.app.BankKeeper.GetBalance(c.ctx, c.address, simapp.Denom).Amount
+}
+
+func TestDepositForBurn(t *testing.T) {
+	c := setupContract(t)
+
+	mintRecipient := make([]byte, 32)
+	mintRecipient[31] = 1
+	sent, err := c.execute(t, bindings.CCTPMsg{DepositForBurn: &bindings.DepositForBurn{
+		Amount:            math.NewInt(1_000),
+		DestinationDomain: remoteDomain,
+		MintRecipient:     mintRecipient,
+		BurnToken:         simapp.Denom,
+	}})
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	require.Equal(t, math.NewInt(999_000), c.balance())
+
+	burnMessage, err := new(types.BurnMessage).Parse(sent[0].MessageBody)
+	require.NoError(t, err)
+	require.Equal(t, mintRecipient, burnMessage.MintRecipient)
+	require.Equal(t, c.address.Bytes(), burnMessage.MessageSender)
+	require.Equal(t, make([]byte, types.DestinationCallerLen), sent[0].DestinationCaller)
+}
+
+func TestDepositForBurnWithCaller(t *testing.T) {
+	c := setupContract(t)
+
+	mintRecipient := make([]byte, 32)
+	mintRecipient[31] = 1
+	destinationCaller := make([]byte, 32)
+	destinationCaller[31] = 2
+	sent, err := c.execute(t, bindings.CCTPMsg{DepositForBurn: &bindings.DepositForBurn{
+		Amount:            math.NewInt(1_000),
+		DestinationDomain: remoteDomain,
+		MintRecipient:     mintRecipient,
+		BurnToken:         simapp.Denom,
+		DestinationCaller: destinationCaller,
+	}})
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	require.Equal(t, destinationCaller, sent[0].DestinationCaller)
+}
+
+func TestSendMessage(t *testing.T) {
+	c := setupContract(t)
+
+	recipient := make([]byte, 32)
+	recipient[31] = 1
+	sent, err := c.execute(t, bindings.CCTPMsg{SendMessage: &bindings.SendMessage{
+		DestinationDomain: remoteDomain,
+		Recipient:         recipient,
+		MessageBody:       []byte("hello"),
+	}})
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	require.Equal(t, c.address.Bytes(), sent[0].Sender)
+	require.Equal(t, []byte("hello"), sent[0].MessageBody)
+
+	destinationCaller := make([]byte, 32)
+	destinationCaller[31] = 2
+	sent, err = c.execute(t, bindings.CCTPMsg{SendMessage: &bindings.SendMessage{
+		DestinationDomain: remoteDomain,
+		Recipient:         recipient,
+		MessageBody:       []byte("hello"),
+		DestinationCaller: destinationCaller,
+	}})
+	require.NoError(t, err)
+	require.Len(t, sent, 1)
+	require.Equal(t, destinationCaller, sent[0].DestinationCaller)
+}
+
+func TestReplaceMessage(t *testing.T) {
+	c := setupContract(t)
+
+	recipient := make([]byte, 32)
+	recipient[31] = 1
+	sent, err := c.execute(t, bindings.CCTPMsg{SendMessage: &bindings.SendMessage{
+		DestinationDomain: remoteDomain,
+		Recipient:         recipient,
+		MessageBody:       []byte("hello"),
+	}})
+	require.NoError(t, err)
+	original, err := sent[0].Bytes()
+	require.NoError(t, err)
+
+	replaced, err := c.execute(t, bindings.CCTPMsg{ReplaceMessage: &bindings.ReplaceMessage{
+		OriginalMessage:      original,
+		OriginalAttestation:  c.attesters.Attest(original),
+		NewMessageBody:       []byte("goodbye"),
+		NewDestinationCaller: make([]byte, types.DestinationCallerLen),
+	}})
+	require.NoError(t, err)
+	require.Len(t, replaced, 1)
+	require.Equal(t, sent[0].Nonce, replaced[0].Nonce)
+	require.Equal(t, []byte("goodbye"), replaced[0].MessageBody)
+}
+
+func TestReplaceDepositForBurn(t *testing.T) {
+	c := setupContract(t)
+
+	mintRecipient := make([]byte, 32)
+	mintRecipient[31] = 1
+	sent, err := c.execute(t, bindings.CCTPMsg{DepositForBurn: &bindings.DepositForBurn{
+		Amount:            math.NewInt(1_000),
+		DestinationDomain: remoteDomain,
+		MintRecipient:     mintRecipient,
+		BurnToken:         simapp.Denom,
+	}})
+	require.NoError(t, err)
+	original, err := sent[0].Bytes()
+	require.NoError(t, err)
+
+	newMintRecipient := make([]byte, 32)
+	newMintRecipient[31] = 3
+	replaced, err := c.execute(t, bindings.CCTPMsg{ReplaceDepositForBurn: &bindings.ReplaceDepositForBurn{
+		OriginalMessage:      original,
+		OriginalAttestation:  c.attesters.Attest(original),
+		NewDestinationCaller: make([]byte, types.DestinationCallerLen),
+		NewMintRecipient:     newMintRecipient,
+		KeyType:              types.KeyType_KEY_TYPE_SECP256K1.String(),
+	}})
+	require.NoError(t, err)
+	require.Len(t, replaced, 1)
+
+	burnMessage, err := new(types.BurnMessage).Parse(replaced[0].MessageBody)
+	require.NoError(t, err)
+	require.Equal(t, newMintRecipient, burnMessage.MintRecipient)
+}
+
+func TestReceiveMessage(t *testing.T) {
+	c := setupContract(t)
+
+	destinationCaller, err := types.Bytes32FromAccAddress(c.address)
+	require.NoError(t, err)
+	message, err := (&types.Message{
+		Version:           types.NobleMessageVersion,
+		SourceDomain:      remoteDomain,
+		DestinationDomain: types.NobleDomainId,
+		Nonce:             7,
+		Sender:            remoteTokenMessenger,
+		Recipient:         make([]byte, 32),
+		DestinationCaller: destinationCaller,
+		MessageBody:       []byte("hello"),
+	}).Bytes()
+	require.NoError(t, err)
+
+	_, err = c.execute(t, bindings.CCTPMsg{ReceiveMessage: &bindings.ReceiveMessage{
+		Message:     message,
+		Attestation: c.attesters.Attest(message),
+	}})
+	require.NoError(t, err)
+	require.True(t, c.app.CCTPKeeper.GetUsedNonce(c.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: 7}))
+}
+
+func TestContractCallbackMessages(t *testing.T) {
+	c := setupContract(t)
+
+	msgs, err := wasmbinding.CustomMessageEncoder(c.address, []byte(`{"enable_contract_callback":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{&types.MsgEnableContractCallback{From: c.address.String()}}, msgs)
+
+	msgs, err = wasmbinding.CustomMessageEncoder(c.address, []byte(`{"disable_contract_callback":{}}`))
+	require.NoError(t, err)
+	require.Equal(t, []sdk.Msg{&types.MsgDisableContractCallback{From: c.address.String()}}, msgs)
+
+	// without a wasm keeper, the sender is never a contract
+	_, err = c.execute(t, bindings.CCTPMsg{EnableContractCallback: &struct{}{}})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
+
+func TestInvalidMessages(t *testing.T) {
+	c := setupContract(t)
+
+	_, err := wasmbinding.CustomMessageEncoder(c.address, []byte("not json"))
+	require.ErrorIs(t, err, sdkerrors.ErrJSONUnmarshal)
+
+	_, err = c.execute(t, bindings.CCTPMsg{})
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+
+	_, err = c.execute(t, bindings.CCTPMsg{ReceiveMessage: &bindings.ReceiveMessage{KeyType: "KEY_TYPE_RSA"}})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
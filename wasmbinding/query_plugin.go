@@ -0,0 +1,89 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmbinding
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	"github.com/circlefin/noble-cctp/wasmbinding/bindings"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// CustomQuerier answers the bindings.CCTPQuery sent by a contract from the
+// state of the CCTP module.
+func CustomQuerier(k *keeper.Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query bindings.CCTPQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, errors.Wrap(sdkerrors.ErrJSONUnmarshal, err.Error())
+		}
+
+		var res any
+		switch {
+		case query.TokenPair != nil:
+			tokenPair, found := k.GetTokenPair(ctx, query.TokenPair.RemoteDomain, query.TokenPair.RemoteToken)
+			if !found {
+				return nil, errors.Wrapf(types.ErrTokenPairNotFound, "remote domain %d, remote token %x", query.TokenPair.RemoteDomain, query.TokenPair.RemoteToken)
+			}
+			res = bindings.TokenPairResponse{
+				RemoteDomain: tokenPair.RemoteDomain,
+				RemoteToken:  tokenPair.RemoteToken,
+				LocalToken:   tokenPair.LocalToken,
+			}
+		case query.RemoteTokenMessenger != nil:
+			messenger, found := k.GetRemoteTokenMessenger(ctx, query.RemoteTokenMessenger.DomainId)
+			if !found {
+				return nil, errors.Wrapf(types.ErrRemoteTokenMessengerNotFound, "domain %d", query.RemoteTokenMessenger.DomainId)
+			}
+			res = bindings.RemoteTokenMessengerResponse{
+				DomainId: messenger.DomainId,
+				Address:  messenger.Address,
+			}
+		case query.NextAvailableNonce != nil:
+			nonce, _ := k.GetNextAvailableNonce(ctx)
+			res = bindings.NextAvailableNonceResponse{Nonce: nonce.Nonce}
+		case query.UsedNonce != nil:
+			used := k.GetUsedNonce(ctx, types.Nonce{SourceDomain: query.UsedNonce.SourceDomain, Nonce: query.UsedNonce.Nonce})
+			res = bindings.UsedNonceResponse{Used: used}
+		case query.PerMessageBurnLimit != nil:
+			limit, found := k.GetPerMessageBurnLimit(ctx, query.PerMessageBurnLimit.Denom)
+			if !found {
+				return nil, errors.Wrapf(sdkerrors.ErrNotFound, "per message burn limit for %s", query.PerMessageBurnLimit.Denom)
+			}
+			res = bindings.PerMessageBurnLimitResponse{Denom: limit.Denom, Amount: limit.Amount}
+		case query.LocalDomain != nil:
+			localDomain := k.GetLocalDomain(ctx)
+			res = bindings.LocalDomainResponse{
+				DomainId:           localDomain.DomainId,
+				MessageVersion:     localDomain.MessageVersion,
+				MessageBodyVersion: localDomain.MessageBodyVersion,
+			}
+		default:
+			return nil, errors.Wrap(sdkerrors.ErrUnknownRequest, "unknown cctp query variant")
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, errors.Wrap(sdkerrors.ErrJSONMarshal, err.Error())
+		}
+		return bz, nil
+	}
+}
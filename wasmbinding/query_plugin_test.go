@@ -0,0 +1,78 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package wasmbinding_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/simapp"
+	"github.com/circlefin/noble-cctp/wasmbinding/bindings"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQueries(t *testing.T) {
+	c := setupContract(t)
+	c.app.CCTPKeeper.SetNextAvailableNonce(c.ctx, types.Nonce{Nonce: 5})
+	c.app.CCTPKeeper.SetUsedNonce(c.ctx, types.Nonce{SourceDomain: remoteDomain, Nonce: 3})
+
+	var tokenPair bindings.TokenPairResponse
+	require.NoError(t, c.query(t, bindings.CCTPQuery{TokenPair: &bindings.TokenPairQuery{RemoteDomain: remoteDomain, RemoteToken: remoteToken}}, &tokenPair))
+	require.Equal(t, bindings.TokenPairResponse{RemoteDomain: remoteDomain, RemoteToken: remoteToken, LocalToken: simapp.Denom}, tokenPair)
+
+	var messenger bindings.RemoteTokenMessengerResponse
+	require.NoError(t, c.query(t, bindings.CCTPQuery{RemoteTokenMessenger: &bindings.RemoteTokenMessengerQuery{DomainId: remoteDomain}}, &messenger))
+	require.Equal(t, bindings.RemoteTokenMessengerResponse{DomainId: remoteDomain, Address: remoteTokenMessenger}, messenger)
+
+	var nonce bindings.NextAvailableNonceResponse
+	require.NoError(t, c.query(t, bindings.CCTPQuery{NextAvailableNonce: &bindings.NextAvailableNonceQuery{}}, &nonce))
+	require.Equal(t, uint64(5), nonce.Nonce)
+
+	var used bindings.UsedNonceResponse
+	require.NoError(t, c.query(t, bindings.CCTPQuery{UsedNonce: &bindings.UsedNonceQuery{SourceDomain: remoteDomain, Nonce: 3}}, &used))
+	require.True(t, used.Used)
+	require.NoError(t, c.query(t, bindings.CCTPQuery{UsedNonce: &bindings.UsedNonceQuery{SourceDomain: remoteDomain, Nonce: 4}}, &used))
+	require.False(t, used.Used)
+
+	var limit bindings.PerMessageBurnLimitResponse
+	require.NoError(t, c.query(t, bindings.CCTPQuery{PerMessageBurnLimit: &bindings.PerMessageBurnLimitQuery{Denom: simapp.Denom}}, &limit))
+	require.Equal(t, simapp.Denom, limit.Denom)
+	require.Equal(t, math.NewInt(1_000_000), limit.Amount)
+
+	var localDomain bindings.LocalDomainResponse
+	require.NoError(t, c.query(t, bindings.CCTPQuery{LocalDomain: &bindings.LocalDomainQuery{}}, &localDomain))
+	require.Equal(t, uint32(types.NobleDomainId), localDomain.DomainId)
+}
+
+func TestQueriesNotFound(t *testing.T) {
+	c := setupContract(t)
+
+	var res struct{}
+	err := c.query(t, bindings.CCTPQuery{TokenPair: &bindings.TokenPairQuery{RemoteDomain: 9, RemoteToken: remoteToken}}, &res)
+	require.ErrorIs(t, err, types.ErrTokenPairNotFound)
+
+	err = c.query(t, bindings.CCTPQuery{RemoteTokenMessenger: &bindings.RemoteTokenMessengerQuery{DomainId: 9}}, &res)
+	require.ErrorIs(t, err, types.ErrRemoteTokenMessengerNotFound)
+
+	err = c.query(t, bindings.CCTPQuery{PerMessageBurnLimit: &bindings.PerMessageBurnLimitQuery{Denom: "unknown"}}, &res)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	err = c.query(t, bindings.CCTPQuery{}, &res)
+	require.ErrorIs(t, err, sdkerrors.ErrUnknownRequest)
+}
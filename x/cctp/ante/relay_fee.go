@@ -0,0 +1,205 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ante provides an optional ante decorator that lets relayers deliver
+// inbound CCTP messages without holding gas tokens on Noble.
+package ante
+
+import (
+	"context"
+	"slices"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// BankKeeper defines the bank methods used to pay relay fees from the budget.
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// RelayFeeOptions bounds the transactions a RelayFeeDecorator pays for.
+type RelayFeeOptions struct {
+	// MaxGas is the highest gas limit of a relay transaction that is waived or
+	// subsidized.
+	MaxGas uint64
+	// MaxFee is the highest fee that is paid from the relay fee budget. A zero
+	// fee is always waived.
+	MaxFee sdk.Coins
+	// MaxMessages is the highest number of messages in a relay transaction
+	// that is waived or subsidized, bounding the attestations it verifies.
+	MaxMessages int
+}
+
+var _ sdk.AnteDecorator = RelayFeeDecorator{}
+
+// RelayFeeDecorator replaces the fee decorator of an ante chain. Transactions
+// that contain only MsgReceiveMessage, within the gas and message limits,
+// whose messages are all received successfully, have their fee waived if it is
+// zero, or paid from the types.RelayFeeBudgetName module account if it is not.
+// Every other transaction, and every relay transaction the budget cannot
+// cover, is passed to the wrapped fee decorator.
+//
+// Whether the messages are received is checked by running the message server
+// on a discarded cache context, so messages that fail to execute, e.g. because
+// their recipient is blacklisted, are never relayed for free.
+//
+// During CheckTx, relay transactions within the limits that would fail, such
+// as those whose nonce was already used by a competing relayer, are rejected
+// before any fee is charged, keeping them out of the mempool. The nonces of
+// accepted relay transactions are marked as used in the CheckTx state, so
+// competing relays of the same message are rejected until the next block. Used
+// nonces are rejected before any attestation is verified.
+type RelayFeeDecorator struct {
+	keeper       *keeper.Keeper
+	server       types.MsgServer
+	bankKeeper   BankKeeper
+	feeDecorator sdk.AnteDecorator
+	options      RelayFeeOptions
+}
+
+func NewRelayFeeDecorator(k *keeper.Keeper, bankKeeper BankKeeper, feeDecorator sdk.AnteDecorator, options RelayFeeOptions) RelayFeeDecorator {
+	return RelayFeeDecorator{
+		keeper:       k,
+		server:       keeper.NewMsgServerImpl(k),
+		bankKeeper:   bankKeeper,
+		feeDecorator: feeDecorator,
+		options:      options,
+	}
+}
+
+func (d RelayFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := relayMessages(tx)
+	if msgs == nil || len(msgs) > d.options.MaxMessages {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok || feeTx.GetGas() > d.options.MaxGas || feeTx.FeeGranter() != nil {
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	nonces, err := d.validate(ctx, msgs)
+	if err != nil {
+		if ctx.IsCheckTx() {
+			return ctx, err
+		}
+		// let the transaction fail in the message server, paid for by the relayer
+		return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	fee := feeTx.GetFee()
+	if !fee.IsZero() {
+		budget := authtypes.NewModuleAddress(types.RelayFeeBudgetName)
+		if !fee.IsAllLTE(d.options.MaxFee) || !fee.IsAllLTE(d.bankKeeper.SpendableCoins(ctx, budget)) {
+			return d.feeDecorator.AnteHandle(ctx, tx, simulate, next)
+		}
+
+		if err := d.bankKeeper.SendCoinsFromModuleToModule(ctx, types.RelayFeeBudgetName, authtypes.FeeCollectorName, fee); err != nil {
+			return ctx, errors.Wrapf(sdkerrors.ErrInsufficientFunds, "unable to pay relay fee from budget: %s", err)
+		}
+	}
+
+	// competing relays of the same messages are rejected until they are received
+	if ctx.IsCheckTx() && !simulate {
+		for _, nonce := range nonces {
+			d.keeper.SetUsedNonce(ctx, nonce)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// validate checks that every message would be received, without modifying
+// state, and returns their nonces. Every message is parsed and checked for a
+// used nonce before any message is received.
+func (d RelayFeeDecorator) validate(ctx sdk.Context, msgs []*types.MsgReceiveMessage) ([]types.Nonce, error) {
+	nonces := make([]types.Nonce, 0, len(msgs))
+	for _, msg := range msgs {
+		message, err := new(types.Message).Parse(msg.Message)
+		if err != nil {
+			return nil, err
+		}
+
+		nonce := types.Nonce{SourceDomain: message.SourceDomain, Nonce: message.Nonce}
+		if slices.Contains(nonces, nonce) || d.keeper.GetUsedNonce(ctx, nonce) {
+			return nil, errors.Wrapf(types.ErrNonceAlreadyUsed, "source domain: %d, nonce: %d", message.SourceDomain, message.Nonce)
+		}
+		nonces = append(nonces, nonce)
+	}
+
+	if err := d.receive(ctx, msgs); err != nil {
+		return nil, err
+	}
+
+	return nonces, nil
+}
+
+// receive runs msgs through the message server on a discarded cache context.
+// The messages are metered separately, up to the gas limit of the transaction,
+// so that relayers are not charged for receiving them twice.
+func (d RelayFeeDecorator) receive(ctx sdk.Context, msgs []*types.MsgReceiveMessage) (err error) {
+	cacheCtx, _ := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(ctx.GasMeter().Limit()))
+
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+	}()
+
+	for _, msg := range msgs {
+		res, err := d.server.ReceiveMessage(cacheCtx, msg)
+		if err != nil {
+			return err
+		}
+		if res.AlreadyReceived {
+			return errors.Wrap(types.ErrNonceAlreadyUsed, "message already received")
+		}
+	}
+
+	return nil
+}
+
+// relayMessages returns the messages of tx if they are all MsgReceiveMessage,
+// and nil otherwise.
+func relayMessages(tx sdk.Tx) []*types.MsgReceiveMessage {
+	txMsgs := tx.GetMsgs()
+	if len(txMsgs) == 0 {
+		return nil
+	}
+
+	msgs := make([]*types.MsgReceiveMessage, 0, len(txMsgs))
+	for _, txMsg := range txMsgs {
+		msg, ok := txMsg.(*types.MsgReceiveMessage)
+		if !ok {
+			return nil
+		}
+		msgs = append(msgs, msg)
+	}
+
+	return msgs
+}
@@ -0,0 +1,304 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ante_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/ante"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+)
+
+/*
+ * Zero fee is waived
+ * Fee is paid from the budget
+ * Falls back when the budget is insufficient
+ * Falls back when the fee is above the maximum
+ * Falls back when the gas is above the maximum
+ * Falls back when there are too many messages
+ * Rejects a used nonce in CheckTx
+ * Rejects duplicate nonces in CheckTx
+ * Rejects a used nonce before verifying attestations
+ * Marks nonces as used in CheckTx
+ * Rejects a message that fails to execute in CheckTx
+ * Falls back for a message that fails to execute in DeliverTx
+ * Falls back for an invalid relay in DeliverTx
+ * Falls back for other messages
+ */
+
+func TestRelayFeeZeroFeeWaived(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	bank, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	called := callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000})
+	require.True(t, called)
+	require.False(t, inner.called)
+	require.Empty(t, bank.sent)
+}
+
+func TestRelayFeePaidFromBudget(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	bank, inner, decorator := newDecorator(testkeeper, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	called := callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000, fee: fee})
+	require.True(t, called)
+	require.False(t, inner.called)
+	require.Equal(t, fee, bank.sent)
+}
+
+func TestRelayFeeInsufficientBudget(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	bank, inner, decorator := newDecorator(testkeeper, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10)))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 100))
+	callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000, fee: fee})
+	require.True(t, inner.called)
+	require.Empty(t, bank.sent)
+}
+
+func TestRelayFeeAboveMaxFee(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	bank, inner, decorator := newDecorator(testkeeper, sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000_000)))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("uusdc", 10_000))
+	callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000, fee: fee})
+	require.True(t, inner.called)
+	require.Empty(t, bank.sent)
+}
+
+func TestRelayFeeAboveMaxGas(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 1_000_000})
+	require.True(t, inner.called)
+}
+
+func TestRelayFeeTooManyMessages(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	// duplicate nonces are not checked, as the relayer pays for the transaction
+	ctx = ctx.WithIsCheckTx(true)
+	callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg, msg, msg}, gas: 100_000})
+	require.True(t, inner.called)
+}
+
+func TestRelayFeeUsedNonceCheckTx(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	testkeeper.SetUsedNonce(ctx, types.Nonce{SourceDomain: 0, Nonce: 0})
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	ctx = ctx.WithIsCheckTx(true)
+	_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000}, false, nextHandler(new(bool)))
+	require.ErrorIs(t, err, types.ErrNonceAlreadyUsed)
+	require.False(t, inner.called)
+}
+
+func TestRelayFeeDuplicateNonceCheckTx(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	ctx = ctx.WithIsCheckTx(true)
+	_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg, msg}, gas: 100_000}, false, nextHandler(new(bool)))
+	require.ErrorIs(t, err, types.ErrNonceAlreadyUsed)
+	require.False(t, inner.called)
+}
+
+func TestRelayFeeUsedNonceBeforeAttestation(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	testkeeper.SetUsedNonce(ctx, types.Nonce{SourceDomain: 0, Nonce: 0})
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	msg.Attestation = make([]byte, len(msg.Attestation))
+	ctx = ctx.WithIsCheckTx(true)
+	_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000}, false, nextHandler(new(bool)))
+	require.ErrorIs(t, err, types.ErrNonceAlreadyUsed)
+	require.False(t, inner.called)
+}
+
+func TestRelayFeeMarksNonceCheckTx(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	ctx = ctx.WithIsCheckTx(true)
+	require.True(t, callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000}))
+	require.True(t, testkeeper.GetUsedNonce(ctx, types.Nonce{SourceDomain: 0, Nonce: 0}))
+
+	_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000}, false, nextHandler(new(bool)))
+	require.ErrorIs(t, err, types.ErrNonceAlreadyUsed)
+	require.False(t, inner.called)
+}
+
+func TestRelayFeeFailingMessageCheckTx(t *testing.T) {
+	testkeeper, ctx, msg := setupFailingRelay(t)
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	ctx = ctx.WithIsCheckTx(true)
+	_, err := decorator.AnteHandle(ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000}, false, nextHandler(new(bool)))
+	require.ErrorIs(t, err, types.ErrParsingBurnMessage)
+	require.False(t, inner.called)
+	require.False(t, testkeeper.GetUsedNonce(ctx, types.Nonce{SourceDomain: 0, Nonce: 0}))
+}
+
+func TestRelayFeeFailingMessageDeliverTx(t *testing.T) {
+	testkeeper, ctx, msg := setupFailingRelay(t)
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000})
+	require.True(t, inner.called)
+	require.False(t, testkeeper.GetUsedNonce(ctx, types.Nonce{SourceDomain: 0, Nonce: 0}))
+}
+
+func TestRelayFeeInvalidRelayDeliverTx(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	testkeeper.SetUsedNonce(ctx, types.Nonce{SourceDomain: 0, Nonce: 0})
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg}, gas: 100_000})
+	require.True(t, inner.called)
+}
+
+func TestRelayFeeOtherMessages(t *testing.T) {
+	testkeeper, ctx, msg := setupRelay(t, 0)
+	_, inner, decorator := newDecorator(testkeeper, sdk.NewCoins())
+
+	other := &types.MsgPauseBurningAndMinting{From: "pauser"}
+	callAnte(t, decorator, ctx, mockTx{msgs: []sdk.Msg{msg, other}, gas: 100_000})
+	require.True(t, inner.called)
+}
+
+// setupFailingRelay returns an attested MsgReceiveMessage to the token
+// messenger whose body is not a burn message, so it fails to execute.
+func setupFailingRelay(t *testing.T) (*keeper.Keeper, sdk.Context, *types.MsgReceiveMessage) {
+	return setupRelayTo(t, 0, types.PaddedModuleAddress)
+}
+
+// setupRelay configures attesters and returns a valid MsgReceiveMessage for
+// the given nonce.
+func setupRelay(t *testing.T, nonce uint64) (*keeper.Keeper, sdk.Context, *types.MsgReceiveMessage) {
+	return setupRelayTo(t, nonce, make([]byte, 32))
+}
+
+func setupRelayTo(t *testing.T, nonce uint64, recipient []byte) (*keeper.Keeper, sdk.Context, *types.MsgReceiveMessage) {
+	testkeeper, goCtx := keepertest.CctpKeeper()
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	attesters := attester.GenerateSet(2)
+	for _, a := range attesters.Attesters() {
+		testkeeper.SetAttester(ctx, a)
+	}
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	message := types.Message{
+		Version:           types.NobleMessageVersion,
+		SourceDomain:      0,
+		DestinationDomain: types.NobleDomainId,
+		Nonce:             nonce,
+		Sender:            make([]byte, 32),
+		Recipient:         recipient,
+		DestinationCaller: make([]byte, types.DestinationCallerLen),
+		MessageBody:       []byte("relay"),
+	}
+	messageBytes, err := message.Bytes()
+	require.NoError(t, err)
+
+	return testkeeper, ctx, &types.MsgReceiveMessage{
+		From:        "relayer",
+		Message:     messageBytes,
+		Attestation: attesters.Attest(messageBytes),
+	}
+}
+
+func newDecorator(testkeeper *keeper.Keeper, budget sdk.Coins) (*mockBank, *mockFeeDecorator, ante.RelayFeeDecorator) {
+	bank := &mockBank{balance: budget}
+	inner := &mockFeeDecorator{}
+	decorator := ante.NewRelayFeeDecorator(testkeeper, bank, inner, ante.RelayFeeOptions{
+		MaxGas:      200_000,
+		MaxFee:      sdk.NewCoins(sdk.NewInt64Coin("uusdc", 1_000)),
+		MaxMessages: 2,
+	})
+	return bank, inner, decorator
+}
+
+func callAnte(t *testing.T, decorator ante.RelayFeeDecorator, ctx sdk.Context, tx sdk.Tx) bool {
+	called := false
+	_, err := decorator.AnteHandle(ctx, tx, false, nextHandler(&called))
+	require.NoError(t, err)
+	return called
+}
+
+func nextHandler(called *bool) sdk.AnteHandler {
+	return func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		*called = true
+		return ctx, nil
+	}
+}
+
+type mockTx struct {
+	msgs []sdk.Msg
+	gas  uint64
+	fee  sdk.Coins
+}
+
+var _ sdk.FeeTx = mockTx{}
+
+func (tx mockTx) GetMsgs() []sdk.Msg                    { return tx.msgs }
+func (tx mockTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx mockTx) GetGas() uint64                        { return tx.gas }
+func (tx mockTx) GetFee() sdk.Coins                     { return tx.fee }
+func (tx mockTx) FeePayer() []byte                      { return nil }
+func (tx mockTx) FeeGranter() []byte                    { return nil }
+
+type mockBank struct {
+	balance sdk.Coins
+	sent    sdk.Coins
+}
+
+var _ ante.BankKeeper = &mockBank{}
+
+func (b *mockBank) SpendableCoins(_ context.Context, _ sdk.AccAddress) sdk.Coins {
+	return b.balance
+}
+
+func (b *mockBank) SendCoinsFromModuleToModule(_ context.Context, _, _ string, amt sdk.Coins) error {
+	balance, negative := b.balance.SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	b.balance = balance
+	b.sent = b.sent.Add(amt...)
+	return nil
+}
+
+type mockFeeDecorator struct {
+	called bool
+}
+
+func (d *mockFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	d.called = true
+	return next(ctx, tx, simulate)
+}
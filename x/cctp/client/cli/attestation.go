@@ -0,0 +1,105 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+)
+
+// CmdAttestation groups the offline commands used to work with attestations.
+func CmdAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "attestation",
+		Short:                      "Offline utilities for working with CCTP attestations",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdVerifyAttestation())
+
+	return cmd
+}
+
+func CmdVerifyAttestation() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify [message] [attestation] [attesters] [signature-threshold]",
+		Short: "Verify an attestation against a set of attesters",
+		Long: `Verify a hex encoded attestation for a hex encoded message, without contacting a node.
+Attesters are provided as a comma separated list of hex encoded public keys, in the same
+format returned by the attesters query, and must all be of the type given by --key-type.`,
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			message, err := parseHex(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			attestation, err := parseHex(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid attestation: %w", err)
+			}
+
+			keyType, err := parseKeyTypeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			var attesters []types.Attester
+			for _, attester := range strings.Split(args[2], ",") {
+				attester = strings.TrimSpace(attester)
+				if _, err := parseHex(attester); err != nil {
+					return fmt.Errorf("invalid attester %s: %w", attester, err)
+				}
+				attesters = append(attesters, types.Attester{Attester: attester, KeyType: keyType})
+			}
+
+			signatureThreshold, err := strconv.ParseUint(args[3], types.BaseTen, types.SignatureThresholdBitLength)
+			if err != nil {
+				return fmt.Errorf("invalid signature threshold: %w", err)
+			}
+
+			signers, err := types.NewAttestationVerifier(attesters, uint32(signatureThreshold)).VerifyKeyType(keyType, message, attestation)
+			if err != nil {
+				return err
+			}
+
+			output := "attestation is valid, signed by:\n"
+			for _, signer := range signers {
+				output += fmt.Sprintf("  %s\n", signer)
+			}
+
+			return clientCtx.PrintString(output)
+		},
+	}
+
+	addOfflineFlagsToCmd(cmd)
+	addKeyTypeFlagToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,155 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+// decodedBurnMessage is the human-readable form of a CCTP burn message.
+type decodedBurnMessage struct {
+	Version       uint32 `json:"version"`
+	BurnToken     string `json:"burn_token"`
+	MintRecipient string `json:"mint_recipient"`
+	Amount        string `json:"amount"`
+	MessageSender string `json:"message_sender"`
+}
+
+// CmdBurnMessage groups the offline commands used to work with CCTP burn messages.
+func CmdBurnMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "burn-message",
+		Short:                      "Offline utilities for encoding and decoding CCTP burn messages",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdEncodeBurnMessage())
+	cmd.AddCommand(CmdDecodeBurnMessage())
+
+	return cmd
+}
+
+func CmdEncodeBurnMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encode [version] [burn-token] [mint-recipient] [amount] [message-sender]",
+		Short: "Encode a CCTP burn message",
+		Long:  "Encode the provided fields into a hex encoded CCTP burn message. Addresses may be hex or base58 encoded.",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[0], types.BaseTen, types.DomainBitLen)
+			if err != nil {
+				return fmt.Errorf("invalid version: %w", err)
+			}
+
+			burnToken, err := parseAddress(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid burn token: %w", err)
+			}
+
+			mintRecipient, err := parseAddress(args[2])
+			if err != nil {
+				return fmt.Errorf("invalid mint recipient: %w", err)
+			}
+
+			amount, ok := math.NewIntFromString(args[3])
+			if !ok || amount.IsNegative() {
+				return errors.Wrapf(types.ErrInvalidAmount, "invalid amount")
+			}
+
+			messageSender, err := parseAddress(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid message sender: %w", err)
+			}
+
+			burnMessage := types.BurnMessage{
+				Version:       uint32(version),
+				BurnToken:     burnToken,
+				MintRecipient: mintRecipient,
+				Amount:        amount,
+				MessageSender: messageSender,
+			}
+
+			bz, err := burnMessage.Bytes()
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(hexutil.Encode(bz) + "\n")
+		},
+	}
+
+	addOfflineFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDecodeBurnMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode [burn-message]",
+		Short: "Decode a hex encoded CCTP burn message",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := parseHex(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid burn message: %w", err)
+			}
+
+			burnMessage, err := new(types.BurnMessage).Parse(bz)
+			if err != nil {
+				return err
+			}
+
+			res, err := json.Marshal(decodedBurnMessage{
+				Version:       burnMessage.Version,
+				BurnToken:     hexutil.Encode(burnMessage.BurnToken),
+				MintRecipient: hexutil.Encode(burnMessage.MintRecipient),
+				Amount:        burnMessage.Amount.String(),
+				MessageSender: hexutil.Encode(burnMessage.MessageSender),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(res)
+		},
+	}
+
+	addOfflineFlagsToCmd(cmd)
+
+	return cmd
+}
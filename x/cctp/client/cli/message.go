@@ -0,0 +1,211 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/spf13/cobra"
+)
+
+// decodedMessage is the human-readable form of a CCTP message.
+type decodedMessage struct {
+	Version           uint32 `json:"version"`
+	SourceDomain      uint32 `json:"source_domain"`
+	DestinationDomain uint32 `json:"destination_domain"`
+	Nonce             uint64 `json:"nonce"`
+	Sender            string `json:"sender"`
+	Recipient         string `json:"recipient"`
+	DestinationCaller string `json:"destination_caller"`
+	MessageBody       string `json:"message_body"`
+}
+
+// CmdMessage groups the offline commands used to work with CCTP messages.
+func CmdMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "message",
+		Short:                      "Offline utilities for encoding, decoding, and hashing CCTP messages",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(CmdEncodeMessage())
+	cmd.AddCommand(CmdDecodeMessage())
+	cmd.AddCommand(CmdHashMessage())
+
+	return cmd
+}
+
+func CmdEncodeMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "encode [version] [source-domain] [destination-domain] [nonce] [sender] [recipient] [destination-caller] [message-body]",
+		Short: "Encode a CCTP message",
+		Long:  "Encode the provided fields into a hex encoded CCTP message. Addresses may be hex or base58 encoded, the message body must be hex encoded.",
+		Args:  cobra.ExactArgs(8),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := strconv.ParseUint(args[0], types.BaseTen, types.DomainBitLen)
+			if err != nil {
+				return fmt.Errorf("invalid version: %w", err)
+			}
+
+			sourceDomain, err := strconv.ParseUint(args[1], types.BaseTen, types.DomainBitLen)
+			if err != nil {
+				return fmt.Errorf("invalid source domain: %w", err)
+			}
+
+			destinationDomain, err := strconv.ParseUint(args[2], types.BaseTen, types.DomainBitLen)
+			if err != nil {
+				return fmt.Errorf("invalid destination domain: %w", err)
+			}
+
+			nonce, err := strconv.ParseUint(args[3], types.BaseTen, 64)
+			if err != nil {
+				return fmt.Errorf("invalid nonce: %w", err)
+			}
+
+			sender, err := parseAddress(args[4])
+			if err != nil {
+				return fmt.Errorf("invalid sender: %w", err)
+			}
+
+			recipient, err := parseAddress(args[5])
+			if err != nil {
+				return fmt.Errorf("invalid recipient: %w", err)
+			}
+
+			destinationCaller, err := parseAddress(args[6])
+			if err != nil {
+				return fmt.Errorf("invalid destination caller: %w", err)
+			}
+
+			messageBody, err := parseHex(args[7])
+			if err != nil {
+				return fmt.Errorf("invalid message body: %w", err)
+			}
+
+			message := types.Message{
+				Version:           uint32(version),
+				SourceDomain:      uint32(sourceDomain),
+				DestinationDomain: uint32(destinationDomain),
+				Nonce:             nonce,
+				Sender:            sender,
+				Recipient:         recipient,
+				DestinationCaller: destinationCaller,
+				MessageBody:       messageBody,
+			}
+
+			bz, err := message.Bytes()
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(hexutil.Encode(bz) + "\n")
+		},
+	}
+
+	addOfflineFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDecodeMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode [message]",
+		Short: "Decode a hex encoded CCTP message",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := parseHex(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			message, err := new(types.Message).Parse(bz)
+			if err != nil {
+				return err
+			}
+
+			res, err := json.Marshal(decodedMessage{
+				Version:           message.Version,
+				SourceDomain:      message.SourceDomain,
+				DestinationDomain: message.DestinationDomain,
+				Nonce:             message.Nonce,
+				Sender:            hexutil.Encode(message.Sender),
+				Recipient:         hexutil.Encode(message.Recipient),
+				DestinationCaller: hexutil.Encode(message.DestinationCaller),
+				MessageBody:       hexutil.Encode(message.MessageBody),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintRaw(res)
+		},
+	}
+
+	addOfflineFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdHashMessage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "hash [message]",
+		Short: "Compute the keccak256 hash of a hex encoded CCTP message",
+		Long:  "Compute the keccak256 hash of a hex encoded CCTP message. This is the hash used to look up attestations.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := parseHex(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid message: %w", err)
+			}
+
+			return clientCtx.PrintString(hexutil.Encode(crypto.Keccak256(bz)) + "\n")
+		},
+	}
+
+	addOfflineFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// addOfflineFlagsToCmd adds the output flag to commands that never contact a node.
+func addOfflineFlagsToCmd(cmd *cobra.Command) {
+	cmd.Flags().StringP(flags.FlagOutput, "o", flags.OutputFormatText, "Output format (text|json)")
+}
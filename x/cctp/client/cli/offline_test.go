@@ -0,0 +1,145 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/x/cctp/client/cli"
+	"github.com/cosmos/cosmos-sdk/client"
+	clitestutil "github.com/cosmos/cosmos-sdk/testutil/cli"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Offline commands are registered under the top-level cctp command
+ * Message encode, decode and hash round-trip
+ * Burn message encode and decode round-trip
+ * Attestation verify against an attester set and threshold
+ */
+
+const (
+	sender    = "0x000000000000000000000000bd3fa81b58ba92a82136038b25adec7066af3155"
+	recipient = "0x00000000000000000000000057d4eaf1091577a6b7d121202afbd2808134f118"
+	caller    = "0x0000000000000000000000000000000000000000000000000000000000000000"
+)
+
+// run executes the cctp offline command with args and returns its output.
+func run(t *testing.T, args ...string) (string, error) {
+	out, err := clitestutil.ExecTestCLICmd(client.Context{}, cli.GetOfflineCmd(), args)
+	return strings.TrimSpace(out.String()), err
+}
+
+func TestOfflineCmd(t *testing.T) {
+	names := make([]string, 0)
+	for _, cmd := range cli.GetOfflineCmd().Commands() {
+		names = append(names, cmd.Name())
+	}
+	require.ElementsMatch(t, []string{"message", "burn-message", "attestation"}, names)
+
+	for _, cmd := range cli.GetQueryCmd().Commands() {
+		require.NotContains(t, names, cmd.Name())
+	}
+}
+
+func TestMessageRoundTrip(t *testing.T) {
+	encoded, err := run(t, "message", "encode", "0", "0", "4", "149612", sender, recipient, caller, "0xdeadbeef")
+	require.NoError(t, err)
+
+	decoded, err := run(t, "message", "decode", encoded, "--output", "json")
+	require.NoError(t, err)
+	var message map[string]any
+	require.NoError(t, json.Unmarshal([]byte(decoded), &message))
+	require.Equal(t, map[string]any{
+		"version":            float64(0),
+		"source_domain":      float64(0),
+		"destination_domain": float64(4),
+		"nonce":              float64(149612),
+		"sender":             sender,
+		"recipient":          recipient,
+		"destination_caller": caller,
+		"message_body":       "0xdeadbeef",
+	}, message)
+
+	// decoding and encoding the fields again gives the same message
+	reencoded, err := run(t, "message", "encode", "0", "0", "4", "149612",
+		message["sender"].(string), message["recipient"].(string), message["destination_caller"].(string), message["message_body"].(string))
+	require.NoError(t, err)
+	require.Equal(t, encoded, reencoded)
+
+	hash, err := run(t, "message", "hash", encoded)
+	require.NoError(t, err)
+	require.Equal(t, hexutil.Encode(crypto.Keccak256(hexutil.MustDecode(encoded))), hash)
+
+	_, err = run(t, "message", "decode", "0x1234")
+	require.Error(t, err)
+}
+
+func TestBurnMessageRoundTrip(t *testing.T) {
+	token := "0x000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+	encoded, err := run(t, "burn-message", "encode", "0", token, recipient, "1000000", sender)
+	require.NoError(t, err)
+
+	decoded, err := run(t, "burn-message", "decode", encoded, "--output", "json")
+	require.NoError(t, err)
+	var burnMessage map[string]any
+	require.NoError(t, json.Unmarshal([]byte(decoded), &burnMessage))
+	require.Equal(t, map[string]any{
+		"version":        float64(0),
+		"burn_token":     token,
+		"mint_recipient": recipient,
+		"amount":         "1000000",
+		"message_sender": sender,
+	}, burnMessage)
+
+	reencoded, err := run(t, "burn-message", "encode", "0",
+		burnMessage["burn_token"].(string), burnMessage["mint_recipient"].(string), burnMessage["amount"].(string), burnMessage["message_sender"].(string))
+	require.NoError(t, err)
+	require.Equal(t, encoded, reencoded)
+
+	_, err = run(t, "burn-message", "encode", "0", token, recipient, "-1", sender)
+	require.Error(t, err)
+}
+
+func TestAttestationVerify(t *testing.T) {
+	attesters := attester.GenerateSet(2)
+	var publicKeys []string
+	for _, a := range attesters.Attesters() {
+		publicKeys = append(publicKeys, a.Attester)
+	}
+
+	message, err := run(t, "message", "encode", "0", "0", "4", "1", sender, recipient, caller, "0x")
+	require.NoError(t, err)
+	attestation := hexutil.Encode(attesters.Attest(hexutil.MustDecode(message)))
+
+	out, err := run(t, "attestation", "verify", message, attestation, strings.Join(publicKeys, ","), "2")
+	require.NoError(t, err)
+	require.Contains(t, out, "attestation is valid")
+
+	// a single signer does not meet a threshold of 2
+	attestation = hexutil.Encode(attester.Set{attesters[0]}.Attest(hexutil.MustDecode(message)))
+	_, err = run(t, "attestation", "verify", message, attestation, strings.Join(publicKeys, ","), "2")
+	require.Error(t, err)
+
+	_, err = run(t, "attestation", "verify", message, attestation, "not hex", "1")
+	require.Error(t, err)
+}
@@ -36,12 +36,15 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdListAttesters())
+	cmd.AddCommand(CmdAttesterHistory())
 	cmd.AddCommand(CmdListPerMessageBurnLimits())
 	cmd.AddCommand(CmdRemoteTokenMessengers())
 	cmd.AddCommand(CmdListTokenPairs())
 	cmd.AddCommand(CmdListUsedNonces())
 	cmd.AddCommand(CmdShowAttester())
 	cmd.AddCommand(CmdRoles())
+	cmd.AddCommand(CmdPendingRoles())
+	cmd.AddCommand(CmdRoleHistory())
 	cmd.AddCommand(CmdShowBurningAndMintingPaused())
 	cmd.AddCommand(CmdShowMaxMessageBodySize())
 	cmd.AddCommand(CmdShowNextAvailableNonce())
@@ -54,6 +57,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(CmdBurnMessageVersion())
 	cmd.AddCommand(CmdLocalMessageVersion())
 	cmd.AddCommand(CmdLocalDomain())
+	cmd.AddCommand(CmdDestinationCaller())
+	cmd.AddCommand(CmdShowForwarding())
 
 	return cmd
 }
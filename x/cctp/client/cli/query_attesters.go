@@ -28,7 +28,7 @@ import (
 func CmdListAttesters() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-attesters",
-		Short: "lists all attesters",
+		Short: "lists all enabled attesters",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
@@ -88,3 +88,40 @@ func CmdShowAttester() *cobra.Command {
 
 	return cmd
 }
+
+func CmdAttesterHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attester-history [attester]",
+		Short: "lists the history of attester set changes, optionally for a single attester",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryAttesterHistoryRequest{
+				Pagination: pageReq,
+			}
+			if len(args) == 1 {
+				params.Attester = args[0]
+			}
+
+			res, err := queryClient.AttesterHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
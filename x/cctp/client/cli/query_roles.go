@@ -48,3 +48,68 @@ func CmdRoles() *cobra.Command {
 
 	return cmd
 }
+
+func CmdPendingRoles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-roles",
+		Short: "lists the role transfers awaiting acceptance",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryPendingRolesRequest{}
+
+			res, err := queryClient.PendingRoles(context.Background(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRoleHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "role-history [role]",
+		Short: "lists the history of role holders, optionally for a single role (owner|attester-manager|pauser|token-controller)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryRoleHistoryRequest{
+				Pagination: pageReq,
+			}
+			if len(args) == 1 {
+				params.Role, err = types.ParseRole(args[0])
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.RoleHistory(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
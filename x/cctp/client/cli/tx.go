@@ -35,6 +35,9 @@ func GetTxCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(CmdAcceptOwner())
+	cmd.AddCommand(CmdAcceptAttesterManager())
+	cmd.AddCommand(CmdAcceptPauser())
+	cmd.AddCommand(CmdAcceptTokenController())
 	cmd.AddCommand(CmdAddRemoteTokenMessenger())
 	cmd.AddCommand(CmdDepositForBurn())
 	cmd.AddCommand(CmdDepositForBurnWithCaller())
@@ -59,6 +62,11 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdUpdateAttesterManager())
 	cmd.AddCommand(CmdUpdateTokenController())
 	cmd.AddCommand(CmdUpdatePauser())
+	cmd.AddCommand(CmdSetForwarding())
+	cmd.AddCommand(CmdRemoveForwarding())
+	cmd.AddCommand(CmdRegisterAccount32())
+	cmd.AddCommand(CmdEnableContractCallback())
+	cmd.AddCommand(CmdDisableContractCallback())
 
 	return cmd
 }
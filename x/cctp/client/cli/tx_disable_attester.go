@@ -36,15 +36,22 @@ func CmdDisableAttester() *cobra.Command {
 				return err
 			}
 
+			reason, err := cmd.Flags().GetString(FlagReason)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgDisableAttester{
 				From:     clientCtx.GetFromAddress().String(),
 				Attester: args[0],
+				Reason:   reason,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	cmd.Flags().String(FlagReason, "", "Reason for disabling the attester")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
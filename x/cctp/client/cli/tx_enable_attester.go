@@ -36,15 +36,29 @@ func CmdEnableAttester() *cobra.Command {
 				return err
 			}
 
+			keyType, err := parseKeyTypeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			operator, err := cmd.Flags().GetString(FlagOperator)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgEnableAttester{
 				From:     clientCtx.GetFromAddress().String(),
 				Attester: args[0],
+				KeyType:  keyType,
+				Operator: operator,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addKeyTypeFlagToCmd(cmd)
+	cmd.Flags().String(FlagOperator, "", "Label of who operates the attester key")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
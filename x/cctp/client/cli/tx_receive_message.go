@@ -37,16 +37,30 @@ func CmdReceiveMessage() *cobra.Command {
 				return err
 			}
 
+			keyType, err := parseKeyTypeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
+			allowAlreadyReceived, err := cmd.Flags().GetBool(FlagAllowAlreadyReceived)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgReceiveMessage{
-				From:        clientCtx.GetFromAddress().String(),
-				Message:     common.FromHex(args[0]),
-				Attestation: common.FromHex(args[1]),
+				From:                 clientCtx.GetFromAddress().String(),
+				Message:              common.FromHex(args[0]),
+				Attestation:          common.FromHex(args[1]),
+				KeyType:              keyType,
+				AllowAlreadyReceived: allowAlreadyReceived,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addKeyTypeFlagToCmd(cmd)
+	cmd.Flags().Bool(FlagAllowAlreadyReceived, false, "Succeed without changes if this exact message was already received")
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
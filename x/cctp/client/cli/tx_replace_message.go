@@ -36,18 +36,25 @@ func CmdReplaceMessage() *cobra.Command {
 				return err
 			}
 
+			keyType, err := parseKeyTypeFlag(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgReplaceMessage{
 				From:                 clientCtx.GetFromAddress().String(),
 				OriginalMessage:      []byte(args[0]),
 				OriginalAttestation:  []byte(args[1]),
 				NewMessageBody:       []byte(args[2]),
 				NewDestinationCaller: []byte(args[3]),
+				KeyType:              keyType,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
+	addKeyTypeFlagToCmd(cmd)
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,90 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cli
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/spf13/cobra"
+)
+
+const (
+	FlagDestinationCaller = "destination-caller"
+	FlagRelayerFee        = "relayer-fee"
+)
+
+func CmdSetForwarding() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-forwarding [destination-domain] [mint-recipient]",
+		Short: "Set Forwarding",
+		Long:  "Broadcast a transaction that forwards every mint received by the sender to a recipient on another domain.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			destinationDomain, err := strconv.ParseUint(args[0], types.BaseTen, types.DomainBitLen)
+			if err != nil {
+				return err
+			}
+
+			mintRecipient, err := parseAddress(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid mint recipient: %w", err)
+			}
+
+			var destinationCaller []byte
+			if caller, _ := cmd.Flags().GetString(FlagDestinationCaller); caller != "" {
+				destinationCaller, err = parseAddress(caller)
+				if err != nil {
+					return fmt.Errorf("invalid destination caller: %w", err)
+				}
+			}
+
+			feeArg, _ := cmd.Flags().GetString(FlagRelayerFee)
+			fee, ok := math.NewIntFromString(feeArg)
+			if !ok {
+				return errors.Wrapf(types.ErrInvalidAmount, "invalid relayer fee")
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetForwarding{
+				From:              clientCtx.GetFromAddress().String(),
+				DestinationDomain: uint32(destinationDomain),
+				MintRecipient:     mintRecipient,
+				DestinationCaller: destinationCaller,
+				Fee:               fee,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagDestinationCaller, "", "only allow this caller to receive forwarded messages on the destination domain")
+	cmd.Flags().String(FlagRelayerFee, "0", "amount paid from each forwarded mint to its relayer")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -28,7 +28,7 @@ func CmdUpdateAttesterManager() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-attester-manager [address]",
 		Short: "Update the Attester Manager",
-		Long:  "Broadcast a transaction that updates the attester manager to the provided address.",
+		Long:  "Broadcast a transaction that initiates a transfer of the attester manager role to the provided address, which must then accept it.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -36,9 +36,15 @@ func CmdUpdateAttesterManager() *cobra.Command {
 				return err
 			}
 
+			expiresAtHeight, err := cmd.Flags().GetInt64(FlagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUpdateAttesterManager{
 				From:               clientCtx.GetFromAddress().String(),
 				NewAttesterManager: args[0],
+				ExpiresAtHeight:    expiresAtHeight,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -46,6 +52,7 @@ func CmdUpdateAttesterManager() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addExpiresAtHeightFlagToCmd(cmd)
 
 	return cmd
 }
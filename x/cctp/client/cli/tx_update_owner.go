@@ -36,9 +36,15 @@ func CmdUpdateOwner() *cobra.Command {
 				return err
 			}
 
+			expiresAtHeight, err := cmd.Flags().GetInt64(FlagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUpdateOwner{
-				From:     clientCtx.GetFromAddress().String(),
-				NewOwner: args[0],
+				From:            clientCtx.GetFromAddress().String(),
+				NewOwner:        args[0],
+				ExpiresAtHeight: expiresAtHeight,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -46,6 +52,7 @@ func CmdUpdateOwner() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addExpiresAtHeightFlagToCmd(cmd)
 
 	return cmd
 }
@@ -28,7 +28,7 @@ func CmdUpdateTokenController() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-token-controller [address]",
 		Short: "Update the Token Controller",
-		Long:  "Broadcast a transaction that updates the token controller to the provided address.",
+		Long:  "Broadcast a transaction that initiates a transfer of the token controller role to the provided address, which must then accept it.",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			clientCtx, err := client.GetClientTxContext(cmd)
@@ -36,9 +36,15 @@ func CmdUpdateTokenController() *cobra.Command {
 				return err
 			}
 
+			expiresAtHeight, err := cmd.Flags().GetInt64(FlagExpiresAtHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgUpdateTokenController{
 				From:               clientCtx.GetFromAddress().String(),
 				NewTokenController: args[0],
+				ExpiresAtHeight:    expiresAtHeight,
 			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
@@ -46,6 +52,7 @@ func CmdUpdateTokenController() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	addExpiresAtHeightFlagToCmd(cmd)
 
 	return cmd
 }
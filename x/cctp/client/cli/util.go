@@ -17,16 +17,41 @@
 package cli
 
 import (
+	"encoding/hex"
 	"errors"
+	"fmt"
+	"strings"
 
+	"github.com/circlefin/noble-cctp/x/cctp/types"
 	"github.com/cosmos/btcutil/base58"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/spf13/cobra"
 )
 
+const (
+	// FlagKeyType selects the signature scheme of an attester or attestation.
+	FlagKeyType = "key-type"
+	// FlagOperator labels who operates an attester key.
+	FlagOperator = "operator"
+	// FlagReason records why an attester is disabled.
+	FlagReason = "reason"
+	// FlagExpiresAtHeight bounds how long a proposed role transfer can be accepted.
+	FlagExpiresAtHeight = "expires-at-height"
+	// FlagAllowAlreadyReceived makes receiving an already received message succeed.
+	FlagAllowAlreadyReceived = "allow-already-received"
+)
+
+// keyTypes maps the accepted values of FlagKeyType to their key type.
+var keyTypes = map[string]types.KeyType{
+	"secp256k1": types.KeyType_KEY_TYPE_SECP256K1,
+	"ed25519":   types.KeyType_KEY_TYPE_ED25519,
+	"bls12-381": types.KeyType_KEY_TYPE_BLS12_381,
+}
+
 // parseAddress parses an encoded address into a 32 length byte array.
 // Currently supported encodings: base58, hex.
 func parseAddress(address string) ([]byte, error) {
-	if address[:2] == "0x" {
+	if strings.HasPrefix(address, "0x") {
 		bz := common.FromHex(address)
 		return leftPadBytes(bz)
 	}
@@ -46,3 +71,39 @@ func leftPadBytes(bz []byte) ([]byte, error) {
 	copy(res[32-len(bz):], bz)
 	return res, nil
 }
+
+// parseHex decodes a hex encoded string, with or without a 0x prefix, into bytes.
+func parseHex(value string) ([]byte, error) {
+	bz, err := hex.DecodeString(strings.TrimPrefix(value, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid hex string: %w", err)
+	}
+
+	return bz, nil
+}
+
+// addExpiresAtHeightFlagToCmd adds FlagExpiresAtHeight to cmd, defaulting to
+// a role transfer that never expires.
+func addExpiresAtHeightFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().Int64(FlagExpiresAtHeight, 0, "Last block height at which the new holder can accept the role (0 for no expiry)")
+}
+
+// addKeyTypeFlagToCmd adds FlagKeyType to cmd, defaulting to secp256k1.
+func addKeyTypeFlagToCmd(cmd *cobra.Command) {
+	cmd.Flags().String(FlagKeyType, "secp256k1", "Attester key type (secp256k1|ed25519|bls12-381)")
+}
+
+// parseKeyTypeFlag returns the key type selected by FlagKeyType.
+func parseKeyTypeFlag(cmd *cobra.Command) (types.KeyType, error) {
+	value, err := cmd.Flags().GetString(FlagKeyType)
+	if err != nil {
+		return 0, err
+	}
+
+	keyType, found := keyTypes[strings.ToLower(value)]
+	if !found {
+		return 0, fmt.Errorf("unsupported key type %s", value)
+	}
+
+	return keyType, nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/errors" // sdkerrors
 )
 
@@ -80,9 +81,44 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, genState types.GenesisSt
 		k.SetUsedNonce(ctx, elem)
 	}
 
+	for _, elem := range genState.UsedNonceRecords {
+		k.SetUsedNonceRecord(ctx, elem)
+	}
+
 	for _, elem := range genState.TokenMessengerList {
 		k.SetRemoteTokenMessenger(ctx, elem)
 	}
+
+	for _, elem := range genState.AttesterHistory {
+		k.SetAttesterHistoryEntry(ctx, elem)
+	}
+
+	for _, elem := range genState.PendingRoles {
+		k.SetPendingRole(ctx, elem)
+	}
+
+	for _, elem := range genState.RoleHistory {
+		k.SetRoleHistoryEntry(ctx, elem)
+	}
+
+	for _, elem := range genState.Forwardings {
+		k.SetForwarding(ctx, elem)
+	}
+
+	for _, elem := range genState.RegisteredAccounts32 {
+		k.SetAccount32(ctx, sdk.MustAccAddressFromBech32(elem))
+	}
+
+	for _, elem := range genState.ContractCallbacks {
+		k.SetContractCallback(ctx, sdk.MustAccAddressFromBech32(elem))
+	}
+
+	// without a local domain in genesis, store the one from the module config
+	if genState.LocalDomain != nil {
+		k.SetLocalDomain(ctx, *genState.LocalDomain)
+	} else {
+		k.SetLocalDomain(ctx, k.GetLocalDomain(ctx))
+	}
 }
 
 // ExportGenesis returns the module's exported GenesisState
@@ -124,7 +160,21 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) *types.GenesisState {
 
 	genesis.TokenPairList = k.GetAllTokenPairs(ctx)
 	genesis.UsedNoncesList = k.GetAllUsedNonces(ctx)
+	genesis.UsedNonceRecords = k.GetAllUsedNonceRecords(ctx)
 	genesis.TokenMessengerList = k.GetRemoteTokenMessengers(ctx)
+	genesis.AttesterHistory = k.GetAttesterHistory(ctx)
+	genesis.PendingRoles = k.GetAllPendingRoles(ctx)
+	genesis.RoleHistory = k.GetRoleHistory(ctx)
+	genesis.Forwardings = k.GetAllForwardings(ctx)
+	for _, address := range k.GetAllAccounts32(ctx) {
+		genesis.RegisteredAccounts32 = append(genesis.RegisteredAccounts32, address.String())
+	}
+	for _, address := range k.GetAllContractCallbacks(ctx) {
+		genesis.ContractCallbacks = append(genesis.ContractCallbacks, address.String())
+	}
+
+	localDomain := k.GetLocalDomain(ctx)
+	genesis.LocalDomain = &localDomain
 
 	return genesis
 }
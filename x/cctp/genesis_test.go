@@ -17,13 +17,16 @@
 package cctp_test
 
 import (
+	"bytes"
 	"testing"
 
 	"cosmossdk.io/math"
 	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
 	"github.com/circlefin/noble-cctp/testutil/nullify"
+	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,6 +44,14 @@ func TestGenesisHappyPath(t *testing.T) {
 			{
 				Attester: "1",
 			},
+			{
+				Attester:         "2",
+				Operator:         "operator",
+				AddedAtHeight:    1,
+				Status:           types.AttesterStatus_ATTESTER_STATUS_DISABLED,
+				DisabledAtHeight: 2,
+				DisableReason:    "rotated",
+			},
 		},
 		PerMessageBurnLimitList: []types.PerMessageBurnLimit{
 			{
@@ -89,6 +100,13 @@ func TestGenesisHappyPath(t *testing.T) {
 				Nonce:        uint64(5678),
 			},
 		},
+		UsedNonceRecords: []types.UsedNonceRecord{
+			{
+				SourceDomain: uint32(2),
+				Nonce:        uint64(5678),
+				MessageHash:  []byte("hash"),
+			},
+		},
 		TokenMessengerList: []types.RemoteTokenMessenger{
 			{
 				DomainId: uint32(1),
@@ -99,6 +117,50 @@ func TestGenesisHappyPath(t *testing.T) {
 				Address:  make([]byte, 32),
 			},
 		},
+		AttesterHistory: []types.AttesterHistoryEntry{
+			{
+				Id:       0,
+				Attester: "2",
+				Status:   types.AttesterStatus_ATTESTER_STATUS_ENABLED,
+				Height:   1,
+			},
+			{
+				Id:       1,
+				Attester: "2",
+				Status:   types.AttesterStatus_ATTESTER_STATUS_DISABLED,
+				Reason:   "rotated",
+				Height:   2,
+			},
+		},
+		PendingRoles: []types.PendingRole{
+			{
+				Role:            types.Role_ROLE_PAUSER,
+				Address:         "901",
+				ExpiresAtHeight: 100,
+			},
+		},
+		RoleHistory: []types.RoleHistoryEntry{
+			{
+				Id:              0,
+				Role:            types.Role_ROLE_OWNER,
+				PreviousAddress: "012",
+				Address:         "123",
+				Height:          5,
+			},
+		},
+		LocalDomain: &types.LocalDomain{
+			DomainId:           7,
+			MessageVersion:     1,
+			MessageBodyVersion: 2,
+		},
+		Forwardings: []types.Forwarding{
+			{
+				Address:           sample.AccAddress(),
+				DestinationDomain: 1,
+				MintRecipient:     make([]byte, 32),
+				Fee:               math.NewInt(10),
+			},
+		},
 	}
 
 	k, ctx := keepertest.CctpKeeper()
@@ -122,7 +184,86 @@ func TestGenesisHappyPath(t *testing.T) {
 	require.Equal(t, genesisState.SignatureThreshold, got.SignatureThreshold)
 	require.ElementsMatch(t, genesisState.TokenPairList, got.TokenPairList)
 	require.ElementsMatch(t, genesisState.UsedNoncesList, got.UsedNoncesList)
+	require.ElementsMatch(t, genesisState.UsedNonceRecords, got.UsedNonceRecords)
 	require.ElementsMatch(t, genesisState.TokenMessengerList, got.TokenMessengerList)
+	require.Equal(t, genesisState.AttesterHistory, got.AttesterHistory)
+	require.Equal(t, genesisState.PendingRoles, got.PendingRoles)
+	require.Equal(t, genesisState.RoleHistory, got.RoleHistory)
+	require.Equal(t, genesisState.LocalDomain, got.LocalDomain)
+	require.Equal(t, genesisState.Forwardings, got.Forwardings)
+
+	// new entries continue from the imported history
+	require.Equal(t, uint64(2), k.AppendAttesterHistoryEntry(ctx, types.AttesterHistoryEntry{Attester: "2"}))
+	require.Equal(t, uint64(1), k.AppendRoleHistoryEntry(ctx, types.RoleHistoryEntry{Role: types.Role_ROLE_PAUSER}))
+}
+
+func TestGenesisUsedNonceRecordWithoutUsedNonce(t *testing.T) {
+	genesisState := types.DefaultGenesis()
+	genesisState.UsedNonceRecords = []types.UsedNonceRecord{{SourceDomain: 1, Nonce: 1, MessageHash: []byte("hash")}}
+	require.ErrorContains(t, genesisState.Validate(), "used nonce record for unused nonce")
+
+	genesisState.UsedNoncesList = []types.Nonce{{SourceDomain: 1, Nonce: 1}}
+	require.NoError(t, genesisState.Validate())
+}
+
+func TestGenesisRegisteredAccounts32(t *testing.T) {
+	genesisState := types.DefaultGenesis()
+	account := sdk.AccAddress(bytes.Repeat([]byte{1}, 32)).String()
+
+	genesisState.RegisteredAccounts32 = []string{sample.AccAddress()}
+	require.ErrorContains(t, genesisState.Validate(), "is not a 32-byte account")
+
+	genesisState.RegisteredAccounts32 = []string{account, account}
+	require.ErrorContains(t, genesisState.Validate(), "duplicated index for registered 32-byte accounts")
+
+	genesisState.RegisteredAccounts32 = []string{account}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.CctpKeeper()
+	cctp.InitGenesis(ctx, k, *genesisState)
+	require.Equal(t, []string{account}, cctp.ExportGenesis(ctx, k).RegisteredAccounts32)
+}
+
+func TestGenesisContractCallbacks(t *testing.T) {
+	genesisState := types.DefaultGenesis()
+	contract := sdk.AccAddress(bytes.Repeat([]byte{2}, 32)).String()
+
+	genesisState.ContractCallbacks = []string{"invalid address"}
+	require.ErrorIs(t, genesisState.Validate(), types.ErrInvalidAddress)
+
+	genesisState.ContractCallbacks = []string{contract, contract}
+	require.ErrorContains(t, genesisState.Validate(), "duplicated index for contract callbacks")
+
+	genesisState.ContractCallbacks = []string{contract}
+	require.NoError(t, genesisState.Validate())
+
+	k, ctx := keepertest.CctpKeeper()
+	cctp.InitGenesis(ctx, k, *genesisState)
+	require.Equal(t, []string{contract}, cctp.ExportGenesis(ctx, k).ContractCallbacks)
+}
+
+func TestGenesisLocalDomainAsRemoteDomain(t *testing.T) {
+	genesisState := types.DefaultGenesis()
+	genesisState.LocalDomain = &types.LocalDomain{DomainId: 0}
+	require.NoError(t, genesisState.Validate())
+
+	genesisState.TokenMessengerList = []types.RemoteTokenMessenger{{DomainId: 0, Address: make([]byte, 32)}}
+	require.ErrorContains(t, genesisState.Validate(), "remote token messenger for local domain 0")
+
+	genesisState.TokenMessengerList = []types.RemoteTokenMessenger{{DomainId: 1, Address: make([]byte, 32)}}
+	genesisState.UsedNoncesList = []types.Nonce{{SourceDomain: 0, Nonce: 1}}
+	require.ErrorContains(t, genesisState.Validate(), "used nonce from local domain 0")
+
+	genesisState.UsedNoncesList = []types.Nonce{{SourceDomain: 1, Nonce: 1}}
+	genesisState.TokenPairList = []types.TokenPair{{RemoteDomain: 0, RemoteToken: []byte("1"), LocalToken: "uusdc"}}
+	require.ErrorContains(t, genesisState.Validate(), "token pair for local domain 0")
+
+	genesisState.TokenPairList = []types.TokenPair{{RemoteDomain: 1, RemoteToken: []byte("1"), LocalToken: "uusdc"}}
+	genesisState.Forwardings = []types.Forwarding{{Address: sample.AccAddress(), DestinationDomain: 0, MintRecipient: make([]byte, 32)}}
+	require.ErrorContains(t, genesisState.Validate(), "forwarding to local domain 0")
+
+	genesisState.Forwardings[0].DestinationDomain = 1
+	require.NoError(t, genesisState.Validate())
 }
 
 func TestGenesisBurningAndMintingPausedDefault(t *testing.T) {
@@ -198,3 +339,13 @@ func TestGenesisSignatureThresholdDefault(t *testing.T) {
 
 	require.Equal(t, uint32(1), got.SignatureThreshold.Amount)
 }
+
+func TestGenesisLocalDomainDefault(t *testing.T) {
+	genesisState := types.GenesisState{}
+	k, ctx := keepertest.CctpKeeper()
+
+	cctp.InitGenesis(ctx, k, genesisState)
+	got := cctp.ExportGenesis(ctx, k)
+
+	require.Equal(t, types.DefaultLocalDomain(), *got.LocalDomain)
+}
@@ -0,0 +1,66 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ibc
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+// MemoKey is the key of the memo object holding burn instructions.
+const MemoKey = "cctp"
+
+// Memo holds the instructions for burning an incoming transfer, e.g.
+//
+//	{"cctp": {"destination_domain": 0, "mint_recipient": "<base64>", "destination_caller": "<base64>"}}
+type Memo struct {
+	DestinationDomain uint32 `json:"destination_domain"`
+	MintRecipient     []byte `json:"mint_recipient"`
+	DestinationCaller []byte `json:"destination_caller,omitempty"`
+}
+
+// Acknowledgement is the result of a successful burn, returned to the sender.
+type Acknowledgement struct {
+	Nonce uint64 `json:"nonce"`
+}
+
+// ParseMemo returns the burn instructions in memo, and whether memo has any.
+func ParseMemo(memo string) (*Memo, bool, error) {
+	var object map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &object); err != nil {
+		return nil, false, nil
+	}
+	raw, found := object[MemoKey]
+	if !found {
+		return nil, false, nil
+	}
+
+	var instructions Memo
+	if err := json.Unmarshal(raw, &instructions); err != nil {
+		return nil, true, errors.Wrap(types.ErrInvalidMemo, err.Error())
+	}
+	if len(instructions.MintRecipient) != types.MintRecipientLen {
+		return nil, true, errors.Wrapf(types.ErrInvalidMemo, "mint recipient must be %d bytes", types.MintRecipientLen)
+	}
+	if len(instructions.DestinationCaller) != 0 && len(instructions.DestinationCaller) != types.DestinationCallerLen {
+		return nil, true, errors.Wrapf(types.ErrInvalidMemo, "destination caller must be %d bytes", types.DestinationCallerLen)
+	}
+
+	return &instructions, true, nil
+}
@@ -0,0 +1,171 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package ibc provides an ICS-20 middleware that burns USDC received over IBC
+// through CCTP when the transfer memo asks for it. A chain wires it into its
+// transfer stack with:
+//
+//	var transferStack porttypes.IBCModule = transfer.NewIBCModule(app.TransferKeeper)
+//	transferStack = cctpibc.NewIBCMiddleware(transferStack, app.CCTPKeeper)
+package ibc
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware wraps an ICS-20 transfer application. Incoming transfers of
+// the minting denom whose memo carries a "cctp" object are received into an
+// address derived from the channel and sender, then burned from it with
+// DepositForBurn. If the burn fails, an error acknowledgement is returned so
+// that the transfer is reverted and the sender refunded.
+type IBCMiddleware struct {
+	app    porttypes.IBCModule
+	keeper *keeper.Keeper
+}
+
+// NewIBCMiddleware returns a middleware wrapping app.
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{app: app, keeper: k}
+}
+
+// OnRecvPacket burns the received funds if the packet memo asks for it, and
+// otherwise passes the packet on to the wrapped application.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	memo, found, err := ParseMemo(data.Memo)
+	if !found {
+		return im.app.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// only tokens native to this chain, i.e. returning over the channel they
+	// left through, can be burned
+	if !transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		return channeltypes.NewErrorAcknowledgement(errors.Wrapf(types.ErrBurnTokenNotSupported, "%s is not native to this chain", data.Denom))
+	}
+	denom := data.Denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return channeltypes.NewErrorAcknowledgement(errors.Wrapf(types.ErrInvalidAmount, "unable to parse transfer amount %s", data.Amount))
+	}
+
+	// receive the funds into an address nobody else can spend from, so that
+	// the burn is always signed by the original sender
+	depositor := Depositor(packet.GetDestChannel(), data.Sender)
+	data.Receiver = depositor.String()
+	packet.Data = data.GetBytes()
+
+	ack := im.app.OnRecvPacket(ctx, packet, relayer)
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+
+	nonce, err := im.keeper.DepositForBurn(ctx, depositor, amount, memo.DestinationDomain, memo.MintRecipient, denom, memo.DestinationCaller)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	result, err := json.Marshal(Acknowledgement{Nonce: nonce})
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(errors.Wrap(sdkerrors.ErrJSONMarshal, err.Error()))
+	}
+	return channeltypes.NewResultAcknowledgement(result)
+}
+
+// Depositor returns the address that receives, and then burns, the funds of
+// sender transferred over channel.
+func Depositor(channel string, sender string) sdk.AccAddress {
+	return address.Module(types.ModuleName, []byte(channel), []byte(sender))
+}
+
+// OnChanOpenInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenInit(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return im.app.OnChanOpenInit(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, version)
+}
+
+// OnChanOpenTry implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenTry(
+	ctx sdk.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID, channelID string,
+	channelCap *capabilitytypes.Capability,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	return im.app.OnChanOpenTry(ctx, order, connectionHops, portID, channelID, channelCap, counterparty, counterpartyVersion)
+}
+
+// OnChanOpenAck implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenAck(ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string) error {
+	return im.app.OnChanOpenAck(ctx, portID, channelID, counterpartyChannelID, counterpartyVersion)
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanOpenConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanOpenConfirm(ctx, portID, channelID)
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseInit(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseInit(ctx, portID, channelID)
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (im IBCMiddleware) OnChanCloseConfirm(ctx sdk.Context, portID, channelID string) error {
+	return im.app.OnChanCloseConfirm(ctx, portID, channelID)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return im.app.OnTimeoutPacket(ctx, packet, relayer)
+}
@@ -0,0 +1,259 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package ibc_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/simapp"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/ibc"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Burns transfer with cctp memo
+ * Burns transfer with destination caller
+ * Passes through transfer without cctp memo
+ * Refunds transfer with invalid memo
+ * Refunds transfer of non-native denom
+ * Refunds transfer when burn fails
+ * Returns error acknowledgement of wrapped app
+ */
+
+const remoteDomain = 0
+
+var (
+	remoteTokenMessenger = append(make([]byte, 12), []byte("remote messenger 020")...)
+	mintRecipient        = append(make([]byte, 12), []byte("mint recipient 56789")...)
+)
+
+// transferApp stands in for the ICS-20 application, paying out received
+// transfers from an escrow account.
+type transferApp struct {
+	porttypes.IBCModule
+	app     *simapp.SimApp
+	escrow  sdk.AccAddress
+	packets []channeltypes.Packet
+	fail    bool
+}
+
+func (a *transferApp) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	a.packets = append(a.packets, packet)
+	if a.fail {
+		return channeltypes.NewErrorAcknowledgement(transfertypes.ErrReceiveDisabled)
+	}
+
+	var data transfertypes.FungibleTokenPacketData
+	transfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+	amount, _ := math.NewIntFromString(data.Amount)
+	denom := data.Denom[len(transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())):]
+	receiver := sdk.MustAccAddressFromBech32(data.Receiver)
+	if err := a.app.BankKeeper.SendCoins(ctx, a.escrow, receiver, sdk.NewCoins(sdk.NewCoin(denom, amount))); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+type middlewareTest struct {
+	app        *simapp.SimApp
+	ctx        sdk.Context
+	transfer   *transferApp
+	middleware ibc.IBCMiddleware
+}
+
+func setupMiddleware(t *testing.T) *middlewareTest {
+	escrow := sdk.MustAccAddressFromBech32(sample.AccAddress())
+	app := simapp.Setup(t, banktypes.Balance{
+		Address: escrow.String(),
+		Coins:   sdk.NewCoins(sdk.NewInt64Coin(simapp.Denom, 1_000_000)),
+	})
+	ctx := app.NewTestContext()
+	app.CCTPKeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{DomainId: remoteDomain, Address: remoteTokenMessenger})
+	app.CCTPKeeper.SetPerMessageBurnLimit(ctx, types.PerMessageBurnLimit{Denom: simapp.Denom, Amount: math.NewInt(1_000_000)})
+
+	transfer := &transferApp{app: app, escrow: escrow}
+	return &middlewareTest{
+		app:        app,
+		ctx:        ctx,
+		transfer:   transfer,
+		middleware: ibc.NewIBCMiddleware(transfer, app.CCTPKeeper),
+	}
+}
+
+// recv delivers a transfer as the IBC core would, only writing state changes
+// on a successful acknowledgement.
+func (m *middlewareTest) recv(denom string, amount string, memo string) (sdk.Context, ibcexported.Acknowledgement) {
+	data := transfertypes.NewFungibleTokenPacketData(denom, amount, "cosmos1sender", sample.AccAddress(), memo)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, "transfer", "channel-7", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+
+	ctx, write := m.ctx.CacheContext()
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	ack := m.middleware.OnRecvPacket(ctx, packet, nil)
+	if ack.Success() {
+		write()
+	}
+	return ctx, ack
+}
+
+func (m *middlewareTest) supply() math.Int {
+	return m.app.BankKeeper.GetSupply(m.ctx, simapp.Denom).Amount
+}
+
+func memo(t *testing.T, instructions ibc.Memo) string {
+	bz, err := json.Marshal(map[string]ibc.Memo{ibc.MemoKey: instructions})
+	require.NoError(t, err)
+	return string(bz)
+}
+
+func TestMiddlewareBurn(t *testing.T) {
+	m := setupMiddleware(t)
+	supply := m.supply()
+
+	ctx, ack := m.recv("transfer/channel-7/"+simapp.Denom, "1000", memo(t, ibc.Memo{
+		DestinationDomain: remoteDomain,
+		MintRecipient:     mintRecipient,
+	}))
+	require.True(t, ack.Success())
+
+	acknowledgement := ack.(channeltypes.Acknowledgement)
+	var result ibc.Acknowledgement
+	require.NoError(t, json.Unmarshal(acknowledgement.GetResult(), &result))
+	require.Equal(t, uint64(0), result.Nonce)
+
+	// funds were received by the depositor, not the packet receiver
+	require.Len(t, m.transfer.packets, 1)
+	var data transfertypes.FungibleTokenPacketData
+	transfertypes.ModuleCdc.MustUnmarshalJSON(m.transfer.packets[0].GetData(), &data)
+	depositor := ibc.Depositor("channel-0", "cosmos1sender")
+	require.Equal(t, depositor.String(), data.Receiver)
+
+	require.True(t, m.app.BankKeeper.GetBalance(m.ctx, depositor, simapp.Denom).IsZero())
+	require.Equal(t, supply.SubRaw(1000), m.supply())
+
+	events := types.MessageSentEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	message, err := new(types.Message).Parse(events[0])
+	require.NoError(t, err)
+	burnMessage, err := new(types.BurnMessage).Parse(message.MessageBody)
+	require.NoError(t, err)
+	require.Equal(t, mintRecipient, burnMessage.MintRecipient)
+	require.Equal(t, math.NewInt(1000), burnMessage.Amount)
+	require.Equal(t, []byte(depositor), burnMessage.MessageSender)
+	require.Equal(t, make([]byte, types.DestinationCallerLen), message.DestinationCaller)
+}
+
+func TestMiddlewareBurnWithCaller(t *testing.T) {
+	m := setupMiddleware(t)
+	caller := append(make([]byte, 12), []byte("destination caller 0")...)
+
+	ctx, ack := m.recv("transfer/channel-7/"+simapp.Denom, "1000", memo(t, ibc.Memo{
+		DestinationDomain: remoteDomain,
+		MintRecipient:     mintRecipient,
+		DestinationCaller: caller,
+	}))
+	require.True(t, ack.Success())
+
+	events := types.MessageSentEvents(ctx.EventManager().ABCIEvents())
+	require.Len(t, events, 1)
+	message, err := new(types.Message).Parse(events[0])
+	require.NoError(t, err)
+	require.Equal(t, caller, message.DestinationCaller)
+}
+
+func TestMiddlewarePassThrough(t *testing.T) {
+	m := setupMiddleware(t)
+	supply := m.supply()
+
+	for _, memo := range []string{"", "not json", `{"wasm":{}}`} {
+		_, ack := m.recv("transfer/channel-7/"+simapp.Denom, "1000", memo)
+		require.True(t, ack.Success())
+	}
+
+	require.Len(t, m.transfer.packets, 3)
+	for _, packet := range m.transfer.packets {
+		var data transfertypes.FungibleTokenPacketData
+		transfertypes.ModuleCdc.MustUnmarshalJSON(packet.GetData(), &data)
+		require.NotEqual(t, ibc.Depositor("channel-0", "cosmos1sender").String(), data.Receiver)
+	}
+	require.Equal(t, supply, m.supply())
+}
+
+func TestMiddlewareInvalidMemo(t *testing.T) {
+	m := setupMiddleware(t)
+
+	for _, memo := range []string{
+		`{"cctp":"burn"}`,
+		`{"cctp":{"destination_domain":0}}`,
+		memo(t, ibc.Memo{MintRecipient: mintRecipient, DestinationCaller: []byte("short")}),
+	} {
+		_, ack := m.recv("transfer/channel-7/"+simapp.Denom, "1000", memo)
+		require.False(t, ack.Success())
+	}
+	require.Empty(t, m.transfer.packets)
+}
+
+func TestMiddlewareNonNativeDenom(t *testing.T) {
+	m := setupMiddleware(t)
+
+	_, ack := m.recv(simapp.Denom, "1000", memo(t, ibc.Memo{
+		DestinationDomain: remoteDomain,
+		MintRecipient:     mintRecipient,
+	}))
+	require.False(t, ack.Success())
+	require.Empty(t, m.transfer.packets)
+}
+
+func TestMiddlewareRefundOnBurnFailure(t *testing.T) {
+	m := setupMiddleware(t)
+	supply := m.supply()
+
+	// no remote token messenger is registered for domain 5
+	_, ack := m.recv("transfer/channel-7/"+simapp.Denom, "1000", memo(t, ibc.Memo{
+		DestinationDomain: 5,
+		MintRecipient:     mintRecipient,
+	}))
+	require.False(t, ack.Success())
+	require.Contains(t, string(ack.Acknowledgement()), "ABCI code: 53")
+
+	// the receive was reverted, so the sender is refunded on acknowledgement
+	require.Len(t, m.transfer.packets, 1)
+	require.True(t, m.app.BankKeeper.GetBalance(m.ctx, ibc.Depositor("channel-0", "cosmos1sender"), simapp.Denom).IsZero())
+	require.Equal(t, supply, m.supply())
+}
+
+func TestMiddlewareWrappedAppError(t *testing.T) {
+	m := setupMiddleware(t)
+	m.transfer.fail = true
+
+	_, ack := m.recv("transfer/channel-7/"+simapp.Denom, "1000", memo(t, ibc.Memo{
+		DestinationDomain: remoteDomain,
+		MintRecipient:     mintRecipient,
+	}))
+	require.False(t, ack.Success())
+	require.Len(t, m.transfer.packets, 1)
+}
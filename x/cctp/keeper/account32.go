@@ -0,0 +1,79 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsAccount32Registered returns whether a 32-byte account is registered
+func (k Keeper) IsAccount32Registered(ctx context.Context, address sdk.AccAddress) bool {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.Account32KeyPrefix))
+
+	return store.Has(types.Account32Key(address))
+}
+
+// SetAccount32 registers a 32-byte account in the store
+func (k Keeper) SetAccount32(ctx context.Context, address sdk.AccAddress) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.Account32KeyPrefix))
+
+	store.Set(types.Account32Key(address), address)
+}
+
+// GetAllAccounts32 returns all registered 32-byte accounts
+func (k Keeper) GetAllAccounts32(ctx context.Context) (list []sdk.AccAddress) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.Account32KeyPrefix))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, sdk.AccAddress(iterator.Value()))
+	}
+
+	return
+}
+
+// recipientAddress converts a 32-byte CCTP recipient into a Noble account.
+// Registered 32-byte accounts are used in full, and recipients left padded
+// with 12 zero bytes are the 20-byte account in their last 20 bytes. Any other
+// recipient is rejected rather than truncated to an unrelated account.
+func (k Keeper) recipientAddress(ctx context.Context, recipient []byte) (sdk.AccAddress, error) {
+	if len(recipient) != types.AddressBytesLen {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "expected %d bytes, found %d", types.AddressBytesLen, len(recipient))
+	}
+
+	if k.IsAccount32Registered(ctx, recipient) {
+		return sdk.AccAddress(recipient), nil
+	}
+
+	if !bytes.Equal(recipient[:12], make([]byte, 12)) {
+		return nil, errors.Wrapf(types.ErrInvalidMintRecipient, "32-byte account %x is not registered", recipient)
+	}
+
+	return sdk.AccAddress(recipient[12:]), nil
+}
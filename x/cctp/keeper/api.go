@@ -0,0 +1,261 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
+)
+
+// The methods in this file are the stable API for other modules composing
+// with CCTP. They take typed arguments, so a module can act as a sender or
+// caller with its own module account without building and signing messages.
+// Their signatures are only changed in a breaking release.
+
+// DepositForBurn burns amount of burnToken from the from account and sends a
+// BurnMessage to destinationDomain minting to mintRecipient. An empty
+// destinationCaller allows any caller on the destination domain to receive
+// the message. It returns the nonce of the sent message.
+func (k Keeper) DepositForBurn(
+	goCtx context.Context,
+	from sdk.AccAddress,
+	amount math.Int,
+	destinationDomain uint32,
+	mintRecipient []byte,
+	burnToken string,
+	destinationCaller []byte,
+) (uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// check for valid `from` address
+	messageSender, err := types.Bytes32FromAccAddress(from)
+	if err != nil {
+		return 0, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if !amount.IsPositive() {
+		return 0, errors.Wrap(types.ErrInvalidAmount, "amount must be positive")
+	}
+
+	emptyByteArr := make([]byte, types.MintRecipientLen)
+	if mintRecipient == nil || bytes.Equal(mintRecipient, emptyByteArr) {
+		return 0, errors.Wrap(types.ErrInvalidMintRecipient, "mint recipient must be nonzero")
+	}
+	if len(mintRecipient) != types.MintRecipientLen {
+		return 0, errors.Wrapf(types.ErrInvalidMintRecipient, "mint recipient must be %d bytes, got %d", types.MintRecipientLen, len(mintRecipient))
+	}
+
+	if _, err := paddedDestinationCaller(destinationCaller); err != nil {
+		return 0, err
+	}
+
+	tokenMessenger, found := k.GetRemoteTokenMessenger(ctx, destinationDomain)
+	if !found {
+		return 0, errors.Wrapf(types.ErrRemoteTokenMessengerNotFound, "unable to look up destination token messenger for domain %d", destinationDomain)
+	}
+
+	// the burn message is only sent after the hooks and the burn, which must
+	// not run for a message that cannot be sent
+	if err := k.validateSendMessage(ctx, tokenMessenger.Address, types.BurnMessageLen); err != nil {
+		return 0, err
+	}
+
+	// Note: fiat token factory only supports burning 1 token denom
+	denom := k.fiattokenfactory.GetMintingDenom(ctx)
+	if !strings.EqualFold(denom.Denom, burnToken) {
+		return 0, errors.Wrapf(types.ErrBurnTokenNotSupported, "burning denom: %s is not supported", burnToken)
+	}
+
+	// check if burning/minting is paused
+	paused, _ := k.GetBurningAndMintingPaused(ctx)
+	if paused.Paused {
+		return 0, errors.Wrap(types.ErrBurningAndMintingPaused, "unable to burn")
+	}
+
+	// check if amount is greater than configured PerMessageBurnLimit for this token
+	perMessageBurnLimit, found := k.GetPerMessageBurnLimit(ctx, strings.ToLower(burnToken))
+	if found {
+		if amount.GT(perMessageBurnLimit.Amount) {
+			return 0, errors.Wrapf(types.ErrBurnLimitExceeded, "cannot burn more than the maximum per message burn limit of %s", perMessageBurnLimit.Amount)
+		}
+	}
+
+	if err := k.Hooks().BeforeDepositForBurn(ctx, from, amount, burnToken, destinationDomain, mintRecipient); err != nil {
+		return 0, err
+	}
+
+	// burn coins
+	coin := sdk.NewCoin(burnToken, math.NewIntFromBigInt(amount.BigInt()))
+
+	err = k.bank.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, sdk.NewCoins(coin))
+	if err != nil {
+		return 0, errors.Wrap(err, "error during transfer")
+	}
+
+	fiatBurnMsg := fiattokenfactorytypes.MsgBurn{
+		From:   types.ModuleAddress.String(),
+		Amount: coin,
+	}
+	_, err = k.fiattokenfactory.Burn(ctx, &fiatBurnMsg)
+	if err != nil {
+		return 0, errors.Wrapf(err, "error during burn")
+	}
+
+	burnMessage := types.BurnMessage{
+		Version:       k.GetLocalDomain(ctx).MessageBodyVersion,
+		BurnToken:     crypto.Keccak256([]byte(strings.ToLower(burnToken))),
+		MintRecipient: mintRecipient,
+		Amount:        amount,
+		MessageSender: messageSender,
+	}
+
+	newMessageBodyBytes, err := burnMessage.Bytes()
+	if err != nil {
+		return 0, errors.Wrapf(types.ErrParsingBurnMessage, "error parsing burn message into bytes")
+	}
+
+	nonce, err := k.SendMessage(ctx, types.ModuleAddress, destinationDomain, tokenMessenger.Address, newMessageBodyBytes, destinationCaller)
+	if err != nil {
+		return 0, err
+	}
+
+	event := types.DepositForBurn{
+		Nonce:                     nonce,
+		BurnToken:                 hex.EncodeToString(crypto.Keccak256([]byte(burnToken))),
+		Amount:                    amount,
+		Depositor:                 from.String(),
+		MintRecipient:             mintRecipient,
+		DestinationDomain:         destinationDomain,
+		DestinationTokenMessenger: tokenMessenger.Address,
+		DestinationCaller:         destinationCaller,
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		return 0, err
+	}
+
+	return nonce, k.Hooks().AfterDepositForBurn(ctx, nonce, from, amount, burnToken, destinationDomain, mintRecipient)
+}
+
+// SendMessage sends messageBody to recipient on destinationDomain on behalf of
+// the from account. An empty destinationCaller allows any caller on the
+// destination domain to receive the message, otherwise it must be a nonzero
+// 32-byte address. It returns the nonce of the sent message.
+func (k Keeper) SendMessage(
+	goCtx context.Context,
+	from sdk.AccAddress,
+	destinationDomain uint32,
+	recipient []byte,
+	messageBody []byte,
+	destinationCaller []byte,
+) (uint64, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	messageSender, err := types.Bytes32FromAccAddress(from)
+	if err != nil {
+		return 0, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	destinationCaller, err = paddedDestinationCaller(destinationCaller)
+	if err != nil {
+		return 0, err
+	}
+
+	// a nonce is only reserved for a message that can be sent, as modules may
+	// call this outside of a transaction that is reverted on failure
+	if err := k.validateSendMessage(ctx, recipient, len(messageBody)); err != nil {
+		return 0, err
+	}
+
+	nonce := k.ReserveAndIncrementNonce(ctx)
+
+	err = k.sendMessage(
+		ctx,
+		destinationDomain,
+		recipient,
+		destinationCaller,
+		messageSender,
+		nonce.Nonce,
+		messageBody)
+
+	return nonce.Nonce, err
+}
+
+// paddedDestinationCaller returns destinationCaller, or 32 zero bytes allowing
+// any caller if it is empty. A set destination caller must be a nonzero
+// 32-byte address.
+func paddedDestinationCaller(destinationCaller []byte) ([]byte, error) {
+	emptyByteArr := make([]byte, types.DestinationCallerLen)
+	if len(destinationCaller) == 0 {
+		return emptyByteArr, nil
+	}
+	if len(destinationCaller) != types.DestinationCallerLen || bytes.Equal(destinationCaller, emptyByteArr) {
+		return nil, errors.Wrap(types.ErrInvalidDestinationCaller, "destination caller must be nonzero")
+	}
+	return destinationCaller, nil
+}
+
+// ReceiveMessage receives an attested message as caller, minting to its
+// recipient if it is a BurnMessage. caller must be allowed by the
+// destination caller of the message.
+func (k Keeper) ReceiveMessage(
+	goCtx context.Context,
+	caller sdk.AccAddress,
+	message []byte,
+	attestation []byte,
+	keyType types.KeyType,
+) error {
+	_, err := k.receiveMessage(sdk.UnwrapSDKContext(goCtx), &types.MsgReceiveMessage{
+		From:        caller.String(),
+		Message:     message,
+		Attestation: attestation,
+		KeyType:     keyType,
+	})
+	return err
+}
+
+// RegisterAccount32 registers the 32-byte account address, so that messages
+// and mints to it are no longer rejected. Modules controlling module-derived
+// accounts, which can not sign MsgRegisterAccount32, register them with it.
+func (k Keeper) RegisterAccount32(goCtx context.Context, address sdk.AccAddress) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// a 32-byte account starting with 12 zero bytes would take over mints to
+	// the 20-byte account in its last 20 bytes
+	if len(address) != types.AddressBytesLen || bytes.Equal(address[:12], make([]byte, 12)) {
+		return errors.Wrapf(types.ErrInvalidAddress, "%s is not a 32-byte account", address)
+	}
+
+	if k.IsAccount32Registered(ctx, address) {
+		return errors.Wrapf(types.ErrAccount32AlreadyRegistered, "%s is already registered", address)
+	}
+	k.SetAccount32(ctx, address)
+
+	event := types.Account32Registered{
+		Address: address.String(),
+	}
+	return ctx.EventManager().EmitTypedEvent(&event)
+}
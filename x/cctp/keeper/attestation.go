@@ -17,91 +17,88 @@
 package keeper
 
 import (
-	"bytes"
-	"crypto/ecdsa"
-	"math/big"
+	"crypto/sha256"
+	"encoding/binary"
+	"sync"
 
-	sdkerrors "cosmossdk.io/errors"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
-	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/crypto"
 )
 
-const (
-	xIndex = 1
-	yIndex = 33
-)
-
-/*
-* Rules for valid attestation:
-* 1. length of `_attestation` == 65 (signature length) * signatureThreshold
-* 2. addresses recovered from attestation must be in increasing order.
-* 	For example, if signature A is signed by address 0x1..., and signature B
-* 		is signed by address 0x2..., attestation must be passed as AB.
-* 3. no duplicate signers
-* 4. all signers must be enabled attesters
- */
+// VerifyAttestationSignatures verifies attestation against message, the
+// enabled attesters and the signature threshold. The inputs are not modified.
+// See types.AttestationVerifier for the rules of a valid attestation.
 func VerifyAttestationSignatures(
 	message []byte,
 	attestation []byte,
 	publicKeys []types.Attester,
 	signatureThreshold uint32,
 ) error {
-	if uint32(len(attestation)) != types.SignatureLength*signatureThreshold {
-		return sdkerrors.Wrap(types.ErrSignatureVerification, "invalid attestation length")
-	}
-
-	if signatureThreshold == 0 {
-		return sdkerrors.Wrap(types.ErrSignatureVerification, "signature verification threshold cannot be 0")
-	}
-
-	// public keys cannot be empty, so the recovered key should be bigger than latestECDSA
-	var latestECDSA ecdsa.PublicKey
+	return VerifyAttestation(types.KeyType_KEY_TYPE_SECP256K1, message, attestation, publicKeys, signatureThreshold)
+}
 
-	digest := crypto.Keccak256(message)
+// VerifyAttestation verifies attestation against message using the scheme
+// of keyType. Only enabled attesters of that key type count towards the
+// signature threshold.
+func VerifyAttestation(
+	keyType types.KeyType,
+	message []byte,
+	attestation []byte,
+	attesters []types.Attester,
+	signatureThreshold uint32,
+) error {
+	_, err := types.NewAttestationVerifier(attesters, signatureThreshold).VerifyKeyType(keyType, message, attestation)
+	return err
+}
 
-	for i := uint32(0); i < signatureThreshold; i++ {
-		signature := attestation[i*types.SignatureLength : (i*types.SignatureLength)+types.SignatureLength]
+// verifierCache holds the AttestationVerifier built for the last attester set
+// and signature threshold, so that attester public keys are only decoded
+// again once either changes. Entries are keyed by the contents of the set
+// rather than invalidated on writes, so that changes made by a transaction
+// that is later reverted never leak into the cache.
+type verifierCache struct {
+	mu       sync.Mutex
+	key      [sha256.Size]byte
+	verifier *types.AttestationVerifier
+}
 
-		// The go-ethereum library assumes that the v-value of a secp256k1
-		// signature is either 0 or 1. However, in legacy Bitcoin signers, this
-		// value is either 27 or 28. So we need to normalise in order to verify.
-		if signature[len(signature)-1] == 27 || signature[len(signature)-1] == 28 {
-			signature[len(signature)-1] -= 27
-		}
+// attestationVerifier returns a verifier for attesters and signatureThreshold,
+// reusing the cached one if neither changed.
+func (k Keeper) attestationVerifier(attesters []types.Attester, signatureThreshold uint32) *types.AttestationVerifier {
+	if k.verifiers == nil {
+		return types.NewAttestationVerifier(attesters, signatureThreshold)
+	}
 
-		recoveredKey, err := crypto.Ecrecover(digest, signature)
-		if err != nil {
-			return sdkerrors.Wrapf(types.ErrSignatureVerification, "failed to recover public key: %s", err)
-		}
+	hash := sha256.New()
+	for _, attester := range attesters {
+		bz := k.cdc.MustMarshal(&attester)
+		hash.Write(binary.BigEndian.AppendUint32(nil, uint32(len(bz))))
+		hash.Write(bz)
+	}
+	hash.Write(binary.BigEndian.AppendUint32(nil, signatureThreshold))
 
-		// Signatures must be in increasing order of address, and may not duplicate signatures from same address
-		recoveredECSDA := ecdsa.PublicKey{
-			X: new(big.Int).SetBytes(recoveredKey[xIndex:yIndex]),
-			Y: new(big.Int).SetBytes(recoveredKey[yIndex:]),
-		}
+	var key [sha256.Size]byte
+	copy(key[:], hash.Sum(nil))
 
-		if latestECDSA.X != nil && latestECDSA.Y != nil && bytes.Compare(
-			crypto.PubkeyToAddress(latestECDSA).Bytes(),
-			crypto.PubkeyToAddress(recoveredECSDA).Bytes()) > -1 {
-			return sdkerrors.Wrap(types.ErrSignatureVerification, "invalid signature order or dupe")
-		}
+	k.verifiers.mu.Lock()
+	defer k.verifiers.mu.Unlock()
 
-		// check that recovered key is a valid attester
-		contains := false
-		for _, key := range publicKeys {
-			hexBz := common.FromHex(key.Attester)
-			if bytes.Equal(hexBz, recoveredKey) {
-				contains = true
-				break
-			}
-		}
+	if k.verifiers.verifier == nil || k.verifiers.key != key {
+		k.verifiers.key = key
+		k.verifiers.verifier = types.NewAttestationVerifier(attesters, signatureThreshold)
+	}
 
-		if !contains {
-			return sdkerrors.Wrap(types.ErrSignatureVerification, "Invalid signature: not an attester")
-		}
+	return k.verifiers.verifier
+}
 
-		latestECDSA.X, latestECDSA.Y = recoveredECSDA.X, recoveredECSDA.Y
-	}
-	return nil
+// verifyAttestation verifies attestation against message like
+// VerifyAttestation, using the cached verifier.
+func (k Keeper) verifyAttestation(
+	keyType types.KeyType,
+	message []byte,
+	attestation []byte,
+	attesters []types.Attester,
+	signatureThreshold uint32,
+) error {
+	_, err := k.attestationVerifier(attesters, signatureThreshold).VerifyKeyType(keyType, message, attestation)
+	return err
 }
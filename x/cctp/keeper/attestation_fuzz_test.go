@@ -0,0 +1,132 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// fuzzKeys holds the enabled attesters followed by a single key that is not
+// an attester.
+var fuzzKeys = attester.DeterministicSet([]byte("attestation-fuzz"), 5)
+
+func fuzzAttesters() []types.Attester {
+	return fuzzKeys[:4].Attesters()
+}
+
+// FuzzVerifyAttestationSignaturesSigners builds attestations from signers
+// picked by the fuzzer, in the order it chooses, and checks that only strictly
+// increasing sets of enabled attesters are accepted.
+func FuzzVerifyAttestationSignaturesSigners(f *testing.F) {
+	for _, seed := range sample.Messages() {
+		f.Add(seed, []byte{0, 1, 2, 3}, false)
+		f.Add(seed, []byte{3, 2}, true)
+		f.Add(seed, []byte{1, 1}, false)
+		f.Add(seed, []byte{4}, false)
+	}
+
+	f.Fuzz(func(t *testing.T, message []byte, signers []byte, legacy bool) {
+		if len(signers) == 0 || len(signers) > len(fuzzKeys) {
+			return
+		}
+
+		var attestation []byte
+		expected := true
+		var previous []byte
+		for _, signer := range signers {
+			key := fuzzKeys[int(signer)%len(fuzzKeys)]
+			signature := key.Sign(message)
+			if legacy {
+				signature[types.SignatureLength-1] += 27
+			}
+			attestation = append(attestation, signature...)
+
+			address := key.Address().Bytes()
+			if int(signer)%len(fuzzKeys) == len(fuzzKeys)-1 || (previous != nil && bytes.Compare(previous, address) >= 0) {
+				expected = false
+			}
+			previous = address
+		}
+
+		err := keeper.VerifyAttestationSignatures(message, attestation, fuzzAttesters(), uint32(len(signers)))
+		if expected && err != nil {
+			t.Fatalf("valid attestation from signers %v rejected: %s", signers, err)
+		}
+		if !expected && err == nil {
+			t.Fatalf("invalid attestation from signers %v accepted", signers)
+		}
+	})
+}
+
+// FuzzVerifyAttestationSignaturesBytes checks that arbitrary attestations
+// never cause a panic, and that any accepted attestation recovers to strictly
+// increasing enabled attesters.
+func FuzzVerifyAttestationSignaturesBytes(f *testing.F) {
+	for _, seed := range sample.Messages() {
+		f.Add(seed, fuzzKeys[:4].Attest(seed))
+		f.Add(seed, fuzzKeys[:4].AttestLegacy(seed))
+		f.Add(seed, fuzzKeys[:2].AttestUnsorted(seed))
+		f.Add(seed, fuzzKeys[:2].AttestWithDuplicate(seed))
+	}
+	f.Add([]byte{}, []byte{})
+
+	f.Fuzz(func(t *testing.T, message []byte, attestation []byte) {
+		threshold := uint32(len(attestation) / types.SignatureLength)
+		if threshold > 4 {
+			return
+		}
+		original := bytes.Clone(attestation)
+
+		err := keeper.VerifyAttestationSignatures(message, attestation, fuzzAttesters(), threshold)
+		if !bytes.Equal(original, attestation) {
+			t.Fatalf("verification modified the attestation")
+		}
+		if err != nil {
+			return
+		}
+
+		digest := crypto.Keccak256(message)
+		var previous []byte
+		for i := uint32(0); i < threshold; i++ {
+			signature := bytes.Clone(original[i*types.SignatureLength : (i+1)*types.SignatureLength])
+			if v := signature[types.SignatureLength-1]; v == 27 || v == 28 {
+				signature[types.SignatureLength-1] -= 27
+			}
+
+			publicKey, err := crypto.SigToPub(digest, signature)
+			if err != nil {
+				t.Fatalf("accepted attestation with unrecoverable signature %d: %s", i, err)
+			}
+
+			address := crypto.PubkeyToAddress(*publicKey).Bytes()
+			if previous != nil && bytes.Compare(previous, address) >= 0 {
+				t.Fatalf("accepted attestation with unsorted or duplicate signer %x", address)
+			}
+			if bytes.Equal(address, fuzzKeys[4].Address().Bytes()) {
+				t.Fatalf("accepted attestation signed by non attester %x", address)
+			}
+			previous = address
+		}
+	})
+}
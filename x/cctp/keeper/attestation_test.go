@@ -17,17 +17,14 @@
 package keeper_test
 
 import (
-	"bytes"
 	"crypto/ecdsa"
-	"crypto/elliptic"
-	"encoding/hex"
-	"log"
-	"sort"
 	"testing"
 
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
-	"github.com/ethereum/go-ethereum/crypto"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -92,11 +89,11 @@ func TestVerifyAttestationSignaturesFailedToRecoverPublicKey(t *testing.T) {
 
 func TestVerifyAttestationSignaturesInvalidSignatureOrder(t *testing.T) {
 	message := []byte("Execute order")
-	privKeys := generateNPrivateKeys(20000) // high number to increase odds of invalid sort order
+	privKeys := generateNPrivateKeys(3)
 	attesters := getAttestersFromPrivateKeys(privKeys)
 	attestation := generateAttestationWithInvalidSignatureOrder(message, privKeys)
 
-	err := keeper.VerifyAttestationSignatures(message, attestation, attesters, 20000)
+	err := keeper.VerifyAttestationSignatures(message, attestation, attesters, 3)
 	require.ErrorIs(t, types.ErrSignatureVerification, err)
 	require.Contains(t, err.Error(), "invalid signature order or dupe")
 }
@@ -117,14 +114,9 @@ func TestVerifyAttestationSignaturesLegacySignature(t *testing.T) {
 
 	keys := generateNPrivateKeys(2)
 	attesters := getAttestersFromPrivateKeys(keys)
-	attestation := generateAttestation(message, keys)
-
 	// Because we use updated signers, we need to mock a legacy signature by
 	// manually changing the v-value.
-	for i := 0; i < len(keys); i++ {
-		signature := attestation[i*types.SignatureLength : (i*types.SignatureLength)+types.SignatureLength]
-		signature[len(signature)-1] += 27
-	}
+	attestation := attester.NewSet(keys...).AttestLegacy(message)
 
 	err := keeper.VerifyAttestationSignatures(message, attestation, attesters, uint32(len(keys)))
 	require.NoError(t, err)
@@ -144,121 +136,75 @@ func TestVerifyAttestationSignaturesInvalidAttester(t *testing.T) {
 	require.Contains(t, err.Error(), "Invalid signature: not an attester")
 }
 
+func TestValidateReceiveMessageFollowsAttesterChanges(t *testing.T) {
+	testkeeper, goCtx := keepertest.CctpKeeper()
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	message, err := (&types.Message{
+		Version:           0,
+		SourceDomain:      0,
+		DestinationDomain: 4,
+		Nonce:             1,
+		Sender:            make([]byte, 32),
+		Recipient:         make([]byte, 32),
+		DestinationCaller: make([]byte, types.DestinationCallerLen),
+		MessageBody:       []byte("hello"),
+	}).Bytes()
+	require.NoError(t, err)
+
+	first, second := attester.GenerateSet(1), attester.GenerateSet(1)
+	testkeeper.SetAttester(ctx, first.Attesters()[0])
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 1})
+
+	validate := func(ctx sdk.Context, signers attester.Set) error {
+		_, err := testkeeper.ValidateReceiveMessage(ctx, &types.MsgReceiveMessage{
+			From:        "random address",
+			Message:     message,
+			Attestation: signers.Attest(message),
+		})
+		return err
+	}
+
+	require.NoError(t, validate(ctx, first))
+	require.ErrorIs(t, validate(ctx, second), types.ErrSignatureVerification)
+
+	// an attester enabled by a reverted transaction is not trusted afterwards
+	cacheCtx, _ := ctx.CacheContext()
+	testkeeper.SetAttester(cacheCtx, second.Attesters()[0])
+	require.NoError(t, validate(cacheCtx, second))
+	require.ErrorIs(t, validate(ctx, second), types.ErrSignatureVerification)
+
+	// replacing the attester takes effect immediately
+	testkeeper.DeleteAttester(ctx, first.Attesters()[0].Attester)
+	testkeeper.SetAttester(ctx, second.Attesters()[0])
+	require.NoError(t, validate(ctx, second))
+	require.ErrorIs(t, validate(ctx, first), types.ErrSignatureVerification)
+
+	// so does raising the signature threshold
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+	require.ErrorIs(t, validate(ctx, second), types.ErrSignatureVerification)
+}
+
 func generateNPrivateKeys(n int) []*ecdsa.PrivateKey {
 	result := make([]*ecdsa.PrivateKey, n)
-	for i := 0; i < n; i++ {
-		result[i], _ = crypto.GenerateKey()
+	for i, key := range attester.GenerateSet(n) {
+		result[i] = key.PrivateKey
 	}
 	return result
 }
 
 func getAttestersFromPrivateKeys(privkeys []*ecdsa.PrivateKey) []types.Attester {
-	result := make([]types.Attester, len(privkeys))
-	for i, privkey := range privkeys {
-		// Get the public key
-		publicKey := privkey.PublicKey
-
-		// Marshal the public key into bytes
-		publicKeyBytes := elliptic.Marshal(publicKey.Curve, publicKey.X, publicKey.Y) //nolint:staticcheck
-
-		result[i] = types.Attester{Attester: hex.EncodeToString(publicKeyBytes)}
-	}
-	return result
+	return attester.NewSet(privkeys...).Attesters()
 }
 
 func generateAttestation(message []byte, privKeys []*ecdsa.PrivateKey) (attestation []byte) {
-	type Attestation struct {
-		pubKey      ecdsa.PublicKey
-		attestation []byte // 65 byte
-	}
-	attestationList := make([]Attestation, len(privKeys))
-
-	for i, privateKey := range privKeys {
-		// Sign the message with the private key
-		sig, err := crypto.Sign(crypto.Keccak256Hash(message).Bytes(), privateKey)
-		if err != nil {
-			log.Fatalf("Failed to sign message: %v", err)
-		}
-		attestationList[i] = Attestation{
-			pubKey:      privateKey.PublicKey,
-			attestation: sig,
-		}
-	}
-
-	sort.Slice(attestationList, func(i, j int) bool {
-		return bytes.Compare(
-			crypto.PubkeyToAddress(attestationList[i].pubKey).Bytes(),
-			crypto.PubkeyToAddress(attestationList[j].pubKey).Bytes(),
-		) < 0
-	})
-
-	var result []byte
-	for _, att := range attestationList {
-		result = append(result, att.attestation...)
-	}
-
-	return result
+	return attester.NewSet(privKeys...).Attest(message)
 }
 
 func generateAttestationWithInvalidSignatureOrder(message []byte, privKeys []*ecdsa.PrivateKey) (attestation []byte) {
-	type Attestation struct {
-		pubKey      ecdsa.PublicKey
-		attestation []byte // 65 byte
-	}
-	attestationList := make([]Attestation, len(privKeys))
-
-	for i, privateKey := range privKeys {
-		// Sign the message with the private key
-		sig, err := crypto.Sign(crypto.Keccak256Hash(message).Bytes(), privateKey)
-		if err != nil {
-			log.Fatalf("Failed to sign message: %v", err)
-		}
-		attestationList[i] = Attestation{
-			pubKey:      privateKey.PublicKey,
-			attestation: sig,
-		}
-	}
-
-	var result []byte
-	for _, att := range attestationList {
-		result = append(result, att.attestation...)
-	}
-
-	return result
+	return attester.NewSet(privKeys...).AttestUnsorted(message)
 }
 
 func generateAttestationWithDupe(message []byte, privKeys []*ecdsa.PrivateKey) (attestation []byte) {
-	type Attestation struct {
-		pubKey      ecdsa.PublicKey
-		attestation []byte // 65 byte
-	}
-	attestationList := make([]Attestation, len(privKeys)+1)
-
-	for i, privateKey := range privKeys {
-		// Sign the message with the private key
-		sig, err := crypto.Sign(crypto.Keccak256Hash(message).Bytes(), privateKey)
-		if err != nil {
-			log.Fatalf("Failed to sign message: %v", err)
-		}
-		attestationList[i] = Attestation{
-			pubKey:      privateKey.PublicKey,
-			attestation: sig,
-		}
-	}
-
-	attestationList[len(privKeys)] = attestationList[len(privKeys)-1]
-
-	sort.Slice(attestationList, func(i, j int) bool {
-		return bytes.Compare(
-			crypto.PubkeyToAddress(attestationList[i].pubKey).Bytes(),
-			crypto.PubkeyToAddress(attestationList[j].pubKey).Bytes(),
-		) < 0
-	})
-
-	var result []byte
-	for _, att := range attestationList {
-		result = append(result, att.attestation...)
-	}
-
-	return result
+	return attester.NewSet(privKeys...).AttestWithDuplicate(message)
 }
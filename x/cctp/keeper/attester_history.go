@@ -0,0 +1,70 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetAttesterHistoryEntry sets an attester history entry in the store
+func (k Keeper) SetAttesterHistoryEntry(ctx context.Context, entry types.AttesterHistoryEntry) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.AttesterHistoryKeyPrefix))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.AttesterHistoryKey(entry.Id), b)
+}
+
+// AppendAttesterHistoryEntry records a change to the attester set under the
+// next available id, which it returns.
+func (k Keeper) AppendAttesterHistoryEntry(ctx context.Context, entry types.AttesterHistoryEntry) uint64 {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.AttesterHistoryKeyPrefix))
+
+	// ids are stored big endian, so the last key holds the highest id
+	entry.Id = 0
+	iterator := store.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		var last types.AttesterHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &last)
+		entry.Id = last.Id + 1
+	}
+	iterator.Close()
+
+	k.SetAttesterHistoryEntry(ctx, entry)
+	return entry.Id
+}
+
+// GetAttesterHistory returns every change to the attester set, oldest first
+func (k Keeper) GetAttesterHistory(ctx context.Context) (list []types.AttesterHistoryEntry) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.AttesterHistoryKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AttesterHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -54,7 +54,7 @@ func (k Keeper) DeleteAttester(ctx context.Context, key string) {
 	store.Delete(types.AttesterKey([]byte(key)))
 }
 
-// GetAllAttesters returns all attesters
+// GetAllAttesters returns all attesters, including disabled ones
 func (k Keeper) GetAllAttesters(ctx context.Context) (list []types.Attester) {
 	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	store := prefix.NewStore(adapter, types.KeyPrefix(types.AttesterKeyPrefix))
@@ -70,3 +70,30 @@ func (k Keeper) GetAllAttesters(ctx context.Context) (list []types.Attester) {
 
 	return
 }
+
+// GetEnabledAttesters returns all attesters that have not been disabled
+func (k Keeper) GetEnabledAttesters(ctx context.Context) (list []types.Attester) {
+	for _, attester := range k.GetAllAttesters(ctx) {
+		if attester.Status == types.AttesterStatus_ATTESTER_STATUS_ENABLED {
+			list = append(list, attester)
+		}
+	}
+
+	return
+}
+
+// maxAttestersPerKeyType returns the highest number of attesters sharing a
+// key type. As attestations are only verified against attesters of their key
+// type, no attestation can meet a signature threshold above it.
+func maxAttestersPerKeyType(attesters []types.Attester) uint32 {
+	counts := make(map[types.KeyType]uint32)
+	var highest uint32
+	for _, attester := range attesters {
+		counts[attester.KeyType]++
+		if counts[attester.KeyType] > highest {
+			highest = counts[attester.KeyType]
+		}
+	}
+
+	return highest
+}
@@ -0,0 +1,62 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// callContract sends callback to address if it is a CosmWasm contract that
+// enabled callbacks and a wasm keeper is set. The callback is limited to the
+// callback gas limit. A contract without a sudo entry point is skipped, and
+// any other failure is returned so that the receive is reverted.
+func (k Keeper) callContract(ctx sdk.Context, address sdk.AccAddress, callback types.ContractCallback) (err error) {
+	if k.wasm == nil || !k.IsContractCallbackEnabled(ctx, address) || !k.wasm.HasContractInfo(ctx, address) {
+		return nil
+	}
+	if !k.wasm.HasSudoEntryPoint(ctx, address) {
+		return nil
+	}
+
+	msg, err := json.Marshal(callback)
+	if err != nil {
+		return errors.Wrap(types.ErrContractCallback, err.Error())
+	}
+
+	gasLimit := min(k.callbackGasLimit, ctx.GasMeter().GasRemaining())
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(storetypes.ErrorOutOfGas); !ok {
+				panic(r)
+			}
+			err = errors.Wrapf(types.ErrContractCallback, "%s ran out of gas with limit %d", address, gasLimit)
+		}
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "cctp contract callback")
+	}()
+
+	if _, err := k.wasm.Sudo(ctx.WithGasMeter(gasMeter), address, msg); err != nil {
+		return errors.Wrapf(types.ErrContractCallback, "%s: %s", address, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,286 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Receive message calls back contract recipient
+ * Receive message with non-contract recipient
+ * Receive message without wasm keeper
+ * Receive message for contract without callback enabled
+ * Receive message for contract without sudo entry point
+ * Callback error reverts receive
+ * Callback gas is capped
+ * Mint calls back contract mint recipient
+ * Zero callback gas limit
+ * Enable and disable contract callback
+ */
+
+type mockWasmKeeper struct {
+	contracts map[string]bool
+	noSudo    bool
+	calls     []types.ContractCallback
+	gas       uint64
+	err       error
+}
+
+func (m *mockWasmKeeper) HasContractInfo(_ context.Context, address sdk.AccAddress) bool {
+	return m.contracts[string(address)]
+}
+
+func (m *mockWasmKeeper) HasSudoEntryPoint(_ context.Context, _ sdk.AccAddress) bool {
+	return !m.noSudo
+}
+
+func (m *mockWasmKeeper) Sudo(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	var callback types.ContractCallback
+	if err := json.Unmarshal(msg, &callback); err != nil {
+		return nil, err
+	}
+	m.calls = append(m.calls, callback)
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(m.gas, "mock sudo")
+	return nil, m.err
+}
+
+var contractAddress = []byte("contract address5678901234567890")
+
+func receiveMessageForContract(t *testing.T, testkeeper *keeper.Keeper, ctx context.Context, recipient []byte, body []byte) *types.MsgReceiveMessage {
+	message := types.Message{
+		Version:           0,
+		SourceDomain:      0,
+		DestinationDomain: 4,
+		Nonce:             7,
+		Sender:            []byte("01234567890123456789012345678912"),
+		Recipient:         recipient,
+		DestinationCaller: make([]byte, types.DestinationCallerLen),
+		MessageBody:       body,
+	}
+	messageBytes, err := message.Bytes()
+	require.Nil(t, err)
+
+	privKeys := generateNPrivateKeys(1)
+	for _, attester := range getAttestersFromPrivateKeys(privKeys) {
+		testkeeper.SetAttester(ctx, attester)
+	}
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 1})
+	testkeeper.SetAccount32(ctx, contractAddress)
+	testkeeper.SetContractCallback(ctx, contractAddress)
+
+	return &types.MsgReceiveMessage{
+		From:        "random address",
+		Message:     messageBytes,
+		Attestation: generateAttestation(messageBytes, privKeys),
+	}
+}
+
+func TestReceiveMessageContractCallback(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	wasm := &mockWasmKeeper{contracts: map[string]bool{string(contractAddress): true}}
+	require.NoError(t, testkeeper.SetWasmKeeper(wasm, 100_000))
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, contractAddress, []byte("hello"))
+	resp, err := server.ReceiveMessage(ctx, msg)
+	require.Nil(t, err)
+	require.True(t, resp.Success)
+
+	require.Len(t, wasm.calls, 1)
+	require.Nil(t, wasm.calls[0].Mint)
+	require.Equal(t, &types.ReceiveMessageCallback{
+		SourceDomain: 0,
+		Sender:       []byte("01234567890123456789012345678912"),
+		Nonce:        7,
+		MessageBody:  []byte("hello"),
+	}, wasm.calls[0].ReceiveMessage)
+}
+
+func TestReceiveMessageNonContractRecipient(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	wasm := &mockWasmKeeper{contracts: map[string]bool{}}
+	require.NoError(t, testkeeper.SetWasmKeeper(wasm, 100_000))
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, contractAddress, []byte("hello"))
+	_, err := server.ReceiveMessage(ctx, msg)
+	require.Nil(t, err)
+	require.Empty(t, wasm.calls)
+}
+
+func TestReceiveMessageWithoutWasmKeeper(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, contractAddress, []byte("hello"))
+	resp, err := server.ReceiveMessage(ctx, msg)
+	require.Nil(t, err)
+	require.True(t, resp.Success)
+}
+
+func TestReceiveMessageContractCallbackNotEnabled(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	wasm := &mockWasmKeeper{contracts: map[string]bool{string(contractAddress): true}}
+	require.NoError(t, testkeeper.SetWasmKeeper(wasm, 100_000))
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, contractAddress, []byte("hello"))
+	testkeeper.DeleteContractCallback(ctx, contractAddress)
+	resp, err := server.ReceiveMessage(ctx, msg)
+	require.Nil(t, err)
+	require.True(t, resp.Success)
+	require.Empty(t, wasm.calls)
+}
+
+func TestReceiveMessageContractWithoutSudo(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	wasm := &mockWasmKeeper{
+		contracts: map[string]bool{string(contractAddress): true},
+		noSudo:    true,
+	}
+	require.NoError(t, testkeeper.SetWasmKeeper(wasm, 100_000))
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, contractAddress, []byte("hello"))
+	resp, err := server.ReceiveMessage(ctx, msg)
+	require.Nil(t, err)
+	require.True(t, resp.Success)
+	require.Empty(t, wasm.calls)
+}
+
+func TestReceiveMessageContractCallbackError(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	wasm := &mockWasmKeeper{
+		contracts: map[string]bool{string(contractAddress): true},
+		err:       errors.New("contract rejected message"),
+	}
+	require.NoError(t, testkeeper.SetWasmKeeper(wasm, 100_000))
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, contractAddress, []byte("hello"))
+	_, err := server.ReceiveMessage(ctx, msg)
+	require.ErrorIs(t, err, types.ErrContractCallback)
+	require.Contains(t, err.Error(), "contract rejected message")
+}
+
+func TestReceiveMessageContractCallbackOutOfGas(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	wasm := &mockWasmKeeper{
+		contracts: map[string]bool{string(contractAddress): true},
+		gas:       100_001,
+	}
+	require.NoError(t, testkeeper.SetWasmKeeper(wasm, 100_000))
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, contractAddress, []byte("hello"))
+	sdkCtx := sdk.UnwrapSDKContext(ctx).WithGasMeter(storetypes.NewGasMeter(10_000_000))
+	_, err := server.ReceiveMessage(sdkCtx, msg)
+	require.ErrorIs(t, err, types.ErrContractCallback)
+	require.Contains(t, err.Error(), "out of gas")
+
+	// only the callback gas limit is charged to the transaction
+	require.Less(t, sdkCtx.GasMeter().GasConsumed(), uint64(10_000_000))
+	require.GreaterOrEqual(t, sdkCtx.GasMeter().GasConsumed(), uint64(100_000))
+}
+
+func TestReceiveMessageMintContractCallback(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	wasm := &mockWasmKeeper{contracts: map[string]bool{string(contractAddress): true}}
+	require.NoError(t, testkeeper.SetWasmKeeper(wasm, 100_000))
+
+	burnMessage := types.BurnMessage{
+		Version:       0,
+		BurnToken:     []byte("02345678901234567890123456789012"),
+		MintRecipient: contractAddress,
+		Amount:        math.NewInt(9876),
+		MessageSender: []byte("message sender567890123456789012"),
+	}
+	burnMessageBytes, err := burnMessage.Bytes()
+	require.Nil(t, err)
+
+	testkeeper.SetTokenPair(ctx, types.TokenPair{
+		RemoteDomain: 0,
+		RemoteToken:  burnMessage.BurnToken,
+		LocalToken:   "uusdc",
+	})
+	testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{
+		DomainId: 0,
+		Address:  []byte("01234567890123456789012345678912"),
+	})
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, types.PaddedModuleAddress, burnMessageBytes)
+	resp, err := server.ReceiveMessage(ctx, msg)
+	require.Nil(t, err)
+	require.True(t, resp.Success)
+
+	require.Len(t, wasm.calls, 1)
+	require.Nil(t, wasm.calls[0].ReceiveMessage)
+	require.Equal(t, uint32(0), wasm.calls[0].Mint.SourceDomain)
+	require.Equal(t, uint64(7), wasm.calls[0].Mint.Nonce)
+	require.Equal(t, burnMessage.MessageSender, wasm.calls[0].Mint.MessageSender)
+	require.Equal(t, math.NewInt(9876), wasm.calls[0].Mint.Amount)
+	require.Equal(t, "uusdc", wasm.calls[0].Mint.Denom)
+}
+
+func TestSetWasmKeeperZeroGasLimit(t *testing.T) {
+	testkeeper, _ := keepertest.CctpKeeper()
+	require.ErrorContains(t, testkeeper.SetWasmKeeper(&mockWasmKeeper{}, 0), "gas limit must be positive")
+}
+
+func TestEnableContractCallback(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	contract := sdk.AccAddress(contractAddress).String()
+
+	// without a wasm keeper, no sender is a contract
+	_, err := server.EnableContractCallback(ctx, &types.MsgEnableContractCallback{From: contract})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	require.NoError(t, testkeeper.SetWasmKeeper(&mockWasmKeeper{contracts: map[string]bool{string(contractAddress): true}}, 100_000))
+
+	_, err = server.EnableContractCallback(ctx, &types.MsgEnableContractCallback{From: "invalid address"})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	_, err = server.EnableContractCallback(ctx, &types.MsgEnableContractCallback{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	_, err = server.EnableContractCallback(ctx, &types.MsgEnableContractCallback{From: contract})
+	require.Nil(t, err)
+	require.True(t, testkeeper.IsContractCallbackEnabled(ctx, contractAddress))
+	require.Equal(t, []sdk.AccAddress{contractAddress}, testkeeper.GetAllContractCallbacks(ctx))
+
+	_, err = server.DisableContractCallback(ctx, &types.MsgDisableContractCallback{From: contract})
+	require.Nil(t, err)
+	require.False(t, testkeeper.IsContractCallbackEnabled(ctx, contractAddress))
+
+	_, err = server.DisableContractCallback(ctx, &types.MsgDisableContractCallback{From: contract})
+	require.ErrorIs(t, err, types.ErrContractCallbackNotEnabled)
+}
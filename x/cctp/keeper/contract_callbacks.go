@@ -0,0 +1,65 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsContractCallbackEnabled returns whether a contract opted in to callbacks
+func (k Keeper) IsContractCallbackEnabled(ctx context.Context, address sdk.AccAddress) bool {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.ContractCallbackKeyPrefix))
+
+	return store.Has(types.ContractCallbackKey(address))
+}
+
+// SetContractCallback opts a contract in to callbacks
+func (k Keeper) SetContractCallback(ctx context.Context, address sdk.AccAddress) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.ContractCallbackKeyPrefix))
+
+	store.Set(types.ContractCallbackKey(address), address)
+}
+
+// DeleteContractCallback opts a contract out of callbacks
+func (k Keeper) DeleteContractCallback(ctx context.Context, address sdk.AccAddress) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.ContractCallbackKeyPrefix))
+
+	store.Delete(types.ContractCallbackKey(address))
+}
+
+// GetAllContractCallbacks returns all contracts opted in to callbacks
+func (k Keeper) GetAllContractCallbacks(ctx context.Context) (list []sdk.AccAddress) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.ContractCallbackKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		list = append(list, sdk.AccAddress(iterator.Value()))
+	}
+
+	return
+}
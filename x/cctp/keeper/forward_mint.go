@@ -0,0 +1,96 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// forwardMint burns a minted coin again to the destination registered by its
+// recipient, paying the registered fee to the relayer. It reports whether the
+// coin was forwarded. A forward that fails is reverted, leaving the coin with
+// the recipient, and only emits a MintForwardingFailed event.
+func (k Keeper) forwardMint(
+	ctx sdk.Context,
+	relayer string,
+	recipient sdk.AccAddress,
+	message *types.Message,
+	coin sdk.Coin,
+) (bool, error) {
+	forwarding, found := k.GetForwarding(ctx, recipient)
+	if !found {
+		return false, nil
+	}
+
+	cacheCtx, write := ctx.CacheContext()
+	nonce, err := k.forward(cacheCtx, relayer, recipient, forwarding, coin)
+	if err != nil {
+		event := types.MintForwardingFailed{
+			Address:      forwarding.Address,
+			SourceDomain: message.SourceDomain,
+			SourceNonce:  message.Nonce,
+			Reason:       err.Error(),
+		}
+		return false, ctx.EventManager().EmitTypedEvent(&event)
+	}
+	write()
+
+	event := types.MintForwarded{
+		Address:      forwarding.Address,
+		SourceDomain: message.SourceDomain,
+		SourceNonce:  message.Nonce,
+		Nonce:        nonce,
+		Amount:       coin.Amount.Sub(forwarding.Fee),
+		Fee:          forwarding.Fee,
+	}
+	return true, ctx.EventManager().EmitTypedEvent(&event)
+}
+
+func (k Keeper) forward(
+	ctx sdk.Context,
+	relayer string,
+	recipient sdk.AccAddress,
+	forwarding types.Forwarding,
+	coin sdk.Coin,
+) (uint64, error) {
+	if !coin.Amount.GT(forwarding.Fee) {
+		return 0, errors.Wrapf(types.ErrInvalidAmount, "minted amount %s does not cover forwarding fee %s", coin.Amount, forwarding.Fee)
+	}
+
+	if forwarding.Fee.IsPositive() {
+		relayerAddress, err := sdk.AccAddressFromBech32(relayer)
+		if err != nil {
+			return 0, errors.Wrapf(types.ErrInvalidAddress, "invalid relayer address (%s)", err)
+		}
+		fee := sdk.NewCoins(sdk.NewCoin(coin.Denom, forwarding.Fee))
+		if err := k.bank.SendCoins(ctx, recipient, relayerAddress, fee); err != nil {
+			return 0, errors.Wrap(err, "error paying forwarding fee")
+		}
+	}
+
+	return k.DepositForBurn(
+		ctx,
+		recipient,
+		coin.Amount.Sub(forwarding.Fee),
+		forwarding.DestinationDomain,
+		forwarding.MintRecipient,
+		coin.Denom,
+		forwarding.DestinationCaller,
+	)
+}
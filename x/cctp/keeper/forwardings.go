@@ -0,0 +1,73 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetForwarding returns the forwarding registered by an address
+func (k Keeper) GetForwarding(ctx context.Context, address sdk.AccAddress) (val types.Forwarding, found bool) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.ForwardingKeyPrefix))
+
+	b := store.Get(types.ForwardingKey(address))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetForwarding sets a forwarding in the store
+func (k Keeper) SetForwarding(ctx context.Context, forwarding types.Forwarding) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.ForwardingKeyPrefix))
+
+	b := k.cdc.MustMarshal(&forwarding)
+	store.Set(types.ForwardingKey(sdk.MustAccAddressFromBech32(forwarding.Address)), b)
+}
+
+// DeleteForwarding removes the forwarding registered by an address
+func (k Keeper) DeleteForwarding(ctx context.Context, address sdk.AccAddress) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.ForwardingKeyPrefix))
+	store.Delete(types.ForwardingKey(address))
+}
+
+// GetAllForwardings returns all forwardings
+func (k Keeper) GetAllForwardings(ctx context.Context) (list []types.Forwarding) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.ForwardingKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.Forwarding
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -53,14 +53,21 @@ func (k Keeper) Attesters(c context.Context, req *types.QueryAllAttestersRequest
 	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
 	attestersStore := prefix.NewStore(adapter, types.KeyPrefix(types.AttesterKeyPrefix))
 
-	pageRes, err := query.Paginate(attestersStore, req.Pagination, func(key []byte, value []byte) error {
+	// disabled attesters are kept in state, but are not part of the attester set
+	pageRes, err := query.FilteredPaginate(attestersStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
 		var Attester types.Attester
 		if err := k.cdc.Unmarshal(value, &Attester); err != nil {
-			return err
+			return false, err
 		}
 
-		attesters = append(attesters, Attester)
-		return nil
+		if Attester.Status != types.AttesterStatus_ATTESTER_STATUS_ENABLED {
+			return false, nil
+		}
+
+		if accumulate {
+			attesters = append(attesters, Attester)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -68,3 +75,36 @@ func (k Keeper) Attesters(c context.Context, req *types.QueryAllAttestersRequest
 
 	return &types.QueryAllAttestersResponse{Attesters: attesters, Pagination: pageRes}, nil
 }
+
+func (k Keeper) AttesterHistory(c context.Context, req *types.QueryAttesterHistoryRequest) (*types.QueryAttesterHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var history []types.AttesterHistoryEntry
+	ctx := sdk.UnwrapSDKContext(c)
+
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	historyStore := prefix.NewStore(adapter, types.KeyPrefix(types.AttesterHistoryKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(historyStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var entry types.AttesterHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return false, err
+		}
+
+		if req.Attester != "" && entry.Attester != req.Attester {
+			return false, nil
+		}
+
+		if accumulate {
+			history = append(history, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAttesterHistoryResponse{History: history, Pagination: pageRes}, nil
+}
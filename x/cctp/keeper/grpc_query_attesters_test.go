@@ -156,3 +156,62 @@ func TestAttesterQueryPaginatedInvalidState(t *testing.T) {
 	require.True(t, ok)
 	require.Equal(t, parsedErr.Code(), codes.Internal)
 }
+
+func TestAttesterQueryPaginatedSkipsDisabled(t *testing.T) {
+	keeper, ctx := keepertest.CctpKeeper()
+	msgs := createNAttesters(keeper, ctx, 5)
+
+	msgs[1].Status = types.AttesterStatus_ATTESTER_STATUS_DISABLED
+	keeper.SetAttester(ctx, msgs[1])
+
+	resp, err := keeper.Attesters(ctx, &types.QueryAllAttestersRequest{
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 4, int(resp.Pagination.Total))
+	require.NotContains(t, resp.Attesters, msgs[1])
+
+	// the disabled attester can still be looked up directly
+	single, err := keeper.Attester(ctx, &types.QueryGetAttesterRequest{Attester: msgs[1].Attester})
+	require.NoError(t, err)
+	require.Equal(t, msgs[1], single.Attester)
+}
+
+func TestAttesterHistoryQuery(t *testing.T) {
+	keeper, ctx := keepertest.CctpKeeper()
+
+	var history []types.AttesterHistoryEntry
+	for i, attester := range []string{"Attester0", "Attester1", "Attester0"} {
+		entry := types.AttesterHistoryEntry{
+			Attester: attester,
+			Status:   types.AttesterStatus(i % 2),
+			Height:   int64(i),
+		}
+		entry.Id = keeper.AppendAttesterHistoryEntry(ctx, entry)
+		require.Equal(t, uint64(i), entry.Id)
+		history = append(history, entry)
+	}
+
+	t.Run("All", func(t *testing.T) {
+		resp, err := keeper.AttesterHistory(ctx, &types.QueryAttesterHistoryRequest{})
+		require.NoError(t, err)
+		require.Equal(t, history, resp.History)
+	})
+	t.Run("ByAttester", func(t *testing.T) {
+		resp, err := keeper.AttesterHistory(ctx, &types.QueryAttesterHistoryRequest{Attester: "Attester0"})
+		require.NoError(t, err)
+		require.Equal(t, []types.AttesterHistoryEntry{history[0], history[2]}, resp.History)
+	})
+	t.Run("Paginated", func(t *testing.T) {
+		resp, err := keeper.AttesterHistory(ctx, &types.QueryAttesterHistoryRequest{
+			Pagination: &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []types.AttesterHistoryEntry{history[1]}, resp.History)
+		require.Equal(t, uint64(3), resp.Pagination.Total)
+	})
+	t.Run("InvalidRequest", func(t *testing.T) {
+		_, err := keeper.AttesterHistory(ctx, nil)
+		require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+	})
+}
@@ -22,6 +22,6 @@ import (
 	"github.com/circlefin/noble-cctp/x/cctp/types"
 )
 
-func (k Keeper) BurnMessageVersion(_ context.Context, _ *types.QueryBurnMessageVersionRequest) (*types.QueryBurnMessageVersionResponse, error) {
-	return &types.QueryBurnMessageVersionResponse{Version: types.MessageBodyVersion}, nil
+func (k Keeper) BurnMessageVersion(ctx context.Context, _ *types.QueryBurnMessageVersionRequest) (*types.QueryBurnMessageVersionResponse, error) {
+	return &types.QueryBurnMessageVersionResponse{Version: k.GetLocalDomain(ctx).MessageBodyVersion}, nil
 }
@@ -0,0 +1,67 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestDestinationCallerQuery(t *testing.T) {
+	keeper, ctx := keepertest.CctpKeeper()
+
+	account := sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	contract := sdk.AccAddress(bytes.Repeat([]byte{2}, 32))
+	paddedAccount := make([]byte, 32)
+	copy(paddedAccount[12:], account)
+
+	for _, tc := range []struct {
+		desc              string
+		destinationCaller []byte
+		expected          string
+	}{
+		{desc: "Anyone", destinationCaller: make([]byte, types.DestinationCallerLen), expected: ""},
+		{desc: "20-byte", destinationCaller: paddedAccount, expected: account.String()},
+		{desc: "32-byte", destinationCaller: contract, expected: contract.String()},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			message, err := (&types.Message{
+				Sender:            make([]byte, 32),
+				Recipient:         make([]byte, 32),
+				DestinationCaller: tc.destinationCaller,
+			}).Bytes()
+			require.NoError(t, err)
+
+			res, err := keeper.DestinationCaller(ctx, &types.QueryDestinationCallerRequest{Message: message})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, res.DestinationCaller)
+		})
+	}
+
+	_, err := keeper.DestinationCaller(ctx, &types.QueryDestinationCallerRequest{Message: []byte("short")})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	_, err = keeper.DestinationCaller(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,82 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestForwardingQuery(t *testing.T) {
+	forwarding := types.Forwarding{
+		Address:           sample.AccAddress(),
+		DestinationDomain: 1,
+		MintRecipient:     make([]byte, 32),
+		Fee:               math.NewInt(10),
+	}
+
+	for _, tc := range []struct {
+		desc     string
+		set      bool
+		request  *types.QueryGetForwardingRequest
+		response *types.QueryGetForwardingResponse
+		err      error
+	}{
+		{
+			desc:     "HappyPath",
+			set:      true,
+			request:  &types.QueryGetForwardingRequest{Address: forwarding.Address},
+			response: &types.QueryGetForwardingResponse{Forwarding: forwarding},
+		},
+		{
+			desc:    "NotFound",
+			request: &types.QueryGetForwardingRequest{Address: forwarding.Address},
+			err:     status.Error(codes.NotFound, "not found"),
+		},
+		{
+			desc:    "InvalidAddress",
+			request: &types.QueryGetForwardingRequest{Address: "invalid"},
+			err:     status.Error(codes.InvalidArgument, "invalid address"),
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			keeper, ctx := keepertest.CctpKeeper()
+			if tc.set {
+				keeper.SetForwarding(ctx, forwarding)
+			}
+
+			response, err := keeper.Forwarding(ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.Equal(t, tc.response, response)
+			}
+		})
+	}
+}
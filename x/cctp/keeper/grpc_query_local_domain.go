@@ -22,6 +22,6 @@ import (
 	"github.com/circlefin/noble-cctp/x/cctp/types"
 )
 
-func (k Keeper) LocalDomain(_ context.Context, _ *types.QueryLocalDomainRequest) (*types.QueryLocalDomainResponse, error) {
-	return &types.QueryLocalDomainResponse{DomainId: types.NobleDomainId}, nil
+func (k Keeper) LocalDomain(ctx context.Context, _ *types.QueryLocalDomainRequest) (*types.QueryLocalDomainResponse, error) {
+	return &types.QueryLocalDomainResponse{DomainId: k.GetLocalDomain(ctx).DomainId}, nil
 }
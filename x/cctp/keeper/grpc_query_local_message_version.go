@@ -22,6 +22,6 @@ import (
 	"github.com/circlefin/noble-cctp/x/cctp/types"
 )
 
-func (k Keeper) LocalMessageVersion(_ context.Context, _ *types.QueryLocalMessageVersionRequest) (*types.QueryLocalMessageVersionResponse, error) {
-	return &types.QueryLocalMessageVersionResponse{Version: types.NobleMessageVersion}, nil
+func (k Keeper) LocalMessageVersion(ctx context.Context, _ *types.QueryLocalMessageVersionRequest) (*types.QueryLocalMessageVersionResponse, error) {
+	return &types.QueryLocalMessageVersionResponse{Version: k.GetLocalDomain(ctx).MessageVersion}, nil
 }
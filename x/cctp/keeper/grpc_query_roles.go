@@ -19,8 +19,11 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -40,3 +43,45 @@ func (k Keeper) Roles(goCtx context.Context, req *types.QueryRolesRequest) (*typ
 
 	return &roles, nil
 }
+
+func (k Keeper) PendingRoles(goCtx context.Context, req *types.QueryPendingRolesRequest) (*types.QueryPendingRolesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryPendingRolesResponse{PendingRoles: k.GetAllPendingRoles(ctx)}, nil
+}
+
+func (k Keeper) RoleHistory(goCtx context.Context, req *types.QueryRoleHistoryRequest) (*types.QueryRoleHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var history []types.RoleHistoryEntry
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	historyStore := prefix.NewStore(adapter, types.KeyPrefix(types.RoleHistoryKeyPrefix))
+
+	pageRes, err := query.FilteredPaginate(historyStore, req.Pagination, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		var entry types.RoleHistoryEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return false, err
+		}
+
+		if req.Role != types.Role_ROLE_UNSPECIFIED && entry.Role != req.Role {
+			return false, nil
+		}
+
+		if accumulate {
+			history = append(history, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRoleHistoryResponse{History: history, Pagination: pageRes}, nil
+}
@@ -75,3 +75,45 @@ func TestRolesQuery(t *testing.T) {
 		})
 	}
 }
+
+func TestPendingRolesQuery(t *testing.T) {
+	keeper, ctx := keepertest.CctpKeeper()
+
+	pendingOwner := types.PendingRole{Role: types.Role_ROLE_OWNER, Address: "test-pending-owner"}
+	keeper.SetPendingRole(ctx, pendingOwner)
+	pendingPauser := types.PendingRole{Role: types.Role_ROLE_PAUSER, Address: "test-pending-pauser", ExpiresAtHeight: 100}
+	keeper.SetPendingRole(ctx, pendingPauser)
+
+	response, err := keeper.PendingRoles(ctx, &types.QueryPendingRolesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.PendingRole{pendingOwner, pendingPauser}, response.PendingRoles)
+
+	_, err = keeper.PendingRoles(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
+
+func TestRoleHistoryQuery(t *testing.T) {
+	keeper, ctx := keepertest.CctpKeeper()
+
+	roles := []types.Role{types.Role_ROLE_OWNER, types.Role_ROLE_PAUSER, types.Role_ROLE_OWNER}
+	for i, role := range roles {
+		keeper.AppendRoleHistoryEntry(ctx, types.RoleHistoryEntry{Role: role, Height: int64(i)})
+	}
+
+	response, err := keeper.RoleHistory(ctx, &types.QueryRoleHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, response.History, 3)
+	for i, entry := range response.History {
+		require.Equal(t, uint64(i), entry.Id)
+		require.Equal(t, roles[i], entry.Role)
+	}
+
+	response, err = keeper.RoleHistory(ctx, &types.QueryRoleHistoryRequest{Role: types.Role_ROLE_OWNER})
+	require.NoError(t, err)
+	require.Len(t, response.History, 2)
+	require.Equal(t, uint64(0), response.History[0].Id)
+	require.Equal(t, uint64(2), response.History[1].Id)
+
+	_, err = keeper.RoleHistory(ctx, nil)
+	require.ErrorIs(t, err, status.Error(codes.InvalidArgument, "invalid request"))
+}
@@ -0,0 +1,160 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Deposit for burn calls hooks
+ * Before deposit for burn hook error fails deposit
+ * Receive message calls mint and received hooks
+ * Before mint hook error fails receive
+ * Hooks can only be set once
+ */
+
+type mockHooks struct {
+	calls []string
+	err   map[string]error
+}
+
+var _ types.CCTPHooks = &mockHooks{}
+
+func (m *mockHooks) call(name string) error {
+	m.calls = append(m.calls, name)
+	return m.err[name]
+}
+
+func (m *mockHooks) BeforeDepositForBurn(_ context.Context, _ sdk.AccAddress, _ math.Int, _ string, _ uint32, _ []byte) error {
+	return m.call("BeforeDepositForBurn")
+}
+
+func (m *mockHooks) AfterDepositForBurn(_ context.Context, _ uint64, _ sdk.AccAddress, _ math.Int, _ string, _ uint32, _ []byte) error {
+	return m.call("AfterDepositForBurn")
+}
+
+func (m *mockHooks) BeforeMint(_ context.Context, _ uint32, _ uint64, _ sdk.AccAddress, _ sdk.Coin) error {
+	return m.call("BeforeMint")
+}
+
+func (m *mockHooks) AfterMint(_ context.Context, _ uint32, _ uint64, _ sdk.AccAddress, _ sdk.Coin) error {
+	return m.call("AfterMint")
+}
+
+func (m *mockHooks) AfterMessageReceived(_ context.Context, _ *types.Message) error {
+	return m.call("AfterMessageReceived")
+}
+
+func depositForBurnWithHooks(t *testing.T, hooks *mockHooks) (*types.MsgDepositForBurnResponse, error) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	testkeeper.SetHooks(hooks)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{DomainId: 0, Address: tokenMessenger})
+
+	return server.DepositForBurn(ctx, &types.MsgDepositForBurn{
+		From:              sample.AccAddress(),
+		Amount:            math.NewInt(531),
+		DestinationDomain: 0,
+		MintRecipient:     append(make([]byte, 12), []byte("34567890123456789012")...),
+		BurnToken:         "uusdc",
+	})
+}
+
+func receiveMintWithHooks(t *testing.T, hooks *mockHooks) (*types.MsgReceiveMessageResponse, error) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	testkeeper.SetHooks(hooks)
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	burnMessage := types.BurnMessage{
+		Version:       0,
+		BurnToken:     []byte("02345678901234567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("nt567890123456789012")...),
+		Amount:        math.NewInt(9876),
+		MessageSender: []byte("message sender567890123456789012"),
+	}
+	burnMessageBytes, err := burnMessage.Bytes()
+	require.Nil(t, err)
+
+	testkeeper.SetTokenPair(ctx, types.TokenPair{
+		RemoteDomain: 0,
+		RemoteToken:  burnMessage.BurnToken,
+		LocalToken:   "uusdc",
+	})
+	testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{
+		DomainId: 0,
+		Address:  []byte("01234567890123456789012345678912"),
+	})
+
+	msg := receiveMessageForContract(t, testkeeper, ctx, types.PaddedModuleAddress, burnMessageBytes)
+	return server.ReceiveMessage(ctx, msg)
+}
+
+func TestDepositForBurnHooks(t *testing.T) {
+	hooks := &mockHooks{}
+	_, err := depositForBurnWithHooks(t, hooks)
+	require.Nil(t, err)
+	require.Equal(t, []string{"BeforeDepositForBurn", "AfterDepositForBurn"}, hooks.calls)
+}
+
+func TestDepositForBurnHookError(t *testing.T) {
+	hooks := &mockHooks{err: map[string]error{"BeforeDepositForBurn": errors.New("sender is screened")}}
+	_, err := depositForBurnWithHooks(t, hooks)
+	require.ErrorContains(t, err, "sender is screened")
+	require.Equal(t, []string{"BeforeDepositForBurn"}, hooks.calls)
+}
+
+func TestReceiveMessageHooks(t *testing.T) {
+	hooks := &mockHooks{}
+	resp, err := receiveMintWithHooks(t, hooks)
+	require.Nil(t, err)
+	require.True(t, resp.Success)
+	require.Equal(t, []string{"BeforeMint", "AfterMint", "AfterMessageReceived"}, hooks.calls)
+
+	// messages without a mint only call the received hook
+	hooks = &mockHooks{}
+	testkeeper, ctx := keepertest.CctpKeeper()
+	testkeeper.SetHooks(hooks)
+	msg := receiveMessageForContract(t, testkeeper, ctx, contractAddress, []byte("hello"))
+	_, err = keeper.NewMsgServerImpl(testkeeper).ReceiveMessage(ctx, msg)
+	require.Nil(t, err)
+	require.Equal(t, []string{"AfterMessageReceived"}, hooks.calls)
+}
+
+func TestReceiveMessageHookError(t *testing.T) {
+	hooks := &mockHooks{err: map[string]error{"BeforeMint": errors.New("recipient is screened")}}
+	_, err := receiveMintWithHooks(t, hooks)
+	require.ErrorContains(t, err, "recipient is screened")
+	require.Equal(t, []string{"BeforeMint"}, hooks.calls)
+}
+
+func TestSetHooksTwice(t *testing.T) {
+	testkeeper, _ := keepertest.CctpKeeper()
+	testkeeper.SetHooks(&mockHooks{})
+	require.Panics(t, func() { testkeeper.SetHooks(&mockHooks{}) })
+}
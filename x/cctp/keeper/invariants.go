@@ -0,0 +1,225 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RegisterInvariants registers all cctp invariants. It panics if the bank
+// keeper does not block sends to the module account, as any account could
+// then break the module balance invariant and halt the chain.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	if !k.bank.BlockedAddr(types.ModuleAddress) {
+		panic(fmt.Sprintf("module account %s must be a blocked address of the bank keeper", types.ModuleAddress))
+	}
+
+	ir.RegisterRoute(types.ModuleName, "signature-threshold", SignatureThresholdInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "token-pair-minter", TokenPairMinterInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "remote-token-messengers", RemoteTokenMessengersInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "used-nonces", UsedNoncesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-balance", ModuleBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the cctp module.
+func AllInvariants(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range []sdk.Invariant{
+			SignatureThresholdInvariant(k),
+			TokenPairMinterInvariant(k),
+			RemoteTokenMessengersInvariant(k),
+			UsedNoncesInvariant(k),
+			ModuleBalanceInvariant(k),
+		} {
+			if msg, broken := invariant(ctx); broken {
+				return msg, broken
+			}
+		}
+		return "", false
+	}
+}
+
+// SignatureThresholdInvariant checks that the signature threshold is non-zero
+// and reachable by the enabled attesters of at least one key type, as only
+// attesters of an attestation's key type count towards it. A chain without
+// enabled attesters has not been configured yet and is not checked.
+func SignatureThresholdInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		attesters := make(map[types.KeyType]int)
+		for _, attester := range k.GetEnabledAttesters(ctx) {
+			attesters[attester.KeyType]++
+		}
+		if len(attesters) == 0 {
+			return sdk.FormatInvariant(types.ModuleName, "signature-threshold", "no enabled attesters"), false
+		}
+
+		threshold, found := k.GetSignatureThreshold(ctx)
+		if !found {
+			return sdk.FormatInvariant(types.ModuleName, "signature-threshold", "signature threshold is not set"), true
+		}
+
+		keyTypes := make([]types.KeyType, 0, len(attesters))
+		for keyType := range attesters {
+			keyTypes = append(keyTypes, keyType)
+		}
+		sort.Slice(keyTypes, func(i, j int) bool { return keyTypes[i] < keyTypes[j] })
+
+		var msg string
+		reachable := false
+		for _, keyType := range keyTypes {
+			msg += fmt.Sprintf("	%s: %d enabled attesters\n", keyType, attesters[keyType])
+			if attesters[keyType] >= int(threshold.Amount) {
+				reachable = true
+			}
+		}
+
+		broken := threshold.Amount == 0 || !reachable
+		return sdk.FormatInvariant(
+			types.ModuleName, "signature-threshold",
+			fmt.Sprintf("signature threshold %d\n%s", threshold.Amount, msg),
+		), broken
+	}
+}
+
+// TokenPairMinterInvariant checks that the local token of every token pair is
+// the fiat token factory minting denom and that the module account is a
+// registered minter, so that receiving a burn message can mint it.
+func TokenPairMinterInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		mintingDenom := k.fiattokenfactory.GetMintingDenom(ctx).Denom
+		_, isMinter := k.fiattokenfactory.GetMinters(ctx, types.ModuleAddress.String())
+
+		for _, tokenPair := range k.GetAllTokenPairs(ctx) {
+			localToken := strings.ToLower(tokenPair.LocalToken)
+			switch {
+			case localToken != mintingDenom:
+				count++
+				msg += fmt.Sprintf("\tlocal token %s of remote token %x on domain %d is not the minting denom %s\n",
+					localToken, tokenPair.RemoteToken, tokenPair.RemoteDomain, mintingDenom)
+			case !isMinter:
+				count++
+				msg += fmt.Sprintf("\tlocal token %s of remote token %x on domain %d has no minter %s\n",
+					localToken, tokenPair.RemoteToken, tokenPair.RemoteDomain, types.ModuleAddress)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "token-pair-minter",
+			fmt.Sprintf("found %d token pairs without a reachable minter\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// RemoteTokenMessengersInvariant checks that every remote token messenger
+// address is 32 bytes long and non-zero.
+func RemoteTokenMessengersInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		for _, remoteTokenMessenger := range k.GetRemoteTokenMessengers(ctx) {
+			address := remoteTokenMessenger.Address
+			if len(address) != 32 || isZero(address) {
+				count++
+				msg += fmt.Sprintf("\tremote token messenger for domain %d has invalid address %x\n",
+					remoteTokenMessenger.DomainId, address)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "remote-token-messengers",
+			fmt.Sprintf("found %d invalid remote token messengers\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// UsedNoncesInvariant checks that no used nonce originates from the local
+// domain, as messages sent from this chain are never received on it.
+func UsedNoncesInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		localDomain := k.GetLocalDomain(ctx).DomainId
+		for _, nonce := range k.GetAllUsedNonces(ctx) {
+			if nonce.SourceDomain == localDomain {
+				count++
+				msg += fmt.Sprintf("\tnonce %d is used for the local domain %d\n", nonce.Nonce, nonce.SourceDomain)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "used-nonces",
+			fmt.Sprintf("found %d used nonces with a local source domain\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// ModuleBalanceInvariant checks that the module account holds no balance of
+// any burnable denom, since deposits are burned in the same transaction as
+// they are sent to the module. RegisterInvariants requires the module account
+// to be blocked from receiving sends, so that no account can break it.
+func ModuleBalanceInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var msg string
+		count := 0
+
+		denoms := make(map[string]bool)
+		for _, tokenPair := range k.GetAllTokenPairs(ctx) {
+			denoms[strings.ToLower(tokenPair.LocalToken)] = true
+		}
+		for _, limit := range k.GetAllPerMessageBurnLimits(ctx) {
+			denoms[strings.ToLower(limit.Denom)] = true
+		}
+
+		sorted := make([]string, 0, len(denoms))
+		for denom := range denoms {
+			sorted = append(sorted, denom)
+		}
+		sort.Strings(sorted)
+
+		for _, denom := range sorted {
+			balance := k.bank.GetBalance(ctx, types.ModuleAddress, denom)
+			if !balance.IsZero() {
+				count++
+				msg += fmt.Sprintf("\tmodule account holds residual balance %s\n", balance)
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "module-balance",
+			fmt.Sprintf("found %d residual balances of burnable denoms\n%s", count, msg),
+		), count != 0
+	}
+}
+
+func isZero(bz []byte) bool {
+	for _, b := range bz {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,185 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+type residualBankKeeper struct {
+	keepertest.MockBankKeeper
+}
+
+func (residualBankKeeper) GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, math.NewInt(1))
+}
+
+type unblockedBankKeeper struct {
+	keepertest.MockBankKeeper
+}
+
+func (unblockedBankKeeper) BlockedAddr(sdk.AccAddress) bool {
+	return false
+}
+
+type invariantRegistry []string
+
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, _ sdk.Invariant) {
+	*r = append(*r, moduleName+"/"+route)
+}
+
+func validInvariantState(k *keeper.Keeper, ctx context.Context) {
+	k.SetAttester(ctx, types.Attester{Attester: "attester1"})
+	k.SetAttester(ctx, types.Attester{Attester: "attester2"})
+	k.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	remoteTokenMessenger := make([]byte, 32)
+	remoteTokenMessenger[31] = 1
+	k.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{DomainId: 0, Address: remoteTokenMessenger})
+	k.SetTokenPair(ctx, types.TokenPair{RemoteDomain: 0, RemoteToken: make([]byte, 32), LocalToken: "uusdc"})
+	k.SetPerMessageBurnLimit(ctx, types.PerMessageBurnLimit{Denom: "uusdc", Amount: math.NewInt(1)})
+	k.SetUsedNonce(ctx, types.Nonce{SourceDomain: 0, Nonce: 1})
+}
+
+func TestInvariantsValid(t *testing.T) {
+	cctpKeeper, ctx := keepertest.CctpKeeper()
+	validInvariantState(cctpKeeper, ctx)
+
+	msg, broken := keeper.AllInvariants(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.False(t, broken, msg)
+}
+
+func TestRegisterInvariants(t *testing.T) {
+	cctpKeeper, _ := keepertest.CctpKeeper()
+	routes := &invariantRegistry{}
+	keeper.RegisterInvariants(routes, cctpKeeper)
+	require.Contains(t, *routes, "cctp/module-balance")
+
+	// sends to the module account would break the module balance invariant
+	cctpKeeper, _ = keepertest.CctpKeeperWithKeepers(unblockedBankKeeper{}, keepertest.MockFiatTokenfactoryKeeper{})
+	require.PanicsWithValue(t, fmt.Sprintf("module account %s must be a blocked address of the bank keeper", types.ModuleAddress), func() {
+		keeper.RegisterInvariants(&invariantRegistry{}, cctpKeeper)
+	})
+}
+
+func TestSignatureThresholdInvariant(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		threshold *types.SignatureThreshold
+	}{
+		{name: "not set"},
+		{name: "zero", threshold: &types.SignatureThreshold{Amount: 0}},
+		{name: "above attester count", threshold: &types.SignatureThreshold{Amount: 3}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cctpKeeper, ctx := keepertest.CctpKeeper()
+			cctpKeeper.SetAttester(ctx, types.Attester{Attester: "attester1"})
+			cctpKeeper.SetAttester(ctx, types.Attester{Attester: "attester2"})
+			if tc.threshold != nil {
+				cctpKeeper.SetSignatureThreshold(ctx, *tc.threshold)
+			}
+
+			_, broken := keeper.SignatureThresholdInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+			require.True(t, broken)
+		})
+	}
+}
+
+func TestSignatureThresholdInvariantUnconfigured(t *testing.T) {
+	cctpKeeper, ctx := keepertest.CctpKeeper()
+	cctp.InitGenesis(ctx, cctpKeeper, *types.DefaultGenesis())
+
+	msg, broken := keeper.SignatureThresholdInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.False(t, broken, msg)
+}
+
+func TestSignatureThresholdInvariantPerKeyType(t *testing.T) {
+	cctpKeeper, ctx := keepertest.CctpKeeper()
+	cctpKeeper.SetAttester(ctx, types.Attester{Attester: "attester1"})
+	cctpKeeper.SetAttester(ctx, types.Attester{Attester: "attester2", KeyType: types.KeyType_KEY_TYPE_ED25519})
+	cctpKeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	msg, broken := keeper.SignatureThresholdInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.True(t, broken)
+	require.Contains(t, msg, "KEY_TYPE_ED25519: 1 enabled attesters")
+
+	cctpKeeper.SetAttester(ctx, types.Attester{Attester: "attester3"})
+
+	msg, broken = keeper.SignatureThresholdInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.False(t, broken, msg)
+}
+
+func TestTokenPairMinterInvariant(t *testing.T) {
+	cctpKeeper, ctx := keepertest.CctpKeeper()
+	cctpKeeper.SetTokenPair(ctx, types.TokenPair{RemoteDomain: 0, RemoteToken: make([]byte, 32), LocalToken: "ueurc"})
+
+	msg, broken := keeper.TokenPairMinterInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.True(t, broken)
+	require.Contains(t, msg, "is not the minting denom")
+
+	cctpKeeper, ctx = keepertest.CctpKeeperWithErrFTF()
+	cctpKeeper.SetTokenPair(ctx, types.TokenPair{RemoteDomain: 0, RemoteToken: make([]byte, 32), LocalToken: "uusdc"})
+
+	msg, broken = keeper.TokenPairMinterInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.True(t, broken)
+	require.Contains(t, msg, "has no minter")
+}
+
+func TestRemoteTokenMessengersInvariant(t *testing.T) {
+	for _, tc := range []struct {
+		name    string
+		address []byte
+	}{
+		{name: "zero", address: make([]byte, 32)},
+		{name: "short", address: []byte{1, 2, 3}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			cctpKeeper, ctx := keepertest.CctpKeeper()
+			cctpKeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{DomainId: 0, Address: tc.address})
+
+			_, broken := keeper.RemoteTokenMessengersInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+			require.True(t, broken)
+		})
+	}
+}
+
+func TestUsedNoncesInvariant(t *testing.T) {
+	cctpKeeper, ctx := keepertest.CctpKeeper()
+	cctpKeeper.SetUsedNonce(ctx, types.Nonce{SourceDomain: types.NobleDomainId, Nonce: 1})
+
+	_, broken := keeper.UsedNoncesInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.True(t, broken)
+}
+
+func TestModuleBalanceInvariant(t *testing.T) {
+	cctpKeeper, ctx := keepertest.CctpKeeperWithKeepers(residualBankKeeper{}, keepertest.MockFiatTokenfactoryKeeper{})
+	validInvariantState(cctpKeeper, ctx)
+
+	msg, broken := keeper.ModuleBalanceInvariant(cctpKeeper)(sdk.UnwrapSDKContext(ctx))
+	require.True(t, broken)
+	require.Contains(t, msg, "1uusdc")
+}
@@ -17,6 +17,7 @@
 package keeper
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/circlefin/noble-cctp/x/cctp/types"
@@ -31,9 +32,17 @@ type (
 		cdc          codec.BinaryCodec
 		logger       log.Logger
 		storeService store.KVStoreService
+		localDomain  types.LocalDomain
 
 		bank             types.BankKeeper
 		fiattokenfactory types.FiatTokenfactoryKeeper
+
+		wasm             types.WasmKeeper
+		callbackGasLimit uint64
+
+		hooks types.CCTPHooks
+
+		verifiers *verifierCache
 	}
 )
 
@@ -41,6 +50,7 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	logger log.Logger,
 	storeService store.KVStoreService,
+	localDomain types.LocalDomain,
 	bank types.BankKeeper,
 	fiattokenfactory types.FiatTokenfactoryKeeper,
 ) *Keeper {
@@ -48,9 +58,39 @@ func NewKeeper(
 		cdc:              cdc,
 		logger:           logger,
 		storeService:     storeService,
+		localDomain:      localDomain,
 		bank:             bank,
 		fiattokenfactory: fiattokenfactory,
+		verifiers:        &verifierCache{},
+	}
+}
+
+// SetWasmKeeper enables callbacks into CosmWasm contracts that opted in and
+// receive messages or mints, each limited to callbackGasLimit gas. It returns
+// an error for a zero gas limit, with which every callback would fail.
+func (k *Keeper) SetWasmKeeper(wasm types.WasmKeeper, callbackGasLimit uint64) error {
+	if callbackGasLimit == 0 {
+		return errors.New("cctp contract callback gas limit must be positive")
+	}
+	k.wasm = wasm
+	k.callbackGasLimit = callbackGasLimit
+	return nil
+}
+
+// SetHooks sets the hooks called on deposits, mints and received messages.
+func (k *Keeper) SetHooks(hooks types.CCTPHooks) {
+	if k.hooks != nil {
+		panic("cannot set cctp hooks twice")
+	}
+	k.hooks = hooks
+}
+
+// Hooks returns the hooks set on the keeper, or hooks that do nothing.
+func (k Keeper) Hooks() types.CCTPHooks {
+	if k.hooks == nil {
+		return types.MultiCCTPHooks{}
 	}
+	return k.hooks
 }
 
 func (k Keeper) Logger() log.Logger {
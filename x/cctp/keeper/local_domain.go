@@ -0,0 +1,49 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// GetLocalDomain returns the LocalDomain stored in state, or the one the
+// keeper was configured with if none has been stored yet.
+func (k Keeper) GetLocalDomain(ctx context.Context) types.LocalDomain {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.LocalDomainKey))
+
+	b := store.Get(types.KeyPrefix(types.LocalDomainKey))
+	if b == nil {
+		return k.localDomain
+	}
+
+	var val types.LocalDomain
+	k.cdc.MustUnmarshal(b, &val)
+	return val
+}
+
+// SetLocalDomain sets LocalDomain in the store
+func (k Keeper) SetLocalDomain(ctx context.Context, localDomain types.LocalDomain) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.LocalDomainKey))
+	b := k.cdc.MustMarshal(&localDomain)
+	store.Set(types.KeyPrefix(types.LocalDomainKey), b)
+}
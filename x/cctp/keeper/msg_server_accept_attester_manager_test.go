@@ -0,0 +1,113 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Happy path
+ * Pending attester manager not set
+ * Invalid pending attester manager
+ * Expired transfer
+ */
+
+func TestAcceptAttesterManagerHappyPath(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+	current := sample.AccAddress()
+	testkeeper.SetRole(ctx, types.Role_ROLE_ATTESTER_MANAGER, current)
+
+	pending := sample.AccAddress()
+	_, err := server.UpdateAttesterManager(ctx, &types.MsgUpdateAttesterManager{
+		From:               owner,
+		NewAttesterManager: pending,
+		ExpiresAtHeight:    10 + 1,
+	})
+	require.Nil(t, err)
+
+	_, err = server.AcceptAttesterManager(ctx, &types.MsgAcceptAttesterManager{From: pending})
+	require.Nil(t, err)
+
+	require.Equal(t, pending, testkeeper.GetAttesterManager(ctx))
+	_, found := testkeeper.GetPendingRole(ctx, types.Role_ROLE_ATTESTER_MANAGER)
+	require.False(t, found)
+
+	require.Equal(t, []types.RoleHistoryEntry{
+		{
+			Id:              0,
+			Role:            types.Role_ROLE_ATTESTER_MANAGER,
+			PreviousAddress: current,
+			Address:         pending,
+			Height:          10,
+		},
+	}, testkeeper.GetRoleHistory(ctx))
+}
+
+func TestAcceptAttesterManagerPendingAttesterManagerNotSet(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetRole(ctx, types.Role_ROLE_ATTESTER_MANAGER, sample.AccAddress())
+
+	_, err := server.AcceptAttesterManager(ctx, &types.MsgAcceptAttesterManager{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Contains(t, err.Error(), "pending attester manager is not set")
+}
+
+func TestAcceptAttesterManagerInvalidPendingAttesterManager(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetRole(ctx, types.Role_ROLE_ATTESTER_MANAGER, sample.AccAddress())
+	testkeeper.SetPendingRole(ctx, types.PendingRole{Role: types.Role_ROLE_ATTESTER_MANAGER, Address: sample.AccAddress()})
+
+	_, err := server.AcceptAttesterManager(ctx, &types.MsgAcceptAttesterManager{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	require.Contains(t, err.Error(), "you are not the pending attester manager")
+}
+
+func TestAcceptAttesterManagerExpired(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	current := sample.AccAddress()
+	testkeeper.SetRole(ctx, types.Role_ROLE_ATTESTER_MANAGER, current)
+	pending := sample.AccAddress()
+	testkeeper.SetPendingRole(ctx, types.PendingRole{
+		Role:            types.Role_ROLE_ATTESTER_MANAGER,
+		Address:         pending,
+		ExpiresAtHeight: 9,
+	})
+
+	_, err := server.AcceptAttesterManager(ctx, &types.MsgAcceptAttesterManager{From: pending})
+	require.ErrorIs(t, err, types.ErrRoleTransferExpired)
+	require.Equal(t, current, testkeeper.GetAttesterManager(ctx))
+}
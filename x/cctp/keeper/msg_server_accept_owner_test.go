@@ -23,6 +23,7 @@ import (
 	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -31,6 +32,7 @@ import (
  * Owner not set
  * Pending owner not set
  * Invalid Pending owner
+ * Expired transfer
  */
 
 func TestAcceptOwnerHappyPath(t *testing.T) {
@@ -51,6 +53,12 @@ func TestAcceptOwnerHappyPath(t *testing.T) {
 
 	newOwner := testkeeper.GetOwner(ctx)
 	require.Equal(t, pendingOwner, newOwner)
+
+	history := testkeeper.GetRoleHistory(ctx)
+	require.Len(t, history, 1)
+	require.Equal(t, types.Role_ROLE_OWNER, history[0].Role)
+	require.Equal(t, owner, history[0].PreviousAddress)
+	require.Equal(t, pendingOwner, history[0].Address)
 }
 
 func TestAcceptOwnerOwnerNotSet(t *testing.T) {
@@ -102,3 +110,26 @@ func TestAcceptOwnerInvalidPendingOwner(t *testing.T) {
 	require.ErrorIs(t, types.ErrUnauthorized, err)
 	require.Contains(t, err.Error(), "you are not the pending owner")
 }
+
+func TestAcceptOwnerExpired(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+	pendingOwner := sample.AccAddress()
+	testkeeper.SetPendingRole(ctx, types.PendingRole{
+		Role:            types.Role_ROLE_OWNER,
+		Address:         pendingOwner,
+		ExpiresAtHeight: 9,
+	})
+
+	message := types.MsgAcceptOwner{
+		From: pendingOwner,
+	}
+
+	_, err := server.AcceptOwner(ctx, &message)
+	require.ErrorIs(t, err, types.ErrRoleTransferExpired)
+	require.Equal(t, owner, testkeeper.GetOwner(ctx))
+}
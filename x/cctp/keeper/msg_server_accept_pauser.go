@@ -0,0 +1,42 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) AcceptPauser(goCtx context.Context, msg *types.MsgAcceptPauser) (*types.MsgAcceptPauserResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	currentPauser, err := k.AcceptRole(ctx, types.Role_ROLE_PAUSER, msg.From)
+	if err != nil {
+		return nil, err
+	}
+
+	event := types.PauserUpdated{
+		PreviousPauser: currentPauser,
+		NewPauser:      msg.From,
+	}
+	err = ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgAcceptPauserResponse{}, err
+}
@@ -17,18 +17,13 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
-	"encoding/hex"
-	"strings"
 
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/circlefin/noble-cctp/x/cctp/types"
-	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 )
 
 func (k msgServer) DepositForBurn(goCtx context.Context, msg *types.MsgDepositForBurn) (*types.MsgDepositForBurnResponse, error) {
@@ -48,6 +43,8 @@ func (k msgServer) DepositForBurn(goCtx context.Context, msg *types.MsgDepositFo
 	return &types.MsgDepositForBurnResponse{Nonce: nonce}, err
 }
 
+// depositForBurn parses the bech32 from address of a message before calling
+// Keeper.DepositForBurn.
 func (k msgServer) depositForBurn(
 	ctx sdk.Context,
 	from string,
@@ -57,121 +54,10 @@ func (k msgServer) depositForBurn(
 	burnToken string,
 	destinationCaller []byte,
 ) (uint64, error) {
-	// check for valid `from` address
 	fromAccAddress, err := sdk.AccAddressFromBech32(from)
 	if err != nil {
 		return 0, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
 
-	if !amount.IsPositive() {
-		return 0, errors.Wrap(types.ErrDepositForBurn, "amount must be positive")
-	}
-
-	emptyByteArr := make([]byte, types.MintRecipientLen)
-	if mintRecipient == nil || bytes.Equal(mintRecipient, emptyByteArr) {
-		return 0, errors.Wrap(types.ErrDepositForBurn, "mint recipient must be nonzero")
-	}
-
-	tokenMessenger, found := k.GetRemoteTokenMessenger(ctx, destinationDomain)
-	if !found {
-		return 0, errors.Wrap(types.ErrDepositForBurn, "unable to look up destination token messenger")
-	}
-
-	// Note: fiat token factory only supports burning 1 token denom
-	denom := k.fiattokenfactory.GetMintingDenom(ctx)
-	if !strings.EqualFold(denom.Denom, burnToken) {
-		return 0, errors.Wrapf(types.ErrBurn, "burning denom: %s is not supported", burnToken)
-	}
-
-	// check if burning/minting is paused
-	paused, _ := k.GetBurningAndMintingPaused(ctx)
-	if paused.Paused {
-		return 0, errors.Wrap(types.ErrBurn, "burning and minting are paused")
-	}
-
-	// check if amount is greater than configured PerMessageBurnLimit for this token
-	perMessageBurnLimit, found := k.GetPerMessageBurnLimit(ctx, strings.ToLower(burnToken))
-	if found {
-		if amount.GT(perMessageBurnLimit.Amount) {
-			return 0, errors.Wrap(types.ErrBurn, "cannot burn more than the maximum per message burn limit")
-		}
-	}
-
-	// burn coins
-	coin := sdk.NewCoin(burnToken, math.NewIntFromBigInt(amount.BigInt()))
-
-	err = k.bank.SendCoinsFromAccountToModule(ctx, fromAccAddress, types.ModuleName, sdk.NewCoins(coin))
-	if err != nil {
-		return 0, errors.Wrap(err, "error during transfer")
-	}
-
-	fiatBurnMsg := fiattokenfactorytypes.MsgBurn{
-		From:   types.ModuleAddress.String(),
-		Amount: coin,
-	}
-	_, err = k.fiattokenfactory.Burn(ctx, &fiatBurnMsg)
-	if err != nil {
-		return 0, errors.Wrapf(err, "error during burn")
-	}
-
-	messageSender := make([]byte, 32)
-	copy(messageSender[12:], fromAccAddress)
-
-	burnMessage := types.BurnMessage{
-		Version:       types.MessageBodyVersion,
-		BurnToken:     crypto.Keccak256([]byte(strings.ToLower(burnToken))),
-		MintRecipient: mintRecipient,
-		Amount:        amount,
-		MessageSender: messageSender,
-	}
-
-	var nonce types.Nonce
-
-	newMessageBodyBytes, err := burnMessage.Bytes()
-	if err != nil {
-		return 0, errors.Wrapf(types.ErrParsingBurnMessage, "error parsing burn message into bytes")
-	}
-
-	if len(destinationCaller) == 0 {
-		message := types.MsgSendMessage{
-			From:              types.ModuleAddress.String(),
-			DestinationDomain: destinationDomain,
-			Recipient:         tokenMessenger.Address,
-			MessageBody:       newMessageBodyBytes,
-		}
-
-		resp, err := k.SendMessage(ctx, &message)
-		if err != nil {
-			return 0, err
-		}
-		nonce.Nonce = resp.Nonce
-	} else {
-		message := types.MsgSendMessageWithCaller{
-			From:              types.ModuleAddress.String(),
-			DestinationDomain: destinationDomain,
-			Recipient:         tokenMessenger.Address,
-			MessageBody:       newMessageBodyBytes,
-			DestinationCaller: destinationCaller,
-		}
-
-		resp, err := k.SendMessageWithCaller(ctx, &message)
-		if err != nil {
-			return 0, err
-		}
-		nonce.Nonce = resp.Nonce
-	}
-
-	event := types.DepositForBurn{
-		Nonce:                     nonce.Nonce,
-		BurnToken:                 hex.EncodeToString(crypto.Keccak256([]byte(burnToken))),
-		Amount:                    amount,
-		Depositor:                 from,
-		MintRecipient:             mintRecipient,
-		DestinationDomain:         destinationDomain,
-		DestinationTokenMessenger: tokenMessenger.Address,
-		DestinationCaller:         destinationCaller,
-	}
-	err = ctx.EventManager().EmitTypedEvent(&event)
-
-	return nonce.Nonce, err
+	return k.Keeper.DepositForBurn(ctx, fromAccAddress, amount, destinationDomain, mintRecipient, burnToken, destinationCaller)
 }
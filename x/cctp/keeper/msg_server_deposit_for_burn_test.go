@@ -89,7 +89,7 @@ func TestDepositForBurnZeroAmount(t *testing.T) {
 		BurnToken:         "uUsDC",
 	}
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidAmount, err)
 	require.Contains(t, err.Error(), "amount must be positive")
 }
 
@@ -105,7 +105,7 @@ func TestDepositForBurnNegativeAmount(t *testing.T) {
 		BurnToken:         "uUsDC",
 	}
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidAmount, err)
 	require.Contains(t, err.Error(), "amount must be positive")
 }
 
@@ -121,7 +121,7 @@ func TestDepositForBurnEmptyMintRecipient(t *testing.T) {
 		BurnToken:         "uUsDC",
 	}
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidMintRecipient, err)
 	require.Contains(t, err.Error(), "mint recipient must be nonzero")
 }
 
@@ -136,7 +136,7 @@ func TestDepositForBurnNilMintRecipient(t *testing.T) {
 		BurnToken:         "uUsDC",
 	}
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidMintRecipient, err)
 	require.Contains(t, err.Error(), "mint recipient must be nonzero")
 }
 
@@ -155,7 +155,7 @@ func TestDepositForBurnTokenMessengerNotFound(t *testing.T) {
 		BurnToken:         "uusdc",
 	}
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrRemoteTokenMessengerNotFound, err)
 	require.Contains(t, err.Error(), "unable to look up destination token messenger")
 }
 
@@ -181,7 +181,7 @@ func TestDepositForBurnMintingDenomNotFound(t *testing.T) {
 	}
 
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrBurn, err)
+	require.ErrorIs(t, types.ErrBurnTokenNotSupported, err)
 	require.Contains(t, err.Error(), "is not supported")
 }
 
@@ -209,7 +209,7 @@ func TestDepositForBurnBurningAndMintingIsPaused(t *testing.T) {
 	}
 
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrBurn, err)
+	require.ErrorIs(t, types.ErrBurningAndMintingPaused, err)
 	require.Contains(t, err.Error(), "burning and minting are paused")
 }
 
@@ -241,7 +241,7 @@ func TestDepositForBurnAmountIsGreaterThanPerMessageBurnLimit(t *testing.T) {
 	}
 
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrBurn, err)
+	require.ErrorIs(t, types.ErrBurnLimitExceeded, err)
 	require.Contains(t, err.Error(), "cannot burn more than the maximum per message burn limit")
 }
 
@@ -303,7 +303,7 @@ func TestDepositForBurnTransferFails(t *testing.T) {
 	require.ErrorContains(t, err, "error during transfer: intentional error")
 }
 
-func TestDepositForBurnMessageFormatFails(t *testing.T) {
+func TestDepositForBurnMintRecipientWrongLength(t *testing.T) {
 	testkeeper, ctx := keepertest.CctpKeeper()
 	server := keeper.NewMsgServerImpl(testkeeper)
 
@@ -331,7 +331,11 @@ func TestDepositForBurnMessageFormatFails(t *testing.T) {
 	}
 
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, err, types.ErrParsingBurnMessage)
+	require.ErrorIs(t, err, types.ErrInvalidMintRecipient)
+
+	nextNonce, found := testkeeper.GetNextAvailableNonce(ctx)
+	require.True(t, found)
+	require.Equal(t, startingNonce, nextNonce)
 }
 
 func TestDepositForBurnSendMessageFails(t *testing.T) {
@@ -364,7 +368,7 @@ func TestDepositForBurnSendMessageFails(t *testing.T) {
 	}
 
 	_, err := server.DepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrSendMessage, err)
+	require.ErrorIs(t, types.ErrSendingAndReceivingPaused, err)
 }
 
 func TestDepositForBurnInvalidFromAddress(t *testing.T) {
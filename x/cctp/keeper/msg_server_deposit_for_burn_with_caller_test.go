@@ -123,7 +123,7 @@ func TestDepositForBurnWithCallerZeroAmount(t *testing.T) {
 		DestinationCaller: []byte("12345678901234567890123456789012"),
 	}
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidAmount, err)
 	require.Contains(t, err.Error(), "amount must be positive")
 }
 
@@ -140,7 +140,7 @@ func TestDepositForBurnWithCallerNegativeAmount(t *testing.T) {
 		DestinationCaller: []byte("12345678901234567890123456789012"),
 	}
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidAmount, err)
 	require.Contains(t, err.Error(), "amount must be positive")
 }
 
@@ -157,7 +157,7 @@ func TestDepositForBurnWithCallerNilMintRecipient(t *testing.T) {
 		DestinationCaller: []byte("12345678901234567890123456789012"),
 	}
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidMintRecipient, err)
 	require.Contains(t, err.Error(), "mint recipient must be nonzero")
 }
 
@@ -173,7 +173,7 @@ func TestDepositForBurnWithCallerEmptyMintRecipient(t *testing.T) {
 		DestinationCaller: []byte("12345678901234567890123456789012"),
 	}
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidMintRecipient, err)
 	require.Contains(t, err.Error(), "mint recipient must be nonzero")
 }
 
@@ -193,7 +193,7 @@ func TestDepositForBurnWithCallerTokenMessengerNotFound(t *testing.T) {
 		DestinationCaller: []byte("12345678901234567890123456789012"),
 	}
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrRemoteTokenMessengerNotFound, err)
 	require.Contains(t, err.Error(), "unable to look up destination token messenger")
 }
 
@@ -220,7 +220,7 @@ func TestDepositForBurnWithCallerMintingDenomNotFound(t *testing.T) {
 	}
 
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrBurn, err)
+	require.ErrorIs(t, types.ErrBurnTokenNotSupported, err)
 	require.Contains(t, err.Error(), "is not supported")
 }
 
@@ -249,7 +249,7 @@ func TestDepositForBurnWithCallerBurningAndMintingIsPaused(t *testing.T) {
 	}
 
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrBurn, err)
+	require.ErrorIs(t, types.ErrBurningAndMintingPaused, err)
 	require.Contains(t, err.Error(), "burning and minting are paused")
 }
 
@@ -282,7 +282,7 @@ func TestDepositForBurnWithCallerAmountIsGreaterThanPerMessageBurnLimit(t *testi
 	}
 
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrBurn, err)
+	require.ErrorIs(t, types.ErrBurnLimitExceeded, err)
 	require.Contains(t, err.Error(), "cannot burn more than the maximum per message burn limit")
 }
 
@@ -317,5 +317,5 @@ func TestDepositForBurnWithCallerSendMessageFails(t *testing.T) {
 	}
 
 	_, err := server.DepositForBurnWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrSendMessage, err)
+	require.ErrorIs(t, types.ErrSendingAndReceivingPaused, err)
 }
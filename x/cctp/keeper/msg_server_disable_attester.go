@@ -38,13 +38,16 @@ func (k msgServer) DisableAttester(goCtx context.Context, msg *types.MsgDisableA
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid attester")
 	}
 
-	_, found := k.GetAttester(ctx, msg.Attester)
+	attester, found := k.GetAttester(ctx, msg.Attester)
 	if !found {
 		return nil, errors.Wrapf(types.ErrDisableAttester, "attester not found")
 	}
+	if attester.Status == types.AttesterStatus_ATTESTER_STATUS_DISABLED {
+		return nil, errors.Wrapf(types.ErrDisableAttester, "attester already disabled")
+	}
 
 	// disallow removing public key if there is only 1 active public key
-	storedAttesters := k.GetAllAttesters(ctx)
+	storedAttesters := k.GetEnabledAttesters(ctx)
 	if len(storedAttesters) == 1 {
 		return nil, errors.Wrap(types.ErrDisableAttester, "cannot disable the last attester")
 	}
@@ -55,14 +58,36 @@ func (k msgServer) DisableAttester(goCtx context.Context, msg *types.MsgDisableA
 		return nil, errors.Wrapf(types.ErrDisableAttester, "signature threshold not set")
 	}
 
-	if uint32(len(storedAttesters)) <= signatureThreshold.Amount {
+	// the threshold must remain reachable by the attesters of one key type
+	remaining := make([]types.Attester, 0, len(storedAttesters))
+	for _, stored := range storedAttesters {
+		if stored.Attester != attester.Attester {
+			remaining = append(remaining, stored)
+		}
+	}
+	if maxAttestersPerKeyType(remaining) < signatureThreshold.Amount {
 		return nil, errors.Wrap(types.ErrDisableAttester, "signature threshold is too low")
 	}
 
-	k.DeleteAttester(ctx, msg.Attester)
+	// the record is kept, so that the attester's history remains auditable
+	attester.Status = types.AttesterStatus_ATTESTER_STATUS_DISABLED
+	attester.DisabledAtHeight = ctx.BlockHeight()
+	attester.DisableReason = msg.Reason
+	k.SetAttester(ctx, attester)
+
+	k.AppendAttesterHistoryEntry(ctx, types.AttesterHistoryEntry{
+		Attester:  attester.Attester,
+		KeyType:   attester.KeyType,
+		Status:    types.AttesterStatus_ATTESTER_STATUS_DISABLED,
+		Operator:  attester.Operator,
+		Reason:    msg.Reason,
+		Height:    ctx.BlockHeight(),
+		Authority: msg.From,
+	})
 
 	event := types.AttesterDisabled{
 		Attester: msg.Attester,
+		Reason:   msg.Reason,
 	}
 	err := ctx.EventManager().EmitTypedEvent(&event)
 
@@ -23,6 +23,7 @@ import (
 	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -35,9 +36,13 @@ import (
  * Fails when only 1 attester is left
  * Fails when signature threshold not found
  * Fails when signature threshold is too low
+ * Disabled attesters do not count towards the threshold
+ * Fails when no key type would reach the signature threshold
+ * Succeeds when another key type reaches the signature threshold
  */
 func TestDisableAttesterHappyPath(t *testing.T) {
 	testkeeper, ctx := keepertest.CctpKeeper()
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
 	server := keeper.NewMsgServerImpl(testkeeper)
 
 	attesterManager := sample.AccAddress()
@@ -62,13 +67,30 @@ func TestDisableAttesterHappyPath(t *testing.T) {
 	message := types.MsgDisableAttester{
 		From:     attesterManager,
 		Attester: "1234",
+		Reason:   "key rotation",
 	}
 
 	_, err := server.DisableAttester(ctx, &message)
 	require.Nil(t, err)
 
-	_, found := testkeeper.GetAttester(ctx, message.Attester)
-	require.False(t, found)
+	// the record is kept, but no longer part of the attester set
+	actual, found := testkeeper.GetAttester(ctx, message.Attester)
+	require.True(t, found)
+	require.Equal(t, types.AttesterStatus_ATTESTER_STATUS_DISABLED, actual.Status)
+	require.Equal(t, int64(10), actual.DisabledAtHeight)
+	require.Equal(t, message.Reason, actual.DisableReason)
+	require.ElementsMatch(t, []types.Attester{existing2, existing3}, testkeeper.GetEnabledAttesters(ctx))
+
+	history := testkeeper.GetAttesterHistory(ctx)
+	require.Len(t, history, 1)
+	require.Equal(t, types.AttesterStatus_ATTESTER_STATUS_DISABLED, history[0].Status)
+	require.Equal(t, message.Reason, history[0].Reason)
+	require.Equal(t, attesterManager, history[0].Authority)
+
+	// an attester cannot be disabled twice
+	_, err = server.DisableAttester(ctx, &message)
+	require.ErrorIs(t, types.ErrDisableAttester, err)
+	require.Contains(t, err.Error(), "attester already disabled")
 }
 
 func TestDisableAttesterAuthorityNotSet(t *testing.T) {
@@ -225,3 +247,59 @@ func TestDisableAttesterFailsWhenSignatureThresholdIsTooLow(t *testing.T) {
 	require.ErrorIs(t, types.ErrDisableAttester, err)
 	require.Contains(t, err.Error(), "signature threshold is too low")
 }
+
+func TestDisableAttesterDisabledAttestersDoNotCount(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesterManager := sample.AccAddress()
+	testkeeper.SetAttesterManager(ctx, attesterManager)
+
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "1234"})
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "5678"})
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "9012", Status: types.AttesterStatus_ATTESTER_STATUS_DISABLED})
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	message := types.MsgDisableAttester{
+		From:     attesterManager,
+		Attester: "1234",
+	}
+
+	_, err := server.DisableAttester(ctx, &message)
+	require.ErrorIs(t, types.ErrDisableAttester, err)
+	require.Contains(t, err.Error(), "signature threshold is too low")
+}
+
+func TestDisableAttesterFailsWhenNoKeyTypeReachesThreshold(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesterManager := sample.AccAddress()
+	testkeeper.SetAttesterManager(ctx, attesterManager)
+
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "1234"})
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "5678"})
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "9012", KeyType: types.KeyType_KEY_TYPE_ED25519})
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	// two attesters would remain, but of different key types
+	_, err := server.DisableAttester(ctx, &types.MsgDisableAttester{From: attesterManager, Attester: "1234"})
+	require.ErrorIs(t, types.ErrDisableAttester, err)
+	require.Contains(t, err.Error(), "signature threshold is too low")
+}
+
+func TestDisableAttesterOtherKeyTypeReachesThreshold(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesterManager := sample.AccAddress()
+	testkeeper.SetAttesterManager(ctx, attesterManager)
+
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "1234"})
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "5678"})
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "9012", KeyType: types.KeyType_KEY_TYPE_ED25519})
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	_, err := server.DisableAttester(ctx, &types.MsgDisableAttester{From: attesterManager, Attester: "9012"})
+	require.Nil(t, err)
+}
@@ -34,22 +34,37 @@ func (k msgServer) EnableAttester(goCtx context.Context, msg *types.MsgEnableAtt
 		return nil, errors.Wrapf(types.ErrUnauthorized, "this message sender cannot enable attesters")
 	}
 
-	if len(common.FromHex(msg.Attester)) == 0 {
-		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid attester")
+	if err := types.ValidateAttesterKey(msg.KeyType, common.FromHex(msg.Attester)); err != nil {
+		return nil, err
 	}
 
-	_, found := k.GetAttester(ctx, msg.Attester)
-	if found {
+	existing, found := k.GetAttester(ctx, msg.Attester)
+	if found && existing.Status == types.AttesterStatus_ATTESTER_STATUS_ENABLED {
 		return nil, errors.Wrapf(types.ErrAttesterAlreadyFound, "this attester already exists in the store")
 	}
 
+	// a previously disabled attester is re-enabled with fresh metadata
 	newAttester := types.Attester{
-		Attester: msg.Attester,
+		Attester:      msg.Attester,
+		KeyType:       msg.KeyType,
+		Operator:      msg.Operator,
+		AddedAtHeight: ctx.BlockHeight(),
+		Status:        types.AttesterStatus_ATTESTER_STATUS_ENABLED,
 	}
 	k.SetAttester(ctx, newAttester)
 
+	k.AppendAttesterHistoryEntry(ctx, types.AttesterHistoryEntry{
+		Attester:  msg.Attester,
+		KeyType:   msg.KeyType,
+		Status:    types.AttesterStatus_ATTESTER_STATUS_ENABLED,
+		Operator:  msg.Operator,
+		Height:    ctx.BlockHeight(),
+		Authority: msg.From,
+	})
+
 	event := types.AttesterEnabled{
 		Attester: msg.Attester,
+		Operator: msg.Operator,
 	}
 	err := ctx.EventManager().EmitTypedEvent(&event)
 
@@ -17,12 +17,15 @@
 package keeper_test
 
 import (
+	"encoding/hex"
 	"testing"
 
+	"github.com/circlefin/noble-cctp/testutil/attester"
 	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
 	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,9 +35,14 @@ import (
  * Invalid authority
  * Invalid attester
  * Attester already found
+ * Re-enable a disabled attester
+ * Typed attesters
+ * Invalid typed attesters
+ * Mixed key types only raise the threshold of their own key type
  */
 func TestEnableAttesterHappyPath(t *testing.T) {
 	testkeeper, ctx := keepertest.CctpKeeper()
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
 	server := keeper.NewMsgServerImpl(testkeeper)
 
 	attesterManager := sample.AccAddress()
@@ -43,6 +51,7 @@ func TestEnableAttesterHappyPath(t *testing.T) {
 	message := types.MsgEnableAttester{
 		From:     attesterManager,
 		Attester: "1234",
+		Operator: "operator",
 	}
 
 	_, err := server.EnableAttester(ctx, &message)
@@ -51,6 +60,47 @@ func TestEnableAttesterHappyPath(t *testing.T) {
 	actual, found := testkeeper.GetAttester(ctx, message.Attester)
 	require.True(t, found)
 	require.Equal(t, message.Attester, actual.Attester)
+	require.Equal(t, message.Operator, actual.Operator)
+	require.Equal(t, int64(10), actual.AddedAtHeight)
+	require.Equal(t, types.AttesterStatus_ATTESTER_STATUS_ENABLED, actual.Status)
+
+	require.Equal(t, []types.AttesterHistoryEntry{{
+		Attester:  message.Attester,
+		Status:    types.AttesterStatus_ATTESTER_STATUS_ENABLED,
+		Operator:  message.Operator,
+		Height:    int64(10),
+		Authority: attesterManager,
+	}}, testkeeper.GetAttesterHistory(ctx))
+}
+
+func TestEnableAttesterReenableDisabledAttester(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesterManager := sample.AccAddress()
+	testkeeper.SetAttesterManager(ctx, attesterManager)
+
+	testkeeper.SetAttester(ctx, types.Attester{
+		Attester:      "1234",
+		Operator:      "old operator",
+		Status:        types.AttesterStatus_ATTESTER_STATUS_DISABLED,
+		DisableReason: "compromised",
+	})
+
+	message := types.MsgEnableAttester{
+		From:     attesterManager,
+		Attester: "1234",
+		Operator: "new operator",
+	}
+
+	_, err := server.EnableAttester(ctx, &message)
+	require.Nil(t, err)
+
+	actual, found := testkeeper.GetAttester(ctx, message.Attester)
+	require.True(t, found)
+	require.Equal(t, types.AttesterStatus_ATTESTER_STATUS_ENABLED, actual.Status)
+	require.Equal(t, message.Operator, actual.Operator)
+	require.Empty(t, actual.DisableReason)
 }
 
 func TestEnableAttesterAuthorityNotSet(t *testing.T) {
@@ -120,3 +170,74 @@ func TestEnableAttesterAttesterAlreadyFound(t *testing.T) {
 	require.ErrorIs(t, types.ErrAttesterAlreadyFound, err)
 	require.Contains(t, err.Error(), "this attester already exists in the store")
 }
+
+func TestEnableAttesterTypedAttesters(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesterManager := sample.AccAddress()
+	testkeeper.SetAttesterManager(ctx, attesterManager)
+
+	attesters := append(
+		attester.DeterministicEd25519Set([]byte("ed25519"), 1).Attesters(),
+		attester.DeterministicBLSSet([]byte("bls"), 1).Attesters()...,
+	)
+	for _, expected := range attesters {
+		_, err := server.EnableAttester(ctx, &types.MsgEnableAttester{
+			From:     attesterManager,
+			Attester: expected.Attester,
+			KeyType:  expected.KeyType,
+		})
+		require.Nil(t, err)
+
+		actual, found := testkeeper.GetAttester(ctx, expected.Attester)
+		require.True(t, found)
+		require.Equal(t, expected, actual)
+	}
+}
+
+func TestEnableAttesterInvalidTypedAttesters(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesterManager := sample.AccAddress()
+	testkeeper.SetAttesterManager(ctx, attesterManager)
+
+	for _, message := range []types.MsgEnableAttester{
+		{From: attesterManager, Attester: "1234", KeyType: types.KeyType_KEY_TYPE_ED25519},
+		{From: attesterManager, Attester: "1234", KeyType: types.KeyType_KEY_TYPE_BLS12_381},
+		{From: attesterManager, Attester: hex.EncodeToString(make([]byte, types.BLSPublicKeyLength)), KeyType: types.KeyType_KEY_TYPE_BLS12_381},
+		{From: attesterManager, Attester: "1234", KeyType: types.KeyType(9)},
+	} {
+		_, err := server.EnableAttester(ctx, &message)
+		require.ErrorIs(t, types.ErrInvalidAddress, err)
+
+		_, found := testkeeper.GetAttester(ctx, message.Attester)
+		require.False(t, found)
+	}
+}
+
+func TestEnableAttesterMixedKeyTypes(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesterManager := sample.AccAddress()
+	testkeeper.SetAttesterManager(ctx, attesterManager)
+
+	secp256k1 := attester.DeterministicSet([]byte("secp256k1"), 3).Attesters()
+	ed25519 := attester.DeterministicEd25519Set([]byte("ed25519"), 1).Attesters()
+	for _, a := range append(ed25519, secp256k1[:2]...) {
+		_, err := server.EnableAttester(ctx, &types.MsgEnableAttester{From: attesterManager, Attester: a.Attester, KeyType: a.KeyType})
+		require.Nil(t, err)
+	}
+
+	// the ed25519 attester does not count towards a secp256k1 threshold
+	_, err := server.UpdateSignatureThreshold(ctx, &types.MsgUpdateSignatureThreshold{From: attesterManager, Amount: 3})
+	require.ErrorIs(t, types.ErrUpdateSignatureThreshold, err)
+
+	_, err = server.EnableAttester(ctx, &types.MsgEnableAttester{From: attesterManager, Attester: secp256k1[2].Attester})
+	require.Nil(t, err)
+
+	_, err = server.UpdateSignatureThreshold(ctx, &types.MsgUpdateSignatureThreshold{From: attesterManager, Amount: 3})
+	require.Nil(t, err)
+}
@@ -0,0 +1,185 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Happy path
+ * Happy path with destination caller and fee
+ * Invalid from address
+ * Local destination domain
+ * Remote token messenger not found
+ * Invalid mint recipient
+ * Invalid destination caller
+ * Negative fee
+ * Remove happy path
+ * Remove forwarding not found
+ */
+
+func setupForwarding(t *testing.T) (*keeper.Keeper, types.MsgServer, sdk.Context) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{DomainId: 0, Address: tokenMessenger})
+	return testkeeper, keeper.NewMsgServerImpl(testkeeper), sdk.UnwrapSDKContext(ctx)
+}
+
+func TestSetForwardingHappyPath(t *testing.T) {
+	testkeeper, server, ctx := setupForwarding(t)
+
+	msg := types.MsgSetForwarding{
+		From:              sample.AccAddress(),
+		DestinationDomain: 0,
+		MintRecipient:     tokenMessenger,
+	}
+	_, err := server.SetForwarding(ctx, &msg)
+	require.Nil(t, err)
+
+	forwarding, found := testkeeper.GetForwarding(ctx, sdk.MustAccAddressFromBech32(msg.From))
+	require.True(t, found)
+	require.Equal(t, types.Forwarding{
+		Address:           msg.From,
+		DestinationDomain: 0,
+		MintRecipient:     tokenMessenger,
+		Fee:               math.ZeroInt(),
+	}, forwarding)
+}
+
+func TestSetForwardingWithCallerAndFee(t *testing.T) {
+	testkeeper, server, ctx := setupForwarding(t)
+
+	caller := make([]byte, types.DestinationCallerLen)
+	caller[31] = 1
+	msg := types.MsgSetForwarding{
+		From:              sample.AccAddress(),
+		DestinationDomain: 0,
+		MintRecipient:     tokenMessenger,
+		DestinationCaller: caller,
+		Fee:               math.NewInt(100),
+	}
+	_, err := server.SetForwarding(ctx, &msg)
+	require.Nil(t, err)
+
+	forwarding, found := testkeeper.GetForwarding(ctx, sdk.MustAccAddressFromBech32(msg.From))
+	require.True(t, found)
+	require.Equal(t, caller, forwarding.DestinationCaller)
+	require.Equal(t, math.NewInt(100), forwarding.Fee)
+
+	// setting again replaces the forwarding
+	msg.Fee = math.NewInt(5)
+	_, err = server.SetForwarding(ctx, &msg)
+	require.Nil(t, err)
+	require.Len(t, testkeeper.GetAllForwardings(ctx), 1)
+	forwarding, _ = testkeeper.GetForwarding(ctx, sdk.MustAccAddressFromBech32(msg.From))
+	require.Equal(t, math.NewInt(5), forwarding.Fee)
+}
+
+func TestSetForwardingInvalid(t *testing.T) {
+	shortCaller := make([]byte, 20)
+	shortCaller[0] = 1
+
+	for _, tc := range []struct {
+		desc   string
+		modify func(msg *types.MsgSetForwarding)
+		err    error
+	}{
+		{
+			desc:   "InvalidFrom",
+			modify: func(msg *types.MsgSetForwarding) { msg.From = "invalid" },
+			err:    types.ErrInvalidAddress,
+		},
+		{
+			desc:   "LocalDomain",
+			modify: func(msg *types.MsgSetForwarding) { msg.DestinationDomain = types.NobleDomainId },
+			err:    types.ErrInvalidDestinationDomain,
+		},
+		{
+			desc:   "RemoteTokenMessengerNotFound",
+			modify: func(msg *types.MsgSetForwarding) { msg.DestinationDomain = 5 },
+			err:    types.ErrRemoteTokenMessengerNotFound,
+		},
+		{
+			desc:   "EmptyMintRecipient",
+			modify: func(msg *types.MsgSetForwarding) { msg.MintRecipient = make([]byte, types.MintRecipientLen) },
+			err:    types.ErrInvalidMintRecipient,
+		},
+		{
+			desc:   "ShortMintRecipient",
+			modify: func(msg *types.MsgSetForwarding) { msg.MintRecipient = tokenMessenger[12:] },
+			err:    types.ErrInvalidMintRecipient,
+		},
+		{
+			desc:   "InvalidDestinationCaller",
+			modify: func(msg *types.MsgSetForwarding) { msg.DestinationCaller = shortCaller },
+			err:    types.ErrInvalidDestinationCaller,
+		},
+		{
+			desc:   "NegativeFee",
+			modify: func(msg *types.MsgSetForwarding) { msg.Fee = math.NewInt(-1) },
+			err:    types.ErrInvalidAmount,
+		},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			testkeeper, server, ctx := setupForwarding(t)
+
+			msg := types.MsgSetForwarding{
+				From:              sample.AccAddress(),
+				DestinationDomain: 0,
+				MintRecipient:     tokenMessenger,
+			}
+			tc.modify(&msg)
+
+			_, err := server.SetForwarding(ctx, &msg)
+			require.ErrorIs(t, err, tc.err)
+			require.Empty(t, testkeeper.GetAllForwardings(ctx))
+		})
+	}
+}
+
+func TestRemoveForwardingHappyPath(t *testing.T) {
+	testkeeper, server, ctx := setupForwarding(t)
+
+	from := sample.AccAddress()
+	_, err := server.SetForwarding(ctx, &types.MsgSetForwarding{
+		From:              from,
+		DestinationDomain: 0,
+		MintRecipient:     tokenMessenger,
+	})
+	require.Nil(t, err)
+
+	_, err = server.RemoveForwarding(ctx, &types.MsgRemoveForwarding{From: from})
+	require.Nil(t, err)
+
+	_, found := testkeeper.GetForwarding(ctx, sdk.MustAccAddressFromBech32(from))
+	require.False(t, found)
+}
+
+func TestRemoveForwardingNotFound(t *testing.T) {
+	_, server, ctx := setupForwarding(t)
+
+	_, err := server.RemoveForwarding(ctx, &types.MsgRemoveForwarding{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrForwardingNotFound)
+}
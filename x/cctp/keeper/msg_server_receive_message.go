@@ -26,84 +26,47 @@ import (
 	"github.com/circlefin/noble-cctp/x/cctp/types"
 	fiattokenfactorytypes "github.com/circlefin/noble-fiattokenfactory/x/fiattokenfactory/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/bech32"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
-var zeroByteArray = []byte{ // 32 bytes
-	0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 0, 0, 0, 0, 0,
-}
-
 func (k msgServer) ReceiveMessage(goCtx context.Context, msg *types.MsgReceiveMessage) (*types.MsgReceiveMessageResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	sendingReceivingPaused, found := k.GetSendingAndReceivingMessagesPaused(ctx)
-	if found && sendingReceivingPaused.Paused {
-		return nil, errors.Wrap(types.ErrReceiveMessage, "sending and receiving messages are paused")
-	}
-
-	// Validate each signature in the attestation
-	publicKeys := k.GetAllAttesters(ctx)
-	if len(publicKeys) == 0 {
-		return nil, errors.Wrap(types.ErrReceiveMessage, "no attesters found")
-	}
-
-	signatureThreshold, found := k.GetSignatureThreshold(ctx)
-	if !found {
-		return nil, errors.Wrap(types.ErrReceiveMessage, "signature threshold not found")
-	}
-
-	if err := VerifyAttestationSignatures(msg.Message, msg.Attestation, publicKeys, signatureThreshold.Amount); err != nil {
-		return nil, errors.Wrapf(types.ErrReceiveMessage, "unable to verify signatures: %s", err)
-	}
+	return k.receiveMessage(sdk.UnwrapSDKContext(goCtx), msg)
+}
 
-	// parse message
-	message, err := new(types.Message).Parse(msg.Message)
+func (k Keeper) receiveMessage(ctx sdk.Context, msg *types.MsgReceiveMessage) (*types.MsgReceiveMessageResponse, error) {
+	message, err := k.ValidateReceiveMessage(ctx, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	// validate domain
-	if message.DestinationDomain != types.NobleDomainId {
-		return nil, errors.Wrapf(types.ErrReceiveMessage, "incorrect destination domain: %d", message.DestinationDomain)
-	}
-
-	// validate destination caller
-	if !bytes.Equal(message.DestinationCaller, zeroByteArray) {
-		bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
-		destinationCaller, err := bech32.ConvertAndEncode(bech32Prefix, message.DestinationCaller[12:])
-		if err != nil {
-			return nil, errors.Wrapf(types.ErrReceiveMessage, "unable to encode destination caller %s: %s", msg.From, err)
-		}
-
-		if destinationCaller != msg.From {
-			return nil, errors.Wrapf(types.ErrReceiveMessage, "incorrect destination caller: %s, sender: %s", destinationCaller, msg.From)
-		}
-	}
-
-	// validate version
-	if message.Version != types.NobleMessageVersion {
-		return nil, errors.Wrapf(types.ErrReceiveMessage, "incorrect message version. expected: %d, found: %d", types.NobleMessageVersion, message.Version)
-	}
-
 	// validate nonce is available
 	// note: we use the domain/nonce combo instead of a hash
 	usedNonce := types.Nonce{SourceDomain: message.SourceDomain, Nonce: message.Nonce}
-	found = k.GetUsedNonce(ctx, usedNonce)
-	if found {
-		return nil, errors.Wrapf(types.ErrReceiveMessage, "nonce already used")
+	if k.GetUsedNonce(ctx, usedNonce) {
+		// a relayer that opted in can tell its message was already received
+		if msg.AllowAlreadyReceived {
+			record, found := k.GetUsedNonceRecord(ctx, message.SourceDomain, message.Nonce)
+			if found && bytes.Equal(record.MessageHash, crypto.Keccak256(msg.Message)) {
+				return &types.MsgReceiveMessageResponse{Success: true, AlreadyReceived: true}, nil
+			}
+		}
+		return nil, errors.Wrapf(types.ErrNonceAlreadyUsed, "source domain: %d, nonce: %d", message.SourceDomain, message.Nonce)
 	}
 
-	// mark nonce as used
+	// mark nonce as used, recording the message so that any later relayer
+	// that opted in can tell it was already received
 	k.SetUsedNonce(ctx, usedNonce)
+	k.SetUsedNonceRecord(ctx, types.UsedNonceRecord{
+		SourceDomain: message.SourceDomain,
+		Nonce:        message.Nonce,
+		MessageHash:  crypto.Keccak256(msg.Message),
+	})
 
 	// verify and parse BurnMessage
 	if bytes.Equal(message.Recipient, types.PaddedModuleAddress) { // then mint
 		burningMintingPaused, found := k.GetBurningAndMintingPaused(ctx)
 		if found && burningMintingPaused.Paused {
-			return nil, errors.Wrap(types.ErrReceiveMessage, "cctp burning and minting is paused")
+			return nil, errors.Wrap(types.ErrBurningAndMintingPaused, "unable to mint")
 		}
 
 		burnMessage, err := new(types.BurnMessage).Parse(message.MessageBody)
@@ -111,38 +74,48 @@ func (k msgServer) ReceiveMessage(goCtx context.Context, msg *types.MsgReceiveMe
 			return nil, err
 		}
 
-		if burnMessage.Version != types.MessageBodyVersion {
-			return nil, errors.Wrap(types.ErrReceiveMessage, "invalid message body version")
+		messageBodyVersion := k.GetLocalDomain(ctx).MessageBodyVersion
+		if burnMessage.Version != messageBodyVersion {
+			return nil, errors.Wrapf(types.ErrInvalidMessageBodyVersion, "expected: %d, found: %d", messageBodyVersion, burnMessage.Version)
 		}
 
 		// look up Noble mint token from corresponding source domain/token
 		tokenPair, found := k.GetTokenPair(ctx, message.SourceDomain, burnMessage.BurnToken)
 		if !found {
-			return nil, errors.Wrap(types.ErrReceiveMessage, "corresponding noble mint token not found")
+			return nil, errors.Wrapf(types.ErrTokenPairNotFound, "no noble mint token for remote token %x on domain %d", burnMessage.BurnToken, message.SourceDomain)
 		}
 
 		remoteTokenMessenger, found := k.GetRemoteTokenMessenger(ctx, message.SourceDomain)
 		if !found {
-			return nil, errors.Wrapf(types.ErrReceiveMessage, "could not retrieve remote token messenger for domain %d", message.SourceDomain)
+			return nil, errors.Wrapf(types.ErrRemoteTokenMessengerNotFound, "could not retrieve remote token messenger for domain %d", message.SourceDomain)
 		}
 		if !bytes.Equal(message.Sender, remoteTokenMessenger.Address) {
-			return nil, errors.Wrap(types.ErrReceiveMessage, "message sender is not the remote token messenger")
+			return nil, errors.Wrap(types.ErrInvalidMessageSender, "message sender is not the remote token messenger")
 		}
 
-		// get mint recipient as noble address
+		// get mint recipient as a 20-byte or registered 32-byte noble address
+		mintRecipientBytes, err := k.recipientAddress(ctx, burnMessage.MintRecipient)
+		if err != nil {
+			return nil, errors.Wrapf(types.ErrInvalidMintRecipient, "error decoding mint recipient address: %s", err)
+		}
 		bech32Prefix := sdk.GetConfig().GetBech32AccountAddrPrefix()
-		mintRecipient, err := sdk.Bech32ifyAddressBytes(bech32Prefix, burnMessage.MintRecipient[12:])
+		mintRecipient, err := sdk.Bech32ifyAddressBytes(bech32Prefix, mintRecipientBytes)
 		if err != nil {
-			return nil, errors.Wrapf(types.ErrReceiveMessage, "error bech32 encoding mint recipient address: %s", err)
+			return nil, errors.Wrapf(types.ErrInvalidMintRecipient, "error bech32 encoding mint recipient address: %s", err)
+		}
+
+		mintAmount := sdk.Coin{
+			Denom:  strings.ToLower(tokenPair.LocalToken),
+			Amount: math.NewIntFromBigInt(burnMessage.Amount.BigInt()),
+		}
+		if err := k.Hooks().BeforeMint(ctx, message.SourceDomain, message.Nonce, mintRecipientBytes, mintAmount); err != nil {
+			return nil, err
 		}
 
 		msgMint := fiattokenfactorytypes.MsgMint{
 			From:    types.ModuleAddress.String(),
 			Address: mintRecipient,
-			Amount: sdk.Coin{
-				Denom:  strings.ToLower(tokenPair.LocalToken),
-				Amount: math.NewIntFromBigInt(burnMessage.Amount.BigInt()),
-			},
+			Amount:  mintAmount,
 		}
 		_, err = k.fiattokenfactory.Mint(ctx, &msgMint)
 		if err != nil {
@@ -158,6 +131,31 @@ func (k msgServer) ReceiveMessage(goCtx context.Context, msg *types.MsgReceiveMe
 		if err != nil {
 			return nil, errors.Wrapf(err, "error emitting mint event: %s", err)
 		}
+
+		if err := k.Hooks().AfterMint(ctx, message.SourceDomain, message.Nonce, mintRecipientBytes, mintAmount); err != nil {
+			return nil, err
+		}
+
+		// burn the mint again if its recipient registered a forwarding,
+		// otherwise call back the recipient if it is a contract
+		forwarded, err := k.forwardMint(ctx, msg.From, mintRecipientBytes, message, msgMint.Amount)
+		if err != nil {
+			return nil, err
+		}
+		if !forwarded {
+			err = k.callContract(ctx, mintRecipientBytes, types.ContractCallback{
+				Mint: &types.MintCallback{
+					SourceDomain:  message.SourceDomain,
+					Nonce:         message.Nonce,
+					MessageSender: burnMessage.MessageSender,
+					Amount:        msgMint.Amount.Amount,
+					Denom:         msgMint.Amount.Denom,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	event := types.MessageReceived{
@@ -167,7 +165,29 @@ func (k msgServer) ReceiveMessage(goCtx context.Context, msg *types.MsgReceiveMe
 		Sender:       message.Sender,
 		MessageBody:  message.MessageBody,
 	}
-	err = ctx.EventManager().EmitTypedEvent(&event)
+	if err := ctx.EventManager().EmitTypedEvent(&event); err != nil {
+		return nil, err
+	}
+	if err := k.Hooks().AfterMessageReceived(ctx, message); err != nil {
+		return nil, err
+	}
+
+	// call back the recipient if it is a contract on noble
+	if !bytes.Equal(message.Recipient, types.PaddedModuleAddress) {
+		if recipient, err := k.recipientAddress(ctx, message.Recipient); err == nil {
+			err = k.callContract(ctx, recipient, types.ContractCallback{
+				ReceiveMessage: &types.ReceiveMessageCallback{
+					SourceDomain: message.SourceDomain,
+					Sender:       message.Sender,
+					Nonce:        message.Nonce,
+					MessageBody:  message.MessageBody,
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
 
-	return &types.MsgReceiveMessageResponse{Success: true}, err
+	return &types.MsgReceiveMessageResponse{Success: true}, nil
 }
@@ -17,9 +17,11 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/testutil/attester"
 	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
 	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
@@ -39,11 +41,17 @@ import (
  * Unable to verify signatures
  * Invalid message length
  * Incorrect destination domain
+ * Configured local domain
  * Incorrect destination caller
+ * 32-byte destination caller
  * Invalid message version
  * Fails when nonce already used
+ * Already received message with allow already received
+ * Already received message whose first receiver did not opt in
+ * Already received message without a record
  * Invalid message body version
  * Token pair not found
+ * Typed attesters
  */
 
 func TestReceiveMessageHappyPath(t *testing.T) {
@@ -53,7 +61,7 @@ func TestReceiveMessageHappyPath(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -114,7 +122,7 @@ func TestReceiveMessageWithoutTokenMessenger(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -159,6 +167,7 @@ func TestReceiveMessageWithoutTokenMessenger(t *testing.T) {
 	}
 
 	_, err = server.ReceiveMessage(ctx, &msg)
+	require.ErrorIs(t, types.ErrRemoteTokenMessengerNotFound, err)
 	require.ErrorContains(t, err, "could not retrieve remote token messenger")
 }
 
@@ -169,7 +178,7 @@ func TestReceiveMessageInvalidTokenMessenger(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -219,6 +228,7 @@ func TestReceiveMessageInvalidTokenMessenger(t *testing.T) {
 	}
 
 	_, err = server.ReceiveMessage(ctx, &msg)
+	require.ErrorIs(t, types.ErrInvalidMessageSender, err)
 	require.ErrorContains(t, err, "message sender is not the remote token messenger")
 }
 
@@ -229,7 +239,7 @@ func TestReceiveMessageWithDestinationCallerHappyPath(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -293,7 +303,7 @@ func TestReceiveMessageSendingAndReceivingMessagesPaused(t *testing.T) {
 	testkeeper.SetSendingAndReceivingMessagesPaused(ctx, paused)
 
 	_, err := server.ReceiveMessage(ctx, &types.MsgReceiveMessage{})
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
+	require.ErrorIs(t, types.ErrSendingAndReceivingPaused, err)
 	require.Contains(t, err.Error(), "sending and receiving messages are paused")
 }
 
@@ -322,7 +332,7 @@ func TestReceiveMessageBurningAndMintingPaused(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     token,
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -357,8 +367,7 @@ func TestReceiveMessageBurningAndMintingPaused(t *testing.T) {
 		Attestation: attestation,
 	})
 
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
-	require.Contains(t, err.Error(), "cctp burning and minting is paused")
+	require.ErrorIs(t, types.ErrBurningAndMintingPaused, err)
 }
 
 func TestReceiveMessageNoAttestersFound(t *testing.T) {
@@ -369,7 +378,7 @@ func TestReceiveMessageNoAttestersFound(t *testing.T) {
 	testkeeper.SetSendingAndReceivingMessagesPaused(ctx, paused)
 
 	_, err := server.ReceiveMessage(ctx, &types.MsgReceiveMessage{})
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
+	require.ErrorIs(t, types.ErrAttestersNotFound, err)
 	require.Contains(t, err.Error(), "no attesters found")
 }
 
@@ -389,7 +398,7 @@ func TestReceiveMessageSignatureThresholdNotFound(t *testing.T) {
 	}
 
 	_, err := server.ReceiveMessage(ctx, &types.MsgReceiveMessage{})
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
+	require.ErrorIs(t, types.ErrSignatureThresholdNotFound, err)
 	require.Contains(t, err.Error(), "signature threshold not found")
 }
 
@@ -410,7 +419,7 @@ func TestReceiveMessageUnableToVerifySignatures(t *testing.T) {
 	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: signatureThreshold})
 
 	_, err := server.ReceiveMessage(ctx, &types.MsgReceiveMessage{})
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
+	require.ErrorIs(t, types.ErrSignatureVerification, err)
 	require.Contains(t, err.Error(), "unable to verify signatures")
 }
 
@@ -448,7 +457,7 @@ func TestReceiveMessageIncorrectDestinationDomain(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -493,8 +502,45 @@ func TestReceiveMessageIncorrectDestinationDomain(t *testing.T) {
 	}
 
 	_, err = server.ReceiveMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
-	require.Contains(t, err.Error(), "incorrect destination domain")
+	require.ErrorIs(t, types.ErrInvalidDestinationDomain, err)
+}
+
+func TestReceiveMessageConfiguredLocalDomain(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	testkeeper.SetLocalDomain(ctx, types.LocalDomain{DomainId: 7, MessageVersion: 1})
+
+	attesters := attester.GenerateSet(2)
+	for _, a := range attesters.Attesters() {
+		testkeeper.SetAttester(ctx, a)
+	}
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	receive := func(destinationDomain uint32, version uint32) error {
+		messageBytes, err := (&types.Message{
+			Version:           version,
+			SourceDomain:      0,
+			DestinationDomain: destinationDomain,
+			Nonce:             uint64(destinationDomain),
+			Sender:            []byte("01234567890123456789012345678912"),
+			Recipient:         []byte("01234567890123456789012345678912"),
+			DestinationCaller: make([]byte, types.DestinationCallerLen),
+			MessageBody:       []byte("message body"),
+		}).Bytes()
+		require.Nil(t, err)
+
+		_, err = server.ReceiveMessage(ctx, &types.MsgReceiveMessage{
+			From:        "random address",
+			Message:     messageBytes,
+			Attestation: attesters.Attest(messageBytes),
+		})
+		return err
+	}
+
+	require.ErrorIs(t, receive(types.NobleDomainId, 1), types.ErrInvalidDestinationDomain)
+	require.ErrorIs(t, receive(7, types.NobleMessageVersion), types.ErrInvalidMessageVersion)
+	require.Nil(t, receive(7, 1))
 }
 
 func TestReceiveMessageIncorrectDestinationCaller(t *testing.T) {
@@ -504,7 +550,7 @@ func TestReceiveMessageIncorrectDestinationCaller(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -549,8 +595,44 @@ func TestReceiveMessageIncorrectDestinationCaller(t *testing.T) {
 	}
 
 	_, err = server.ReceiveMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
-	require.Contains(t, err.Error(), "incorrect destination caller")
+	require.ErrorIs(t, types.ErrDestinationCallerMismatch, err)
+}
+
+func TestReceiveMessage32ByteDestinationCaller(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesters := attester.GenerateSet(2)
+	for _, a := range attesters.Attesters() {
+		testkeeper.SetAttester(ctx, a)
+	}
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	caller := sdk.AccAddress(bytes.Repeat([]byte{9}, 32))
+	messageBytes, err := (&types.Message{
+		Version:           0,
+		SourceDomain:      0,
+		DestinationDomain: 4,
+		Nonce:             0,
+		Sender:            []byte("01234567890123456789012345678912"),
+		Recipient:         []byte("01234567890123456789012345678912"),
+		DestinationCaller: caller,
+		MessageBody:       []byte("message body"),
+	}).Bytes()
+	require.Nil(t, err)
+
+	receive := func(from sdk.AccAddress) error {
+		_, err := server.ReceiveMessage(ctx, &types.MsgReceiveMessage{
+			From:        from.String(),
+			Message:     messageBytes,
+			Attestation: attesters.Attest(messageBytes),
+		})
+		return err
+	}
+
+	// the 20-byte account sharing the last 20 bytes is not the caller
+	require.ErrorIs(t, receive(caller[12:]), types.ErrDestinationCallerMismatch)
+	require.Nil(t, receive(caller))
 }
 
 func TestReceiveMessageInvalidMessageVersion(t *testing.T) {
@@ -560,7 +642,7 @@ func TestReceiveMessageInvalidMessageVersion(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -605,8 +687,7 @@ func TestReceiveMessageInvalidMessageVersion(t *testing.T) {
 	}
 
 	_, err = server.ReceiveMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
-	require.Contains(t, err.Error(), "incorrect message version")
+	require.ErrorIs(t, types.ErrInvalidMessageVersion, err)
 }
 
 func TestReceiveMessageNonceAlreadyUsed(t *testing.T) {
@@ -616,7 +697,7 @@ func TestReceiveMessageNonceAlreadyUsed(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -667,10 +748,149 @@ func TestReceiveMessageNonceAlreadyUsed(t *testing.T) {
 	}
 
 	_, err = server.ReceiveMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
+	require.ErrorIs(t, types.ErrNonceAlreadyUsed, err)
 	require.Contains(t, err.Error(), "nonce already used")
 }
 
+func TestReceiveMessageAlreadyReceived(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	signers := attester.GenerateSet(2)
+	for _, attester := range signers.Attesters() {
+		testkeeper.SetAttester(ctx, attester)
+	}
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	message := types.Message{
+		Version:           0,
+		SourceDomain:      5,
+		DestinationDomain: 4,
+		Nonce:             18,
+		Sender:            []byte("01234567890123456789012345678912"),
+		Recipient:         []byte("12345678901234567890123456789012"),
+		DestinationCaller: make([]byte, types.DestinationCallerLen),
+		MessageBody:       []byte("message body"),
+	}
+	messageBytes, err := message.Bytes()
+	require.Nil(t, err)
+
+	msg := types.MsgReceiveMessage{
+		From:                 "random address",
+		Message:              messageBytes,
+		Attestation:          signers.Attest(messageBytes),
+		AllowAlreadyReceived: true,
+	}
+
+	resp, err := server.ReceiveMessage(ctx, &msg)
+	require.Nil(t, err)
+	require.True(t, resp.Success)
+	require.False(t, resp.AlreadyReceived)
+
+	record, found := testkeeper.GetUsedNonceRecord(ctx, 5, 18)
+	require.True(t, found)
+	require.Equal(t, crypto.Keccak256(messageBytes), record.MessageHash)
+
+	// the same message succeeds without any state changes
+	events := len(sdk.UnwrapSDKContext(ctx).EventManager().Events())
+	resp, err = server.ReceiveMessage(ctx, &msg)
+	require.Nil(t, err)
+	require.True(t, resp.Success)
+	require.True(t, resp.AlreadyReceived)
+	require.Len(t, sdk.UnwrapSDKContext(ctx).EventManager().Events(), events)
+
+	// unless the relayer did not opt in
+	msg.AllowAlreadyReceived = false
+	_, err = server.ReceiveMessage(ctx, &msg)
+	require.ErrorIs(t, err, types.ErrNonceAlreadyUsed)
+
+	// a different message reusing the nonce is always rejected
+	message.MessageBody = []byte("another body")
+	otherBytes, err := message.Bytes()
+	require.Nil(t, err)
+
+	_, err = server.ReceiveMessage(ctx, &types.MsgReceiveMessage{
+		From:                 "random address",
+		Message:              otherBytes,
+		Attestation:          signers.Attest(otherBytes),
+		AllowAlreadyReceived: true,
+	})
+	require.ErrorIs(t, err, types.ErrNonceAlreadyUsed)
+}
+
+func TestReceiveMessageAlreadyReceivedWithoutOptIn(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	signers := attester.GenerateSet(1)
+	testkeeper.SetAttester(ctx, signers.Attesters()[0])
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 1})
+
+	message := types.Message{
+		Version:           0,
+		SourceDomain:      5,
+		DestinationDomain: 4,
+		Nonce:             18,
+		Sender:            []byte("01234567890123456789012345678912"),
+		Recipient:         []byte("12345678901234567890123456789012"),
+		DestinationCaller: make([]byte, types.DestinationCallerLen),
+		MessageBody:       []byte("message body"),
+	}
+	messageBytes, err := message.Bytes()
+	require.Nil(t, err)
+
+	// the hash is recorded even if the winning relayer did not opt in
+	msg := types.MsgReceiveMessage{
+		From:        "random address",
+		Message:     messageBytes,
+		Attestation: signers.Attest(messageBytes),
+	}
+	_, err = server.ReceiveMessage(ctx, &msg)
+	require.Nil(t, err)
+	require.True(t, testkeeper.GetUsedNonce(ctx, types.Nonce{SourceDomain: 5, Nonce: 18}))
+	record, found := testkeeper.GetUsedNonceRecord(ctx, 5, 18)
+	require.True(t, found)
+	require.Equal(t, crypto.Keccak256(messageBytes), record.MessageHash)
+
+	// so a losing relayer that opted in can tell it is the same message
+	msg.AllowAlreadyReceived = true
+	resp, err := server.ReceiveMessage(ctx, &msg)
+	require.Nil(t, err)
+	require.True(t, resp.AlreadyReceived)
+}
+
+func TestReceiveMessageAlreadyReceivedWithoutRecord(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	signers := attester.GenerateSet(1)
+	testkeeper.SetAttester(ctx, signers.Attesters()[0])
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 1})
+
+	message := types.Message{
+		Version:           0,
+		SourceDomain:      5,
+		DestinationDomain: 4,
+		Nonce:             18,
+		Sender:            []byte("01234567890123456789012345678912"),
+		Recipient:         []byte("12345678901234567890123456789012"),
+		DestinationCaller: make([]byte, types.DestinationCallerLen),
+		MessageBody:       []byte("message body"),
+	}
+	messageBytes, err := message.Bytes()
+	require.Nil(t, err)
+
+	// a nonce used without a record, e.g. from genesis, can not be matched
+	testkeeper.SetUsedNonce(ctx, types.Nonce{SourceDomain: 5, Nonce: 18})
+	_, err = server.ReceiveMessage(ctx, &types.MsgReceiveMessage{
+		From:                 "random address",
+		Message:              messageBytes,
+		Attestation:          signers.Attest(messageBytes),
+		AllowAlreadyReceived: true,
+	})
+	require.ErrorIs(t, err, types.ErrNonceAlreadyUsed)
+}
+
 func TestReceiveMessageInvalidMessageBodyVersion(t *testing.T) {
 	testkeeper, ctx := keepertest.CctpKeeper()
 	server := keeper.NewMsgServerImpl(testkeeper)
@@ -678,7 +898,7 @@ func TestReceiveMessageInvalidMessageBodyVersion(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       13, // not the current version
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -723,7 +943,7 @@ func TestReceiveMessageInvalidMessageBodyVersion(t *testing.T) {
 	}
 
 	_, err = server.ReceiveMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
+	require.ErrorIs(t, types.ErrInvalidMessageBodyVersion, err)
 	require.Contains(t, err.Error(), "invalid message body version")
 }
 
@@ -734,7 +954,7 @@ func TestReceiveMessageTokenPairNotFound(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -772,8 +992,7 @@ func TestReceiveMessageTokenPairNotFound(t *testing.T) {
 	}
 
 	_, err = server.ReceiveMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReceiveMessage, err)
-	require.Contains(t, err.Error(), "corresponding noble mint token not found")
+	require.ErrorIs(t, types.ErrTokenPairNotFound, err)
 }
 
 func TestReceiveMessageInvalidMessageBody(t *testing.T) {
@@ -783,7 +1002,7 @@ func TestReceiveMessageInvalidMessageBody(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -838,7 +1057,7 @@ func TestReceiveMessageMintingFails(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -898,7 +1117,7 @@ func TestReceiveMessageInvalidPrefixForMintRecipient(t *testing.T) {
 	burnMessage := types.BurnMessage{
 		Version:       0,
 		BurnToken:     []byte("02345678901234567890123456789012"),
-		MintRecipient: []byte("message sender567890123456789012"),
+		MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
 		Amount:        math.NewInt(9876),
 		MessageSender: []byte("message sender567890123456789012"),
 	}
@@ -953,8 +1172,124 @@ func TestReceiveMessageInvalidPrefixForMintRecipient(t *testing.T) {
 	config.SetBech32PrefixForAccount("", "") // Empty bech32 prefix
 
 	_, err = server.ReceiveMessage(ctx, &msg)
-	require.ErrorIs(t, err, types.ErrReceiveMessage)
+	require.ErrorIs(t, err, types.ErrInvalidMintRecipient)
 	require.ErrorContains(t, err, "error bech32 encoding mint recipient address")
 
 	config.SetBech32PrefixForAccount(resetAddrPrefix, resetPubPrefix) // needed for other unit tests to pass
 }
+
+func TestReceiveMessageDoesNotModifyAttestation(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesters := attester.GenerateSet(2)
+	for _, a := range attesters.Attesters() {
+		testkeeper.SetAttester(ctx, a)
+	}
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	message, err := (&types.Message{
+		Version:           types.NobleMessageVersion,
+		SourceDomain:      0,
+		DestinationDomain: types.NobleDomainId,
+		Nonce:             1,
+		Sender:            make([]byte, 32),
+		Recipient:         append(make([]byte, 31), 1),
+		DestinationCaller: make([]byte, types.DestinationCallerLen),
+		MessageBody:       []byte("hello"),
+	}).Bytes()
+	require.NoError(t, err)
+
+	// legacy signatures have a v-value of 27 or 28
+	attestation := attesters.AttestLegacy(message)
+	original := bytes.Clone(attestation)
+
+	msg := types.MsgReceiveMessage{
+		From:        sample.AccAddress(),
+		Message:     message,
+		Attestation: attestation,
+	}
+	_, err = server.ReceiveMessage(ctx, &msg)
+	require.NoError(t, err)
+	require.Equal(t, original, msg.Attestation)
+}
+
+func TestReceiveMessageTypedAttesters(t *testing.T) {
+	for _, tc := range []struct {
+		name        string
+		keyType     types.KeyType
+		attesters   []types.Attester
+		attestation func(message []byte) []byte
+	}{
+		{
+			name:        "ed25519",
+			keyType:     types.KeyType_KEY_TYPE_ED25519,
+			attesters:   attester.DeterministicEd25519Set([]byte("ed25519"), 3).Attesters(),
+			attestation: attester.DeterministicEd25519Set([]byte("ed25519"), 3)[:2].Attest,
+		},
+		{
+			name:      "bls12-381",
+			keyType:   types.KeyType_KEY_TYPE_BLS12_381,
+			attesters: attester.DeterministicBLSSet([]byte("bls"), 3).Attesters(),
+			attestation: func(message []byte) []byte {
+				return attester.DeterministicBLSSet([]byte("bls"), 3).AttestBy(message, 0, 2)
+			},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			testkeeper, ctx := keepertest.CctpKeeper()
+			server := keeper.NewMsgServerImpl(testkeeper)
+
+			burnMessage := types.BurnMessage{
+				BurnToken:     []byte("02345678901234567890123456789012"),
+				MintRecipient: append(make([]byte, 12), []byte("er567890123456789012")...),
+				Amount:        math.NewInt(9876),
+				MessageSender: []byte("message sender567890123456789012"),
+			}
+			burnMessageBytes, err := burnMessage.Bytes()
+			require.Nil(t, err)
+
+			testkeeper.SetTokenPair(ctx, types.TokenPair{
+				RemoteDomain: 0,
+				RemoteToken:  burnMessage.BurnToken,
+				LocalToken:   string(crypto.Keccak256([]byte("uusdc"))),
+			})
+
+			message := types.Message{
+				DestinationDomain: types.NobleDomainId,
+				Sender:            []byte("01234567890123456789012345678912"),
+				Recipient:         types.PaddedModuleAddress,
+				DestinationCaller: make([]byte, types.DestinationCallerLen),
+				MessageBody:       burnMessageBytes,
+			}
+			messageBytes, err := message.Bytes()
+			require.Nil(t, err)
+
+			testkeeper.SetRemoteTokenMessenger(ctx, types.RemoteTokenMessenger{
+				DomainId: message.SourceDomain,
+				Address:  message.Sender,
+			})
+
+			// secp256k1 attesters are also enabled, but do not count here
+			for _, attester := range append(tc.attesters, attester.GenerateSet(2).Attesters()...) {
+				testkeeper.SetAttester(ctx, attester)
+			}
+			testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+			msg := types.MsgReceiveMessage{
+				From:        sample.AccAddress(),
+				Message:     messageBytes,
+				Attestation: tc.attestation(messageBytes),
+			}
+
+			// without a key type, the attestation is parsed as secp256k1
+			_, err = server.ReceiveMessage(ctx, &msg)
+			require.ErrorIs(t, err, types.ErrSignatureVerification)
+
+			msg.KeyType = tc.keyType
+			resp, err := server.ReceiveMessage(ctx, &msg)
+			require.Nil(t, err)
+			require.True(t, resp.Success)
+		})
+	}
+}
@@ -0,0 +1,34 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) RegisterAccount32(goCtx context.Context, msg *types.MsgRegisterAccount32) (*types.MsgRegisterAccount32Response, error) {
+	address, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	return &types.MsgRegisterAccount32Response{}, k.Keeper.RegisterAccount32(goCtx, address)
+}
@@ -0,0 +1,105 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	addresstypes "github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+)
+
+/*
+ * Happy path
+ * Invalid from address
+ * 20-byte account
+ * 32-byte account with a zero prefix
+ * Already registered
+ * Module-derived account registered by its module
+ */
+
+func TestRegisterAccount32HappyPath(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	address := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	require.False(t, testkeeper.IsAccount32Registered(ctx, address))
+
+	_, err := server.RegisterAccount32(ctx, &types.MsgRegisterAccount32{From: address.String()})
+	require.Nil(t, err)
+	require.True(t, testkeeper.IsAccount32Registered(ctx, address))
+	require.Equal(t, []sdk.AccAddress{address}, testkeeper.GetAllAccounts32(ctx))
+}
+
+func TestRegisterAccount32InvalidFrom(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	_, err := server.RegisterAccount32(ctx, &types.MsgRegisterAccount32{From: "invalid address"})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
+
+func TestRegisterAccount32TwentyByteAccount(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	_, err := server.RegisterAccount32(ctx, &types.MsgRegisterAccount32{From: sample.AccAddress()})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	require.Empty(t, testkeeper.GetAllAccounts32(ctx))
+}
+
+func TestRegisterAccount32ZeroPrefix(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	address := sdk.AccAddress(append(make([]byte, 12), bytes.Repeat([]byte{1}, 20)...))
+	_, err := server.RegisterAccount32(ctx, &types.MsgRegisterAccount32{From: address.String()})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+	require.False(t, testkeeper.IsAccount32Registered(ctx, address))
+}
+
+func TestRegisterAccount32AlreadyRegistered(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	address := sdk.AccAddress(bytes.Repeat([]byte{1}, 32))
+	testkeeper.SetAccount32(ctx, address)
+
+	_, err := server.RegisterAccount32(ctx, &types.MsgRegisterAccount32{From: address.String()})
+	require.ErrorIs(t, err, types.ErrAccount32AlreadyRegistered)
+}
+
+func TestRegisterAccount32ModuleAccount(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+
+	// module-derived accounts have no key to sign MsgRegisterAccount32 with
+	address := sdk.AccAddress(addresstypes.Module("icahost", []byte("connection-0"), []byte("owner")))
+	require.NoError(t, testkeeper.RegisterAccount32(ctx, address))
+	require.True(t, testkeeper.IsAccount32Registered(ctx, address))
+
+	require.ErrorIs(t, testkeeper.RegisterAccount32(ctx, address), types.ErrAccount32AlreadyRegistered)
+
+	// 20-byte module accounts are never truncated, so need no registration
+	require.ErrorIs(t, testkeeper.RegisterAccount32(ctx, authtypes.NewModuleAddress("icahost")), types.ErrInvalidAddress)
+}
@@ -32,7 +32,7 @@ func (k msgServer) ReplaceDepositForBurn(goCtx context.Context, msg *types.MsgRe
 
 	paused, found := k.GetBurningAndMintingPaused(ctx)
 	if found && paused.Paused {
-		return nil, errors.Wrap(types.ErrDepositForBurn, "burning and minting are paused")
+		return nil, errors.Wrap(types.ErrBurningAndMintingPaused, "unable to replace deposit for burn")
 	}
 
 	// verify and parse original originalMessage
@@ -48,20 +48,22 @@ func (k msgServer) ReplaceDepositForBurn(goCtx context.Context, msg *types.MsgRe
 	}
 
 	// validate originalMessage sender is the same as this message sender
-	messageSender := make([]byte, 32)
 	fromAccAddress, err := sdk.AccAddressFromBech32(msg.From)
 	if err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
-	copy(messageSender[12:], fromAccAddress)
+	messageSender, err := types.Bytes32FromAccAddress(fromAccAddress)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
 	if !bytes.Equal(messageSender, burnMessage.MessageSender) {
-		return nil, errors.Wrap(types.ErrDepositForBurn, "invalid sender for message")
+		return nil, errors.Wrap(types.ErrInvalidMessageSender, "invalid sender for message")
 	}
 
 	// validate new mint recipient
 	emptyByteArr := make([]byte, types.MintRecipientLen)
 	if bytes.Equal(emptyByteArr, msg.NewMintRecipient) {
-		return nil, errors.Wrap(types.ErrDepositForBurn, "mint recipient must be nonzero")
+		return nil, errors.Wrap(types.ErrInvalidMintRecipient, "mint recipient must be nonzero")
 	}
 
 	newMessageBody := types.BurnMessage{
@@ -83,6 +85,7 @@ func (k msgServer) ReplaceDepositForBurn(goCtx context.Context, msg *types.MsgRe
 		OriginalAttestation:  msg.OriginalAttestation,
 		NewMessageBody:       newMessageBodyBytes,
 		NewDestinationCaller: msg.NewDestinationCaller,
+		KeyType:              msg.KeyType,
 	}
 	_, err = k.ReplaceMessage(goCtx, &replaceMessage)
 	if err != nil {
@@ -146,7 +146,7 @@ func TestReplaceDepositForBurnFailsWhenPaused(t *testing.T) {
 	}
 
 	_, err = server.ReplaceDepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrBurningAndMintingPaused, err)
 	require.Contains(t, err.Error(), "burning and minting are paused")
 }
 
@@ -321,7 +321,7 @@ func TestReplaceDepositForBurnSenderDoesNotMatchOriginalSender(t *testing.T) {
 	}
 
 	_, err = server.ReplaceDepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidMessageSender, err)
 	require.Contains(t, err.Error(), "invalid sender for message")
 }
 
@@ -380,7 +380,7 @@ func TestReplaceDepositForBurnEmptyNewMintRecipient(t *testing.T) {
 	}
 
 	_, err = server.ReplaceDepositForBurn(ctx, &msg)
-	require.ErrorIs(t, types.ErrDepositForBurn, err)
+	require.ErrorIs(t, types.ErrInvalidMintRecipient, err)
 	require.Contains(t, err.Error(), "mint recipient must be nonzero")
 }
 
@@ -31,19 +31,19 @@ func (k msgServer) ReplaceMessage(goCtx context.Context, msg *types.MsgReplaceMe
 
 	paused, found := k.GetSendingAndReceivingMessagesPaused(ctx)
 	if found && paused.Paused {
-		return nil, errors.Wrap(types.ErrReplaceMessage, "sending and receiving messages are paused")
+		return nil, errors.Wrap(types.ErrSendingAndReceivingPaused, "unable to replace message")
 	}
 
 	// Validate each signature in the attestation
 	// Note: changing attesters or the signature threshold can render all previous messages irreplaceable
-	attesters := k.GetAllAttesters(ctx)
+	attesters := k.GetEnabledAttesters(ctx)
 	signatureThreshold, found := k.GetSignatureThreshold(ctx)
 	if !found {
-		return nil, errors.Wrap(types.ErrReplaceMessage, "signature threshold not found")
+		return nil, errors.Wrap(types.ErrSignatureThresholdNotFound, "unable to verify attestation")
 	}
 
-	if err := VerifyAttestationSignatures(msg.OriginalMessage, msg.OriginalAttestation, attesters, signatureThreshold.Amount); err != nil {
-		return nil, errors.Wrapf(types.ErrSignatureVerification, "unable to verify signatures")
+	if err := k.verifyAttestation(msg.KeyType, msg.OriginalMessage, msg.OriginalAttestation, attesters, signatureThreshold.Amount); err != nil {
+		return nil, errors.Wrap(err, "unable to verify signatures")
 	}
 
 	// validate message format
@@ -53,19 +53,25 @@ func (k msgServer) ReplaceMessage(goCtx context.Context, msg *types.MsgReplaceMe
 	}
 
 	// validate that the original message sender is the same as this message sender
-	messageSender := make([]byte, 32)
 	fromAccAddress, err := sdk.AccAddressFromBech32(msg.From)
 	if err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
-	copy(messageSender[12:], fromAccAddress)
+	messageSender, err := types.Bytes32FromAccAddress(fromAccAddress)
+	if err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
 	if !bytes.Equal(messageSender, originalMessage.Sender) {
-		return nil, errors.Wrap(types.ErrReplaceMessage, "sender not permitted to use nonce")
+		return nil, errors.Wrap(types.ErrInvalidMessageSender, "sender not permitted to use nonce")
 	}
 
 	// validate source domain
-	if originalMessage.SourceDomain != types.NobleDomainId {
-		return nil, errors.Wrap(types.ErrReplaceMessage, "message not originally sent from this domain")
+	if originalMessage.SourceDomain != k.GetLocalDomain(ctx).DomainId {
+		return nil, errors.Wrap(types.ErrInvalidSourceDomain, "message not originally sent from this domain")
+	}
+
+	if err := k.validateSendMessage(ctx, originalMessage.Recipient, len(msg.NewMessageBody)); err != nil {
+		return nil, err
 	}
 
 	err = k.sendMessage(
@@ -17,9 +17,11 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
 	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/testutil/attester"
 	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
 	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
@@ -30,6 +32,7 @@ import (
 
 /*
  * Happy path
+ * 32-byte sender
  * Fails when paused
  * Signature threshold not found
  * Signature verification failed
@@ -96,6 +99,52 @@ func TestReplaceMessageHappyPath(t *testing.T) {
 	require.Nil(t, err)
 }
 
+func TestReplaceMessage32ByteSender(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	paused := types.SendingAndReceivingMessagesPaused{Paused: false}
+	testkeeper.SetSendingAndReceivingMessagesPaused(ctx, paused)
+
+	attesters := attester.GenerateSet(2)
+	for _, a := range attesters.Attesters() {
+		testkeeper.SetAttester(ctx, a)
+	}
+	testkeeper.SetSignatureThreshold(ctx, types.SignatureThreshold{Amount: 2})
+
+	sender := sdk.AccAddress(bytes.Repeat([]byte{7}, 32))
+	replace := func(originalSender []byte) error {
+		originalMessageBytes, err := (&types.Message{
+			Version:           1,
+			SourceDomain:      types.NobleDomainId,
+			DestinationDomain: 3,
+			Nonce:             2,
+			Sender:            originalSender,
+			Recipient:         []byte("recipient01234567890123456789012"),
+			DestinationCaller: make([]byte, types.DestinationCallerLen),
+			MessageBody:       []byte("message body"),
+		}).Bytes()
+		require.Nil(t, err)
+
+		_, err = server.ReplaceMessage(ctx, &types.MsgReplaceMessage{
+			From:                 sender.String(),
+			OriginalMessage:      originalMessageBytes,
+			OriginalAttestation:  attesters.Attest(originalMessageBytes),
+			NewMessageBody:       []byte("123"),
+			NewDestinationCaller: make([]byte, types.DestinationCallerLen),
+		})
+		return err
+	}
+
+	// the sender is matched in full
+	require.Nil(t, replace(sender))
+
+	// and not by its truncated form
+	truncated := make([]byte, 32)
+	copy(truncated[12:], sender[12:])
+	require.ErrorIs(t, replace(truncated), types.ErrInvalidMessageSender)
+}
+
 func TestReplaceMessageFailsWhenPaused(t *testing.T) {
 	testkeeper, ctx := keepertest.CctpKeeper()
 	server := keeper.NewMsgServerImpl(testkeeper)
@@ -104,7 +153,7 @@ func TestReplaceMessageFailsWhenPaused(t *testing.T) {
 	testkeeper.SetSendingAndReceivingMessagesPaused(ctx, paused)
 
 	_, err := server.ReplaceMessage(ctx, &types.MsgReplaceMessage{})
-	require.ErrorIs(t, types.ErrReplaceMessage, err)
+	require.ErrorIs(t, types.ErrSendingAndReceivingPaused, err)
 	require.Contains(t, err.Error(), "sending and receiving messages are paused")
 }
 
@@ -156,7 +205,7 @@ func TestReplaceMessageSignatureThresholdNotFound(t *testing.T) {
 	}
 
 	_, err = server.ReplaceMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReplaceMessage, err)
+	require.ErrorIs(t, types.ErrSignatureThresholdNotFound, err)
 	require.Contains(t, err.Error(), "signature threshold not found")
 }
 
@@ -325,7 +374,7 @@ func TestReplaceMessageSenderDoesNotMatchOriginalSender(t *testing.T) {
 	}
 
 	_, err = server.ReplaceMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReplaceMessage, err)
+	require.ErrorIs(t, types.ErrInvalidMessageSender, err)
 	require.Contains(t, err.Error(), "sender not permitted to use nonce")
 }
 
@@ -441,6 +490,6 @@ func TestReplaceMessageMessageNotOriginallySentFromThisDomain(t *testing.T) {
 	}
 
 	_, err = server.ReplaceMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrReplaceMessage, err)
+	require.ErrorIs(t, types.ErrInvalidSourceDomain, err)
 	require.Contains(t, err.Error(), "message not originally sent from this domain")
 }
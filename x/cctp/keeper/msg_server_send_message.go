@@ -29,56 +29,56 @@ import (
 func (k msgServer) SendMessage(goCtx context.Context, msg *types.MsgSendMessage) (*types.MsgSendMessageResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	messageSender := make([]byte, 32)
 	fromAccAddress, err := sdk.AccAddressFromBech32(msg.From)
 	if err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
-	copy(messageSender[12:], fromAccAddress)
 
-	nonce := k.ReserveAndIncrementNonce(ctx)
+	nonce, err := k.Keeper.SendMessage(ctx, fromAccAddress, msg.DestinationDomain, msg.Recipient, msg.MessageBody, nil)
 
-	err = k.sendMessage(
-		ctx,
-		msg.DestinationDomain,
-		msg.Recipient,
-		make([]byte, types.DestinationCallerLen),
-		messageSender,
-		nonce.Nonce,
-		msg.MessageBody)
-
-	return &types.MsgSendMessageResponse{Nonce: nonce.Nonce}, err
+	return &types.MsgSendMessageResponse{Nonce: nonce}, err
 }
 
-func (k msgServer) sendMessage(
-	ctx sdk.Context,
-	destinationDomain uint32,
-	recipient []byte,
-	destinationCaller []byte,
-	messageSender []byte,
-	nonce uint64,
-	messageBody []byte,
-) error {
+// validateSendMessage checks that a message body of messageBodyLen bytes can
+// be sent to recipient, before a nonce is reserved for it.
+func (k Keeper) validateSendMessage(ctx sdk.Context, recipient []byte, messageBodyLen int) error {
 	paused, found := k.GetSendingAndReceivingMessagesPaused(ctx)
 	if found && paused.Paused {
-		return errors.Wrap(types.ErrSendMessage, "sending and receiving messages is paused")
+		return errors.Wrap(types.ErrSendingAndReceivingPaused, "unable to send message")
 	}
 
 	// check if message body is too long, ignore if max length not found
 	max, found := k.GetMaxMessageBodySize(ctx)
-	if found && uint64(len(messageBody)) > max.Amount {
-		return errors.Wrap(types.ErrSendMessage, "message body exceeds max size")
+	if found && uint64(messageBodyLen) > max.Amount {
+		return errors.Wrapf(types.ErrMessageBodyTooLarge, "message body is %d bytes", messageBodyLen)
 	}
 
 	emptyByteArr := make([]byte, len(recipient))
 	if len(recipient) == 0 || bytes.Equal(recipient, emptyByteArr) {
-		return errors.Wrap(types.ErrSendMessage, "recipient must not be nonzero")
+		return errors.Wrap(types.ErrInvalidRecipient, "recipient must be nonzero")
+	}
+	if len(recipient) != types.AddressBytesLen {
+		return errors.Wrapf(types.ErrParsingMessage, "recipient must be %d bytes, got %d", types.AddressBytesLen, len(recipient))
 	}
 
+	return nil
+}
+
+// sendMessage emits a message that was validated with validateSendMessage.
+func (k Keeper) sendMessage(
+	ctx sdk.Context,
+	destinationDomain uint32,
+	recipient []byte,
+	destinationCaller []byte,
+	messageSender []byte,
+	nonce uint64,
+	messageBody []byte,
+) error {
 	// serialize message
+	localDomain := k.GetLocalDomain(ctx)
 	message := types.Message{
-		Version:           types.MessageBodyVersion,
-		SourceDomain:      types.NobleDomainId,
+		Version:           localDomain.MessageVersion,
+		SourceDomain:      localDomain.DomainId,
 		DestinationDomain: destinationDomain,
 		Nonce:             nonce,
 		Sender:            messageSender,
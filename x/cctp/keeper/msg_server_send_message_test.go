@@ -72,8 +72,7 @@ func TestSendMessageSendingAndReceivingMessagesPaused(t *testing.T) {
 	_, err := server.SendMessage(ctx, &types.MsgSendMessage{
 		From: sample.AccAddress(),
 	})
-	require.ErrorIs(t, types.ErrSendMessage, err)
-	require.Contains(t, err.Error(), "sending and receiving messages is paused")
+	require.ErrorIs(t, types.ErrSendingAndReceivingPaused, err)
 }
 
 func TestSendMessageMessageBodyTooLong(t *testing.T) {
@@ -99,7 +98,7 @@ func TestSendMessageMessageBodyTooLong(t *testing.T) {
 	}
 
 	_, err := server.SendMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrSendMessage, err)
+	require.ErrorIs(t, types.ErrMessageBodyTooLarge, err)
 	require.Contains(t, err.Error(), "message body exceeds max size")
 }
 
@@ -123,8 +122,7 @@ func TestSendMessageRecipientEmpty(t *testing.T) {
 	}
 
 	_, err := server.SendMessage(ctx, &msg)
-	require.ErrorIs(t, types.ErrSendMessage, err)
-	require.Contains(t, err.Error(), "recipient must not be nonzero")
+	require.ErrorIs(t, types.ErrInvalidRecipient, err)
 }
 
 func TestSendMessageRecipientInvalid(t *testing.T) {
@@ -29,28 +29,17 @@ import (
 func (k msgServer) SendMessageWithCaller(goCtx context.Context, msg *types.MsgSendMessageWithCaller) (*types.MsgSendMessageWithCallerResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	messageSender := make([]byte, 32)
 	fromAccAddress, err := sdk.AccAddressFromBech32(msg.From)
 	if err != nil {
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
 	}
-	copy(messageSender[12:], fromAccAddress)
 
 	emptyByteArr := make([]byte, types.DestinationCallerLen)
 	if len(msg.DestinationCaller) != types.DestinationCallerLen || bytes.Equal(msg.DestinationCaller, emptyByteArr) {
-		return nil, errors.Wrap(types.ErrSendMessage, "destination caller must be nonzero")
+		return nil, errors.Wrap(types.ErrInvalidDestinationCaller, "destination caller must be nonzero")
 	}
 
-	nonce := k.ReserveAndIncrementNonce(ctx)
+	nonce, err := k.Keeper.SendMessage(ctx, fromAccAddress, msg.DestinationDomain, msg.Recipient, msg.MessageBody, msg.DestinationCaller)
 
-	err = k.sendMessage(
-		ctx,
-		msg.DestinationDomain,
-		msg.Recipient,
-		msg.DestinationCaller,
-		messageSender,
-		nonce.Nonce,
-		msg.MessageBody)
-
-	return &types.MsgSendMessageWithCallerResponse{Nonce: nonce.Nonce}, err
+	return &types.MsgSendMessageWithCallerResponse{Nonce: nonce}, err
 }
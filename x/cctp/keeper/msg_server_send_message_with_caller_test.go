@@ -83,7 +83,7 @@ func TestSendMessageWithCallerInvalidDestinationCaller(t *testing.T) {
 	}
 
 	_, err := server.SendMessageWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrSendMessage, err)
+	require.ErrorIs(t, types.ErrInvalidDestinationCaller, err)
 	require.Contains(t, err.Error(), "destination caller must be nonzero")
 }
 
@@ -127,8 +127,7 @@ func TestSendMessageWithCallerSendingAndReceivingMessagesPaused(t *testing.T) {
 	}
 
 	_, err := server.SendMessageWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrSendMessage, err)
-	require.Contains(t, err.Error(), "sending and receiving messages is paused")
+	require.ErrorIs(t, types.ErrSendingAndReceivingPaused, err)
 }
 
 func TestSendMessageWithCallerRecipientEmpty(t *testing.T) {
@@ -152,8 +151,7 @@ func TestSendMessageWithCallerRecipientEmpty(t *testing.T) {
 	}
 
 	_, err := server.SendMessageWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrSendMessage, err)
-	require.Contains(t, err.Error(), "recipient must not be nonzero")
+	require.ErrorIs(t, types.ErrInvalidRecipient, err)
 }
 
 func TestSendMessageWithCallerMessageBodyTooLong(t *testing.T) {
@@ -180,6 +178,6 @@ func TestSendMessageWithCallerMessageBodyTooLong(t *testing.T) {
 	}
 
 	_, err := server.SendMessageWithCaller(ctx, &msg)
-	require.ErrorIs(t, types.ErrSendMessage, err)
+	require.ErrorIs(t, types.ErrMessageBodyTooLarge, err)
 	require.Contains(t, err.Error(), "message body exceeds max size")
 }
@@ -0,0 +1,76 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func (k msgServer) SetForwarding(goCtx context.Context, msg *types.MsgSetForwarding) (*types.MsgSetForwardingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, err := sdk.AccAddressFromBech32(msg.From); err != nil {
+		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid from address (%s)", err)
+	}
+
+	if msg.DestinationDomain == k.GetLocalDomain(ctx).DomainId {
+		return nil, errors.Wrap(types.ErrInvalidDestinationDomain, "cannot forward to the local domain")
+	}
+	if _, found := k.GetRemoteTokenMessenger(ctx, msg.DestinationDomain); !found {
+		return nil, errors.Wrapf(types.ErrRemoteTokenMessengerNotFound, "unable to look up destination token messenger for domain %d", msg.DestinationDomain)
+	}
+
+	if len(msg.MintRecipient) != types.MintRecipientLen || isZero(msg.MintRecipient) {
+		return nil, errors.Wrapf(types.ErrInvalidMintRecipient, "mint recipient must be %d nonzero bytes", types.MintRecipientLen)
+	}
+	if len(msg.DestinationCaller) != 0 && (len(msg.DestinationCaller) != types.DestinationCallerLen || isZero(msg.DestinationCaller)) {
+		return nil, errors.Wrapf(types.ErrInvalidDestinationCaller, "destination caller must be empty or %d nonzero bytes", types.DestinationCallerLen)
+	}
+
+	fee := msg.Fee
+	if fee.IsNil() {
+		fee = math.ZeroInt()
+	}
+	if fee.IsNegative() {
+		return nil, errors.Wrap(types.ErrInvalidAmount, "fee must not be negative")
+	}
+
+	forwarding := types.Forwarding{
+		Address:           msg.From,
+		DestinationDomain: msg.DestinationDomain,
+		MintRecipient:     msg.MintRecipient,
+		DestinationCaller: msg.DestinationCaller,
+		Fee:               fee,
+	}
+	k.Keeper.SetForwarding(ctx, forwarding)
+
+	event := types.ForwardingSet{
+		Address:           forwarding.Address,
+		DestinationDomain: forwarding.DestinationDomain,
+		MintRecipient:     forwarding.MintRecipient,
+		DestinationCaller: forwarding.DestinationCaller,
+		Fee:               forwarding.Fee,
+	}
+	err := ctx.EventManager().EmitTypedEvent(&event)
+
+	return &types.MsgSetForwardingResponse{}, err
+}
@@ -38,12 +38,15 @@ func (k msgServer) UpdateAttesterManager(goCtx context.Context, msg *types.MsgUp
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid attester manager address (%s)", err)
 	}
 
-	currentAttesterManager := k.GetAttesterManager(ctx)
-	k.SetAttesterManager(ctx, msg.NewAttesterManager)
+	currentAttesterManager, err := k.ProposeRole(ctx, types.Role_ROLE_ATTESTER_MANAGER, msg.NewAttesterManager, msg.ExpiresAtHeight)
+	if err != nil {
+		return nil, err
+	}
 
-	event := types.AttesterManagerUpdated{
+	event := types.AttesterManagerTransferStarted{
 		PreviousAttesterManager: currentAttesterManager,
 		NewAttesterManager:      msg.NewAttesterManager,
+		ExpiresAtHeight:         msg.ExpiresAtHeight,
 	}
 	err = ctx.EventManager().EmitTypedEvent(&event)
 
@@ -23,11 +23,13 @@ import (
 	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 /*
  * Happy path
+ * Expiry in the past
  * Authority not set
  * Invalid authority
  * Invalid attester manager address
@@ -52,8 +54,35 @@ func TestUpdateAttesterManagerHappyPath(t *testing.T) {
 	_, err := server.UpdateAttesterManager(ctx, &message)
 	require.Nil(t, err)
 
-	actual := testkeeper.GetAttesterManager(ctx)
-	require.Equal(t, newAttesterManager, actual)
+	// the role only changes hands once the transfer is accepted
+	require.NotEqual(t, newAttesterManager, testkeeper.GetAttesterManager(ctx))
+
+	pending, found := testkeeper.GetPendingRole(ctx, types.Role_ROLE_ATTESTER_MANAGER)
+	require.True(t, found)
+	require.Equal(t, newAttesterManager, pending.Address)
+	require.Equal(t, int64(0), pending.ExpiresAtHeight)
+}
+
+func TestUpdateAttesterManagerExpiryInPast(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+	testkeeper.SetRole(ctx, types.Role_ROLE_ATTESTER_MANAGER, sample.AccAddress())
+
+	message := types.MsgUpdateAttesterManager{
+		From:               owner,
+		NewAttesterManager: sample.AccAddress(),
+		ExpiresAtHeight:    10,
+	}
+
+	_, err := server.UpdateAttesterManager(ctx, &message)
+	require.ErrorIs(t, err, types.ErrInvalidRoleTransferExpiry)
+
+	_, found := testkeeper.GetPendingRole(ctx, types.Role_ROLE_ATTESTER_MANAGER)
+	require.False(t, found)
 }
 
 func TestUpdateAttesterManagerAuthorityIsNotSet(t *testing.T) {
@@ -38,11 +38,14 @@ func (k msgServer) UpdateOwner(goCtx context.Context, msg *types.MsgUpdateOwner)
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid new owner address (%s)", err)
 	}
 
-	k.SetPendingOwner(ctx, msg.NewOwner)
+	if _, err := k.ProposeRole(ctx, types.Role_ROLE_OWNER, msg.NewOwner, msg.ExpiresAtHeight); err != nil {
+		return nil, err
+	}
 
 	event := types.OwnershipTransferStarted{
-		PreviousOwner: previousOwner,
-		NewOwner:      msg.NewOwner,
+		PreviousOwner:   previousOwner,
+		NewOwner:        msg.NewOwner,
+		ExpiresAtHeight: msg.ExpiresAtHeight,
 	}
 
 	err = ctx.EventManager().EmitTypedEvent(&event)
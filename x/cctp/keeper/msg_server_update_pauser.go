@@ -38,12 +38,15 @@ func (k msgServer) UpdatePauser(goCtx context.Context, msg *types.MsgUpdatePause
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid new pauser address (%s)", err)
 	}
 
-	currentPauser := k.GetPauser(ctx)
-	k.SetPauser(ctx, msg.NewPauser)
+	currentPauser, err := k.ProposeRole(ctx, types.Role_ROLE_PAUSER, msg.NewPauser, msg.ExpiresAtHeight)
+	if err != nil {
+		return nil, err
+	}
 
-	event := types.PauserUpdated{
-		PreviousPauser: currentPauser,
-		NewPauser:      msg.NewPauser,
+	event := types.PauserTransferStarted{
+		PreviousPauser:  currentPauser,
+		NewPauser:       msg.NewPauser,
+		ExpiresAtHeight: msg.ExpiresAtHeight,
 	}
 	err = ctx.EventManager().EmitTypedEvent(&event)
 
@@ -23,11 +23,13 @@ import (
 	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 /*
  * Happy path
+ * Expiry in the past
  * Authority not set
  * Invalid authority
  * Invalid new pauser address
@@ -52,8 +54,35 @@ func TestUpdatePauserHappyPath(t *testing.T) {
 	_, err := server.UpdatePauser(ctx, &message)
 	require.Nil(t, err)
 
-	actual := testkeeper.GetPauser(ctx)
-	require.Equal(t, newPauser, actual)
+	// the role only changes hands once the transfer is accepted
+	require.NotEqual(t, newPauser, testkeeper.GetPauser(ctx))
+
+	pending, found := testkeeper.GetPendingRole(ctx, types.Role_ROLE_PAUSER)
+	require.True(t, found)
+	require.Equal(t, newPauser, pending.Address)
+	require.Equal(t, int64(0), pending.ExpiresAtHeight)
+}
+
+func TestUpdatePauserExpiryInPast(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+	testkeeper.SetRole(ctx, types.Role_ROLE_PAUSER, sample.AccAddress())
+
+	message := types.MsgUpdatePauser{
+		From:            owner,
+		NewPauser:       sample.AccAddress(),
+		ExpiresAtHeight: 10,
+	}
+
+	_, err := server.UpdatePauser(ctx, &message)
+	require.ErrorIs(t, err, types.ErrInvalidRoleTransferExpiry)
+
+	_, found := testkeeper.GetPendingRole(ctx, types.Role_ROLE_PAUSER)
+	require.False(t, found)
 }
 
 func TestUpdatePauserAuthorityIsNotSet(t *testing.T) {
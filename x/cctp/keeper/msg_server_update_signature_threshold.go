@@ -42,9 +42,9 @@ func (k msgServer) UpdateSignatureThreshold(goCtx context.Context, msg *types.Ms
 		return nil, sdkerrors.Wrapf(types.ErrUpdateSignatureThreshold, "signature threshold already set to this value")
 	}
 
-	// new signature threshold cannot be greater than the number of stored public keys
-	attesters := k.GetAllAttesters(ctx)
-	if msg.Amount > uint32(len(attesters)) {
+	// new signature threshold cannot be greater than the number of stored public keys of any one key type
+	attesters := k.GetEnabledAttesters(ctx)
+	if msg.Amount > maxAttestersPerKeyType(attesters) {
 		return nil, sdkerrors.Wrapf(types.ErrUpdateSignatureThreshold, "new signature threshold is too high")
 	}
 
@@ -35,6 +35,7 @@ import (
  * Amount is 0
  * Signature threshold already set to this value
  * Signature threshold is too high
+ * Signature threshold is too high for any one key type
  */
 func TestUpdateSignatureThresholdHappyPath(t *testing.T) {
 	testkeeper, ctx := keepertest.CctpKeeper()
@@ -147,6 +148,25 @@ func TestUpdateSignatureThresholdSignatureThresholdIsTooHigh(t *testing.T) {
 	require.Contains(t, err.Error(), "new signature threshold is too high")
 }
 
+func TestUpdateSignatureThresholdTooHighForAnyKeyType(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+
+	attesterManager := sample.AccAddress()
+	testkeeper.SetAttesterManager(ctx, attesterManager)
+
+	addNAttesters(ctx, 2, testkeeper)
+	testkeeper.SetAttester(ctx, types.Attester{Attester: "ed25519", KeyType: types.KeyType_KEY_TYPE_ED25519})
+
+	// three attesters are enabled, but only two share a key type
+	_, err := server.UpdateSignatureThreshold(ctx, &types.MsgUpdateSignatureThreshold{From: attesterManager, Amount: 3})
+	require.ErrorIs(t, types.ErrUpdateSignatureThreshold, err)
+	require.Contains(t, err.Error(), "new signature threshold is too high")
+
+	_, err = server.UpdateSignatureThreshold(ctx, &types.MsgUpdateSignatureThreshold{From: attesterManager, Amount: 2})
+	require.Nil(t, err)
+}
+
 func addNAttesters(ctx context.Context, n int, testkeeper *keeper.Keeper) {
 	for i := 0; i < n; i++ {
 		a := types.Attester{Attester: strconv.Itoa(i)}
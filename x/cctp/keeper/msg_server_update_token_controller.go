@@ -38,12 +38,15 @@ func (k msgServer) UpdateTokenController(goCtx context.Context, msg *types.MsgUp
 		return nil, errors.Wrapf(types.ErrInvalidAddress, "invalid new token controller address (%s)", err)
 	}
 
-	currentTokenController := k.GetTokenController(ctx)
-	k.SetTokenController(ctx, msg.NewTokenController)
+	currentTokenController, err := k.ProposeRole(ctx, types.Role_ROLE_TOKEN_CONTROLLER, msg.NewTokenController, msg.ExpiresAtHeight)
+	if err != nil {
+		return nil, err
+	}
 
-	event := types.TokenControllerUpdated{
+	event := types.TokenControllerTransferStarted{
 		PreviousTokenController: currentTokenController,
 		NewTokenController:      msg.NewTokenController,
+		ExpiresAtHeight:         msg.ExpiresAtHeight,
 	}
 
 	err = ctx.EventManager().EmitTypedEvent(&event)
@@ -23,11 +23,13 @@ import (
 	"github.com/circlefin/noble-cctp/testutil/sample"
 	"github.com/circlefin/noble-cctp/x/cctp/keeper"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 /*
  * Happy path
+ * Expiry in the past
  * Authority not set
  * Invalid authority
  * Invalid new token controller address
@@ -52,8 +54,35 @@ func TestUpdateTokenControllerHappyPath(t *testing.T) {
 	_, err := server.UpdateTokenController(ctx, &message)
 	require.Nil(t, err)
 
-	actual := testkeeper.GetTokenController(ctx)
-	require.Equal(t, newTokenController, actual)
+	// the role only changes hands once the transfer is accepted
+	require.NotEqual(t, newTokenController, testkeeper.GetTokenController(ctx))
+
+	pending, found := testkeeper.GetPendingRole(ctx, types.Role_ROLE_TOKEN_CONTROLLER)
+	require.True(t, found)
+	require.Equal(t, newTokenController, pending.Address)
+	require.Equal(t, int64(0), pending.ExpiresAtHeight)
+}
+
+func TestUpdateTokenControllerExpiryInPast(t *testing.T) {
+	testkeeper, ctx := keepertest.CctpKeeper()
+	server := keeper.NewMsgServerImpl(testkeeper)
+	ctx = sdk.UnwrapSDKContext(ctx).WithBlockHeight(10)
+
+	owner := sample.AccAddress()
+	testkeeper.SetOwner(ctx, owner)
+	testkeeper.SetRole(ctx, types.Role_ROLE_TOKEN_CONTROLLER, sample.AccAddress())
+
+	message := types.MsgUpdateTokenController{
+		From:               owner,
+		NewTokenController: sample.AccAddress(),
+		ExpiresAtHeight:    10,
+	}
+
+	_, err := server.UpdateTokenController(ctx, &message)
+	require.ErrorIs(t, err, types.ErrInvalidRoleTransferExpiry)
+
+	_, found := testkeeper.GetPendingRole(ctx, types.Role_ROLE_TOKEN_CONTROLLER)
+	require.False(t, found)
 }
 
 func TestUpdateTokenControllerAuthorityIsNotSet(t *testing.T) {
@@ -0,0 +1,93 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	"cosmossdk.io/errors"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var zeroByteArray = []byte{ // 32 bytes
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0,
+}
+
+// ValidateReceiveMessage performs the checks of MsgReceiveMessage that do not
+// depend on the used nonces or the message body: the module is not paused,
+// the attestation verifies against the enabled attesters, and the message is
+// addressed to the local domain, message version and sender. It returns the parsed message.
+func (k Keeper) ValidateReceiveMessage(goCtx context.Context, msg *types.MsgReceiveMessage) (*types.Message, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sendingReceivingPaused, found := k.GetSendingAndReceivingMessagesPaused(ctx)
+	if found && sendingReceivingPaused.Paused {
+		return nil, errors.Wrap(types.ErrSendingAndReceivingPaused, "unable to receive message")
+	}
+
+	// Validate each signature in the attestation
+	publicKeys := k.GetEnabledAttesters(ctx)
+	if len(publicKeys) == 0 {
+		return nil, errors.Wrap(types.ErrAttestersNotFound, "unable to verify attestation")
+	}
+
+	signatureThreshold, found := k.GetSignatureThreshold(ctx)
+	if !found {
+		return nil, errors.Wrap(types.ErrSignatureThresholdNotFound, "unable to verify attestation")
+	}
+
+	if err := k.verifyAttestation(msg.KeyType, msg.Message, msg.Attestation, publicKeys, signatureThreshold.Amount); err != nil {
+		return nil, errors.Wrap(err, "unable to verify signatures")
+	}
+
+	// parse message
+	message, err := new(types.Message).Parse(msg.Message)
+	if err != nil {
+		return nil, err
+	}
+
+	localDomain := k.GetLocalDomain(ctx)
+
+	// validate domain
+	if message.DestinationDomain != localDomain.DomainId {
+		return nil, errors.Wrapf(types.ErrInvalidDestinationDomain, "expected: %d, found: %d", localDomain.DomainId, message.DestinationDomain)
+	}
+
+	// validate destination caller against the left padded sender
+	if !bytes.Equal(message.DestinationCaller, zeroByteArray) {
+		var caller []byte
+		if from, err := sdk.AccAddressFromBech32(msg.From); err == nil {
+			caller, _ = types.Bytes32FromAccAddress(from)
+		}
+
+		if !bytes.Equal(message.DestinationCaller, caller) {
+			return nil, errors.Wrapf(types.ErrDestinationCallerMismatch, "destination caller: %x, sender: %s", message.DestinationCaller, msg.From)
+		}
+	}
+
+	// validate version
+	if message.Version != localDomain.MessageVersion {
+		return nil, errors.Wrapf(types.ErrInvalidMessageVersion, "expected: %d, found: %d", localDomain.MessageVersion, message.Version)
+	}
+
+	return message, nil
+}
@@ -0,0 +1,70 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+)
+
+// SetRoleHistoryEntry sets a role history entry in the store
+func (k Keeper) SetRoleHistoryEntry(ctx context.Context, entry types.RoleHistoryEntry) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.RoleHistoryKeyPrefix))
+	b := k.cdc.MustMarshal(&entry)
+	store.Set(types.RoleHistoryKey(entry.Id), b)
+}
+
+// AppendRoleHistoryEntry records a change of role holder under the
+// next available id, which it returns.
+func (k Keeper) AppendRoleHistoryEntry(ctx context.Context, entry types.RoleHistoryEntry) uint64 {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.RoleHistoryKeyPrefix))
+
+	// ids are stored big endian, so the last key holds the highest id
+	entry.Id = 0
+	iterator := store.ReverseIterator(nil, nil)
+	if iterator.Valid() {
+		var last types.RoleHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &last)
+		entry.Id = last.Id + 1
+	}
+	iterator.Close()
+
+	k.SetRoleHistoryEntry(ctx, entry)
+	return entry.Id
+}
+
+// GetRoleHistory returns every change of role holder, oldest first
+func (k Keeper) GetRoleHistory(ctx context.Context) (list []types.RoleHistoryEntry) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.RoleHistoryKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.RoleHistoryEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
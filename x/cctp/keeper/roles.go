@@ -18,14 +18,63 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
+	"fmt"
 
+	"cosmossdk.io/errors"
 	"github.com/circlefin/noble-cctp/x/cctp/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // DeletePendingOwner deletes the pending owner of the CCTP module from state.
 func (k Keeper) DeletePendingOwner(ctx context.Context) {
-	runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)).Delete(types.PendingOwnerKey)
+	k.DeletePendingRole(ctx, types.Role_ROLE_OWNER)
+}
+
+// DeletePendingRole deletes a pending role transfer from state.
+func (k Keeper) DeletePendingRole(ctx context.Context, role types.Role) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Delete(types.PendingRoleKey(role))
+	store.Delete(types.PendingRoleExpiryKey(role))
+}
+
+// GetRole returns the current holder of a role from state.
+func (k Keeper) GetRole(ctx context.Context, role types.Role) string {
+	bz := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)).Get(types.RoleKey(role))
+	if bz == nil {
+		panic(fmt.Sprintf("cctp %s not found in state", role.Name()))
+	}
+
+	return string(bz)
+}
+
+// GetPendingRole returns a pending role transfer from state.
+func (k Keeper) GetPendingRole(ctx context.Context, role types.Role) (pending types.PendingRole, found bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+
+	bz := store.Get(types.PendingRoleKey(role))
+	if bz == nil {
+		return types.PendingRole{}, false
+	}
+
+	pending = types.PendingRole{Role: role, Address: string(bz)}
+	if expiry := store.Get(types.PendingRoleExpiryKey(role)); expiry != nil {
+		pending.ExpiresAtHeight = int64(binary.BigEndian.Uint64(expiry))
+	}
+
+	return pending, true
+}
+
+// GetAllPendingRoles returns every pending role transfer from state.
+func (k Keeper) GetAllPendingRoles(ctx context.Context) (list []types.PendingRole) {
+	for _, role := range types.Roles {
+		if pending, found := k.GetPendingRole(ctx, role); found {
+			list = append(list, pending)
+		}
+	}
+
+	return
 }
 
 // GetOwner returns the owner of the CCTP module from state.
@@ -40,12 +89,8 @@ func (k Keeper) GetOwner(ctx context.Context) (owner string) {
 
 // GetPendingOwner returns the pending owner of the CCTP module from state.
 func (k Keeper) GetPendingOwner(ctx context.Context) (pendingOwner string, found bool) {
-	bz := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)).Get(types.PendingOwnerKey)
-	if bz == nil {
-		return "", false
-	}
-
-	return string(bz), true
+	pending, found := k.GetPendingRole(ctx, types.Role_ROLE_OWNER)
+	return pending.Address, found
 }
 
 // GetAttesterManager returns the attester manager of the CCTP module from state.
@@ -86,8 +131,77 @@ func (k Keeper) SetOwner(ctx context.Context, owner string) {
 
 // SetPendingOwner stores the pending owner of the CCTP module in state.
 func (k Keeper) SetPendingOwner(ctx context.Context, pendingOwner string) {
-	bz := []byte(pendingOwner)
-	runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)).Set(types.PendingOwnerKey, bz)
+	k.SetPendingRole(ctx, types.PendingRole{Role: types.Role_ROLE_OWNER, Address: pendingOwner})
+}
+
+// SetRole stores the current holder of a role in state.
+func (k Keeper) SetRole(ctx context.Context, role types.Role, address string) {
+	runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)).Set(types.RoleKey(role), []byte(address))
+}
+
+// SetPendingRole stores a pending role transfer in state, replacing any
+// transfer of the same role that was already pending.
+func (k Keeper) SetPendingRole(ctx context.Context, pending types.PendingRole) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store.Set(types.PendingRoleKey(pending.Role), []byte(pending.Address))
+
+	if pending.ExpiresAtHeight == 0 {
+		store.Delete(types.PendingRoleExpiryKey(pending.Role))
+		return
+	}
+
+	expiry := make([]byte, 8)
+	binary.BigEndian.PutUint64(expiry, uint64(pending.ExpiresAtHeight))
+	store.Set(types.PendingRoleExpiryKey(pending.Role), expiry)
+}
+
+// ProposeRole starts the transfer of a role to address, which must then
+// accept it before expiresAtHeight (or at any time, if it is 0). It returns
+// the current holder of the role.
+func (k Keeper) ProposeRole(ctx context.Context, role types.Role, address string, expiresAtHeight int64) (string, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if expiresAtHeight != 0 && expiresAtHeight <= height {
+		return "", errors.Wrapf(types.ErrInvalidRoleTransferExpiry, "expiry height %d is not after current height %d", expiresAtHeight, height)
+	}
+
+	k.SetPendingRole(ctx, types.PendingRole{
+		Role:            role,
+		Address:         address,
+		ExpiresAtHeight: expiresAtHeight,
+	})
+
+	return k.GetRole(ctx, role), nil
+}
+
+// AcceptRole completes a pending role transfer on behalf of from, recording
+// the change in the role history. It returns the previous holder of the role.
+func (k Keeper) AcceptRole(ctx context.Context, role types.Role, from string) (string, error) {
+	pending, found := k.GetPendingRole(ctx, role)
+	if !found {
+		return "", errors.Wrapf(types.ErrUnauthorized, "pending %s is not set", role.Name())
+	}
+
+	if pending.Address != from {
+		return "", errors.Wrapf(types.ErrUnauthorized, "you are not the pending %s", role.Name())
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if pending.ExpiresAtHeight != 0 && height > pending.ExpiresAtHeight {
+		return "", errors.Wrapf(types.ErrRoleTransferExpired, "pending %s transfer expired at height %d", role.Name(), pending.ExpiresAtHeight)
+	}
+
+	previous := k.GetRole(ctx, role)
+	k.SetRole(ctx, role, pending.Address)
+	k.DeletePendingRole(ctx, role)
+
+	k.AppendRoleHistoryEntry(ctx, types.RoleHistoryEntry{
+		Role:            role,
+		PreviousAddress: previous,
+		Address:         pending.Address,
+		Height:          height,
+	})
+
+	return previous, nil
 }
 
 // SetAttesterManager stores the attester manager of the CCTP module in state.
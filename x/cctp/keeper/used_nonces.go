@@ -56,3 +56,43 @@ func (k Keeper) GetAllUsedNonces(ctx context.Context) (list []types.Nonce) {
 
 	return
 }
+
+// GetUsedNonceRecord returns the hash of the message that used a nonce, if it
+// was recorded.
+func (k Keeper) GetUsedNonceRecord(ctx context.Context, sourceDomain uint32, nonce uint64) (val types.UsedNonceRecord, found bool) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.UsedNonceRecordKeyPrefix))
+
+	b := store.Get(types.UsedNonceKey(nonce, sourceDomain))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// SetUsedNonceRecord records the hash of the message that used a nonce
+func (k Keeper) SetUsedNonceRecord(ctx context.Context, record types.UsedNonceRecord) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.UsedNonceRecordKeyPrefix))
+	b := k.cdc.MustMarshal(&record)
+	store.Set(types.UsedNonceKey(record.Nonce, record.SourceDomain), b)
+}
+
+// GetAllUsedNonceRecords returns all UsedNonceRecords
+func (k Keeper) GetAllUsedNonceRecords(ctx context.Context) (list []types.UsedNonceRecord) {
+	adapter := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	store := prefix.NewStore(adapter, types.KeyPrefix(types.UsedNonceRecordKeyPrefix))
+	iterator := store.Iterator(nil, nil)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.UsedNonceRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 
 	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
 	"cosmossdk.io/core/appmodule"
@@ -50,6 +51,8 @@ var (
 	_ module.HasConsensusVersion = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasServices         = AppModule{}
+	_ module.HasInvariants       = AppModule{}
+	_ module.AppModuleSimulation = AppModule{}
 )
 
 // ----------------------------------------------------------------------------
@@ -105,13 +108,17 @@ func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, cfg client.TxEncoding
 type AppModule struct {
 	AppModuleBasic
 
-	keeper *keeper.Keeper
+	keeper        *keeper.Keeper
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
 }
 
-func NewAppModule(keeper *keeper.Keeper) AppModule {
+func NewAppModule(keeper *keeper.Keeper, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper) AppModule {
 	return AppModule{
 		AppModuleBasic: NewAppModuleBasic(),
 		keeper:         keeper,
+		accountKeeper:  accountKeeper,
+		bankKeeper:     bankKeeper,
 	}
 }
 
@@ -139,6 +146,11 @@ func (m AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterQueryServer(cfg.QueryServer(), m.keeper)
 }
 
+// RegisterInvariants registers the cctp module invariants
+func (m AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, m.keeper)
+}
+
 func (AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 	return &autocliv1.ModuleOptions{
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -165,6 +177,7 @@ func (AppModule) GetQueryCmd() *cobra.Command {
 func init() {
 	appmodule.Register(&modulev1.Module{},
 		appmodule.Provide(ProvideModule),
+		appmodule.Invoke(InvokeSetCCTPHooks),
 	)
 }
 
@@ -176,6 +189,7 @@ type ModuleInputs struct {
 	StoreService store.KVStoreService
 	Logger       log.Logger
 
+	AccountKeeper          types.AccountKeeper
 	BankKeeper             types.BankKeeper
 	FiatTokenFactoryKeeper types.FiatTokenfactoryKeeper
 }
@@ -188,14 +202,46 @@ type ModuleOutputs struct {
 }
 
 func ProvideModule(in ModuleInputs) ModuleOutputs {
+	localDomain := types.LocalDomain{
+		DomainId:           types.NobleDomainId,
+		MessageVersion:     in.Config.MessageVersion,
+		MessageBodyVersion: in.Config.MessageBodyVersion,
+	}
+	if in.Config.LocalDomain != nil {
+		localDomain.DomainId = in.Config.LocalDomain.Value
+	}
+
 	k := keeper.NewKeeper(
 		in.Cdc,
 		in.Logger,
 		in.StoreService,
+		localDomain,
 		in.BankKeeper,
 		in.FiatTokenFactoryKeeper,
 	)
-	m := NewAppModule(k)
+	m := NewAppModule(k, in.AccountKeeper, in.BankKeeper)
 
 	return ModuleOutputs{Keeper: k, Module: m}
 }
+
+// InvokeSetCCTPHooks sets the CCTPHooks provided by other modules on the
+// keeper, calling them in alphabetical order of the modules providing them.
+func InvokeSetCCTPHooks(k *keeper.Keeper, hooks map[string]types.CCTPHooksWrapper) error {
+	if k == nil || len(hooks) == 0 {
+		return nil
+	}
+
+	names := make([]string, 0, len(hooks))
+	for name := range hooks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var multiHooks types.MultiCCTPHooks
+	for _, name := range names {
+		multiHooks = append(multiHooks, hooks[name])
+	}
+	k.SetHooks(multiHooks)
+
+	return nil
+}
@@ -0,0 +1,512 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cctp
+
+import (
+	"math/rand"
+
+	cctpsimulation "github.com/circlefin/noble-cctp/x/cctp/simulation"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+const (
+	opWeightMsgDepositForBurn          = "op_weight_msg_deposit_for_burn"
+	defaultWeightMsgDepositForBurn int = 100
+
+	opWeightMsgDepositForBurnWithCaller          = "op_weight_msg_deposit_for_burn_with_caller"
+	defaultWeightMsgDepositForBurnWithCaller int = 50
+
+	opWeightMsgReplaceDepositForBurn          = "op_weight_msg_replace_deposit_for_burn"
+	defaultWeightMsgReplaceDepositForBurn int = 20
+
+	opWeightMsgSendMessage          = "op_weight_msg_send_message"
+	defaultWeightMsgSendMessage int = 100
+
+	opWeightMsgSendMessageWithCaller          = "op_weight_msg_send_message_with_caller"
+	defaultWeightMsgSendMessageWithCaller int = 50
+
+	opWeightMsgReplaceMessage          = "op_weight_msg_replace_message"
+	defaultWeightMsgReplaceMessage int = 20
+
+	opWeightMsgReceiveMessage          = "op_weight_msg_receive_message"
+	defaultWeightMsgReceiveMessage int = 100
+
+	opWeightMsgEnableAttester          = "op_weight_msg_enable_attester"
+	defaultWeightMsgEnableAttester int = 10
+
+	opWeightMsgDisableAttester          = "op_weight_msg_disable_attester"
+	defaultWeightMsgDisableAttester int = 10
+
+	opWeightMsgUpdateSignatureThreshold          = "op_weight_msg_update_signature_threshold"
+	defaultWeightMsgUpdateSignatureThreshold int = 10
+
+	opWeightMsgLinkTokenPair          = "op_weight_msg_link_token_pair"
+	defaultWeightMsgLinkTokenPair int = 10
+
+	opWeightMsgUnlinkTokenPair          = "op_weight_msg_unlink_token_pair"
+	defaultWeightMsgUnlinkTokenPair int = 5
+
+	opWeightMsgSetMaxBurnAmountPerMessage          = "op_weight_msg_set_max_burn_amount_per_message"
+	defaultWeightMsgSetMaxBurnAmountPerMessage int = 10
+
+	opWeightMsgAddRemoteTokenMessenger          = "op_weight_msg_add_remote_token_messenger"
+	defaultWeightMsgAddRemoteTokenMessenger int = 10
+
+	opWeightMsgRemoveRemoteTokenMessenger          = "op_weight_msg_remove_remote_token_messenger"
+	defaultWeightMsgRemoveRemoteTokenMessenger int = 5
+
+	opWeightMsgUpdateMaxMessageBodySize          = "op_weight_msg_update_max_message_body_size"
+	defaultWeightMsgUpdateMaxMessageBodySize int = 5
+
+	opWeightMsgUpdateOwner          = "op_weight_msg_update_owner"
+	defaultWeightMsgUpdateOwner int = 5
+
+	opWeightMsgAcceptOwner          = "op_weight_msg_accept_owner"
+	defaultWeightMsgAcceptOwner int = 5
+
+	opWeightMsgUpdateAttesterManager          = "op_weight_msg_update_attester_manager"
+	defaultWeightMsgUpdateAttesterManager int = 5
+
+	opWeightMsgAcceptAttesterManager          = "op_weight_msg_accept_attester_manager"
+	defaultWeightMsgAcceptAttesterManager int = 5
+
+	opWeightMsgUpdatePauser          = "op_weight_msg_update_pauser"
+	defaultWeightMsgUpdatePauser int = 5
+
+	opWeightMsgAcceptPauser          = "op_weight_msg_accept_pauser"
+	defaultWeightMsgAcceptPauser int = 5
+
+	opWeightMsgUpdateTokenController          = "op_weight_msg_update_token_controller"
+	defaultWeightMsgUpdateTokenController int = 5
+
+	opWeightMsgAcceptTokenController          = "op_weight_msg_accept_token_controller"
+	defaultWeightMsgAcceptTokenController int = 5
+
+	opWeightMsgPauseBurningAndMinting          = "op_weight_msg_pause_burning_and_minting"
+	defaultWeightMsgPauseBurningAndMinting int = 5
+
+	opWeightMsgUnpauseBurningAndMinting          = "op_weight_msg_unpause_burning_and_minting"
+	defaultWeightMsgUnpauseBurningAndMinting int = 10
+
+	opWeightMsgPauseSendingAndReceivingMessages          = "op_weight_msg_pause_sending_and_receiving_messages"
+	defaultWeightMsgPauseSendingAndReceivingMessages int = 5
+
+	opWeightMsgUnpauseSendingAndReceivingMessages          = "op_weight_msg_unpause_sending_and_receiving_messages"
+	defaultWeightMsgUnpauseSendingAndReceivingMessages int = 10
+
+	opWeightMsgSetForwarding          = "op_weight_msg_set_forwarding"
+	defaultWeightMsgSetForwarding int = 10
+
+	opWeightMsgRemoveForwarding          = "op_weight_msg_remove_forwarding"
+	defaultWeightMsgRemoveForwarding int = 5
+
+	opWeightMsgRegisterAccount32          = "op_weight_msg_register_account32"
+	defaultWeightMsgRegisterAccount32 int = 5
+
+	opWeightMsgEnableContractCallback          = "op_weight_msg_enable_contract_callback"
+	defaultWeightMsgEnableContractCallback int = 5
+
+	opWeightMsgDisableContractCallback          = "op_weight_msg_disable_contract_callback"
+	defaultWeightMsgDisableContractCallback int = 5
+)
+
+// GenerateGenesisState creates a randomized GenState of the module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	cctpsimulation.RandomizedGenState(simState)
+}
+
+// RandomizedParams returns no parameter changes, as the module has no params.
+// Its configuration lives in state and is changed by the role-gated messages
+// covered by WeightedOperations.
+func (AppModule) RandomizedParams(_ *rand.Rand) []simtypes.LegacyParamChange {
+	return nil
+}
+
+// RegisterStoreDecoder registers a decoder.
+func (AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
+
+// WeightedOperations returns all the cctp module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	operations := make([]simtypes.WeightedOperation, 0, 33)
+
+	var weightMsgDepositForBurn int
+	simState.AppParams.GetOrGenerate(opWeightMsgDepositForBurn, &weightMsgDepositForBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgDepositForBurn = defaultWeightMsgDepositForBurn
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDepositForBurn,
+		cctpsimulation.SimulateMsgDepositForBurn(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgDepositForBurnWithCaller int
+	simState.AppParams.GetOrGenerate(opWeightMsgDepositForBurnWithCaller, &weightMsgDepositForBurnWithCaller, nil,
+		func(_ *rand.Rand) {
+			weightMsgDepositForBurnWithCaller = defaultWeightMsgDepositForBurnWithCaller
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDepositForBurnWithCaller,
+		cctpsimulation.SimulateMsgDepositForBurnWithCaller(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgReplaceDepositForBurn int
+	simState.AppParams.GetOrGenerate(opWeightMsgReplaceDepositForBurn, &weightMsgReplaceDepositForBurn, nil,
+		func(_ *rand.Rand) {
+			weightMsgReplaceDepositForBurn = defaultWeightMsgReplaceDepositForBurn
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReplaceDepositForBurn,
+		cctpsimulation.SimulateMsgReplaceDepositForBurn(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgSendMessage int
+	simState.AppParams.GetOrGenerate(opWeightMsgSendMessage, &weightMsgSendMessage, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendMessage = defaultWeightMsgSendMessage
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendMessage,
+		cctpsimulation.SimulateMsgSendMessage(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgSendMessageWithCaller int
+	simState.AppParams.GetOrGenerate(opWeightMsgSendMessageWithCaller, &weightMsgSendMessageWithCaller, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendMessageWithCaller = defaultWeightMsgSendMessageWithCaller
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendMessageWithCaller,
+		cctpsimulation.SimulateMsgSendMessageWithCaller(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgReplaceMessage int
+	simState.AppParams.GetOrGenerate(opWeightMsgReplaceMessage, &weightMsgReplaceMessage, nil,
+		func(_ *rand.Rand) {
+			weightMsgReplaceMessage = defaultWeightMsgReplaceMessage
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReplaceMessage,
+		cctpsimulation.SimulateMsgReplaceMessage(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgReceiveMessage int
+	simState.AppParams.GetOrGenerate(opWeightMsgReceiveMessage, &weightMsgReceiveMessage, nil,
+		func(_ *rand.Rand) {
+			weightMsgReceiveMessage = defaultWeightMsgReceiveMessage
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReceiveMessage,
+		cctpsimulation.SimulateMsgReceiveMessage(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgEnableAttester int
+	simState.AppParams.GetOrGenerate(opWeightMsgEnableAttester, &weightMsgEnableAttester, nil,
+		func(_ *rand.Rand) {
+			weightMsgEnableAttester = defaultWeightMsgEnableAttester
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgEnableAttester,
+		cctpsimulation.SimulateMsgEnableAttester(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgDisableAttester int
+	simState.AppParams.GetOrGenerate(opWeightMsgDisableAttester, &weightMsgDisableAttester, nil,
+		func(_ *rand.Rand) {
+			weightMsgDisableAttester = defaultWeightMsgDisableAttester
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDisableAttester,
+		cctpsimulation.SimulateMsgDisableAttester(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUpdateSignatureThreshold int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateSignatureThreshold, &weightMsgUpdateSignatureThreshold, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateSignatureThreshold = defaultWeightMsgUpdateSignatureThreshold
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateSignatureThreshold,
+		cctpsimulation.SimulateMsgUpdateSignatureThreshold(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgLinkTokenPair int
+	simState.AppParams.GetOrGenerate(opWeightMsgLinkTokenPair, &weightMsgLinkTokenPair, nil,
+		func(_ *rand.Rand) {
+			weightMsgLinkTokenPair = defaultWeightMsgLinkTokenPair
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgLinkTokenPair,
+		cctpsimulation.SimulateMsgLinkTokenPair(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUnlinkTokenPair int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnlinkTokenPair, &weightMsgUnlinkTokenPair, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnlinkTokenPair = defaultWeightMsgUnlinkTokenPair
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnlinkTokenPair,
+		cctpsimulation.SimulateMsgUnlinkTokenPair(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgSetMaxBurnAmountPerMessage int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetMaxBurnAmountPerMessage, &weightMsgSetMaxBurnAmountPerMessage, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetMaxBurnAmountPerMessage = defaultWeightMsgSetMaxBurnAmountPerMessage
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetMaxBurnAmountPerMessage,
+		cctpsimulation.SimulateMsgSetMaxBurnAmountPerMessage(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgAddRemoteTokenMessenger int
+	simState.AppParams.GetOrGenerate(opWeightMsgAddRemoteTokenMessenger, &weightMsgAddRemoteTokenMessenger, nil,
+		func(_ *rand.Rand) {
+			weightMsgAddRemoteTokenMessenger = defaultWeightMsgAddRemoteTokenMessenger
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAddRemoteTokenMessenger,
+		cctpsimulation.SimulateMsgAddRemoteTokenMessenger(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgRemoveRemoteTokenMessenger int
+	simState.AppParams.GetOrGenerate(opWeightMsgRemoveRemoteTokenMessenger, &weightMsgRemoveRemoteTokenMessenger, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveRemoteTokenMessenger = defaultWeightMsgRemoveRemoteTokenMessenger
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRemoveRemoteTokenMessenger,
+		cctpsimulation.SimulateMsgRemoveRemoteTokenMessenger(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUpdateMaxMessageBodySize int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateMaxMessageBodySize, &weightMsgUpdateMaxMessageBodySize, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateMaxMessageBodySize = defaultWeightMsgUpdateMaxMessageBodySize
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateMaxMessageBodySize,
+		cctpsimulation.SimulateMsgUpdateMaxMessageBodySize(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUpdateOwner int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateOwner, &weightMsgUpdateOwner, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateOwner = defaultWeightMsgUpdateOwner
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateOwner,
+		cctpsimulation.SimulateMsgUpdateOwner(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgAcceptOwner int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptOwner, &weightMsgAcceptOwner, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptOwner = defaultWeightMsgAcceptOwner
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptOwner,
+		cctpsimulation.SimulateMsgAcceptOwner(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUpdateAttesterManager int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateAttesterManager, &weightMsgUpdateAttesterManager, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateAttesterManager = defaultWeightMsgUpdateAttesterManager
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateAttesterManager,
+		cctpsimulation.SimulateMsgUpdateAttesterManager(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgAcceptAttesterManager int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptAttesterManager, &weightMsgAcceptAttesterManager, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptAttesterManager = defaultWeightMsgAcceptAttesterManager
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptAttesterManager,
+		cctpsimulation.SimulateMsgAcceptAttesterManager(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUpdatePauser int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdatePauser, &weightMsgUpdatePauser, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdatePauser = defaultWeightMsgUpdatePauser
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdatePauser,
+		cctpsimulation.SimulateMsgUpdatePauser(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgAcceptPauser int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptPauser, &weightMsgAcceptPauser, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptPauser = defaultWeightMsgAcceptPauser
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptPauser,
+		cctpsimulation.SimulateMsgAcceptPauser(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUpdateTokenController int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateTokenController, &weightMsgUpdateTokenController, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateTokenController = defaultWeightMsgUpdateTokenController
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateTokenController,
+		cctpsimulation.SimulateMsgUpdateTokenController(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgAcceptTokenController int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptTokenController, &weightMsgAcceptTokenController, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptTokenController = defaultWeightMsgAcceptTokenController
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptTokenController,
+		cctpsimulation.SimulateMsgAcceptTokenController(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgPauseBurningAndMinting int
+	simState.AppParams.GetOrGenerate(opWeightMsgPauseBurningAndMinting, &weightMsgPauseBurningAndMinting, nil,
+		func(_ *rand.Rand) {
+			weightMsgPauseBurningAndMinting = defaultWeightMsgPauseBurningAndMinting
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPauseBurningAndMinting,
+		cctpsimulation.SimulateMsgPauseBurningAndMinting(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUnpauseBurningAndMinting int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnpauseBurningAndMinting, &weightMsgUnpauseBurningAndMinting, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnpauseBurningAndMinting = defaultWeightMsgUnpauseBurningAndMinting
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnpauseBurningAndMinting,
+		cctpsimulation.SimulateMsgUnpauseBurningAndMinting(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgPauseSendingAndReceivingMessages int
+	simState.AppParams.GetOrGenerate(opWeightMsgPauseSendingAndReceivingMessages, &weightMsgPauseSendingAndReceivingMessages, nil,
+		func(_ *rand.Rand) {
+			weightMsgPauseSendingAndReceivingMessages = defaultWeightMsgPauseSendingAndReceivingMessages
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPauseSendingAndReceivingMessages,
+		cctpsimulation.SimulateMsgPauseSendingAndReceivingMessages(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgUnpauseSendingAndReceivingMessages int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnpauseSendingAndReceivingMessages, &weightMsgUnpauseSendingAndReceivingMessages, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnpauseSendingAndReceivingMessages = defaultWeightMsgUnpauseSendingAndReceivingMessages
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnpauseSendingAndReceivingMessages,
+		cctpsimulation.SimulateMsgUnpauseSendingAndReceivingMessages(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgSetForwarding int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetForwarding, &weightMsgSetForwarding, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetForwarding = defaultWeightMsgSetForwarding
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetForwarding,
+		cctpsimulation.SimulateMsgSetForwarding(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgRemoveForwarding int
+	simState.AppParams.GetOrGenerate(opWeightMsgRemoveForwarding, &weightMsgRemoveForwarding, nil,
+		func(_ *rand.Rand) {
+			weightMsgRemoveForwarding = defaultWeightMsgRemoveForwarding
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRemoveForwarding,
+		cctpsimulation.SimulateMsgRemoveForwarding(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgRegisterAccount32 int
+	simState.AppParams.GetOrGenerate(opWeightMsgRegisterAccount32, &weightMsgRegisterAccount32, nil,
+		func(_ *rand.Rand) {
+			weightMsgRegisterAccount32 = defaultWeightMsgRegisterAccount32
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRegisterAccount32,
+		cctpsimulation.SimulateMsgRegisterAccount32(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgEnableContractCallback int
+	simState.AppParams.GetOrGenerate(opWeightMsgEnableContractCallback, &weightMsgEnableContractCallback, nil,
+		func(_ *rand.Rand) {
+			weightMsgEnableContractCallback = defaultWeightMsgEnableContractCallback
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgEnableContractCallback,
+		cctpsimulation.SimulateMsgEnableContractCallback(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	var weightMsgDisableContractCallback int
+	simState.AppParams.GetOrGenerate(opWeightMsgDisableContractCallback, &weightMsgDisableContractCallback, nil,
+		func(_ *rand.Rand) {
+			weightMsgDisableContractCallback = defaultWeightMsgDisableContractCallback
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDisableContractCallback,
+		cctpsimulation.SimulateMsgDisableContractCallback(am.accountKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	return operations
+}
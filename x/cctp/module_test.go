@@ -0,0 +1,102 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package cctp_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	modulev1 "github.com/circlefin/noble-cctp/api/circle/cctp/module/v1"
+	keepertest "github.com/circlefin/noble-cctp/testutil/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type namedHooks struct{ name string }
+
+func (namedHooks) BeforeDepositForBurn(context.Context, sdk.AccAddress, math.Int, string, uint32, []byte) error {
+	return nil
+}
+
+func (namedHooks) AfterDepositForBurn(context.Context, uint64, sdk.AccAddress, math.Int, string, uint32, []byte) error {
+	return nil
+}
+
+func (namedHooks) BeforeMint(context.Context, uint32, uint64, sdk.AccAddress, sdk.Coin) error {
+	return nil
+}
+
+func (namedHooks) AfterMint(context.Context, uint32, uint64, sdk.AccAddress, sdk.Coin) error {
+	return nil
+}
+
+func (namedHooks) AfterMessageReceived(context.Context, *types.Message) error { return nil }
+
+func TestInvokeSetCCTPHooks(t *testing.T) {
+	k, _ := keepertest.CctpKeeper()
+	require.Equal(t, types.MultiCCTPHooks{}, k.Hooks())
+
+	// without hooks provided, none are set
+	require.NoError(t, cctp.InvokeSetCCTPHooks(k, nil))
+	require.Equal(t, types.MultiCCTPHooks{}, k.Hooks())
+
+	// hooks are called in alphabetical order of their modules
+	err := cctp.InvokeSetCCTPHooks(k, map[string]types.CCTPHooksWrapper{
+		"rewards":    {CCTPHooks: namedHooks{"rewards"}},
+		"accounting": {CCTPHooks: namedHooks{"accounting"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, types.MultiCCTPHooks{
+		types.CCTPHooksWrapper{CCTPHooks: namedHooks{"accounting"}},
+		types.CCTPHooksWrapper{CCTPHooks: namedHooks{"rewards"}},
+	}, k.Hooks())
+}
+
+func TestProvideModuleLocalDomain(t *testing.T) {
+	provide := func(config *modulev1.Module) types.LocalDomain {
+		key := storetypes.NewKVStoreKey(types.StoreKey)
+		ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test"))
+		out := cctp.ProvideModule(cctp.ModuleInputs{
+			Config:                 config,
+			Cdc:                    codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+			StoreService:           runtime.NewKVStoreService(key),
+			Logger:                 log.NewNopLogger(),
+			BankKeeper:             keepertest.MockBankKeeper{},
+			FiatTokenFactoryKeeper: keepertest.MockFiatTokenfactoryKeeper{},
+		})
+		return out.Keeper.GetLocalDomain(ctx)
+	}
+
+	// when unset, Noble's domain is used
+	require.Equal(t, types.DefaultLocalDomain(), provide(&modulev1.Module{}))
+
+	// domain 0 can be configured explicitly
+	require.Equal(t, types.LocalDomain{DomainId: 0, MessageVersion: 1}, provide(&modulev1.Module{
+		LocalDomain:    wrapperspb.UInt32(0),
+		MessageVersion: 1,
+	}))
+}
@@ -0,0 +1,43 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgAcceptAttesterManager accepts a pending transfer of the attester manager role.
+func SimulateMsgAcceptAttesterManager(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		account, reason := pendingRole(ctx, k, accs, types.Role_ROLE_ATTESTER_MANAGER)
+		if reason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgAcceptAttesterManager{}), reason), nil, nil
+		}
+
+		msg := &types.MsgAcceptAttesterManager{From: account.Address.String()}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, nil)
+	}
+}
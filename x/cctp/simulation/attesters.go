@@ -0,0 +1,118 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// AttesterSeed derives the attester keys used in simulations. Every attester
+// enabled by genesis or by EnableAttester comes from this set, so operations
+// can sign attestations for whatever attesters are enabled in state.
+var AttesterSeed = []byte("cctp-simulation")
+
+var attesters = deriveAttesterKeys(AttesterSeed, MaxAttesters)
+
+// attesterKey is a secp256k1 attester key that simulations sign with.
+type attesterKey struct {
+	privateKey *ecdsa.PrivateKey
+}
+
+// deriveAttesterKeys returns n attester keys derived from seed, so that every
+// simulation run uses the same keys.
+func deriveAttesterKeys(seed []byte, n int) []attesterKey {
+	keys := make([]attesterKey, n)
+	for i := range keys {
+		privateKey, err := crypto.ToECDSA(crypto.Keccak256(seed, []byte(fmt.Sprintf("/%d", i))))
+		if err != nil {
+			panic(fmt.Sprintf("failed to derive attester key: %s", err))
+		}
+		keys[i] = attesterKey{privateKey: privateKey}
+	}
+
+	return keys
+}
+
+// PublicKey returns the hex encoded uncompressed public key of the attester,
+// as it is stored in the module.
+func (k attesterKey) PublicKey() string {
+	return hex.EncodeToString(crypto.FromECDSAPub(&k.privateKey.PublicKey))
+}
+
+// Attester returns the attester as it is stored in the module.
+func (k attesterKey) Attester() types.Attester {
+	return types.Attester{Attester: k.PublicKey()}
+}
+
+// enabledSigners returns the simulation attester keys enabled in state along
+// with the signature threshold. ok is false if they cannot reach the threshold.
+func enabledSigners(ctx sdk.Context, k *keeper.Keeper) (signers []attesterKey, threshold uint32, ok bool) {
+	signatureThreshold, found := k.GetSignatureThreshold(ctx)
+	if !found || signatureThreshold.Amount == 0 {
+		return nil, 0, false
+	}
+
+	enabled := make(map[string]struct{})
+	for _, a := range k.GetEnabledAttesters(ctx) {
+		enabled[strings.ToLower(a.Attester)] = struct{}{}
+	}
+	for _, key := range attesters {
+		if _, found := enabled[strings.ToLower(key.PublicKey())]; found {
+			signers = append(signers, key)
+		}
+	}
+
+	return signers, signatureThreshold.Amount, uint32(len(signers)) >= signatureThreshold.Amount
+}
+
+// attest signs message with a random subset of threshold signers, ordered by
+// increasing attester address as the module requires.
+func attest(r *rand.Rand, signers []attesterKey, threshold uint32, message []byte) []byte {
+	subset := make([]attesterKey, threshold)
+	for i, j := range r.Perm(len(signers))[:threshold] {
+		subset[i] = signers[j]
+	}
+	sort.Slice(subset, func(i, j int) bool {
+		return bytes.Compare(
+			crypto.PubkeyToAddress(subset[i].privateKey.PublicKey).Bytes(),
+			crypto.PubkeyToAddress(subset[j].privateKey.PublicKey).Bytes(),
+		) < 0
+	})
+
+	digest := crypto.Keccak256(message)
+	attestation := make([]byte, 0, len(subset)*types.SignatureLength)
+	for _, key := range subset {
+		signature, err := crypto.Sign(digest, key.privateKey)
+		if err != nil {
+			panic(fmt.Sprintf("failed to sign message: %s", err))
+		}
+		attestation = append(attestation, signature...)
+	}
+
+	return attestation
+}
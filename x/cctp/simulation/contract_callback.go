@@ -0,0 +1,69 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgEnableContractCallback opts a random account in to callbacks,
+// which only succeeds for contracts.
+func SimulateMsgEnableContractCallback(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		account, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgEnableContractCallback{
+			From: account.Address.String(),
+		}
+
+		// only contracts known to the wasm keeper, if any, can opt in
+		if err := dryRun(app, ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "account is not a contract"), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, nil)
+	}
+}
+
+// SimulateMsgDisableContractCallback opts a random contract out of callbacks.
+func SimulateMsgDisableContractCallback(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		callbacks := k.GetAllContractCallbacks(ctx)
+		if len(callbacks) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgDisableContractCallback{}), "no contract callbacks"), nil, nil
+		}
+
+		account, found := simtypes.FindAccount(accs, callbacks[r.Intn(len(callbacks))])
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgDisableContractCallback{}), "contract is not a simulation account"), nil, nil
+		}
+
+		msg := &types.MsgDisableContractCallback{
+			From: account.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, nil)
+	}
+}
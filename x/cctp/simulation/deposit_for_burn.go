@@ -0,0 +1,130 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgDepositForBurn burns a random amount of a linked token held by a
+// random account.
+func SimulateMsgDepositForBurn(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		account, amount, destinationDomain, reason := randomDeposit(r, ctx, k, bk, accs)
+		if reason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgDepositForBurn{}), reason), nil, nil
+		}
+
+		msg := &types.MsgDepositForBurn{
+			From:              account.Address.String(),
+			Amount:            amount.Amount,
+			DestinationDomain: destinationDomain,
+			MintRecipient:     randomBytes32(r),
+			BurnToken:         amount.Denom,
+		}
+
+		// burning depends on the fiat token factory minter configuration
+		if err := dryRun(app, ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to burn"), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, sdk.NewCoins(amount))
+	}
+}
+
+// SimulateMsgDepositForBurnWithCaller burns a random amount of a linked token
+// held by a random account, restricting who can receive it.
+func SimulateMsgDepositForBurnWithCaller(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		account, amount, destinationDomain, reason := randomDeposit(r, ctx, k, bk, accs)
+		if reason != "" {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgDepositForBurnWithCaller{}), reason), nil, nil
+		}
+
+		msg := &types.MsgDepositForBurnWithCaller{
+			From:              account.Address.String(),
+			Amount:            amount.Amount,
+			DestinationDomain: destinationDomain,
+			MintRecipient:     randomBytes32(r),
+			BurnToken:         amount.Denom,
+			DestinationCaller: randomBytes32(r),
+		}
+
+		// burning depends on the fiat token factory minter configuration
+		if err := dryRun(app, ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to burn"), nil, nil
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, sdk.NewCoins(amount))
+	}
+}
+
+// randomDeposit picks an account holding a linked local token, an amount
+// within its balance and the burn limit, and a domain with a remote token
+// messenger. reason is set when no deposit is possible.
+func randomDeposit(
+	r *rand.Rand,
+	ctx sdk.Context,
+	k *keeper.Keeper,
+	bk types.BankKeeper,
+	accs []simtypes.Account,
+) (account simtypes.Account, amount sdk.Coin, destinationDomain uint32, reason string) {
+	if sendingAndReceivingPaused(ctx, k) || burningAndMintingPaused(ctx, k) {
+		return account, amount, 0, "deposits are paused"
+	}
+	if !fitsMessageBody(ctx, k, types.BurnMessageLen) {
+		return account, amount, 0, "burn message exceeds max message body size"
+	}
+
+	messengers := k.GetRemoteTokenMessengers(ctx)
+	if len(messengers) == 0 {
+		return account, amount, 0, "no remote token messengers"
+	}
+	destinationDomain = messengers[r.Intn(len(messengers))].DomainId
+
+	tokenPairs := k.GetAllTokenPairs(ctx)
+	if len(tokenPairs) == 0 {
+		return account, amount, 0, "no token pairs"
+	}
+	denom := tokenPairs[r.Intn(len(tokenPairs))].LocalToken
+
+	account, _ = simtypes.RandomAcc(r, accs)
+	balance := bk.SpendableCoins(ctx, account.Address).AmountOf(denom)
+	if limit, found := k.GetPerMessageBurnLimit(ctx, denom); found && limit.Amount.LT(balance) {
+		balance = limit.Amount
+	}
+	if !balance.IsPositive() {
+		return account, amount, 0, "account has no balance to burn"
+	}
+
+	value, err := simtypes.RandPositiveInt(r, balance)
+	if err != nil {
+		return account, amount, 0, "unable to generate amount"
+	}
+
+	return account, sdk.NewCoin(denom, math.NewIntFromBigInt(value.BigInt())), destinationDomain, ""
+}
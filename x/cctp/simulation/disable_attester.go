@@ -0,0 +1,54 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgDisableAttester disables a random attester while keeping enough
+// attesters to meet the signature threshold.
+func SimulateMsgDisableAttester(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		attesterManager, found := FindAccount(accs, k.GetAttesterManager(ctx))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgDisableAttester{}), "attester manager is not a simulation account"), nil, nil
+		}
+
+		enabled := k.GetEnabledAttesters(ctx)
+		threshold, found := k.GetSignatureThreshold(ctx)
+		if !found || len(enabled) <= 1 || uint32(len(enabled)) <= threshold.Amount {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgDisableAttester{}), "no attester can be disabled"), nil, nil
+		}
+
+		msg := &types.MsgDisableAttester{
+			From:     attesterManager.Address.String(),
+			Attester: enabled[r.Intn(len(enabled))].Attester,
+			Reason:   simtypes.RandStringOfLength(r, 20),
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, attesterManager, msg, nil)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgEnableAttester enables a simulation attester key that is not
+// currently enabled.
+func SimulateMsgEnableAttester(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		attesterManager, found := FindAccount(accs, k.GetAttesterManager(ctx))
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgEnableAttester{}), "attester manager is not a simulation account"), nil, nil
+		}
+
+		var candidates []string
+		for _, key := range attesters {
+			existing, found := k.GetAttester(ctx, key.PublicKey())
+			if !found || existing.Status == types.AttesterStatus_ATTESTER_STATUS_DISABLED {
+				candidates = append(candidates, key.PublicKey())
+			}
+		}
+		if len(candidates) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgEnableAttester{}), "all attesters are enabled"), nil, nil
+		}
+
+		msg := &types.MsgEnableAttester{
+			From:     attesterManager.Address.String(),
+			Attester: candidates[r.Intn(len(candidates))],
+			Operator: simtypes.RandStringOfLength(r, 10),
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, attesterManager, msg, nil)
+	}
+}
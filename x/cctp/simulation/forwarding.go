@@ -0,0 +1,83 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgSetForwarding forwards the mints of a random account to a random
+// recipient on a domain with a remote token messenger.
+func SimulateMsgSetForwarding(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		messengers := k.GetRemoteTokenMessengers(ctx)
+		if len(messengers) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgSetForwarding{}), "no remote token messengers"), nil, nil
+		}
+		destinationDomain := messengers[r.Intn(len(messengers))].DomainId
+		if destinationDomain == k.GetLocalDomain(ctx).DomainId {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgSetForwarding{}), "cannot forward to the local domain"), nil, nil
+		}
+
+		var destinationCaller []byte
+		if r.Intn(2) == 0 {
+			destinationCaller = randomBytes32(r)
+		}
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		msg := &types.MsgSetForwarding{
+			From:              account.Address.String(),
+			DestinationDomain: destinationDomain,
+			MintRecipient:     randomBytes32(r),
+			DestinationCaller: destinationCaller,
+			Fee:               math.NewInt(r.Int63n(1_000)),
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, nil)
+	}
+}
+
+// SimulateMsgRemoveForwarding removes a random forwarding.
+func SimulateMsgRemoveForwarding(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		forwardings := k.GetAllForwardings(ctx)
+		if len(forwardings) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRemoveForwarding{}), "no forwardings"), nil, nil
+		}
+
+		account, found := FindAccount(accs, forwardings[r.Intn(len(forwardings))].Address)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgRemoveForwarding{}), "forwarding is not a simulation account"), nil, nil
+		}
+
+		msg := &types.MsgRemoveForwarding{
+			From: account.Address.String(),
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, nil)
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// RandomizedGenState generates a random GenesisState for cctp. Roles are
+// assigned to simulation accounts and attesters are taken from the
+// simulation attester keys, leaving some keys for EnableAttester.
+func RandomizedGenState(simState *module.SimulationState) {
+	r := simState.Rand
+	randomAddress := func() string {
+		account, _ := simtypes.RandomAcc(r, simState.Accounts)
+		return account.Address.String()
+	}
+
+	numAttesters := r.Intn(MaxAttesters/2) + 1
+	attesterList := make([]types.Attester, numAttesters)
+	for i, key := range attesters[:numAttesters] {
+		attesterList[i] = key.Attester()
+	}
+
+	var tokenPairList []types.TokenPair
+	var tokenMessengerList []types.RemoteTokenMessenger
+	for domain := uint32(0); domain < 8; domain++ {
+		if domain == types.NobleDomainId || r.Intn(4) == 0 {
+			continue
+		}
+
+		tokenMessengerList = append(tokenMessengerList, types.RemoteTokenMessenger{
+			DomainId: domain,
+			Address:  randomBytes32(r),
+		})
+		tokenPairList = append(tokenPairList, types.TokenPair{
+			RemoteDomain: domain,
+			RemoteToken:  randomBytes32(r),
+			LocalToken:   Denom,
+		})
+	}
+
+	genesis := types.GenesisState{
+		Owner:           randomAddress(),
+		AttesterManager: randomAddress(),
+		Pauser:          randomAddress(),
+		TokenController: randomAddress(),
+		AttesterList:    attesterList,
+		PerMessageBurnLimitList: []types.PerMessageBurnLimit{
+			{Denom: Denom, Amount: math.NewInt(r.Int63n(1_000_000_000_000) + 1)},
+		},
+		BurningAndMintingPaused:           &types.BurningAndMintingPaused{Paused: false},
+		SendingAndReceivingMessagesPaused: &types.SendingAndReceivingMessagesPaused{Paused: false},
+		MaxMessageBodySize:                &types.MaxMessageBodySize{Amount: uint64(r.Intn(8000) + 1000)},
+		NextAvailableNonce:                &types.Nonce{Nonce: uint64(r.Intn(1000))},
+		SignatureThreshold:                &types.SignatureThreshold{Amount: uint32(r.Intn(numAttesters) + 1)},
+		TokenPairList:                     tokenPairList,
+		UsedNoncesList:                    []types.Nonce{},
+		TokenMessengerList:                tokenMessengerList,
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&genesis)
+}
@@ -0,0 +1,74 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/x/cctp/simulation"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	keys := make(map[string]bool)
+	for _, key := range attester.DeterministicSet(simulation.AttesterSeed, simulation.MaxAttesters) {
+		keys[key.PublicKey()] = true
+	}
+
+	for seed := int64(0); seed < 20; seed++ {
+		r := rand.New(rand.NewSource(seed))
+		simState := module.SimulationState{
+			Rand:     r,
+			Cdc:      cdc,
+			Accounts: simtypes.RandomAccounts(r, 5),
+			GenState: make(map[string]json.RawMessage),
+		}
+
+		simulation.RandomizedGenState(&simState)
+
+		var genesis types.GenesisState
+		cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &genesis)
+		require.NoError(t, genesis.Validate())
+
+		for _, role := range []string{genesis.Owner, genesis.AttesterManager, genesis.Pauser, genesis.TokenController} {
+			_, found := simulation.FindAccount(simState.Accounts, role)
+			require.True(t, found)
+		}
+
+		// every attester can be signed for by the simulation operations
+		require.NotEmpty(t, genesis.AttesterList)
+		for _, a := range genesis.AttesterList {
+			require.True(t, keys[a.Attester])
+		}
+		require.NotZero(t, genesis.SignatureThreshold.Amount)
+		require.LessOrEqual(t, int(genesis.SignatureThreshold.Amount), len(genesis.AttesterList))
+
+		for _, tokenPair := range genesis.TokenPairList {
+			require.NotEqual(t, types.NobleDomainId, tokenPair.RemoteDomain)
+			require.Len(t, tokenPair.RemoteToken, 32)
+		}
+	}
+}
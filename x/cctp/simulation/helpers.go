@@ -0,0 +1,163 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+const (
+	// Denom is the local token linked to remote tokens in simulated genesis.
+	Denom = "uusdc"
+	// MaxAttesters is the number of attester keys available to simulations.
+	MaxAttesters = 8
+)
+
+// FindAccount finds a specific address from an account list
+func FindAccount(accs []simtypes.Account, address string) (simtypes.Account, bool) {
+	addr, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return simtypes.Account{}, false
+	}
+	return simtypes.FindAccount(accs, addr)
+}
+
+// paddedAddress left pads an account address to 32 bytes.
+func paddedAddress(address sdk.AccAddress) []byte {
+	padded := make([]byte, 32)
+	copy(padded[12:], address)
+	return padded
+}
+
+// randomBytes32 returns 32 random bytes, never all zero.
+func randomBytes32(r *rand.Rand) []byte {
+	bz := make([]byte, 32)
+	_, _ = r.Read(bz)
+	bz[31] |= 1
+	return bz
+}
+
+// randomRemoteDomain returns a random domain other than the local domain.
+func randomRemoteDomain(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper) uint32 {
+	domain := uint32(r.Intn(10))
+	if domain == k.GetLocalDomain(ctx).DomainId {
+		domain++
+	}
+	return domain
+}
+
+// randomMessageBody returns a random message body within the max body size.
+func randomMessageBody(r *rand.Rand, ctx sdk.Context, k *keeper.Keeper) []byte {
+	length := 256
+	if max, found := k.GetMaxMessageBodySize(ctx); found && max.Amount < uint64(length) {
+		length = int(max.Amount)
+	}
+
+	body := make([]byte, r.Intn(length+1))
+	_, _ = r.Read(body)
+	return body
+}
+
+// fitsMessageBody reports whether a body of length bytes can be sent.
+func fitsMessageBody(ctx sdk.Context, k *keeper.Keeper, length int) bool {
+	max, found := k.GetMaxMessageBodySize(ctx)
+	return !found || uint64(length) <= max.Amount
+}
+
+// randomRoleTransferExpiry returns either no expiry or a height a few blocks
+// after the current one.
+func randomRoleTransferExpiry(r *rand.Rand, ctx sdk.Context) int64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+	return ctx.BlockHeight() + 1 + int64(r.Intn(50))
+}
+
+// pendingRole returns the account that can accept a pending transfer of role,
+// if there is one that has not yet expired.
+func pendingRole(ctx sdk.Context, k *keeper.Keeper, accs []simtypes.Account, role types.Role) (simtypes.Account, string) {
+	pending, found := k.GetPendingRole(ctx, role)
+	if !found {
+		return simtypes.Account{}, fmt.Sprintf("pending %s is not set", role.Name())
+	}
+
+	if pending.ExpiresAtHeight != 0 && ctx.BlockHeight() > pending.ExpiresAtHeight {
+		return simtypes.Account{}, fmt.Sprintf("pending %s transfer has expired", role.Name())
+	}
+
+	account, found := FindAccount(accs, pending.Address)
+	if !found {
+		return simtypes.Account{}, fmt.Sprintf("pending %s is not a simulation account", role.Name())
+	}
+
+	return account, ""
+}
+
+func sendingAndReceivingPaused(ctx sdk.Context, k *keeper.Keeper) bool {
+	paused, found := k.GetSendingAndReceivingMessagesPaused(ctx)
+	return found && paused.Paused
+}
+
+func burningAndMintingPaused(ctx sdk.Context, k *keeper.Keeper) bool {
+	paused, found := k.GetBurningAndMintingPaused(ctx)
+	return found && paused.Paused
+}
+
+// dryRun executes msg on a cached context, for operations whose outcome also
+// depends on the state of other modules such as the fiat token factory.
+func dryRun(app *baseapp.BaseApp, ctx sdk.Context, msg sdk.Msg) error {
+	cacheCtx, _ := ctx.CacheContext()
+	_, err := app.MsgServiceRouter().Handler(msg)(cacheCtx, msg)
+	return err
+}
+
+// deliver signs msg with account and delivers it in a transaction paying
+// random fees.
+func deliver(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	txGen client.TxConfig,
+	account simtypes.Account,
+	msg sdk.Msg,
+	spent sdk.Coins,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	return simulation.GenAndDeliverTxWithRandFees(simulation.OperationInput{
+		R:               r,
+		App:             app,
+		TxGen:           txGen,
+		Cdc:             nil,
+		Msg:             msg,
+		CoinsSpentInMsg: spent,
+		Context:         ctx,
+		SimAccount:      account,
+		AccountKeeper:   ak,
+		Bankkeeper:      bk,
+		ModuleName:      types.ModuleName,
+	})
+}
@@ -0,0 +1,111 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgReceiveMessage receives a message from a random remote domain,
+// signed by the simulation attesters. Half of the messages are burn messages
+// for a linked token, minting to a random account.
+func SimulateMsgReceiveMessage(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if sendingAndReceivingPaused(ctx, k) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReceiveMessage{}), "sending and receiving messages is paused"), nil, nil
+		}
+
+		signers, threshold, ok := enabledSigners(ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReceiveMessage{}), "not enough simulation attesters enabled"), nil, nil
+		}
+
+		caller, _ := simtypes.RandomAcc(r, accs)
+		localDomain := k.GetLocalDomain(ctx)
+		message := types.Message{
+			Version:           localDomain.MessageVersion,
+			SourceDomain:      randomRemoteDomain(r, ctx, k),
+			DestinationDomain: localDomain.DomainId,
+			Nonce:             r.Uint64(),
+			Sender:            randomBytes32(r),
+			Recipient:         randomBytes32(r),
+			DestinationCaller: make([]byte, types.DestinationCallerLen),
+			MessageBody:       randomMessageBody(r, ctx, k),
+		}
+		if r.Intn(2) == 0 {
+			message.DestinationCaller = paddedAddress(caller.Address)
+		}
+
+		mint := false
+		if tokenPairs := k.GetAllTokenPairs(ctx); len(tokenPairs) > 0 && r.Intn(2) == 0 && !burningAndMintingPaused(ctx, k) {
+			tokenPair := tokenPairs[r.Intn(len(tokenPairs))]
+			messenger, found := k.GetRemoteTokenMessenger(ctx, tokenPair.RemoteDomain)
+			if found {
+				recipient, _ := simtypes.RandomAcc(r, accs)
+				body, err := (&types.BurnMessage{
+					Version:       localDomain.MessageBodyVersion,
+					BurnToken:     tokenPair.RemoteToken,
+					MintRecipient: paddedAddress(recipient.Address),
+					Amount:        math.NewInt(r.Int63n(1_000_000_000) + 1),
+					MessageSender: randomBytes32(r),
+				}).Bytes()
+				if err != nil {
+					return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReceiveMessage{}), "unable to encode burn message"), nil, err
+				}
+
+				message.SourceDomain = tokenPair.RemoteDomain
+				message.Sender = messenger.Address
+				message.Recipient = types.PaddedModuleAddress
+				message.MessageBody = body
+				mint = true
+			}
+		}
+
+		if k.GetUsedNonce(ctx, types.Nonce{SourceDomain: message.SourceDomain, Nonce: message.Nonce}) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReceiveMessage{}), "nonce already used"), nil, nil
+		}
+
+		bz, err := message.Bytes()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReceiveMessage{}), "unable to encode message"), nil, err
+		}
+
+		msg := &types.MsgReceiveMessage{
+			From:        caller.Address.String(),
+			Message:     bz,
+			Attestation: attest(r, signers, threshold, bz),
+		}
+
+		// minting depends on the fiat token factory minter configuration
+		if mint {
+			if err := dryRun(app, ctx, msg); err != nil {
+				return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to mint"), nil, nil
+			}
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, caller, msg, nil)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgReplaceDepositForBurn replaces the mint recipient of a deposit
+// previously made from Noble by a random account, attested by the simulation
+// attesters.
+func SimulateMsgReplaceDepositForBurn(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if sendingAndReceivingPaused(ctx, k) || burningAndMintingPaused(ctx, k) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceDepositForBurn{}), "deposits are paused"), nil, nil
+		}
+		if !fitsMessageBody(ctx, k, types.BurnMessageLen) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceDepositForBurn{}), "burn message exceeds max message body size"), nil, nil
+		}
+
+		signers, threshold, ok := enabledSigners(ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceDepositForBurn{}), "not enough simulation attesters enabled"), nil, nil
+		}
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		localDomain := k.GetLocalDomain(ctx)
+		body, err := (&types.BurnMessage{
+			Version:       localDomain.MessageBodyVersion,
+			BurnToken:     randomBytes32(r),
+			MintRecipient: randomBytes32(r),
+			Amount:        math.NewInt(r.Int63n(1_000_000_000) + 1),
+			MessageSender: paddedAddress(account.Address),
+		}).Bytes()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceDepositForBurn{}), "unable to encode burn message"), nil, err
+		}
+
+		original, err := (&types.Message{
+			Version:           localDomain.MessageVersion,
+			SourceDomain:      localDomain.DomainId,
+			DestinationDomain: randomRemoteDomain(r, ctx, k),
+			Nonce:             r.Uint64(),
+			Sender:            types.PaddedModuleAddress,
+			Recipient:         randomBytes32(r),
+			DestinationCaller: make([]byte, types.DestinationCallerLen),
+			MessageBody:       body,
+		}).Bytes()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceDepositForBurn{}), "unable to encode message"), nil, err
+		}
+
+		msg := &types.MsgReplaceDepositForBurn{
+			From:                 account.Address.String(),
+			OriginalMessage:      original,
+			OriginalAttestation:  attest(r, signers, threshold, original),
+			NewDestinationCaller: randomBytes32(r),
+			NewMintRecipient:     randomBytes32(r),
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, nil)
+	}
+}
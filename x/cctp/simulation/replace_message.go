@@ -0,0 +1,70 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/circlefin/noble-cctp/x/cctp/keeper"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+)
+
+// SimulateMsgReplaceMessage replaces a message previously sent from Noble by
+// a random account, attested by the simulation attesters.
+func SimulateMsgReplaceMessage(ak types.AccountKeeper, bk types.BankKeeper, k *keeper.Keeper, txGen client.TxConfig) simtypes.Operation {
+	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		if sendingAndReceivingPaused(ctx, k) {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceMessage{}), "sending and receiving messages is paused"), nil, nil
+		}
+
+		signers, threshold, ok := enabledSigners(ctx, k)
+		if !ok {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceMessage{}), "not enough simulation attesters enabled"), nil, nil
+		}
+
+		account, _ := simtypes.RandomAcc(r, accs)
+		localDomain := k.GetLocalDomain(ctx)
+		original, err := (&types.Message{
+			Version:           localDomain.MessageVersion,
+			SourceDomain:      localDomain.DomainId,
+			DestinationDomain: randomRemoteDomain(r, ctx, k),
+			Nonce:             r.Uint64(),
+			Sender:            paddedAddress(account.Address),
+			Recipient:         randomBytes32(r),
+			DestinationCaller: make([]byte, types.DestinationCallerLen),
+			MessageBody:       randomMessageBody(r, ctx, k),
+		}).Bytes()
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgReplaceMessage{}), "unable to encode message"), nil, err
+		}
+
+		msg := &types.MsgReplaceMessage{
+			From:                 account.Address.String(),
+			OriginalMessage:      original,
+			OriginalAttestation:  attest(r, signers, threshold, original),
+			NewMessageBody:       randomMessageBody(r, ctx, k),
+			NewDestinationCaller: randomBytes32(r),
+		}
+
+		return deliver(r, app, ctx, ak, bk, txGen, account, msg, nil)
+	}
+}
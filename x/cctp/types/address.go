@@ -0,0 +1,55 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccAddressFromBytes32 is the inverse of Bytes32FromAccAddress, used for
+// destination callers. Addresses whose first 12 bytes are zero are 20-byte
+// accounts, left padded with zeros, and any other address is a full 32-byte
+// account. Mint and message recipients are instead decoded as 20-byte
+// accounts unless registered with MsgRegisterAccount32.
+func AccAddressFromBytes32(address []byte) (sdk.AccAddress, error) {
+	if len(address) != AddressBytesLen {
+		return nil, errors.Wrapf(ErrInvalidAddress, "expected %d bytes, found %d", AddressBytesLen, len(address))
+	}
+
+	if bytes.Equal(address[:12], make([]byte, 12)) {
+		return sdk.AccAddress(address[12:]), nil
+	}
+
+	return sdk.AccAddress(address), nil
+}
+
+// Bytes32FromAccAddress converts a Cosmos account address into a 32-byte CCTP
+// address. 20-byte accounts are left padded with zeros, and 32-byte accounts
+// are used in full.
+func Bytes32FromAccAddress(address sdk.AccAddress) ([]byte, error) {
+	bz := make([]byte, AddressBytesLen)
+	switch len(address) {
+	case 20, AddressBytesLen:
+		copy(bz[AddressBytesLen-len(address):], address)
+		return bz, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidAddress, "expected 20 or %d bytes, found %d", AddressBytesLen, len(address))
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccAddressFromBytes32(t *testing.T) {
+	account := bytes.Repeat([]byte{1}, 20)
+	padded := make([]byte, 32)
+	copy(padded[12:], account)
+
+	address, err := types.AccAddressFromBytes32(padded)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(account), address)
+
+	contract := bytes.Repeat([]byte{2}, 32)
+	address, err = types.AccAddressFromBytes32(contract)
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(contract), address)
+
+	_, err = types.AccAddressFromBytes32(account)
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
+
+func TestBytes32FromAccAddress(t *testing.T) {
+	account := bytes.Repeat([]byte{1}, 20)
+	padded := make([]byte, 32)
+	copy(padded[12:], account)
+
+	bz, err := types.Bytes32FromAccAddress(account)
+	require.NoError(t, err)
+	require.Equal(t, padded, bz)
+
+	contract := bytes.Repeat([]byte{2}, 32)
+	bz, err = types.Bytes32FromAccAddress(contract)
+	require.NoError(t, err)
+	require.Equal(t, contract, bz)
+
+	// both lengths round trip
+	for _, address := range []sdk.AccAddress{account, contract} {
+		bz, err := types.Bytes32FromAccAddress(address)
+		require.NoError(t, err)
+		got, err := types.AccAddressFromBytes32(bz)
+		require.NoError(t, err)
+		require.Equal(t, address, got)
+	}
+
+	_, err = types.Bytes32FromAccAddress(bytes.Repeat([]byte{3}, 33))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
@@ -0,0 +1,329 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"encoding/hex"
+	"math/bits"
+	"sort"
+
+	"cosmossdk.io/errors"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// AttestationVerifier verifies attestations against a fixed set of enabled
+// attesters and signature threshold. Attester keys are decoded once, so a
+// verifier can be reused for any number of messages, and the message and
+// attestation passed to Verify are never modified.
+//
+// Every scheme signs the keccak256 hash of the message, and only attesters
+// of the scheme being verified are taken into account.
+//
+// Rules for a valid secp256k1 attestation:
+//  1. its length is SignatureLength * threshold;
+//  2. the addresses recovered from its signatures are in increasing order,
+//     e.g. if signature A is signed by 0x1... and signature B by 0x2..., the
+//     attestation must be AB;
+//  3. there are no duplicate signers;
+//  4. every signer is an enabled attester.
+//
+// Rules for a valid ed25519 attestation:
+//  1. it is threshold entries of a public key followed by its signature;
+//  2. the public keys are in strictly increasing byte order;
+//  3. every public key is an enabled ed25519 attester.
+//
+// Rules for a valid BLS12-381 attestation:
+//  1. it is a bitmap of the signers followed by a single aggregate signature.
+//     Bit i (byte i/8, mask 1<<(i%8)) selects the i-th enabled BLS attester,
+//     ordered by compressed public key;
+//  2. at least threshold bits are set, and none beyond the enabled attesters;
+//  3. the aggregate signature verifies against the sum of the signers' keys.
+//     Rogue key attacks are not a concern as attesters are only enabled by
+//     the attester manager, who must check a proof of possession off chain.
+type AttestationVerifier struct {
+	attesters map[string]struct{}
+	ed25519   map[string]struct{}
+	bls       []bls12381.G1Affine
+	threshold uint32
+}
+
+// NewAttestationVerifier returns a verifier for the given enabled attesters
+// and signature threshold. BLS12-381 attesters whose keys cannot be decoded
+// are ignored.
+func NewAttestationVerifier(attesters []Attester, threshold uint32) *AttestationVerifier {
+	verifier := &AttestationVerifier{
+		attesters: make(map[string]struct{}, len(attesters)),
+		ed25519:   make(map[string]struct{}),
+		threshold: threshold,
+	}
+
+	seen := make(map[[BLSPublicKeyLength]byte]struct{})
+	for _, attester := range attesters {
+		key := common.FromHex(attester.Attester)
+
+		switch attester.KeyType {
+		case KeyType_KEY_TYPE_ED25519:
+			verifier.ed25519[string(key)] = struct{}{}
+		case KeyType_KEY_TYPE_BLS12_381:
+			publicKey, err := ParseBLSPublicKey(key)
+			if err != nil {
+				continue
+			}
+			if _, found := seen[publicKey.Bytes()]; found {
+				continue
+			}
+			seen[publicKey.Bytes()] = struct{}{}
+			verifier.bls = append(verifier.bls, publicKey)
+		default:
+			verifier.attesters[string(key)] = struct{}{}
+		}
+	}
+
+	sort.Slice(verifier.bls, func(i, j int) bool {
+		a, b := verifier.bls[i].Bytes(), verifier.bls[j].Bytes()
+		return bytes.Compare(a[:], b[:]) < 0
+	})
+
+	return verifier
+}
+
+// ParseBLSPublicKey decodes a compressed BLS12-381 G1 public key, rejecting
+// points that are not in the prime order subgroup and the point at infinity.
+func ParseBLSPublicKey(key []byte) (bls12381.G1Affine, error) {
+	var publicKey bls12381.G1Affine
+	if len(key) != BLSPublicKeyLength {
+		return publicKey, errors.Wrapf(ErrInvalidAddress, "BLS public key must be %d bytes", BLSPublicKeyLength)
+	}
+	if _, err := publicKey.SetBytes(key); err != nil {
+		return publicKey, errors.Wrapf(ErrInvalidAddress, "invalid BLS public key: %s", err)
+	}
+	if publicKey.IsInfinity() {
+		return publicKey, errors.Wrap(ErrInvalidAddress, "BLS public key cannot be the point at infinity")
+	}
+
+	return publicKey, nil
+}
+
+// ValidateAttesterKey checks that key is a well formed public key of keyType.
+func ValidateAttesterKey(keyType KeyType, key []byte) error {
+	switch keyType {
+	case KeyType_KEY_TYPE_SECP256K1:
+		if len(key) == 0 {
+			return errors.Wrap(ErrInvalidAddress, "invalid attester")
+		}
+	case KeyType_KEY_TYPE_ED25519:
+		if len(key) != Ed25519PublicKeyLength {
+			return errors.Wrapf(ErrInvalidAddress, "ed25519 public key must be %d bytes", Ed25519PublicKeyLength)
+		}
+	case KeyType_KEY_TYPE_BLS12_381:
+		_, err := ParseBLSPublicKey(key)
+		return err
+	default:
+		return errors.Wrapf(ErrInvalidAddress, "unsupported key type %s", keyType)
+	}
+
+	return nil
+}
+
+// VerifyKeyType checks attestation against message using the scheme of
+// keyType. The signers are returned hex encoded, as addresses for secp256k1
+// and as public keys for every other scheme.
+func (v *AttestationVerifier) VerifyKeyType(keyType KeyType, message []byte, attestation []byte) ([]string, error) {
+	var signers []string
+
+	switch keyType {
+	case KeyType_KEY_TYPE_SECP256K1:
+		addresses, err := v.Verify(message, attestation)
+		if err != nil {
+			return nil, err
+		}
+		for _, address := range addresses {
+			signers = append(signers, address.Hex())
+		}
+	case KeyType_KEY_TYPE_ED25519:
+		keys, err := v.VerifyEd25519(message, attestation)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			signers = append(signers, hex.EncodeToString(key))
+		}
+	case KeyType_KEY_TYPE_BLS12_381:
+		keys, err := v.VerifyBLS(message, attestation)
+		if err != nil {
+			return nil, err
+		}
+		for _, key := range keys {
+			signers = append(signers, hex.EncodeToString(key))
+		}
+	default:
+		return nil, errors.Wrapf(ErrSignatureVerification, "unsupported key type %s", keyType)
+	}
+
+	return signers, nil
+}
+
+// Verify checks a secp256k1 attestation against message and returns the
+// addresses of the signers, in the order they appear in the attestation.
+func (v *AttestationVerifier) Verify(message []byte, attestation []byte) ([]common.Address, error) {
+	if uint32(len(attestation)) != SignatureLength*v.threshold {
+		return nil, errors.Wrap(ErrSignatureVerification, "invalid attestation length")
+	}
+
+	if v.threshold == 0 {
+		return nil, errors.Wrap(ErrSignatureVerification, "signature verification threshold cannot be 0")
+	}
+
+	digest := crypto.Keccak256(message)
+	signers := make([]common.Address, 0, v.threshold)
+
+	for i := uint32(0); i < v.threshold; i++ {
+		var signature [SignatureLength]byte
+		copy(signature[:], attestation[i*SignatureLength:(i+1)*SignatureLength])
+
+		// The go-ethereum library assumes that the v-value of a secp256k1
+		// signature is either 0 or 1. However, in legacy Bitcoin signers, this
+		// value is either 27 or 28. So we need to normalise in order to verify.
+		if signature[SignatureLength-1] == 27 || signature[SignatureLength-1] == 28 {
+			signature[SignatureLength-1] -= 27
+		}
+
+		recoveredKey, err := crypto.Ecrecover(digest, signature[:])
+		if err != nil {
+			return nil, errors.Wrapf(ErrSignatureVerification, "failed to recover public key: %s", err)
+		}
+		signer := common.BytesToAddress(crypto.Keccak256(recoveredKey[1:])[12:])
+
+		// Signatures must be in increasing order of address, and may not duplicate signatures from same address
+		if len(signers) > 0 && bytes.Compare(signers[len(signers)-1].Bytes(), signer.Bytes()) > -1 {
+			return nil, errors.Wrap(ErrSignatureVerification, "invalid signature order or dupe")
+		}
+
+		// check that recovered key is a valid attester
+		if _, found := v.attesters[string(recoveredKey)]; !found {
+			return nil, errors.Wrap(ErrSignatureVerification, "Invalid signature: not an attester")
+		}
+
+		signers = append(signers, signer)
+	}
+
+	return signers, nil
+}
+
+// VerifyEd25519 checks an ed25519 attestation against message and returns
+// the public keys of the signers, in the order they appear in the attestation.
+func (v *AttestationVerifier) VerifyEd25519(message []byte, attestation []byte) ([][]byte, error) {
+	if uint32(len(attestation)) != Ed25519AttestationEntry*v.threshold {
+		return nil, errors.Wrap(ErrSignatureVerification, "invalid attestation length")
+	}
+
+	if v.threshold == 0 {
+		return nil, errors.Wrap(ErrSignatureVerification, "signature verification threshold cannot be 0")
+	}
+
+	digest := crypto.Keccak256(message)
+	signers := make([][]byte, 0, v.threshold)
+
+	for i := uint32(0); i < v.threshold; i++ {
+		entry := attestation[i*Ed25519AttestationEntry : (i+1)*Ed25519AttestationEntry]
+		publicKey := bytes.Clone(entry[:Ed25519PublicKeyLength])
+
+		// Signers must be in increasing order of public key, without duplicates
+		if len(signers) > 0 && bytes.Compare(signers[len(signers)-1], publicKey) > -1 {
+			return nil, errors.Wrap(ErrSignatureVerification, "invalid signature order or dupe")
+		}
+
+		if _, found := v.ed25519[string(publicKey)]; !found {
+			return nil, errors.Wrap(ErrSignatureVerification, "Invalid signature: not an attester")
+		}
+
+		if !ed25519.Verify(publicKey, digest, entry[Ed25519PublicKeyLength:]) {
+			return nil, errors.Wrap(ErrSignatureVerification, "invalid ed25519 signature")
+		}
+
+		signers = append(signers, publicKey)
+	}
+
+	return signers, nil
+}
+
+// VerifyBLS checks a BLS12-381 aggregate attestation against message and
+// returns the compressed public keys of the signers, in bitmap order.
+func (v *AttestationVerifier) VerifyBLS(message []byte, attestation []byte) ([][]byte, error) {
+	if v.threshold == 0 {
+		return nil, errors.Wrap(ErrSignatureVerification, "signature verification threshold cannot be 0")
+	}
+
+	bitmapLen := (len(v.bls) + 7) / 8
+	if len(attestation) != bitmapLen+BLSAggregateSigLength {
+		return nil, errors.Wrap(ErrSignatureVerification, "invalid attestation length")
+	}
+	bitmap := attestation[:bitmapLen]
+
+	count := 0
+	for _, b := range bitmap {
+		count += bits.OnesCount8(b)
+	}
+	if uint32(count) < v.threshold {
+		return nil, errors.Wrap(ErrSignatureVerification, "not enough signers")
+	}
+
+	var aggregate bls12381.G1Jac
+	signers := make([][]byte, 0, count)
+	for i := 0; i < bitmapLen*8; i++ {
+		if bitmap[i/8]&(1<<(i%8)) == 0 {
+			continue
+		}
+		if i >= len(v.bls) {
+			return nil, errors.Wrap(ErrSignatureVerification, "Invalid signature: not an attester")
+		}
+
+		aggregate.AddMixed(&v.bls[i])
+		publicKey := v.bls[i].Bytes()
+		signers = append(signers, publicKey[:])
+	}
+
+	var signature bls12381.G2Affine
+	if _, err := signature.SetBytes(attestation[bitmapLen:]); err != nil {
+		return nil, errors.Wrapf(ErrSignatureVerification, "invalid aggregate signature: %s", err)
+	}
+
+	hash, err := bls12381.HashToG2(crypto.Keccak256(message), BLSDomainSeparationTag)
+	if err != nil {
+		return nil, errors.Wrapf(ErrSignatureVerification, "failed to hash message: %s", err)
+	}
+
+	// e(pk, H(m)) == e(g1, sig)  <=>  e(pk, H(m)) * e(-g1, sig) == 1
+	var publicKey, negGenerator bls12381.G1Affine
+	publicKey.FromJacobian(&aggregate)
+	_, _, generator, _ := bls12381.Generators()
+	negGenerator.Neg(&generator)
+
+	valid, err := bls12381.PairingCheck(
+		[]bls12381.G1Affine{publicKey, negGenerator},
+		[]bls12381.G2Affine{hash, signature},
+	)
+	if err != nil || !valid {
+		return nil, errors.Wrap(ErrSignatureVerification, "invalid aggregate signature")
+	}
+
+	return signers, nil
+}
@@ -0,0 +1,219 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/circlefin/noble-cctp/testutil/attester"
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAttestationVerifierHappyPath(t *testing.T) {
+	attesters := attester.GenerateSet(3)
+	verifier := types.NewAttestationVerifier(attesters.Attesters(), 3)
+	message := sample.Messages()[0]
+
+	var expected []common.Address
+	for _, key := range attesters.Sorted() {
+		expected = append(expected, key.Address())
+	}
+
+	signers, err := verifier.Verify(message, attesters.Attest(message))
+	require.NoError(t, err)
+	require.Equal(t, expected, signers)
+}
+
+func TestAttestationVerifierDoesNotModifyInput(t *testing.T) {
+	attesters := attester.GenerateSet(2)
+	verifier := types.NewAttestationVerifier(attesters.Attesters(), 2)
+	message := sample.Messages()[0]
+
+	// legacy signatures used to be normalised in place
+	attestation := attesters.AttestLegacy(message)
+	original := bytes.Clone(attestation)
+	originalMessage := bytes.Clone(message)
+
+	first, err := verifier.Verify(message, attestation)
+	require.NoError(t, err)
+	require.Equal(t, original, attestation)
+	require.Equal(t, originalMessage, message)
+
+	second, err := verifier.Verify(message, attestation)
+	require.NoError(t, err)
+	require.Equal(t, first, second)
+}
+
+func TestAttestationVerifierIsReusable(t *testing.T) {
+	attesters := attester.GenerateSet(2)
+	verifier := types.NewAttestationVerifier(attesters.Attesters(), 2)
+
+	for _, message := range sample.Messages() {
+		_, err := verifier.Verify(message, attesters.Attest(message))
+		require.NoError(t, err)
+	}
+}
+
+func TestAttestationVerifierInvalid(t *testing.T) {
+	attesters := attester.GenerateSet(2)
+	outsider := attester.GenerateSet(1)
+	message := sample.Messages()[0]
+
+	for _, tc := range []struct {
+		name        string
+		threshold   uint32
+		attestation []byte
+		err         string
+	}{
+		{name: "invalid length", threshold: 2, attestation: attesters[:1].Attest(message), err: "invalid attestation length"},
+		{name: "zero threshold", threshold: 0, attestation: []byte{}, err: "threshold cannot be 0"},
+		{name: "unsorted", threshold: 2, attestation: attesters.AttestUnsorted(message), err: "invalid signature order or dupe"},
+		{name: "duplicate", threshold: 3, attestation: attesters.AttestWithDuplicate(message), err: "invalid signature order or dupe"},
+		{name: "not an attester", threshold: 1, attestation: outsider.Attest(message), err: "not an attester"},
+		{name: "unrecoverable", threshold: 1, attestation: make([]byte, types.SignatureLength), err: "failed to recover public key"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			signers, err := types.NewAttestationVerifier(attesters.Attesters(), tc.threshold).Verify(message, tc.attestation)
+			require.ErrorIs(t, err, types.ErrSignatureVerification)
+			require.ErrorContains(t, err, tc.err)
+			require.Nil(t, signers)
+		})
+	}
+}
+
+func TestAttestationVerifierEd25519(t *testing.T) {
+	attesters := attester.DeterministicEd25519Set([]byte("ed25519"), 3)
+	message := sample.Messages()[0]
+
+	// secp256k1 attesters do not count towards an ed25519 threshold
+	enabled := append(attesters.Attesters(), attester.GenerateSet(1).Attesters()...)
+	verifier := types.NewAttestationVerifier(enabled, 3)
+
+	signers, err := verifier.VerifyKeyType(types.KeyType_KEY_TYPE_ED25519, message, attesters.Attest(message))
+	require.NoError(t, err)
+	require.Len(t, signers, 3)
+
+	_, err = verifier.VerifyKeyType(types.KeyType_KEY_TYPE_SECP256K1, message, attesters.Attest(message))
+	require.ErrorIs(t, err, types.ErrSignatureVerification)
+}
+
+func TestAttestationVerifierEd25519Invalid(t *testing.T) {
+	attesters := attester.DeterministicEd25519Set([]byte("ed25519"), 2)
+	outsider := attester.DeterministicEd25519Set([]byte("outsider"), 1)
+	message := sample.Messages()[0]
+
+	valid := attesters.Attest(message)
+	unsorted := append(bytes.Clone(valid[types.Ed25519AttestationEntry:]), valid[:types.Ed25519AttestationEntry]...)
+	tampered := bytes.Clone(valid)
+	tampered[len(tampered)-1] ^= 1
+
+	for _, tc := range []struct {
+		name        string
+		threshold   uint32
+		attestation []byte
+		err         string
+	}{
+		{name: "invalid length", threshold: 2, attestation: valid[:types.Ed25519AttestationEntry], err: "invalid attestation length"},
+		{name: "zero threshold", threshold: 0, attestation: []byte{}, err: "threshold cannot be 0"},
+		{name: "unsorted", threshold: 2, attestation: unsorted, err: "invalid signature order or dupe"},
+		{name: "duplicate", threshold: 2, attestation: append(bytes.Clone(valid[:types.Ed25519AttestationEntry]), valid[:types.Ed25519AttestationEntry]...), err: "invalid signature order or dupe"},
+		{name: "not an attester", threshold: 1, attestation: outsider.Attest(message), err: "not an attester"},
+		{name: "invalid signature", threshold: 2, attestation: tampered, err: "invalid ed25519 signature"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			signers, err := types.NewAttestationVerifier(attesters.Attesters(), tc.threshold).VerifyEd25519(message, tc.attestation)
+			require.ErrorIs(t, err, types.ErrSignatureVerification)
+			require.ErrorContains(t, err, tc.err)
+			require.Nil(t, signers)
+		})
+	}
+}
+
+func TestAttestationVerifierBLS(t *testing.T) {
+	attesters := attester.DeterministicBLSSet([]byte("bls"), 10)
+	verifier := types.NewAttestationVerifier(attesters.Attesters(), 7)
+	message := sample.Messages()[0]
+
+	// a threshold of 7 needs a 2 byte bitmap and a single aggregate signature
+	attestation := attesters.AttestBy(message, 0, 1, 2, 4, 5, 8, 9)
+	require.Len(t, attestation, 2+types.BLSAggregateSigLength)
+
+	signers, err := verifier.VerifyBLS(message, attestation)
+	require.NoError(t, err)
+	require.Len(t, signers, 7)
+
+	signers, err = verifier.VerifyBLS(message, attesters.Attest(message))
+	require.NoError(t, err)
+	require.Len(t, signers, 10)
+
+	original := bytes.Clone(attestation)
+	_, err = verifier.VerifyKeyType(types.KeyType_KEY_TYPE_BLS12_381, message, attestation)
+	require.NoError(t, err)
+	require.Equal(t, original, attestation)
+}
+
+func TestAttestationVerifierBLSInvalid(t *testing.T) {
+	attesters := attester.DeterministicBLSSet([]byte("bls"), 4)
+	message := sample.Messages()[0]
+
+	// the bitmap claims signers 0 and 1, but only 0 and 2 signed
+	wrongSigners := attesters.AttestBy(message, 0, 2)
+	wrongSigners[0] = 0b0011
+
+	outOfRange := attesters.AttestBy(message, 0, 1)
+	outOfRange[0] |= 1 << 4
+
+	for _, tc := range []struct {
+		name        string
+		threshold   uint32
+		attestation []byte
+		err         string
+	}{
+		{name: "invalid length", threshold: 2, attestation: attesters.Attest(message)[:types.BLSAggregateSigLength], err: "invalid attestation length"},
+		{name: "zero threshold", threshold: 0, attestation: attesters.Attest(message), err: "threshold cannot be 0"},
+		{name: "not enough signers", threshold: 3, attestation: attesters.AttestBy(message, 0, 1), err: "not enough signers"},
+		{name: "signer out of range", threshold: 2, attestation: outOfRange, err: "not an attester"},
+		{name: "wrong signers", threshold: 2, attestation: wrongSigners, err: "invalid aggregate signature"},
+		{name: "wrong message", threshold: 2, attestation: attesters.AttestBy(sample.Messages()[1], 0, 1), err: "invalid aggregate signature"},
+		{name: "malformed signature", threshold: 2, attestation: append([]byte{0b0011}, make([]byte, types.BLSAggregateSigLength)...), err: "invalid aggregate signature"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			signers, err := types.NewAttestationVerifier(attesters.Attesters(), tc.threshold).VerifyBLS(message, tc.attestation)
+			require.ErrorIs(t, err, types.ErrSignatureVerification)
+			require.ErrorContains(t, err, tc.err)
+			require.Nil(t, signers)
+		})
+	}
+}
+
+func TestValidateAttesterKey(t *testing.T) {
+	bls := attester.DeterministicBLSSet([]byte("bls"), 1)
+
+	require.NoError(t, types.ValidateAttesterKey(types.KeyType_KEY_TYPE_SECP256K1, attester.GenerateSet(1)[0].Address().Bytes()))
+	require.NoError(t, types.ValidateAttesterKey(types.KeyType_KEY_TYPE_ED25519, make([]byte, types.Ed25519PublicKeyLength)))
+	require.NoError(t, types.ValidateAttesterKey(types.KeyType_KEY_TYPE_BLS12_381, bls.PublicKey(0)))
+
+	require.ErrorIs(t, types.ValidateAttesterKey(types.KeyType_KEY_TYPE_SECP256K1, nil), types.ErrInvalidAddress)
+	require.ErrorIs(t, types.ValidateAttesterKey(types.KeyType_KEY_TYPE_ED25519, make([]byte, 33)), types.ErrInvalidAddress)
+	require.ErrorIs(t, types.ValidateAttesterKey(types.KeyType_KEY_TYPE_BLS12_381, bls.PublicKey(0)[1:]), types.ErrInvalidAddress)
+	require.ErrorIs(t, types.ValidateAttesterKey(types.KeyType_KEY_TYPE_BLS12_381, make([]byte, types.BLSPublicKeyLength)), types.ErrInvalidAddress)
+	require.ErrorIs(t, types.ValidateAttesterKey(types.KeyType(9), []byte{1}), types.ErrInvalidAddress)
+}
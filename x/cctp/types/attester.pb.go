@@ -22,11 +22,86 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// *
+// The signature scheme of an attester key
+// @param KEY_TYPE_SECP256K1 65 byte recoverable ECDSA signatures, one per signer
+// @param KEY_TYPE_ED25519 32 byte public key and 64 byte signature, one pair per signer
+// @param KEY_TYPE_BLS12_381 signer bitmap followed by a single 96 byte aggregate signature
+type KeyType int32
+
+const (
+	KeyType_KEY_TYPE_SECP256K1 KeyType = 0
+	KeyType_KEY_TYPE_ED25519   KeyType = 1
+	KeyType_KEY_TYPE_BLS12_381 KeyType = 2
+)
+
+var KeyType_name = map[int32]string{
+	0: "KEY_TYPE_SECP256K1",
+	1: "KEY_TYPE_ED25519",
+	2: "KEY_TYPE_BLS12_381",
+}
+
+var KeyType_value = map[string]int32{
+	"KEY_TYPE_SECP256K1": 0,
+	"KEY_TYPE_ED25519":   1,
+	"KEY_TYPE_BLS12_381": 2,
+}
+
+func (x KeyType) String() string {
+	return proto.EnumName(KeyType_name, int32(x))
+}
+
+func (KeyType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c84b7240ac7d2071, []int{0}
+}
+
+// *
+// Whether an attester currently counts towards the signature threshold
+// @param ATTESTER_STATUS_ENABLED the attester's signatures are accepted
+// @param ATTESTER_STATUS_DISABLED the attester was disabled, its record is kept for auditing
+type AttesterStatus int32
+
+const (
+	AttesterStatus_ATTESTER_STATUS_ENABLED  AttesterStatus = 0
+	AttesterStatus_ATTESTER_STATUS_DISABLED AttesterStatus = 1
+)
+
+var AttesterStatus_name = map[int32]string{
+	0: "ATTESTER_STATUS_ENABLED",
+	1: "ATTESTER_STATUS_DISABLED",
+}
+
+var AttesterStatus_value = map[string]int32{
+	"ATTESTER_STATUS_ENABLED":  0,
+	"ATTESTER_STATUS_DISABLED": 1,
+}
+
+func (x AttesterStatus) String() string {
+	return proto.EnumName(AttesterStatus_name, int32(x))
+}
+
+func (AttesterStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c84b7240ac7d2071, []int{1}
+}
+
 // *
 // A public key used to verify message signatures
-// @param attester ECDSA uncompressed public key, hex encoded
+// @param attester public key, hex encoded. ECDSA uncompressed for secp256k1,
+// 32 bytes for ed25519 and 48 bytes compressed G1 for BLS12-381
+// @param key_type signature scheme of the public key
+// @param operator free form label of who operates the key
+// @param added_at_height block height at which the attester was last enabled
+// @param status whether the attester is enabled or disabled
+// @param disabled_at_height block height at which the attester was disabled
+// @param disable_reason reason given by the attester manager when disabling
 type Attester struct {
-	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	Attester         string         `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	KeyType          KeyType        `protobuf:"varint,2,opt,name=key_type,json=keyType,proto3,enum=circle.cctp.v1.KeyType" json:"key_type,omitempty"`
+	Operator         string         `protobuf:"bytes,3,opt,name=operator,proto3" json:"operator,omitempty"`
+	AddedAtHeight    int64          `protobuf:"varint,4,opt,name=added_at_height,json=addedAtHeight,proto3" json:"added_at_height,omitempty"`
+	Status           AttesterStatus `protobuf:"varint,5,opt,name=status,proto3,enum=circle.cctp.v1.AttesterStatus" json:"status,omitempty"`
+	DisabledAtHeight int64          `protobuf:"varint,6,opt,name=disabled_at_height,json=disabledAtHeight,proto3" json:"disabled_at_height,omitempty"`
+	DisableReason    string         `protobuf:"bytes,7,opt,name=disable_reason,json=disableReason,proto3" json:"disable_reason,omitempty"`
 }
 
 func (m *Attester) Reset()         { *m = Attester{} }
@@ -69,24 +144,200 @@ func (m *Attester) GetAttester() string {
 	return ""
 }
 
+func (m *Attester) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_SECP256K1
+}
+
+func (m *Attester) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *Attester) GetAddedAtHeight() int64 {
+	if m != nil {
+		return m.AddedAtHeight
+	}
+	return 0
+}
+
+func (m *Attester) GetStatus() AttesterStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AttesterStatus_ATTESTER_STATUS_ENABLED
+}
+
+func (m *Attester) GetDisabledAtHeight() int64 {
+	if m != nil {
+		return m.DisabledAtHeight
+	}
+	return 0
+}
+
+func (m *Attester) GetDisableReason() string {
+	if m != nil {
+		return m.DisableReason
+	}
+	return ""
+}
+
+// *
+// A single change to the attester set
+// @param id sequence number of the change, starting at 0
+// @param attester public key of the attester, hex encoded
+// @param key_type signature scheme of the public key
+// @param status status of the attester after the change
+// @param operator operator label at the time of the change
+// @param reason reason given when disabling the attester
+// @param height block height of the change
+// @param authority attester manager who made the change
+type AttesterHistoryEntry struct {
+	Id        uint64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Attester  string         `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	KeyType   KeyType        `protobuf:"varint,3,opt,name=key_type,json=keyType,proto3,enum=circle.cctp.v1.KeyType" json:"key_type,omitempty"`
+	Status    AttesterStatus `protobuf:"varint,4,opt,name=status,proto3,enum=circle.cctp.v1.AttesterStatus" json:"status,omitempty"`
+	Operator  string         `protobuf:"bytes,5,opt,name=operator,proto3" json:"operator,omitempty"`
+	Reason    string         `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height    int64          `protobuf:"varint,7,opt,name=height,proto3" json:"height,omitempty"`
+	Authority string         `protobuf:"bytes,8,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *AttesterHistoryEntry) Reset()         { *m = AttesterHistoryEntry{} }
+func (m *AttesterHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*AttesterHistoryEntry) ProtoMessage()    {}
+func (*AttesterHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c84b7240ac7d2071, []int{1}
+}
+func (m *AttesterHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterHistoryEntry.Merge(m, src)
+}
+func (m *AttesterHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterHistoryEntry proto.InternalMessageInfo
+
+func (m *AttesterHistoryEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *AttesterHistoryEntry) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
+
+func (m *AttesterHistoryEntry) GetKeyType() KeyType {
+	if m != nil {
+		return m.KeyType
+	}
+	return KeyType_KEY_TYPE_SECP256K1
+}
+
+func (m *AttesterHistoryEntry) GetStatus() AttesterStatus {
+	if m != nil {
+		return m.Status
+	}
+	return AttesterStatus_ATTESTER_STATUS_ENABLED
+}
+
+func (m *AttesterHistoryEntry) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
+func (m *AttesterHistoryEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AttesterHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *AttesterHistoryEntry) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("circle.cctp.v1.KeyType", KeyType_name, KeyType_value)
+	proto.RegisterEnum("circle.cctp.v1.AttesterStatus", AttesterStatus_name, AttesterStatus_value)
 	proto.RegisterType((*Attester)(nil), "circle.cctp.v1.Attester")
+	proto.RegisterType((*AttesterHistoryEntry)(nil), "circle.cctp.v1.AttesterHistoryEntry")
 }
 
 func init() { proto.RegisterFile("circle/cctp/v1/attester.proto", fileDescriptor_c84b7240ac7d2071) }
 
 var fileDescriptor_c84b7240ac7d2071 = []byte{
-	// 155 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xce, 0x2c, 0x4a,
-	0xce, 0x49, 0xd5, 0x4f, 0x4e, 0x2e, 0x29, 0xd0, 0x2f, 0x33, 0xd4, 0x4f, 0x2c, 0x29, 0x49, 0x2d,
-	0x2e, 0x49, 0x2d, 0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x48, 0xeb, 0x81, 0xa4,
-	0xf5, 0xca, 0x0c, 0x95, 0xd4, 0xb8, 0x38, 0x1c, 0xa1, 0x2a, 0x84, 0xa4, 0xb8, 0x38, 0x60, 0xaa,
-	0x25, 0x18, 0x15, 0x18, 0x35, 0x38, 0x83, 0xe0, 0x7c, 0x27, 0xb7, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
-	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
-	0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x49, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf,
-	0xd5, 0x87, 0x18, 0x9e, 0x96, 0x99, 0xa7, 0x9f, 0x97, 0x9f, 0x94, 0x93, 0xaa, 0x0b, 0x76, 0x44,
-	0x05, 0xc4, 0x2d, 0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x67, 0x18, 0x03, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xa3, 0x02, 0x78, 0x2c, 0xa7, 0x00, 0x00, 0x00,
+	// 486 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xc1, 0x6e, 0x9b, 0x40,
+	0x10, 0x05, 0xec, 0x60, 0x67, 0xa4, 0x50, 0xb4, 0x8a, 0x12, 0xd4, 0xa6, 0xc8, 0x8a, 0xd4, 0xca,
+	0x8a, 0x52, 0x10, 0x44, 0x8e, 0xda, 0x23, 0xae, 0xa9, 0x52, 0x39, 0x6a, 0x23, 0xa0, 0x87, 0xf4,
+	0x82, 0x30, 0x6c, 0x63, 0x14, 0xd7, 0xa0, 0x65, 0x1d, 0x95, 0xbf, 0xe8, 0xb1, 0xff, 0xd0, 0x1f,
+	0xe9, 0x31, 0xc7, 0x1e, 0x2b, 0xfb, 0x47, 0x2a, 0x2f, 0x8b, 0x53, 0x7c, 0xa8, 0xda, 0x1b, 0xf3,
+	0xde, 0x63, 0xde, 0xec, 0x1b, 0x0d, 0x3c, 0x8d, 0x53, 0x12, 0xcf, 0xb0, 0x19, 0xc7, 0x34, 0x37,
+	0xef, 0x2c, 0x33, 0xa2, 0x14, 0x17, 0x14, 0x13, 0x23, 0x27, 0x19, 0xcd, 0x90, 0x52, 0xd1, 0xc6,
+	0x9a, 0x36, 0xee, 0xac, 0xe3, 0xef, 0x12, 0x74, 0x1d, 0x2e, 0x41, 0x8f, 0xa1, 0x5b, 0xcb, 0x35,
+	0xb1, 0x27, 0xf6, 0x77, 0xbd, 0x4d, 0x8d, 0x6c, 0xe8, 0xde, 0xe2, 0x32, 0xa4, 0x65, 0x8e, 0x35,
+	0xa9, 0x27, 0xf6, 0x15, 0xfb, 0xd0, 0x68, 0xf6, 0x32, 0xc6, 0xb8, 0x0c, 0xca, 0x1c, 0x7b, 0x9d,
+	0xdb, 0xea, 0x63, 0xdd, 0x2f, 0xcb, 0x31, 0x89, 0x68, 0x46, 0xb4, 0x56, 0xd5, 0xaf, 0xae, 0xd1,
+	0x73, 0x78, 0x14, 0x25, 0x09, 0x4e, 0xc2, 0x88, 0x86, 0x53, 0x9c, 0xde, 0x4c, 0xa9, 0xd6, 0xee,
+	0x89, 0xfd, 0x96, 0xb7, 0xc7, 0x60, 0x87, 0x5e, 0x30, 0x10, 0x9d, 0x83, 0x5c, 0xd0, 0x88, 0x2e,
+	0x0a, 0x6d, 0x87, 0xb9, 0xea, 0xdb, 0xae, 0xf5, 0xf4, 0x3e, 0x53, 0x79, 0x5c, 0x8d, 0x4e, 0x01,
+	0x25, 0x69, 0x11, 0x4d, 0x66, 0x0d, 0x0b, 0x99, 0x59, 0xa8, 0x35, 0xb3, 0x71, 0x79, 0x06, 0x0a,
+	0xc7, 0x42, 0x82, 0xa3, 0x22, 0x9b, 0x6b, 0x1d, 0x36, 0xef, 0x1e, 0x47, 0x3d, 0x06, 0x1e, 0x7f,
+	0x93, 0x60, 0xbf, 0xf6, 0xbb, 0x48, 0x0b, 0x9a, 0x91, 0xd2, 0x9d, 0x53, 0x52, 0x22, 0x05, 0xa4,
+	0x34, 0x61, 0x99, 0xb5, 0x3d, 0x29, 0x4d, 0x1a, 0x49, 0x4a, 0x7f, 0x49, 0xb2, 0xf5, 0x8f, 0x49,
+	0x3e, 0xa4, 0xd0, 0xfe, 0xaf, 0x14, 0xfe, 0xdc, 0xc0, 0xce, 0xd6, 0x06, 0x0e, 0x40, 0xe6, 0x6f,
+	0x95, 0x19, 0xc3, 0xab, 0x35, 0xce, 0xd3, 0xea, 0xb0, 0xb4, 0x78, 0x85, 0x8e, 0x60, 0x37, 0x5a,
+	0xd0, 0x69, 0x46, 0x52, 0x5a, 0x6a, 0x5d, 0xf6, 0xcb, 0x03, 0x70, 0xf2, 0x1e, 0x3a, 0x7c, 0x6a,
+	0x74, 0x00, 0x68, 0xec, 0x5e, 0x87, 0xc1, 0xf5, 0x95, 0x1b, 0xfa, 0xee, 0xeb, 0x2b, 0x7b, 0x70,
+	0x3e, 0xb6, 0x54, 0x01, 0xed, 0x83, 0xba, 0xc1, 0xdd, 0x91, 0x3d, 0x18, 0x58, 0xaf, 0x54, 0xb1,
+	0xa1, 0x1e, 0x5e, 0xfa, 0x96, 0x1d, 0x9e, 0xbd, 0xb4, 0x54, 0xe9, 0x64, 0x0c, 0x4a, 0xf3, 0x51,
+	0xe8, 0x09, 0x1c, 0x3a, 0x41, 0xe0, 0xfa, 0x81, 0xeb, 0x85, 0x7e, 0xe0, 0x04, 0x1f, 0xfc, 0xd0,
+	0x7d, 0xe7, 0x0c, 0x2f, 0xdd, 0x91, 0x2a, 0xa0, 0x23, 0xd0, 0xb6, 0xc9, 0xd1, 0x5b, 0xbf, 0x62,
+	0xc5, 0xe1, 0x9b, 0x1f, 0x4b, 0x5d, 0xbc, 0x5f, 0xea, 0xe2, 0xaf, 0xa5, 0x2e, 0x7e, 0x5d, 0xe9,
+	0xc2, 0xfd, 0x4a, 0x17, 0x7e, 0xae, 0x74, 0xe1, 0xe3, 0xe9, 0x4d, 0x4a, 0xa7, 0x8b, 0x89, 0x11,
+	0x67, 0x9f, 0xcd, 0x2a, 0xd3, 0x4f, 0xe9, 0xdc, 0x9c, 0x67, 0x93, 0x19, 0x7e, 0xc1, 0x6e, 0xe8,
+	0x4b, 0x75, 0x4a, 0xeb, 0x75, 0x15, 0x13, 0x99, 0x5d, 0xd1, 0xd9, 0xef, 0x01, 0x00, 0x42, 0x88,
+	0xba, 0x96, 0x66, 0x03, 0x00, 0x00,
 }
 
 func (m *Attester) Marshal() (dAtA []byte, err error) {
@@ -109,6 +360,40 @@ func (m *Attester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DisableReason) > 0 {
+		i -= len(m.DisableReason)
+		copy(dAtA[i:], m.DisableReason)
+		i = encodeVarintAttester(dAtA, i, uint64(len(m.DisableReason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.DisabledAtHeight != 0 {
+		i = encodeVarintAttester(dAtA, i, uint64(m.DisabledAtHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Status != 0 {
+		i = encodeVarintAttester(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.AddedAtHeight != 0 {
+		i = encodeVarintAttester(dAtA, i, uint64(m.AddedAtHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintAttester(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintAttester(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Attester) > 0 {
 		i -= len(m.Attester)
 		copy(dAtA[i:], m.Attester)
@@ -119,6 +404,77 @@ func (m *Attester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AttesterHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintAttester(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Height != 0 {
+		i = encodeVarintAttester(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintAttester(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintAttester(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintAttester(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintAttester(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintAttester(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintAttester(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAttester(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAttester(v)
 	base := offset
@@ -140,6 +496,63 @@ func (m *Attester) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAttester(uint64(l))
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovAttester(uint64(m.KeyType))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAttester(uint64(l))
+	}
+	if m.AddedAtHeight != 0 {
+		n += 1 + sovAttester(uint64(m.AddedAtHeight))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAttester(uint64(m.Status))
+	}
+	if m.DisabledAtHeight != 0 {
+		n += 1 + sovAttester(uint64(m.DisabledAtHeight))
+	}
+	l = len(m.DisableReason)
+	if l > 0 {
+		n += 1 + l + sovAttester(uint64(l))
+	}
+	return n
+}
+
+func (m *AttesterHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovAttester(uint64(m.Id))
+	}
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovAttester(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovAttester(uint64(m.KeyType))
+	}
+	if m.Status != 0 {
+		n += 1 + sovAttester(uint64(m.Status))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovAttester(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovAttester(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovAttester(uint64(m.Height))
+	}
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovAttester(uint64(l))
+	}
 	return n
 }
 
@@ -210,6 +623,400 @@ func (m *Attester) Unmarshal(dAtA []byte) error {
 			}
 			m.Attester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttester
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttester
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddedAtHeight", wireType)
+			}
+			m.AddedAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AddedAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AttesterStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledAtHeight", wireType)
+			}
+			m.DisabledAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisabledAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisableReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttester
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttester
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisableReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttester(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttester
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttester
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttester
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttester
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AttesterStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttester
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttester
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttester
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttester
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttester
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttester
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttester
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAttester(dAtA[iNdEx:])
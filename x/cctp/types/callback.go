@@ -0,0 +1,45 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import "cosmossdk.io/math"
+
+// ContractCallback is the sudo message sent to a CosmWasm contract that
+// receives a message or a mint. Exactly one field is set.
+type ContractCallback struct {
+	ReceiveMessage *ReceiveMessageCallback `json:"cctp_receive_message,omitempty"`
+	Mint           *MintCallback           `json:"cctp_mint,omitempty"`
+}
+
+// ReceiveMessageCallback is sent to a contract that is the recipient of a
+// received message.
+type ReceiveMessageCallback struct {
+	SourceDomain uint32 `json:"source_domain"`
+	Sender       []byte `json:"sender"`
+	Nonce        uint64 `json:"nonce"`
+	MessageBody  []byte `json:"message_body"`
+}
+
+// MintCallback is sent to a contract that is the mint recipient of a received
+// burn message, after the amount is minted to it.
+type MintCallback struct {
+	SourceDomain  uint32   `json:"source_domain"`
+	Nonce         uint64   `json:"nonce"`
+	MessageSender []byte   `json:"message_sender"`
+	Amount        math.Int `json:"amount"`
+	Denom         string   `json:"denom"`
+}
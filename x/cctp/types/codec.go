@@ -30,6 +30,9 @@ func init() {
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgAcceptOwner{}, "cctp/AcceptOwner", nil)
+	cdc.RegisterConcrete(&MsgAcceptAttesterManager{}, "cctp/AcceptAttesterManager", nil)
+	cdc.RegisterConcrete(&MsgAcceptPauser{}, "cctp/AcceptPauser", nil)
+	cdc.RegisterConcrete(&MsgAcceptTokenController{}, "cctp/AcceptTokenController", nil)
 	cdc.RegisterConcrete(&MsgAddRemoteTokenMessenger{}, "cctp/AddRemoteTokenMessenger", nil)
 	cdc.RegisterConcrete(&MsgDepositForBurn{}, "cctp/DepositForBurn", nil)
 	cdc.RegisterConcrete(&MsgDepositForBurnWithCaller{}, "cctp/DepositForBurnWithCaller", nil)
@@ -54,11 +57,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateMaxMessageBodySize{}, "cctp/UpdateMaxMessageBodySize", nil)
 	cdc.RegisterConcrete(&MsgSetMaxBurnAmountPerMessage{}, "cctp/SetMaxBurnAmountPerMessage", nil)
 	cdc.RegisterConcrete(&MsgUpdateSignatureThreshold{}, "cctp/UpdateSignatureThreshold", nil)
+	cdc.RegisterConcrete(&MsgSetForwarding{}, "cctp/SetForwarding", nil)
+	cdc.RegisterConcrete(&MsgRemoveForwarding{}, "cctp/RemoveForwarding", nil)
+	cdc.RegisterConcrete(&MsgRegisterAccount32{}, "cctp/RegisterAccount32", nil)
+	cdc.RegisterConcrete(&MsgEnableContractCallback{}, "cctp/EnableContractCallback", nil)
+	cdc.RegisterConcrete(&MsgDisableContractCallback{}, "cctp/DisableContractCallback", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgAcceptOwner{},
+		&MsgAcceptAttesterManager{},
+		&MsgAcceptPauser{},
+		&MsgAcceptTokenController{},
 		&MsgAddRemoteTokenMessenger{},
 		&MsgDepositForBurn{},
 		&MsgDepositForBurnWithCaller{},
@@ -83,6 +94,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateMaxMessageBodySize{},
 		&MsgSetMaxBurnAmountPerMessage{},
 		&MsgUpdateSignatureThreshold{},
+		&MsgSetForwarding{},
+		&MsgRemoveForwarding{},
+		&MsgRegisterAccount32{},
+		&MsgEnableContractCallback{},
+		&MsgDisableContractCallback{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -41,6 +41,7 @@ const (
 	// 4 byte version + 32 bytes burnToken + 32 bytes mintRecipient + 32 bytes amount + 32 bytes messageSender
 	BurnMessageLen = 132
 
+	// Defaults of the LocalDomain, used when the module config does not set it
 	NobleMessageVersion = 0
 	MessageBodyVersion  = 0
 	NobleDomainId       = 4
@@ -57,4 +58,15 @@ const (
 	BaseTen                     = 10
 
 	SignatureLength = 65
+
+	// Lengths of ed25519 and BLS12-381 attester keys and signatures
+	Ed25519PublicKeyLength  = 32
+	Ed25519SignatureLength  = 64
+	BLSPublicKeyLength      = 48
+	BLSAggregateSigLength   = 96
+	Ed25519AttestationEntry = Ed25519PublicKeyLength + Ed25519SignatureLength
 )
+
+// BLSDomainSeparationTag is the hash-to-curve domain separation tag of the
+// BLS12-381 attestation scheme (minimal-pubkey-size, proof of possession).
+var BLSDomainSeparationTag = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
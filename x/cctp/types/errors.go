@@ -50,6 +50,29 @@ var (
 	ErrParsingMessage                   = errors.Register(ModuleName, 54, "error while parsing message into bytes")
 	ErrParsingBurnMessage               = errors.Register(ModuleName, 55, "error while parsing burn message into bytes")
 	ErrInvalidRemoteToken               = errors.Register(ModuleName, 56, "invalid remote token")
+	ErrSendingAndReceivingPaused        = errors.Register(ModuleName, 57, "sending and receiving messages are paused")
+	ErrBurningAndMintingPaused          = errors.Register(ModuleName, 58, "burning and minting are paused")
+	ErrAttestersNotFound                = errors.Register(ModuleName, 59, "no attesters found")
+	ErrSignatureThresholdNotFound       = errors.Register(ModuleName, 60, "signature threshold not found")
+	ErrInvalidDestinationDomain         = errors.Register(ModuleName, 61, "invalid destination domain")
+	ErrDestinationCallerMismatch        = errors.Register(ModuleName, 62, "sender is not the destination caller")
+	ErrInvalidMessageVersion            = errors.Register(ModuleName, 63, "invalid message version")
+	ErrInvalidMessageBodyVersion        = errors.Register(ModuleName, 64, "invalid message body version")
+	ErrNonceAlreadyUsed                 = errors.Register(ModuleName, 65, "nonce already used")
+	ErrInvalidMessageSender             = errors.Register(ModuleName, 66, "invalid message sender")
+	ErrInvalidMintRecipient             = errors.Register(ModuleName, 67, "invalid mint recipient")
+	ErrBurnTokenNotSupported            = errors.Register(ModuleName, 68, "burn token not supported")
+	ErrBurnLimitExceeded                = errors.Register(ModuleName, 69, "per message burn limit exceeded")
+	ErrInvalidSourceDomain              = errors.Register(ModuleName, 70, "invalid source domain")
+	ErrMessageBodyTooLarge              = errors.Register(ModuleName, 71, "message body exceeds max size")
+	ErrInvalidRecipient                 = errors.Register(ModuleName, 72, "invalid recipient")
+	ErrInvalidRoleTransferExpiry        = errors.Register(ModuleName, 73, "role transfer expiry must be in the future")
+	ErrRoleTransferExpired              = errors.Register(ModuleName, 74, "role transfer has expired")
+	ErrContractCallback                 = errors.Register(ModuleName, 75, "contract callback failed")
+	ErrInvalidMemo                      = errors.Register(ModuleName, 76, "invalid cctp memo")
+	ErrForwardingNotFound               = errors.Register(ModuleName, 77, "forwarding not found")
+	ErrAccount32AlreadyRegistered       = errors.Register(ModuleName, 78, "32-byte account already registered")
+	ErrContractCallbackNotEnabled       = errors.Register(ModuleName, 79, "contract callback not enabled")
 
 	ErrInvalidAddress = errors.Register(ModuleName, 100, "invalid address")
 )
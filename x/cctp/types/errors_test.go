@@ -0,0 +1,73 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/errors"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	"github.com/stretchr/testify/require"
+)
+
+// Relayers decide whether to retry from the ABCI code alone, so every
+// failure class must keep its own code and resolve back to its error.
+func TestErrorCodes(t *testing.T) {
+	errs := []*errors.Error{
+		types.ErrSendingAndReceivingPaused,
+		types.ErrBurningAndMintingPaused,
+		types.ErrAttestersNotFound,
+		types.ErrSignatureThresholdNotFound,
+		types.ErrSignatureVerification,
+		types.ErrParsingMessage,
+		types.ErrParsingBurnMessage,
+		types.ErrInvalidDestinationDomain,
+		types.ErrInvalidDestinationCaller,
+		types.ErrDestinationCallerMismatch,
+		types.ErrInvalidMessageVersion,
+		types.ErrInvalidMessageBodyVersion,
+		types.ErrNonceAlreadyUsed,
+		types.ErrTokenPairNotFound,
+		types.ErrRemoteTokenMessengerNotFound,
+		types.ErrInvalidMessageSender,
+		types.ErrInvalidMintRecipient,
+		types.ErrInvalidAmount,
+		types.ErrBurnTokenNotSupported,
+		types.ErrBurnLimitExceeded,
+		types.ErrInvalidSourceDomain,
+		types.ErrMessageBodyTooLarge,
+		types.ErrInvalidRecipient,
+		types.ErrInvalidRoleTransferExpiry,
+		types.ErrRoleTransferExpired,
+		types.ErrContractCallback,
+		types.ErrInvalidMemo,
+		types.ErrForwardingNotFound,
+		types.ErrAccount32AlreadyRegistered,
+		types.ErrContractCallbackNotEnabled,
+	}
+
+	codes := make(map[uint32]string)
+	for _, err := range errs {
+		require.Equal(t, types.ModuleName, err.Codespace())
+		if other, found := codes[err.ABCICode()]; found {
+			t.Fatalf("%q and %q share code %d", err, other, err.ABCICode())
+		}
+		codes[err.ABCICode()] = err.Error()
+
+		require.ErrorIs(t, errors.ABCIError(err.Codespace(), err.ABCICode(), "log"), err)
+	}
+}
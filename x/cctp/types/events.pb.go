@@ -27,8 +27,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // *
 // Emitted when an attester is enabled
 // @param attester newly enabled attester
+// @param operator operator label of the attester
 type AttesterEnabled struct {
 	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	Operator string `protobuf:"bytes,2,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
 func (m *AttesterEnabled) Reset()         { *m = AttesterEnabled{} }
@@ -71,11 +73,20 @@ func (m *AttesterEnabled) GetAttester() string {
 	return ""
 }
 
+func (m *AttesterEnabled) GetOperator() string {
+	if m != nil {
+		return m.Operator
+	}
+	return ""
+}
+
 // *
 // Emitted when an attester is disabled
 // @param attester newly disabled attester
+// @param reason reason for disabling the attester
 type AttesterDisabled struct {
 	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	Reason   string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *AttesterDisabled) Reset()         { *m = AttesterDisabled{} }
@@ -118,6 +129,13 @@ func (m *AttesterDisabled) GetAttester() string {
 	return ""
 }
 
+func (m *AttesterDisabled) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // *
 // Emitted when threshold number of attestations (m in m/n multisig) is updated
 // @param old_signature_threshold old signature threshold
@@ -234,9 +252,11 @@ func (m *OwnerUpdated) GetNewOwner() string {
 // Emitted when starting the two stage transfer ownership process
 // @param previousOwner representing the address of the previous owner
 // @param newOwner representing the address of the new owner
+// @param expires_at_height last block height at which the transfer can be accepted, 0 if it never expires
 type OwnershipTransferStarted struct {
-	PreviousOwner string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
-	NewOwner      string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	PreviousOwner   string `protobuf:"bytes,1,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner        string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	ExpiresAtHeight int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *OwnershipTransferStarted) Reset()         { *m = OwnershipTransferStarted{} }
@@ -286,6 +306,208 @@ func (m *OwnershipTransferStarted) GetNewOwner() string {
 	return ""
 }
 
+func (m *OwnershipTransferStarted) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+// *
+// Emitted when starting the two stage transfer of the attester manager role
+// @param previous_attester_manager representing the address of the previous attester manager
+// @param new_attester_manager representing the address of the new attester manager
+// @param expires_at_height last block height at which the transfer can be accepted, 0 if it never expires
+type AttesterManagerTransferStarted struct {
+	PreviousAttesterManager string `protobuf:"bytes,1,opt,name=previous_attester_manager,json=previousAttesterManager,proto3" json:"previous_attester_manager,omitempty"`
+	NewAttesterManager      string `protobuf:"bytes,2,opt,name=new_attester_manager,json=newAttesterManager,proto3" json:"new_attester_manager,omitempty"`
+	ExpiresAtHeight         int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (m *AttesterManagerTransferStarted) Reset()         { *m = AttesterManagerTransferStarted{} }
+func (m *AttesterManagerTransferStarted) String() string { return proto.CompactTextString(m) }
+func (*AttesterManagerTransferStarted) ProtoMessage()    {}
+func (*AttesterManagerTransferStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{5}
+}
+func (m *AttesterManagerTransferStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttesterManagerTransferStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttesterManagerTransferStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttesterManagerTransferStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttesterManagerTransferStarted.Merge(m, src)
+}
+func (m *AttesterManagerTransferStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttesterManagerTransferStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttesterManagerTransferStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttesterManagerTransferStarted proto.InternalMessageInfo
+
+func (m *AttesterManagerTransferStarted) GetPreviousAttesterManager() string {
+	if m != nil {
+		return m.PreviousAttesterManager
+	}
+	return ""
+}
+
+func (m *AttesterManagerTransferStarted) GetNewAttesterManager() string {
+	if m != nil {
+		return m.NewAttesterManager
+	}
+	return ""
+}
+
+func (m *AttesterManagerTransferStarted) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+// *
+// Emitted when starting the two stage transfer of the pauser role
+// @param previous_pauser representing the address of the previous pauser
+// @param new_pauser representing the address of the new pauser
+// @param expires_at_height last block height at which the transfer can be accepted, 0 if it never expires
+type PauserTransferStarted struct {
+	PreviousPauser  string `protobuf:"bytes,1,opt,name=previous_pauser,json=previousPauser,proto3" json:"previous_pauser,omitempty"`
+	NewPauser       string `protobuf:"bytes,2,opt,name=new_pauser,json=newPauser,proto3" json:"new_pauser,omitempty"`
+	ExpiresAtHeight int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (m *PauserTransferStarted) Reset()         { *m = PauserTransferStarted{} }
+func (m *PauserTransferStarted) String() string { return proto.CompactTextString(m) }
+func (*PauserTransferStarted) ProtoMessage()    {}
+func (*PauserTransferStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{6}
+}
+func (m *PauserTransferStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauserTransferStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauserTransferStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauserTransferStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauserTransferStarted.Merge(m, src)
+}
+func (m *PauserTransferStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauserTransferStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauserTransferStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauserTransferStarted proto.InternalMessageInfo
+
+func (m *PauserTransferStarted) GetPreviousPauser() string {
+	if m != nil {
+		return m.PreviousPauser
+	}
+	return ""
+}
+
+func (m *PauserTransferStarted) GetNewPauser() string {
+	if m != nil {
+		return m.NewPauser
+	}
+	return ""
+}
+
+func (m *PauserTransferStarted) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
+// *
+// Emitted when starting the two stage transfer of the token controller role
+// @param previous_token_controller representing the address of the previous token controller
+// @param new_token_controller representing the address of the new token controller
+// @param expires_at_height last block height at which the transfer can be accepted, 0 if it never expires
+type TokenControllerTransferStarted struct {
+	PreviousTokenController string `protobuf:"bytes,1,opt,name=previous_token_controller,json=previousTokenController,proto3" json:"previous_token_controller,omitempty"`
+	NewTokenController      string `protobuf:"bytes,2,opt,name=new_token_controller,json=newTokenController,proto3" json:"new_token_controller,omitempty"`
+	ExpiresAtHeight         int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
+}
+
+func (m *TokenControllerTransferStarted) Reset()         { *m = TokenControllerTransferStarted{} }
+func (m *TokenControllerTransferStarted) String() string { return proto.CompactTextString(m) }
+func (*TokenControllerTransferStarted) ProtoMessage()    {}
+func (*TokenControllerTransferStarted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{7}
+}
+func (m *TokenControllerTransferStarted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenControllerTransferStarted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenControllerTransferStarted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenControllerTransferStarted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenControllerTransferStarted.Merge(m, src)
+}
+func (m *TokenControllerTransferStarted) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenControllerTransferStarted) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenControllerTransferStarted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenControllerTransferStarted proto.InternalMessageInfo
+
+func (m *TokenControllerTransferStarted) GetPreviousTokenController() string {
+	if m != nil {
+		return m.PreviousTokenController
+	}
+	return ""
+}
+
+func (m *TokenControllerTransferStarted) GetNewTokenController() string {
+	if m != nil {
+		return m.NewTokenController
+	}
+	return ""
+}
+
+func (m *TokenControllerTransferStarted) GetExpiresAtHeight() int64 {
+	if m != nil {
+		return m.ExpiresAtHeight
+	}
+	return 0
+}
+
 // *
 // Emitted when pauser address is updated
 // @param previous_pauser representing the address of the previous pauser
@@ -299,7 +521,7 @@ func (m *PauserUpdated) Reset()         { *m = PauserUpdated{} }
 func (m *PauserUpdated) String() string { return proto.CompactTextString(m) }
 func (*PauserUpdated) ProtoMessage()    {}
 func (*PauserUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{5}
+	return fileDescriptor_e7ce5881ab629356, []int{8}
 }
 func (m *PauserUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -357,7 +579,7 @@ func (m *AttesterManagerUpdated) Reset()         { *m = AttesterManagerUpdated{}
 func (m *AttesterManagerUpdated) String() string { return proto.CompactTextString(m) }
 func (*AttesterManagerUpdated) ProtoMessage()    {}
 func (*AttesterManagerUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{6}
+	return fileDescriptor_e7ce5881ab629356, []int{9}
 }
 func (m *AttesterManagerUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -415,7 +637,7 @@ func (m *TokenControllerUpdated) Reset()         { *m = TokenControllerUpdated{}
 func (m *TokenControllerUpdated) String() string { return proto.CompactTextString(m) }
 func (*TokenControllerUpdated) ProtoMessage()    {}
 func (*TokenControllerUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{7}
+	return fileDescriptor_e7ce5881ab629356, []int{10}
 }
 func (m *TokenControllerUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +689,7 @@ func (m *BurningAndMintingPausedEvent) Reset()         { *m = BurningAndMintingP
 func (m *BurningAndMintingPausedEvent) String() string { return proto.CompactTextString(m) }
 func (*BurningAndMintingPausedEvent) ProtoMessage()    {}
 func (*BurningAndMintingPausedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{8}
+	return fileDescriptor_e7ce5881ab629356, []int{11}
 }
 func (m *BurningAndMintingPausedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -505,7 +727,7 @@ func (m *BurningAndMintingUnpausedEvent) Reset()         { *m = BurningAndMintin
 func (m *BurningAndMintingUnpausedEvent) String() string { return proto.CompactTextString(m) }
 func (*BurningAndMintingUnpausedEvent) ProtoMessage()    {}
 func (*BurningAndMintingUnpausedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{9}
+	return fileDescriptor_e7ce5881ab629356, []int{12}
 }
 func (m *BurningAndMintingUnpausedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -543,7 +765,7 @@ func (m *SendingAndReceivingPausedEvent) Reset()         { *m = SendingAndReceiv
 func (m *SendingAndReceivingPausedEvent) String() string { return proto.CompactTextString(m) }
 func (*SendingAndReceivingPausedEvent) ProtoMessage()    {}
 func (*SendingAndReceivingPausedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{10}
+	return fileDescriptor_e7ce5881ab629356, []int{13}
 }
 func (m *SendingAndReceivingPausedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -581,7 +803,7 @@ func (m *SendingAndReceivingUnpausedEvent) Reset()         { *m = SendingAndRece
 func (m *SendingAndReceivingUnpausedEvent) String() string { return proto.CompactTextString(m) }
 func (*SendingAndReceivingUnpausedEvent) ProtoMessage()    {}
 func (*SendingAndReceivingUnpausedEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{11}
+	return fileDescriptor_e7ce5881ab629356, []int{14}
 }
 func (m *SendingAndReceivingUnpausedEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -639,7 +861,7 @@ func (m *DepositForBurn) Reset()         { *m = DepositForBurn{} }
 func (m *DepositForBurn) String() string { return proto.CompactTextString(m) }
 func (*DepositForBurn) ProtoMessage()    {}
 func (*DepositForBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{12}
+	return fileDescriptor_e7ce5881ab629356, []int{15}
 }
 func (m *DepositForBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -732,7 +954,7 @@ func (m *MintAndWithdraw) Reset()         { *m = MintAndWithdraw{} }
 func (m *MintAndWithdraw) String() string { return proto.CompactTextString(m) }
 func (*MintAndWithdraw) ProtoMessage()    {}
 func (*MintAndWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{13}
+	return fileDescriptor_e7ce5881ab629356, []int{16}
 }
 func (m *MintAndWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +1012,7 @@ func (m *TokenPairLinked) Reset()         { *m = TokenPairLinked{} }
 func (m *TokenPairLinked) String() string { return proto.CompactTextString(m) }
 func (*TokenPairLinked) ProtoMessage()    {}
 func (*TokenPairLinked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{14}
+	return fileDescriptor_e7ce5881ab629356, []int{17}
 }
 func (m *TokenPairLinked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -855,7 +1077,7 @@ func (m *TokenPairUnlinked) Reset()         { *m = TokenPairUnlinked{} }
 func (m *TokenPairUnlinked) String() string { return proto.CompactTextString(m) }
 func (*TokenPairUnlinked) ProtoMessage()    {}
 func (*TokenPairUnlinked) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{15}
+	return fileDescriptor_e7ce5881ab629356, []int{18}
 }
 func (m *TokenPairUnlinked) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -916,7 +1138,7 @@ func (m *MessageSent) Reset()         { *m = MessageSent{} }
 func (m *MessageSent) String() string { return proto.CompactTextString(m) }
 func (*MessageSent) ProtoMessage()    {}
 func (*MessageSent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{16}
+	return fileDescriptor_e7ce5881ab629356, []int{19}
 }
 func (m *MessageSent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1193,7 @@ func (m *MessageReceived) Reset()         { *m = MessageReceived{} }
 func (m *MessageReceived) String() string { return proto.CompactTextString(m) }
 func (*MessageReceived) ProtoMessage()    {}
 func (*MessageReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{17}
+	return fileDescriptor_e7ce5881ab629356, []int{20}
 }
 func (m *MessageReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1046,7 +1268,7 @@ func (m *MaxMessageBodySizeUpdated) Reset()         { *m = MaxMessageBodySizeUpd
 func (m *MaxMessageBodySizeUpdated) String() string { return proto.CompactTextString(m) }
 func (*MaxMessageBodySizeUpdated) ProtoMessage()    {}
 func (*MaxMessageBodySizeUpdated) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{18}
+	return fileDescriptor_e7ce5881ab629356, []int{21}
 }
 func (m *MaxMessageBodySizeUpdated) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1095,7 +1317,7 @@ func (m *RemoteTokenMessengerAdded) Reset()         { *m = RemoteTokenMessengerA
 func (m *RemoteTokenMessengerAdded) String() string { return proto.CompactTextString(m) }
 func (*RemoteTokenMessengerAdded) ProtoMessage()    {}
 func (*RemoteTokenMessengerAdded) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{19}
+	return fileDescriptor_e7ce5881ab629356, []int{22}
 }
 func (m *RemoteTokenMessengerAdded) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,7 +1373,7 @@ func (m *RemoteTokenMessengerRemoved) Reset()         { *m = RemoteTokenMessenge
 func (m *RemoteTokenMessengerRemoved) String() string { return proto.CompactTextString(m) }
 func (*RemoteTokenMessengerRemoved) ProtoMessage()    {}
 func (*RemoteTokenMessengerRemoved) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{20}
+	return fileDescriptor_e7ce5881ab629356, []int{23}
 }
 func (m *RemoteTokenMessengerRemoved) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1208,7 +1430,7 @@ func (m *SetBurnLimitPerMessage) Reset()         { *m = SetBurnLimitPerMessage{}
 func (m *SetBurnLimitPerMessage) String() string { return proto.CompactTextString(m) }
 func (*SetBurnLimitPerMessage) ProtoMessage()    {}
 func (*SetBurnLimitPerMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7ce5881ab629356, []int{21}
+	return fileDescriptor_e7ce5881ab629356, []int{24}
 }
 func (m *SetBurnLimitPerMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1244,149 +1466,570 @@ func (m *SetBurnLimitPerMessage) GetToken() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*AttesterEnabled)(nil), "circle.cctp.v1.AttesterEnabled")
-	proto.RegisterType((*AttesterDisabled)(nil), "circle.cctp.v1.AttesterDisabled")
-	proto.RegisterType((*SignatureThresholdUpdated)(nil), "circle.cctp.v1.SignatureThresholdUpdated")
-	proto.RegisterType((*OwnerUpdated)(nil), "circle.cctp.v1.OwnerUpdated")
-	proto.RegisterType((*OwnershipTransferStarted)(nil), "circle.cctp.v1.OwnershipTransferStarted")
-	proto.RegisterType((*PauserUpdated)(nil), "circle.cctp.v1.PauserUpdated")
-	proto.RegisterType((*AttesterManagerUpdated)(nil), "circle.cctp.v1.AttesterManagerUpdated")
-	proto.RegisterType((*TokenControllerUpdated)(nil), "circle.cctp.v1.TokenControllerUpdated")
-	proto.RegisterType((*BurningAndMintingPausedEvent)(nil), "circle.cctp.v1.BurningAndMintingPausedEvent")
-	proto.RegisterType((*BurningAndMintingUnpausedEvent)(nil), "circle.cctp.v1.BurningAndMintingUnpausedEvent")
-	proto.RegisterType((*SendingAndReceivingPausedEvent)(nil), "circle.cctp.v1.SendingAndReceivingPausedEvent")
-	proto.RegisterType((*SendingAndReceivingUnpausedEvent)(nil), "circle.cctp.v1.SendingAndReceivingUnpausedEvent")
-	proto.RegisterType((*DepositForBurn)(nil), "circle.cctp.v1.DepositForBurn")
-	proto.RegisterType((*MintAndWithdraw)(nil), "circle.cctp.v1.MintAndWithdraw")
-	proto.RegisterType((*TokenPairLinked)(nil), "circle.cctp.v1.TokenPairLinked")
-	proto.RegisterType((*TokenPairUnlinked)(nil), "circle.cctp.v1.TokenPairUnlinked")
-	proto.RegisterType((*MessageSent)(nil), "circle.cctp.v1.MessageSent")
-	proto.RegisterType((*MessageReceived)(nil), "circle.cctp.v1.MessageReceived")
-	proto.RegisterType((*MaxMessageBodySizeUpdated)(nil), "circle.cctp.v1.MaxMessageBodySizeUpdated")
-	proto.RegisterType((*RemoteTokenMessengerAdded)(nil), "circle.cctp.v1.RemoteTokenMessengerAdded")
-	proto.RegisterType((*RemoteTokenMessengerRemoved)(nil), "circle.cctp.v1.RemoteTokenMessengerRemoved")
-	proto.RegisterType((*SetBurnLimitPerMessage)(nil), "circle.cctp.v1.SetBurnLimitPerMessage")
+// *
+// Emitted when a Forwarding is set
+// @param address the forwarding noble account
+// @param destination_domain the domain mints are forwarded to
+// @param mint_recipient the recipient on destination_domain
+// @param destination_caller the caller allowed to receive forwarded messages
+// @param fee the amount paid from each forwarded mint to its relayer
+type ForwardingSet struct {
+	Address           string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	DestinationDomain uint32                `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	MintRecipient     []byte                `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	DestinationCaller []byte                `protobuf:"bytes,4,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	Fee               cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+}
+
+func (m *ForwardingSet) Reset()         { *m = ForwardingSet{} }
+func (m *ForwardingSet) String() string { return proto.CompactTextString(m) }
+func (*ForwardingSet) ProtoMessage()    {}
+func (*ForwardingSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{25}
+}
+func (m *ForwardingSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardingSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardingSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardingSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingSet.Merge(m, src)
+}
+func (m *ForwardingSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardingSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingSet.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("circle/cctp/v1/events.proto", fileDescriptor_e7ce5881ab629356) }
+var xxx_messageInfo_ForwardingSet proto.InternalMessageInfo
 
-var fileDescriptor_e7ce5881ab629356 = []byte{
-	// 986 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0x3a, 0xad, 0xdb, 0xbc, 0xd8, 0x09, 0x5d, 0x52, 0xd7, 0x6e, 0x1a, 0xc7, 0x2c, 0x42,
-	0xcd, 0x81, 0xd8, 0x54, 0xfc, 0x11, 0xe2, 0x80, 0x94, 0x34, 0xad, 0x84, 0x54, 0x8b, 0x68, 0x9d,
-	0xa8, 0x12, 0x07, 0x56, 0xe3, 0x9d, 0xa9, 0x3d, 0xca, 0xee, 0xcc, 0x6a, 0x66, 0x6c, 0x27, 0x11,
-	0x27, 0x0e, 0x5c, 0x90, 0x10, 0xdf, 0x80, 0xaf, 0xd3, 0x63, 0x8f, 0x88, 0x43, 0x41, 0xc9, 0x17,
-	0x41, 0xf3, 0x67, 0xed, 0xad, 0x6d, 0x09, 0x90, 0x22, 0x6e, 0xfb, 0xde, 0xfb, 0xbd, 0xf7, 0x7b,
-	0x7f, 0x77, 0x17, 0xb6, 0x63, 0x2a, 0xe2, 0x84, 0x74, 0xe2, 0x58, 0x65, 0x9d, 0xf1, 0x93, 0x0e,
-	0x19, 0x13, 0xa6, 0x64, 0x3b, 0x13, 0x5c, 0x71, 0x7f, 0xc3, 0x1a, 0xdb, 0xda, 0xd8, 0x1e, 0x3f,
-	0x79, 0xb8, 0x35, 0xe0, 0x03, 0x6e, 0x4c, 0x1d, 0xfd, 0x64, 0x51, 0xc1, 0x3e, 0x6c, 0x1e, 0x28,
-	0x45, 0xa4, 0x22, 0xe2, 0x19, 0x43, 0xfd, 0x84, 0x60, 0xff, 0x21, 0xdc, 0x45, 0x4e, 0x55, 0xf7,
-	0x5a, 0xde, 0xde, 0x5a, 0x38, 0x95, 0x83, 0x36, 0xbc, 0x97, 0xc3, 0x8f, 0xa8, 0xfc, 0x67, 0xfc,
-	0xcf, 0x1e, 0x34, 0x7a, 0x74, 0xc0, 0x90, 0x1a, 0x09, 0x72, 0x32, 0x14, 0x44, 0x0e, 0x79, 0x82,
-	0x4f, 0x33, 0x8c, 0x14, 0xc1, 0xfe, 0x17, 0xf0, 0x80, 0x27, 0x38, 0x92, 0x39, 0x20, 0x52, 0x39,
-	0xc2, 0x04, 0xba, 0x15, 0xde, 0xe7, 0x09, 0x5e, 0x74, 0xd7, 0x7e, 0x8c, 0x4c, 0x96, 0xfa, 0x95,
-	0xac, 0x1f, 0x23, 0x93, 0x45, 0xbf, 0x20, 0x84, 0xca, 0xb7, 0x13, 0x46, 0x44, 0xce, 0xff, 0x11,
-	0x6c, 0x64, 0x82, 0x8c, 0x29, 0x1f, 0xc9, 0x88, 0x6b, 0x83, 0xcb, 0xbf, 0x9a, 0x6b, 0x0d, 0xda,
-	0xdf, 0x86, 0x35, 0x4d, 0x67, 0x11, 0x25, 0x5b, 0x21, 0x23, 0x13, 0x63, 0x0c, 0xbe, 0x87, 0xba,
-	0x79, 0x90, 0x43, 0x9a, 0x9d, 0x08, 0xc4, 0xe4, 0x2b, 0x22, 0x7a, 0x0a, 0x89, 0x9b, 0x8a, 0xff,
-	0x12, 0xaa, 0xc7, 0x68, 0x24, 0x67, 0x49, 0x3f, 0x86, 0xcd, 0x69, 0xd0, 0xcc, 0x58, 0x5c, 0xd4,
-	0x29, 0x97, 0xc5, 0xfb, 0x3b, 0x00, 0x3a, 0xac, 0xc3, 0xd8, 0xb8, 0x9a, 0xc8, 0x9a, 0x83, 0x9f,
-	0x3c, 0xa8, 0xe5, 0xb3, 0xec, 0x22, 0x86, 0x06, 0x33, 0x8a, 0xaf, 0xa0, 0x31, 0xa5, 0xc8, 0x47,
-	0x19, 0xa5, 0x16, 0xe3, 0xc8, 0x1e, 0xe4, 0x80, 0xb9, 0x10, 0xfe, 0x27, 0xb0, 0xa5, 0x59, 0x17,
-	0xdc, 0x2c, 0xbf, 0xcf, 0xc8, 0x64, 0xce, 0xc3, 0x24, 0x72, 0xc2, 0xcf, 0x08, 0x7b, 0xca, 0x99,
-	0x12, 0x3c, 0x49, 0x96, 0x27, 0xa2, 0x34, 0x24, 0x8a, 0xa7, 0x98, 0xf9, 0x44, 0xe6, 0x42, 0xe4,
-	0x89, 0x2c, 0xb8, 0xcd, 0x12, 0x99, 0xf3, 0x08, 0x9a, 0xf0, 0xe8, 0x70, 0x24, 0x18, 0x65, 0x83,
-	0x03, 0x86, 0xbb, 0x94, 0x29, 0xca, 0x06, 0xa6, 0x59, 0xf8, 0x99, 0x3e, 0xac, 0xa0, 0x05, 0xcd,
-	0x05, 0xfb, 0x29, 0xcb, 0xde, 0x45, 0xf4, 0x08, 0xc3, 0x16, 0x11, 0x92, 0x98, 0xd0, 0xf1, 0x5c,
-	0x8c, 0x00, 0x5a, 0x4b, 0x10, 0xef, 0x46, 0xf9, 0xb3, 0x04, 0x1b, 0x47, 0x24, 0xe3, 0x92, 0xaa,
-	0xe7, 0x5c, 0x68, 0x4a, 0x7f, 0x0b, 0x6e, 0x33, 0xce, 0x62, 0xe2, 0xee, 0xc2, 0x0a, 0x7a, 0xc2,
-	0xfd, 0x91, 0x60, 0xb6, 0xc6, 0x7c, 0xc2, 0x5a, 0x63, 0x2a, 0xf3, 0x3f, 0x87, 0x32, 0x4a, 0xf9,
-	0x88, 0xa9, 0xfa, 0xaa, 0x36, 0x1d, 0xee, 0xbc, 0x7e, 0xbb, 0xbb, 0xf2, 0xc7, 0xdb, 0xdd, 0xfb,
-	0x31, 0x97, 0x29, 0x97, 0x12, 0x9f, 0xb5, 0x29, 0xef, 0xa4, 0x48, 0x0d, 0xdb, 0xdf, 0x30, 0x15,
-	0x3a, 0xb0, 0xff, 0x08, 0xd6, 0xb0, 0x65, 0xe7, 0xa2, 0x7e, 0xcb, 0x06, 0x9d, 0x2a, 0xf4, 0x4e,
-	0xa7, 0x94, 0xa9, 0x48, 0x90, 0x98, 0x66, 0x94, 0x30, 0x55, 0xbf, 0xdd, 0xf2, 0xf6, 0x2a, 0x61,
-	0x55, 0x6b, 0xc3, 0x5c, 0xe9, 0xef, 0x83, 0x8f, 0x89, 0x54, 0x94, 0x21, 0x45, 0x39, 0x8b, 0x30,
-	0x4f, 0x11, 0x65, 0xf5, 0x72, 0xcb, 0xdb, 0xab, 0x86, 0xf7, 0x0a, 0x96, 0x23, 0x63, 0xf0, 0xbf,
-	0x86, 0xed, 0x22, 0xdc, 0x0e, 0x2d, 0x25, 0x52, 0x12, 0xa6, 0x97, 0xe7, 0x8e, 0xa1, 0x68, 0x14,
-	0x20, 0xa6, 0xc2, 0x6e, 0x0e, 0x98, 0xa7, 0x8b, 0x91, 0x19, 0xf5, 0x5d, 0xe3, 0x56, 0xa4, 0x7b,
-	0x6a, 0x0c, 0xc1, 0x2f, 0x1e, 0x6c, 0xea, 0x01, 0x1e, 0x30, 0xfc, 0x92, 0xaa, 0x21, 0x16, 0x68,
-	0xb2, 0xa4, 0x30, 0x6f, 0x59, 0x61, 0xb3, 0xa6, 0x96, 0xfe, 0x4b, 0x53, 0x77, 0x00, 0x4c, 0x74,
-	0x3b, 0xaa, 0x55, 0xdb, 0x55, 0xad, 0x31, 0x85, 0x04, 0x97, 0xb0, 0x69, 0x1e, 0x8e, 0x11, 0x15,
-	0x2f, 0x28, 0x3b, 0x23, 0xd8, 0xdf, 0x85, 0xf5, 0x84, 0xc7, 0x28, 0x71, 0x2e, 0x76, 0xdb, 0xc1,
-	0xa8, 0xec, 0x78, 0x3f, 0x84, 0xaa, 0x20, 0x29, 0x57, 0x24, 0xef, 0x6e, 0xc9, 0x74, 0xb7, 0x62,
-	0x95, 0xae, 0xb1, 0x1f, 0x80, 0x93, 0x0b, 0xcc, 0x95, 0x70, 0xdd, 0xea, 0x2c, 0xf7, 0x0f, 0x70,
-	0x6f, 0xca, 0x7d, 0xca, 0x92, 0xff, 0x99, 0xfd, 0x31, 0xac, 0xeb, 0x31, 0xa2, 0x01, 0xe9, 0xe9,
-	0xf6, 0xd6, 0xe1, 0x4e, 0x6a, 0x45, 0xd7, 0xfe, 0x5c, 0x0c, 0x7e, 0xd3, 0x33, 0xb3, 0xcf, 0xf6,
-	0x6e, 0x08, 0xf6, 0x6b, 0x50, 0x76, 0xa3, 0xb6, 0x09, 0x3a, 0x49, 0x27, 0x27, 0xf9, 0x48, 0xc4,
-	0xf3, 0xc9, 0x59, 0xa5, 0x4b, 0x6e, 0x7a, 0x53, 0xab, 0xc5, 0x9b, 0xaa, 0x41, 0x59, 0x12, 0x86,
-	0x89, 0x5d, 0xfd, 0x4a, 0xe8, 0x24, 0x5d, 0x8a, 0xcb, 0x24, 0xea, 0x73, 0x7c, 0xe1, 0xb6, 0x7e,
-	0xdd, 0xe9, 0x0e, 0x39, 0xbe, 0x08, 0x4e, 0xa1, 0xd1, 0x45, 0xe7, 0xdd, 0x99, 0xa6, 0x47, 0x2f,
-	0x49, 0xfe, 0x2a, 0xfb, 0x12, 0x1a, 0xfa, 0x75, 0x94, 0xa2, 0xf3, 0xa8, 0x18, 0x27, 0x92, 0xf4,
-	0x32, 0xbf, 0x6a, 0xfd, 0xd5, 0x5a, 0x0c, 0x10, 0x50, 0x68, 0x84, 0xb3, 0x86, 0x4d, 0x77, 0xfe,
-	0x00, 0x63, 0xdb, 0x01, 0x57, 0xa2, 0x67, 0x4a, 0x74, 0x92, 0xff, 0x19, 0xd4, 0x8a, 0x9d, 0x2f,
-	0xdc, 0x52, 0xc9, 0x24, 0xbe, 0x25, 0x96, 0x84, 0x0c, 0xce, 0x60, 0x7b, 0x19, 0x95, 0xd6, 0x8d,
-	0x6f, 0x9c, 0xec, 0x47, 0x0f, 0x6a, 0x3d, 0xa2, 0xf4, 0xfb, 0xed, 0x05, 0x4d, 0xa9, 0x3a, 0x26,
-	0xc2, 0x95, 0xae, 0x47, 0x53, 0xdc, 0x3b, 0x2b, 0xf8, 0x21, 0xd4, 0xcc, 0xeb, 0x2e, 0xd1, 0xe8,
-	0x28, 0xd3, 0x1f, 0x17, 0xb7, 0x2a, 0xff, 0xea, 0x14, 0xdf, 0xef, 0x2f, 0x32, 0x1d, 0x3e, 0x7f,
-	0x7d, 0xd5, 0xf4, 0xde, 0x5c, 0x35, 0xbd, 0xbf, 0xae, 0x9a, 0xde, 0xaf, 0xd7, 0xcd, 0x95, 0x37,
-	0xd7, 0xcd, 0x95, 0xdf, 0xaf, 0x9b, 0x2b, 0xdf, 0x7d, 0x3c, 0xa0, 0x6a, 0x38, 0xea, 0xb7, 0x63,
-	0x9e, 0x76, 0xec, 0xaf, 0xd4, 0x2b, 0xca, 0x3a, 0x8c, 0xf7, 0x13, 0xb2, 0x6f, 0x7e, 0xb8, 0xce,
-	0xed, 0x7f, 0x97, 0xba, 0xc8, 0x88, 0xec, 0x97, 0xcd, 0xef, 0xd4, 0xa7, 0x7f, 0x07, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0x38, 0xf8, 0xe9, 0x93, 0x09, 0x00, 0x00,
+func (m *ForwardingSet) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *AttesterEnabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ForwardingSet) GetDestinationDomain() uint32 {
+	if m != nil {
+		return m.DestinationDomain
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *AttesterEnabled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ForwardingSet) GetMintRecipient() []byte {
+	if m != nil {
+		return m.MintRecipient
+	}
+	return nil
 }
 
-func (m *AttesterEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Attester) > 0 {
-		i -= len(m.Attester)
-		copy(dAtA[i:], m.Attester)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Attester)))
-		i--
-		dAtA[i] = 0xa
+func (m *ForwardingSet) GetDestinationCaller() []byte {
+	if m != nil {
+		return m.DestinationCaller
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *AttesterDisabled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+// *
+// Emitted when a Forwarding is removed
+// @param address the noble account that no longer forwards
+type ForwardingRemoved struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ForwardingRemoved) Reset()         { *m = ForwardingRemoved{} }
+func (m *ForwardingRemoved) String() string { return proto.CompactTextString(m) }
+func (*ForwardingRemoved) ProtoMessage()    {}
+func (*ForwardingRemoved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{26}
+}
+func (m *ForwardingRemoved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardingRemoved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardingRemoved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardingRemoved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingRemoved.Merge(m, src)
+}
+func (m *ForwardingRemoved) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardingRemoved) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingRemoved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingRemoved proto.InternalMessageInfo
+
+func (m *ForwardingRemoved) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// *
+// Emitted when a 32-byte account is registered as a recipient
+// @param address the registered noble account
+type Account32Registered struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *Account32Registered) Reset()         { *m = Account32Registered{} }
+func (m *Account32Registered) String() string { return proto.CompactTextString(m) }
+func (*Account32Registered) ProtoMessage()    {}
+func (*Account32Registered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{27}
+}
+func (m *Account32Registered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Account32Registered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Account32Registered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Account32Registered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Account32Registered.Merge(m, src)
+}
+func (m *Account32Registered) XXX_Size() int {
+	return m.Size()
+}
+func (m *Account32Registered) XXX_DiscardUnknown() {
+	xxx_messageInfo_Account32Registered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Account32Registered proto.InternalMessageInfo
+
+func (m *Account32Registered) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// *
+// Emitted when a contract opts in to callbacks
+// @param address the contract address
+type ContractCallbackEnabled struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ContractCallbackEnabled) Reset()         { *m = ContractCallbackEnabled{} }
+func (m *ContractCallbackEnabled) String() string { return proto.CompactTextString(m) }
+func (*ContractCallbackEnabled) ProtoMessage()    {}
+func (*ContractCallbackEnabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{28}
+}
+func (m *ContractCallbackEnabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallbackEnabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallbackEnabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCallbackEnabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallbackEnabled.Merge(m, src)
+}
+func (m *ContractCallbackEnabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallbackEnabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallbackEnabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallbackEnabled proto.InternalMessageInfo
+
+func (m *ContractCallbackEnabled) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// *
+// Emitted when a contract opts out of callbacks
+// @param address the contract address
+type ContractCallbackDisabled struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *ContractCallbackDisabled) Reset()         { *m = ContractCallbackDisabled{} }
+func (m *ContractCallbackDisabled) String() string { return proto.CompactTextString(m) }
+func (*ContractCallbackDisabled) ProtoMessage()    {}
+func (*ContractCallbackDisabled) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{29}
+}
+func (m *ContractCallbackDisabled) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractCallbackDisabled) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractCallbackDisabled.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractCallbackDisabled) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractCallbackDisabled.Merge(m, src)
+}
+func (m *ContractCallbackDisabled) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractCallbackDisabled) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractCallbackDisabled.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractCallbackDisabled proto.InternalMessageInfo
+
+func (m *ContractCallbackDisabled) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// *
+// Emitted when a received mint is forwarded to another domain
+// @param address the forwarding noble account
+// @param source_domain the domain of the received message
+// @param source_nonce the nonce of the received message
+// @param nonce the nonce of the forwarded message
+// @param amount the amount forwarded
+// @param fee the amount paid to the relayer
+type MintForwarded struct {
+	Address      string                `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SourceDomain uint32                `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	SourceNonce  uint64                `protobuf:"varint,3,opt,name=source_nonce,json=sourceNonce,proto3" json:"source_nonce,omitempty"`
+	Nonce        uint64                `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	Amount       cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Fee          cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+}
+
+func (m *MintForwarded) Reset()         { *m = MintForwarded{} }
+func (m *MintForwarded) String() string { return proto.CompactTextString(m) }
+func (*MintForwarded) ProtoMessage()    {}
+func (*MintForwarded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{30}
+}
+func (m *MintForwarded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintForwarded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintForwarded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintForwarded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintForwarded.Merge(m, src)
+}
+func (m *MintForwarded) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintForwarded) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintForwarded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintForwarded proto.InternalMessageInfo
+
+func (m *MintForwarded) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MintForwarded) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+func (m *MintForwarded) GetSourceNonce() uint64 {
+	if m != nil {
+		return m.SourceNonce
+	}
+	return 0
+}
+
+func (m *MintForwarded) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+// *
+// Emitted when a received mint cannot be forwarded, leaving the funds with the
+// forwarding account
+// @param address the forwarding noble account
+// @param source_domain the domain of the received message
+// @param source_nonce the nonce of the received message
+// @param reason why the mint was not forwarded
+type MintForwardingFailed struct {
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	SourceDomain uint32 `protobuf:"varint,2,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	SourceNonce  uint64 `protobuf:"varint,3,opt,name=source_nonce,json=sourceNonce,proto3" json:"source_nonce,omitempty"`
+	Reason       string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MintForwardingFailed) Reset()         { *m = MintForwardingFailed{} }
+func (m *MintForwardingFailed) String() string { return proto.CompactTextString(m) }
+func (*MintForwardingFailed) ProtoMessage()    {}
+func (*MintForwardingFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7ce5881ab629356, []int{31}
+}
+func (m *MintForwardingFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintForwardingFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintForwardingFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintForwardingFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintForwardingFailed.Merge(m, src)
+}
+func (m *MintForwardingFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintForwardingFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintForwardingFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintForwardingFailed proto.InternalMessageInfo
+
+func (m *MintForwardingFailed) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MintForwardingFailed) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+func (m *MintForwardingFailed) GetSourceNonce() uint64 {
+	if m != nil {
+		return m.SourceNonce
+	}
+	return 0
+}
+
+func (m *MintForwardingFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AttesterEnabled)(nil), "circle.cctp.v1.AttesterEnabled")
+	proto.RegisterType((*AttesterDisabled)(nil), "circle.cctp.v1.AttesterDisabled")
+	proto.RegisterType((*SignatureThresholdUpdated)(nil), "circle.cctp.v1.SignatureThresholdUpdated")
+	proto.RegisterType((*OwnerUpdated)(nil), "circle.cctp.v1.OwnerUpdated")
+	proto.RegisterType((*OwnershipTransferStarted)(nil), "circle.cctp.v1.OwnershipTransferStarted")
+	proto.RegisterType((*AttesterManagerTransferStarted)(nil), "circle.cctp.v1.AttesterManagerTransferStarted")
+	proto.RegisterType((*PauserTransferStarted)(nil), "circle.cctp.v1.PauserTransferStarted")
+	proto.RegisterType((*TokenControllerTransferStarted)(nil), "circle.cctp.v1.TokenControllerTransferStarted")
+	proto.RegisterType((*PauserUpdated)(nil), "circle.cctp.v1.PauserUpdated")
+	proto.RegisterType((*AttesterManagerUpdated)(nil), "circle.cctp.v1.AttesterManagerUpdated")
+	proto.RegisterType((*TokenControllerUpdated)(nil), "circle.cctp.v1.TokenControllerUpdated")
+	proto.RegisterType((*BurningAndMintingPausedEvent)(nil), "circle.cctp.v1.BurningAndMintingPausedEvent")
+	proto.RegisterType((*BurningAndMintingUnpausedEvent)(nil), "circle.cctp.v1.BurningAndMintingUnpausedEvent")
+	proto.RegisterType((*SendingAndReceivingPausedEvent)(nil), "circle.cctp.v1.SendingAndReceivingPausedEvent")
+	proto.RegisterType((*SendingAndReceivingUnpausedEvent)(nil), "circle.cctp.v1.SendingAndReceivingUnpausedEvent")
+	proto.RegisterType((*DepositForBurn)(nil), "circle.cctp.v1.DepositForBurn")
+	proto.RegisterType((*MintAndWithdraw)(nil), "circle.cctp.v1.MintAndWithdraw")
+	proto.RegisterType((*TokenPairLinked)(nil), "circle.cctp.v1.TokenPairLinked")
+	proto.RegisterType((*TokenPairUnlinked)(nil), "circle.cctp.v1.TokenPairUnlinked")
+	proto.RegisterType((*MessageSent)(nil), "circle.cctp.v1.MessageSent")
+	proto.RegisterType((*MessageReceived)(nil), "circle.cctp.v1.MessageReceived")
+	proto.RegisterType((*MaxMessageBodySizeUpdated)(nil), "circle.cctp.v1.MaxMessageBodySizeUpdated")
+	proto.RegisterType((*RemoteTokenMessengerAdded)(nil), "circle.cctp.v1.RemoteTokenMessengerAdded")
+	proto.RegisterType((*RemoteTokenMessengerRemoved)(nil), "circle.cctp.v1.RemoteTokenMessengerRemoved")
+	proto.RegisterType((*SetBurnLimitPerMessage)(nil), "circle.cctp.v1.SetBurnLimitPerMessage")
+	proto.RegisterType((*ForwardingSet)(nil), "circle.cctp.v1.ForwardingSet")
+	proto.RegisterType((*ForwardingRemoved)(nil), "circle.cctp.v1.ForwardingRemoved")
+	proto.RegisterType((*Account32Registered)(nil), "circle.cctp.v1.Account32Registered")
+	proto.RegisterType((*ContractCallbackEnabled)(nil), "circle.cctp.v1.ContractCallbackEnabled")
+	proto.RegisterType((*ContractCallbackDisabled)(nil), "circle.cctp.v1.ContractCallbackDisabled")
+	proto.RegisterType((*MintForwarded)(nil), "circle.cctp.v1.MintForwarded")
+	proto.RegisterType((*MintForwardingFailed)(nil), "circle.cctp.v1.MintForwardingFailed")
+}
+
+func init() { proto.RegisterFile("circle/cctp/v1/events.proto", fileDescriptor_e7ce5881ab629356) }
+
+var fileDescriptor_e7ce5881ab629356 = []byte{
+	// 1253 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x57, 0x4f, 0x6f, 0x13, 0x47,
+	0x14, 0xcf, 0x3a, 0x7f, 0x20, 0x2f, 0x31, 0x69, 0x96, 0x10, 0x1c, 0x02, 0x26, 0x6c, 0x55, 0x11,
+	0x55, 0x25, 0x2e, 0x85, 0x56, 0x55, 0x0f, 0x95, 0x12, 0x20, 0x2a, 0x12, 0x69, 0xd1, 0x9a, 0x08,
+	0xa9, 0x97, 0xd5, 0x78, 0xf7, 0x61, 0x8f, 0xb2, 0x9e, 0x59, 0xcd, 0x8c, 0xe3, 0x80, 0x7a, 0xea,
+	0xa1, 0x87, 0x22, 0x55, 0x7c, 0x83, 0x7e, 0x8f, 0x7e, 0x02, 0x8e, 0x1c, 0xab, 0x1e, 0xa0, 0x82,
+	0x0f, 0xd0, 0xaf, 0x50, 0xcd, 0x9f, 0xb5, 0x97, 0xb5, 0x4d, 0x13, 0x89, 0x72, 0xdb, 0xf7, 0xff,
+	0xbd, 0xdf, 0x7b, 0xf3, 0x66, 0x07, 0xd6, 0x63, 0x2a, 0xe2, 0x14, 0x1b, 0x71, 0xac, 0xb2, 0xc6,
+	0xe1, 0xf5, 0x06, 0x1e, 0x22, 0x53, 0x72, 0x2b, 0x13, 0x5c, 0x71, 0xff, 0x8c, 0x15, 0x6e, 0x69,
+	0xe1, 0xd6, 0xe1, 0xf5, 0x0b, 0x2b, 0x6d, 0xde, 0xe6, 0x46, 0xd4, 0xd0, 0x5f, 0x56, 0x2b, 0xb8,
+	0x0b, 0x4b, 0xdb, 0x4a, 0xa1, 0x54, 0x28, 0xee, 0x30, 0xd2, 0x4a, 0x31, 0xf1, 0x2f, 0xc0, 0x69,
+	0xe2, 0x58, 0x35, 0x6f, 0xc3, 0xdb, 0x9c, 0x0f, 0x07, 0xb4, 0x96, 0xf1, 0x0c, 0x05, 0x51, 0x5c,
+	0xd4, 0x2a, 0x56, 0x96, 0xd3, 0xc1, 0x2e, 0x7c, 0x94, 0xbb, 0xba, 0x4d, 0xe5, 0x7f, 0xfb, 0x5a,
+	0x85, 0x39, 0x81, 0x44, 0x72, 0xe6, 0x3c, 0x39, 0x2a, 0x78, 0xea, 0xc1, 0x5a, 0x93, 0xb6, 0x19,
+	0x51, 0x3d, 0x81, 0x0f, 0x3a, 0x02, 0x65, 0x87, 0xa7, 0xc9, 0x7e, 0x96, 0x10, 0x85, 0x89, 0xff,
+	0x15, 0x9c, 0xe7, 0x69, 0x12, 0xc9, 0x5c, 0x21, 0x52, 0xb9, 0x86, 0x09, 0x30, 0x13, 0x9e, 0xe3,
+	0x69, 0x32, 0x6a, 0xae, 0xed, 0x18, 0xf6, 0xc7, 0xda, 0x55, 0xac, 0x1d, 0xc3, 0xfe, 0xa8, 0x5d,
+	0x10, 0xc2, 0xe2, 0x0f, 0x7d, 0x86, 0x22, 0x8f, 0xff, 0x09, 0x9c, 0xc9, 0x04, 0x1e, 0x52, 0xde,
+	0x93, 0x11, 0xd7, 0x02, 0x57, 0x57, 0x35, 0xe7, 0x1a, 0x6d, 0x7f, 0x1d, 0xe6, 0x75, 0x38, 0xab,
+	0xe1, 0x90, 0x62, 0xd8, 0x37, 0xc2, 0xe0, 0x57, 0x0f, 0x6a, 0xe6, 0x4b, 0x76, 0x68, 0xf6, 0x40,
+	0x10, 0x26, 0x1f, 0xa1, 0x68, 0x2a, 0x22, 0xde, 0x53, 0x00, 0xff, 0x53, 0x58, 0xc6, 0xa3, 0x8c,
+	0x0a, 0x94, 0x11, 0x51, 0x51, 0x07, 0x69, 0xbb, 0xa3, 0x6a, 0xd3, 0x1b, 0xde, 0xe6, 0x74, 0xb8,
+	0xe4, 0x04, 0xdb, 0xea, 0x3b, 0xc3, 0x0e, 0xfe, 0xf0, 0xa0, 0x9e, 0xf7, 0x6d, 0x8f, 0x30, 0xd2,
+	0x46, 0x51, 0x4e, 0xe9, 0x1b, 0x58, 0x1b, 0xa4, 0x94, 0xb7, 0x2f, 0xea, 0x5a, 0x5d, 0x97, 0xdd,
+	0xf9, 0x5c, 0xa1, 0xe4, 0xca, 0xff, 0x1c, 0x56, 0x74, 0x9e, 0x23, 0x66, 0x36, 0x65, 0x9f, 0x61,
+	0xbf, 0x6c, 0x71, 0x92, 0xe4, 0x9f, 0x7a, 0x70, 0xee, 0x3e, 0xe9, 0xc9, 0xd1, 0x9c, 0xaf, 0xc2,
+	0xd2, 0x20, 0xe7, 0xcc, 0x68, 0xb8, 0x4c, 0x07, 0xe8, 0x5a, 0x3b, 0xff, 0x12, 0x80, 0x4e, 0xd0,
+	0xe9, 0xd8, 0xb4, 0x34, 0xb4, 0x4e, 0x7c, 0x52, 0x28, 0x1f, 0xf0, 0x03, 0x64, 0xb7, 0x38, 0x53,
+	0x82, 0xa7, 0xe9, 0xbb, 0xa1, 0x54, 0x5a, 0x35, 0x8a, 0x07, 0xba, 0x65, 0x28, 0x4b, 0xae, 0x72,
+	0x28, 0x47, 0xcc, 0x86, 0x50, 0x96, 0x2d, 0x4e, 0x92, 0xfc, 0x43, 0xa8, 0xda, 0x92, 0xf3, 0x49,
+	0x7f, 0x4f, 0x08, 0x06, 0xbf, 0x78, 0xb0, 0x5a, 0xea, 0x71, 0x1e, 0xe2, 0x83, 0x0e, 0x96, 0x49,
+	0xa4, 0x84, 0xd0, 0xb8, 0x44, 0xfe, 0xf7, 0xb6, 0x04, 0x75, 0xb8, 0xb8, 0xd3, 0x13, 0x8c, 0xb2,
+	0xf6, 0x36, 0x4b, 0xf6, 0x28, 0x53, 0x94, 0xb5, 0x0d, 0x58, 0xc9, 0x1d, 0xbd, 0xc1, 0x83, 0x0d,
+	0xa8, 0x8f, 0xc8, 0xf7, 0x59, 0xf6, 0xb6, 0x46, 0x13, 0x59, 0x62, 0x35, 0x42, 0x8c, 0x91, 0x1e,
+	0x96, 0x7c, 0x04, 0xb0, 0x31, 0x46, 0xe3, 0x6d, 0x2f, 0xaf, 0x2a, 0x70, 0xe6, 0x36, 0x66, 0x5c,
+	0x52, 0xb5, 0xcb, 0x85, 0x0e, 0xe9, 0xaf, 0xc0, 0x2c, 0xe3, 0x2c, 0x46, 0xb7, 0x4c, 0x2d, 0xa1,
+	0x3b, 0xdc, 0xea, 0x09, 0x66, 0x6b, 0xcc, 0x3b, 0xac, 0x39, 0xa6, 0x32, 0xff, 0x4b, 0x98, 0x23,
+	0x5d, 0xde, 0x63, 0x76, 0xb6, 0xe6, 0x77, 0x2e, 0x3d, 0x7f, 0x79, 0x79, 0xea, 0xaf, 0x97, 0x97,
+	0xcf, 0xc5, 0x5c, 0x76, 0xb9, 0x94, 0xc9, 0xc1, 0x16, 0xe5, 0x8d, 0x2e, 0x51, 0x9d, 0xad, 0xbb,
+	0x4c, 0x85, 0x4e, 0xd9, 0xbf, 0x08, 0xf3, 0x89, 0x8d, 0xce, 0x45, 0x6d, 0xc6, 0x3a, 0x1d, 0x30,
+	0xf4, 0x1e, 0xec, 0x52, 0xa6, 0x22, 0x81, 0x31, 0xcd, 0x28, 0x32, 0x55, 0x9b, 0xdd, 0xf0, 0x36,
+	0x17, 0xc3, 0xaa, 0xe6, 0x86, 0x39, 0xd3, 0xbf, 0x06, 0x7e, 0x82, 0x52, 0x51, 0x46, 0x14, 0xe5,
+	0x2c, 0x4a, 0x78, 0x97, 0x50, 0x56, 0x9b, 0xdb, 0xf0, 0x36, 0xab, 0xe1, 0x72, 0x41, 0x72, 0xdb,
+	0x08, 0xfc, 0x6f, 0x61, 0xbd, 0xa8, 0x6e, 0x9b, 0xd6, 0x45, 0x29, 0x91, 0xe9, 0xe1, 0x39, 0x65,
+	0x42, 0xac, 0x15, 0x54, 0x4c, 0x85, 0x7b, 0xb9, 0x42, 0x39, 0x5c, 0x4c, 0x4c, 0xab, 0x4f, 0x1b,
+	0xb3, 0x62, 0xb8, 0x5b, 0x46, 0x10, 0xfc, 0xe6, 0xc1, 0x92, 0x6e, 0xe0, 0x36, 0x4b, 0x1e, 0x52,
+	0xd5, 0x49, 0x04, 0xe9, 0x8f, 0x29, 0xcc, 0x1b, 0x57, 0xd8, 0x10, 0xd4, 0xca, 0x49, 0x40, 0xbd,
+	0x04, 0x60, 0xbc, 0xdb, 0x56, 0x4d, 0x5b, 0x54, 0x35, 0xc7, 0x14, 0x12, 0x3c, 0x81, 0x25, 0xf3,
+	0x71, 0x9f, 0x50, 0x71, 0x8f, 0xb2, 0x03, 0x4c, 0xfc, 0xcb, 0xb0, 0x90, 0xf2, 0x98, 0xa4, 0xce,
+	0xc4, 0x4e, 0x3b, 0x18, 0x96, 0x6d, 0xef, 0xc7, 0x50, 0x15, 0xd8, 0xe5, 0x0a, 0x73, 0x74, 0x2b,
+	0x06, 0xdd, 0x45, 0xcb, 0x74, 0xc0, 0x5e, 0x01, 0x47, 0x17, 0x22, 0x2f, 0x86, 0x0b, 0x96, 0x67,
+	0x63, 0xff, 0x04, 0xcb, 0x83, 0xd8, 0xfb, 0x2c, 0xfd, 0xc0, 0xd1, 0xaf, 0xc2, 0x82, 0x6e, 0x23,
+	0x69, 0x63, 0x53, 0xc3, 0x5b, 0x83, 0x53, 0x5d, 0x4b, 0x3a, 0xf8, 0x73, 0x32, 0xf8, 0x5d, 0xf7,
+	0xcc, 0x7e, 0xdb, 0x73, 0x83, 0x89, 0xfe, 0x57, 0x71, 0xad, 0xb6, 0x09, 0x3a, 0x4a, 0x27, 0x27,
+	0x79, 0x4f, 0xc4, 0xe5, 0xe4, 0x2c, 0xd3, 0x25, 0x37, 0x38, 0x53, 0xd3, 0xc5, 0x33, 0xb5, 0x0a,
+	0x73, 0x12, 0x59, 0x82, 0x76, 0xf4, 0x17, 0x43, 0x47, 0xe9, 0x52, 0x5c, 0x26, 0x51, 0x8b, 0x27,
+	0x8f, 0xdd, 0xd4, 0x2f, 0x38, 0xde, 0x0e, 0x4f, 0x1e, 0x07, 0xfb, 0xb0, 0xb6, 0x47, 0x8e, 0xf6,
+	0x86, 0x9c, 0x26, 0x7d, 0x82, 0xf9, 0x2a, 0xfb, 0x1a, 0xd6, 0xf4, 0x3a, 0xea, 0x92, 0xa3, 0xa8,
+	0xe8, 0x27, 0x92, 0xf4, 0x49, 0x7e, 0xaa, 0xf5, 0xaf, 0xce, 0xa8, 0x83, 0x80, 0xc2, 0x5a, 0x38,
+	0x04, 0x6c, 0x30, 0xf3, 0xdb, 0x49, 0x62, 0x11, 0x70, 0x25, 0x7a, 0xa6, 0x44, 0x47, 0xf9, 0x37,
+	0x61, 0xb5, 0x88, 0x7c, 0xe1, 0x2c, 0x55, 0x4c, 0xe2, 0x2b, 0x62, 0x8c, 0xcb, 0xe0, 0x00, 0xd6,
+	0xc7, 0x85, 0xd2, 0xbc, 0xc3, 0xf7, 0x1e, 0xec, 0x67, 0x0f, 0x56, 0x9b, 0xa8, 0xf4, 0x7e, 0xbb,
+	0x47, 0xbb, 0x54, 0xdd, 0x47, 0xe1, 0x4a, 0xd7, 0xad, 0x29, 0xce, 0x9d, 0x25, 0xfc, 0x10, 0x56,
+	0xcd, 0xba, 0x4b, 0xb5, 0x76, 0x94, 0xe9, 0xcb, 0xc5, 0x8d, 0xca, 0xb1, 0x8e, 0xe2, 0xd9, 0xd6,
+	0x68, 0xa4, 0xe0, 0x95, 0x07, 0xd5, 0x5d, 0x2e, 0xfa, 0x44, 0xe8, 0x9d, 0xdc, 0x44, 0x33, 0x81,
+	0x24, 0x49, 0x04, 0x4a, 0xe9, 0xa2, 0xe7, 0xe4, 0x84, 0x9d, 0x56, 0x99, 0xb4, 0xd3, 0x46, 0x17,
+	0xca, 0xf4, 0x31, 0x36, 0xa5, 0x9b, 0xe7, 0x99, 0x09, 0xab, 0xcb, 0x6f, 0xc0, 0xf4, 0x23, 0xc4,
+	0xda, 0xec, 0x71, 0x2a, 0xd6, 0x9a, 0xc1, 0x35, 0x58, 0x1e, 0x16, 0x98, 0x77, 0x72, 0x62, 0x91,
+	0x41, 0x03, 0xce, 0x6e, 0xc7, 0xb1, 0xde, 0x59, 0x37, 0xbe, 0x08, 0xb1, 0x4d, 0xa5, 0x42, 0xf1,
+	0x4e, 0x83, 0x1b, 0x70, 0xde, 0xdc, 0xa1, 0x24, 0x56, 0x3a, 0xc5, 0x16, 0x89, 0x0f, 0xf2, 0x27,
+	0xcb, 0x64, 0xa3, 0x9b, 0x50, 0x2b, 0x1b, 0x0d, 0x1e, 0x27, 0x93, 0xad, 0xfe, 0xf1, 0xa0, 0xaa,
+	0xd7, 0xb6, 0xab, 0xe7, 0x5d, 0xba, 0xc7, 0x5b, 0x01, 0x57, 0xc0, 0xd1, 0x51, 0x71, 0x13, 0x2c,
+	0x58, 0xde, 0xf7, 0x9a, 0x35, 0xdc, 0x12, 0x33, 0xc5, 0x2d, 0x31, 0xbc, 0x05, 0x66, 0x4f, 0x72,
+	0x0b, 0xb8, 0xe6, 0xcd, 0x1d, 0xbb, 0x79, 0xcf, 0x3c, 0x58, 0x29, 0x54, 0x4c, 0x59, 0x7b, 0x97,
+	0xd0, 0xf4, 0x83, 0x14, 0x3e, 0x7c, 0x07, 0xce, 0x14, 0xdf, 0x81, 0x3b, 0xbb, 0xcf, 0x5f, 0xd7,
+	0xbd, 0x17, 0xaf, 0xeb, 0xde, 0xdf, 0xaf, 0xeb, 0xde, 0xb3, 0x37, 0xf5, 0xa9, 0x17, 0x6f, 0xea,
+	0x53, 0x7f, 0xbe, 0xa9, 0x4f, 0xfd, 0xf8, 0x59, 0x9b, 0xaa, 0x4e, 0xaf, 0xb5, 0x15, 0xf3, 0x6e,
+	0xc3, 0xbe, 0x72, 0x1f, 0x51, 0xd6, 0x60, 0xbc, 0x95, 0xe2, 0x35, 0xf3, 0x16, 0x3e, 0xb2, 0x4f,
+	0x62, 0xf5, 0x38, 0x43, 0xd9, 0x9a, 0x33, 0x2f, 0xdd, 0x1b, 0xff, 0x0e, 0x00, 0xad, 0xc9, 0xae,
+	0x9c, 0x2e, 0x0f, 0x00, 0x00,
+}
+
+func (m *AttesterEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
 
-func (m *AttesterDisabled) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttesterEnabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AttesterDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttesterEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Attester) > 0 {
 		i -= len(m.Attester)
 		copy(dAtA[i:], m.Attester)
@@ -1397,7 +2040,7 @@ func (m *AttesterDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SignatureThresholdUpdated) Marshal() (dAtA []byte, err error) {
+func (m *AttesterDisabled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1407,30 +2050,67 @@ func (m *SignatureThresholdUpdated) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SignatureThresholdUpdated) MarshalTo(dAtA []byte) (int, error) {
+func (m *AttesterDisabled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SignatureThresholdUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AttesterDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NewSignatureThreshold != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.NewSignatureThreshold))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.OldSignatureThreshold != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.OldSignatureThreshold))
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Attester)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *OwnerUpdated) Marshal() (dAtA []byte, err error) {
+func (m *SignatureThresholdUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SignatureThresholdUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SignatureThresholdUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewSignatureThreshold != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.NewSignatureThreshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.OldSignatureThreshold != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OldSignatureThreshold))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OwnerUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1487,6 +2167,11 @@ func (m *OwnershipTransferStarted) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.NewOwner) > 0 {
 		i -= len(m.NewOwner)
 		copy(dAtA[i:], m.NewOwner)
@@ -1504,6 +2189,132 @@ func (m *OwnershipTransferStarted) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *AttesterManagerTransferStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttesterManagerTransferStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttesterManagerTransferStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewAttesterManager) > 0 {
+		i -= len(m.NewAttesterManager)
+		copy(dAtA[i:], m.NewAttesterManager)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewAttesterManager)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousAttesterManager) > 0 {
+		i -= len(m.PreviousAttesterManager)
+		copy(dAtA[i:], m.PreviousAttesterManager)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousAttesterManager)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PauserTransferStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauserTransferStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauserTransferStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewPauser) > 0 {
+		i -= len(m.NewPauser)
+		copy(dAtA[i:], m.NewPauser)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewPauser)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousPauser) > 0 {
+		i -= len(m.PreviousPauser)
+		copy(dAtA[i:], m.PreviousPauser)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousPauser)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TokenControllerTransferStarted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenControllerTransferStarted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenControllerTransferStarted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.NewTokenController) > 0 {
+		i -= len(m.NewTokenController)
+		copy(dAtA[i:], m.NewTokenController)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.NewTokenController)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PreviousTokenController) > 0 {
+		i -= len(m.PreviousTokenController)
+		copy(dAtA[i:], m.PreviousTokenController)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.PreviousTokenController)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *PauserUpdated) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2138,56 +2949,355 @@ func (m *SetBurnLimitPerMessage) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ForwardingSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AttesterEnabled) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *ForwardingSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardingSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Attester)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *AttesterDisabled) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ForwardingRemoved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardingRemoved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardingRemoved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Attester)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SignatureThresholdUpdated) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Account32Registered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Account32Registered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Account32Registered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.OldSignatureThreshold != 0 {
-		n += 1 + sovEvents(uint64(m.OldSignatureThreshold))
-	}
-	if m.NewSignatureThreshold != 0 {
-		n += 1 + sovEvents(uint64(m.NewSignatureThreshold))
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallbackEnabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallbackEnabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallbackEnabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractCallbackDisabled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractCallbackDisabled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractCallbackDisabled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintForwarded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintForwarded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintForwarded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SourceNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintForwardingFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintForwardingFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintForwardingFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SourceNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AttesterEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *AttesterDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *SignatureThresholdUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OldSignatureThreshold != 0 {
+		n += 1 + sovEvents(uint64(m.OldSignatureThreshold))
+	}
+	if m.NewSignatureThreshold != 0 {
+		n += 1 + sovEvents(uint64(m.NewSignatureThreshold))
+	}
+	return n
 }
 
 func (m *OwnerUpdated) Size() (n int) {
@@ -2221,6 +3331,69 @@ func (m *OwnershipTransferStarted) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *AttesterManagerTransferStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousAttesterManager)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewAttesterManager)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *PauserTransferStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousPauser)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewPauser)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *TokenControllerTransferStarted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PreviousTokenController)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.NewTokenController)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovEvents(uint64(m.ExpiresAtHeight))
+	}
 	return n
 }
 
@@ -2488,31 +3661,1120 @@ func (m *RemoteTokenMessengerRemoved) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *SetBurnLimitPerMessage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.BurnLimitPerMessage.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ForwardingSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovEvents(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *ForwardingRemoved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *Account32Registered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallbackEnabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *ContractCallbackDisabled) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *MintForwarded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SourceDomain != 0 {
+		n += 1 + sovEvents(uint64(m.SourceDomain))
+	}
+	if m.SourceNonce != 0 {
+		n += 1 + sovEvents(uint64(m.SourceNonce))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Fee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *MintForwardingFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.SourceDomain != 0 {
+		n += 1 + sovEvents(uint64(m.SourceDomain))
+	}
+	if m.SourceNonce != 0 {
+		n += 1 + sovEvents(uint64(m.SourceNonce))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AttesterEnabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterEnabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterDisabled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterDisabled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SignatureThresholdUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SignatureThresholdUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SignatureThresholdUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldSignatureThreshold", wireType)
+			}
+			m.OldSignatureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldSignatureThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewSignatureThreshold", wireType)
+			}
+			m.NewSignatureThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewSignatureThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnerUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnerUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OwnershipTransferStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OwnershipTransferStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OwnershipTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttesterManagerTransferStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttesterManagerTransferStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttesterManagerTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAttesterManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousAttesterManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAttesterManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAttesterManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PauserTransferStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauserTransferStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauserTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousPauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenControllerTransferStarted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenControllerTransferStarted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenControllerTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTokenController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousTokenController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewTokenController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *SetBurnLimitPerMessage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.BurnLimitPerMessage.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *AttesterEnabled) Unmarshal(dAtA []byte) error {
+func (m *PauserUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2535,15 +4797,15 @@ func (m *AttesterEnabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttesterEnabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: PauserUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttesterEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PauserUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPauser", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2571,7 +4833,39 @@ func (m *AttesterEnabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attester = string(dAtA[iNdEx:postIndex])
+			m.PreviousPauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPauser = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2594,7 +4888,7 @@ func (m *AttesterEnabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AttesterDisabled) Unmarshal(dAtA []byte) error {
+func (m *AttesterManagerUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2617,15 +4911,15 @@ func (m *AttesterDisabled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AttesterDisabled: wiretype end group for non-group")
+			return fmt.Errorf("proto: AttesterManagerUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttesterDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AttesterManagerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAttesterManager", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2653,7 +4947,39 @@ func (m *AttesterDisabled) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attester = string(dAtA[iNdEx:postIndex])
+			m.PreviousAttesterManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAttesterManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAttesterManager = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2676,7 +5002,7 @@ func (m *AttesterDisabled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SignatureThresholdUpdated) Unmarshal(dAtA []byte) error {
+func (m *TokenControllerUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2699,17 +5025,17 @@ func (m *SignatureThresholdUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SignatureThresholdUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenControllerUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SignatureThresholdUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenControllerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OldSignatureThreshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTokenController", wireType)
 			}
-			m.OldSignatureThreshold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2719,16 +5045,29 @@ func (m *SignatureThresholdUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OldSignatureThreshold |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousTokenController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewSignatureThreshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenController", wireType)
 			}
-			m.NewSignatureThreshold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -2738,11 +5077,124 @@ func (m *SignatureThresholdUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewSignatureThreshold |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewTokenController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurningAndMintingPausedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurningAndMintingPausedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurningAndMintingPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BurningAndMintingUnpausedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BurningAndMintingUnpausedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BurningAndMintingUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2764,7 +5216,7 @@ func (m *SignatureThresholdUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnerUpdated) Unmarshal(dAtA []byte) error {
+func (m *SendingAndReceivingPausedEvent) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2787,76 +5239,62 @@ func (m *OwnerUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnerUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: SendingAndReceivingPausedEvent: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SendingAndReceivingPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SendingAndReceivingUnpausedEvent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SendingAndReceivingUnpausedEvent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SendingAndReceivingUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -2878,7 +5316,7 @@ func (m *OwnerUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OwnershipTransferStarted) Unmarshal(dAtA []byte) error {
+func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2901,15 +5339,34 @@ func (m *OwnershipTransferStarted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OwnershipTransferStarted: wiretype end group for non-group")
+			return fmt.Errorf("proto: DepositForBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OwnershipTransferStarted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2937,11 +5394,11 @@ func (m *OwnershipTransferStarted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			m.BurnToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2969,61 +5426,13 @@ func (m *OwnershipTransferStarted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PauserUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PauserUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PauserUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousPauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3051,13 +5460,13 @@ func (m *PauserUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousPauser = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3067,79 +5476,50 @@ func (m *PauserUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewPauser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AttesterManagerUpdated) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: AttesterManagerUpdated: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AttesterManagerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousAttesterManager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationTokenMessenger", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3149,29 +5529,31 @@ func (m *AttesterManagerUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousAttesterManager = string(dAtA[iNdEx:postIndex])
+			m.DestinationTokenMessenger = append(m.DestinationTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationTokenMessenger == nil {
+				m.DestinationTokenMessenger = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAttesterManager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3181,23 +5563,25 @@ func (m *AttesterManagerUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAttesterManager = string(dAtA[iNdEx:postIndex])
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3220,7 +5604,7 @@ func (m *AttesterManagerUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenControllerUpdated) Unmarshal(dAtA []byte) error {
+func (m *MintAndWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3243,17 +5627,17 @@ func (m *TokenControllerUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenControllerUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintAndWithdraw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenControllerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintAndWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousTokenController", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3263,27 +5647,29 @@ func (m *TokenControllerUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PreviousTokenController = string(dAtA[iNdEx:postIndex])
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenController", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3311,158 +5697,42 @@ func (m *TokenControllerUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewTokenController = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BurningAndMintingPausedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintToken", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BurningAndMintingPausedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BurningAndMintingPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BurningAndMintingUnpausedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BurningAndMintingUnpausedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BurningAndMintingUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SendingAndReceivingPausedEvent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SendingAndReceivingPausedEvent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendingAndReceivingPausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.MintToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3484,7 +5754,7 @@ func (m *SendingAndReceivingPausedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SendingAndReceivingUnpausedEvent) Unmarshal(dAtA []byte) error {
+func (m *TokenPairLinked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3507,12 +5777,97 @@ func (m *SendingAndReceivingUnpausedEvent) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SendingAndReceivingUnpausedEvent: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenPairLinked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SendingAndReceivingUnpausedEvent: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenPairLinked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+			}
+			m.RemoteDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteToken = append(m.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.RemoteToken == nil {
+				m.RemoteToken = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3534,7 +5889,7 @@ func (m *SendingAndReceivingUnpausedEvent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
+func (m *TokenPairUnlinked) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3557,17 +5912,17 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DepositForBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: TokenPairUnlinked: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TokenPairUnlinked: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3577,16 +5932,48 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+			}
+			m.RemoteDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3596,29 +5983,81 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnToken = string(dAtA[iNdEx:postIndex])
+			m.RemoteToken = append(m.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.RemoteToken == nil {
+				m.RemoteToken = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageSent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageSent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageSent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3628,29 +6067,79 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageReceived) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageReceived: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3678,13 +6167,13 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.Caller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
 			}
-			var byteLen int
+			m.SourceDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3694,31 +6183,16 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.SourceDomain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.MintRecipient == nil {
-				m.MintRecipient = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
-			m.DestinationDomain = 0
+			m.Nonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3728,14 +6202,14 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
+				m.Nonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationTokenMessenger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3762,14 +6236,14 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationTokenMessenger = append(m.DestinationTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
-			if m.DestinationTokenMessenger == nil {
-				m.DestinationTokenMessenger = []byte{}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBody", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -3796,9 +6270,9 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-			if m.DestinationCaller == nil {
-				m.DestinationCaller = []byte{}
+			m.MessageBody = append(m.MessageBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageBody == nil {
+				m.MessageBody = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3822,7 +6296,7 @@ func (m *DepositForBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MintAndWithdraw) Unmarshal(dAtA []byte) error {
+func (m *MaxMessageBodySizeUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3845,17 +6319,17 @@ func (m *MintAndWithdraw) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MintAndWithdraw: wiretype end group for non-group")
+			return fmt.Errorf("proto: MaxMessageBodySizeUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MintAndWithdraw: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MaxMessageBodySizeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMaxMessageBodySize", wireType)
 			}
-			var byteLen int
+			m.NewMaxMessageBodySize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3865,31 +6339,85 @@ func (m *MintAndWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.NewMaxMessageBodySize |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.MintRecipient == nil {
-				m.MintRecipient = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteTokenMessengerAdded) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteTokenMessengerAdded: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteTokenMessengerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			m.Domain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Domain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3899,31 +6427,100 @@ func (m *MintAndWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.RemoteTokenMessenger = append(m.RemoteTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
+			if m.RemoteTokenMessenger == nil {
+				m.RemoteTokenMessenger = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteTokenMessengerRemoved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteTokenMessengerRemoved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteTokenMessengerRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			}
+			m.Domain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Domain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3933,23 +6530,25 @@ func (m *MintAndWithdraw) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintToken = string(dAtA[iNdEx:postIndex])
+			m.RemoteTokenMessenger = append(m.RemoteTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
+			if m.RemoteTokenMessenger == nil {
+				m.RemoteTokenMessenger = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3972,7 +6571,7 @@ func (m *MintAndWithdraw) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenPairLinked) Unmarshal(dAtA []byte) error {
+func (m *SetBurnLimitPerMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3995,15 +6594,15 @@ func (m *TokenPairLinked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPairLinked: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBurnLimitPerMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPairLinked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBurnLimitPerMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4031,32 +6630,13 @@ func (m *TokenPairLinked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalToken = string(dAtA[iNdEx:postIndex])
+			m.Token = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
-			}
-			m.RemoteDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RemoteDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnLimitPerMessage", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4066,24 +6646,24 @@ func (m *TokenPairLinked) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteToken = append(m.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.RemoteToken == nil {
-				m.RemoteToken = []byte{}
+			if err := m.BurnLimitPerMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4107,7 +6687,7 @@ func (m *TokenPairLinked) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TokenPairUnlinked) Unmarshal(dAtA []byte) error {
+func (m *ForwardingSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4130,15 +6710,15 @@ func (m *TokenPairUnlinked) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TokenPairUnlinked: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardingSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TokenPairUnlinked: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardingSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4166,13 +6746,13 @@ func (m *TokenPairUnlinked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalToken = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
-			m.RemoteDomain = 0
+			m.DestinationDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4182,14 +6762,14 @@ func (m *TokenPairUnlinked) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RemoteDomain |= uint32(b&0x7F) << shift
+				m.DestinationDomain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4216,66 +6796,50 @@ func (m *TokenPairUnlinked) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteToken = append(m.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.RemoteToken == nil {
-				m.RemoteToken = []byte{}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MessageSent) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MessageSent: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageSent: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4285,24 +6849,24 @@ func (m *MessageSent) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
-			if m.Message == nil {
-				m.Message = []byte{}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4326,7 +6890,7 @@ func (m *MessageSent) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageReceived) Unmarshal(dAtA []byte) error {
+func (m *ForwardingRemoved) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4349,15 +6913,15 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageReceived: wiretype end group for non-group")
+			return fmt.Errorf("proto: ForwardingRemoved: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ForwardingRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Caller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4385,85 +6949,63 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Caller = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
-			}
-			m.SourceDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Account32Registered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
-			if m.Sender == nil {
-				m.Sender = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Account32Registered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Account32Registered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4473,25 +7015,23 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageBody = append(m.MessageBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageBody == nil {
-				m.MessageBody = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4514,7 +7054,7 @@ func (m *MessageReceived) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MaxMessageBodySizeUpdated) Unmarshal(dAtA []byte) error {
+func (m *ContractCallbackEnabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4537,17 +7077,17 @@ func (m *MaxMessageBodySizeUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MaxMessageBodySizeUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallbackEnabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MaxMessageBodySizeUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallbackEnabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMaxMessageBodySize", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.NewMaxMessageBodySize = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4557,11 +7097,24 @@ func (m *MaxMessageBodySizeUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewMaxMessageBodySize |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -4583,7 +7136,7 @@ func (m *MaxMessageBodySizeUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoteTokenMessengerAdded) Unmarshal(dAtA []byte) error {
+func (m *ContractCallbackDisabled) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4606,36 +7159,17 @@ func (m *RemoteTokenMessengerAdded) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoteTokenMessengerAdded: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractCallbackDisabled: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoteTokenMessengerAdded: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractCallbackDisabled: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
-			}
-			m.Domain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Domain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4645,25 +7179,23 @@ func (m *RemoteTokenMessengerAdded) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteTokenMessenger = append(m.RemoteTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
-			if m.RemoteTokenMessenger == nil {
-				m.RemoteTokenMessenger = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4686,7 +7218,7 @@ func (m *RemoteTokenMessengerAdded) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RemoteTokenMessengerRemoved) Unmarshal(dAtA []byte) error {
+func (m *MintForwarded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4709,17 +7241,17 @@ func (m *RemoteTokenMessengerRemoved) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RemoteTokenMessengerRemoved: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintForwarded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RemoteTokenMessengerRemoved: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintForwarded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Domain", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Domain = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4729,16 +7261,86 @@ func (m *RemoteTokenMessengerRemoved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Domain |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceNonce", wireType)
+			}
+			m.SourceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4748,24 +7350,58 @@ func (m *RemoteTokenMessengerRemoved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteTokenMessenger = append(m.RemoteTokenMessenger[:0], dAtA[iNdEx:postIndex]...)
-			if m.RemoteTokenMessenger == nil {
-				m.RemoteTokenMessenger = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4789,7 +7425,7 @@ func (m *RemoteTokenMessengerRemoved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetBurnLimitPerMessage) Unmarshal(dAtA []byte) error {
+func (m *MintForwardingFailed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4812,15 +7448,15 @@ func (m *SetBurnLimitPerMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetBurnLimitPerMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MintForwardingFailed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetBurnLimitPerMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MintForwardingFailed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Token", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4848,11 +7484,49 @@ func (m *SetBurnLimitPerMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Token = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceNonce", wireType)
+			}
+			m.SourceNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnLimitPerMessage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4880,9 +7554,7 @@ func (m *SetBurnLimitPerMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnLimitPerMessage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -0,0 +1,36 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMessageSentEvents(t *testing.T) {
+	em := sdk.NewEventManager()
+	require.NoError(t, em.EmitTypedEvent(&types.MessageSent{Message: []byte("first")}))
+	require.NoError(t, em.EmitTypedEvent(&types.MessageReceived{Caller: "caller", MessageBody: []byte("other")}))
+	require.NoError(t, em.EmitTypedEvent(&types.MessageSent{Message: []byte("second")}))
+	em.EmitEvent(sdk.NewEvent("circle.cctp.v1.MessageSent", sdk.NewAttribute("message", "not json")))
+
+	require.Equal(t, [][]byte{[]byte("first"), []byte("second")}, types.MessageSentEvents(em.ABCIEvents()))
+	require.Empty(t, types.MessageSentEvents(nil))
+}
@@ -31,11 +31,25 @@ type AccountKeeper interface {
 
 type BankKeeper interface {
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	BlockedAddr(addr sdk.AccAddress) bool
 }
 
 type FiatTokenfactoryKeeper interface {
 	Burn(ctx sdk.Context, msg *fiattokenfactorytypes.MsgBurn) (*fiattokenfactorytypes.MsgBurnResponse, error)
 	Mint(ctx sdk.Context, msg *fiattokenfactorytypes.MsgMint) (*fiattokenfactorytypes.MsgMintResponse, error)
 	GetMintingDenom(ctx context.Context) (val fiattokenfactorytypes.MintingDenom)
+	GetMinters(ctx context.Context, address string) (val fiattokenfactorytypes.Minters, found bool)
+}
+
+// WasmKeeper defines the CosmWasm methods used to call back contracts that
+// receive messages or mints. HasSudoEntryPoint reports whether the code of a
+// contract exports sudo, e.g. from the entry points wasmvm reports when
+// analyzing the code checksum in its code info.
+type WasmKeeper interface {
+	HasContractInfo(ctx context.Context, contractAddress sdk.AccAddress) bool
+	HasSudoEntryPoint(ctx context.Context, contractAddress sdk.AccAddress) bool
+	Sudo(ctx context.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
 }
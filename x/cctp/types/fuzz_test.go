@@ -0,0 +1,81 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/circlefin/noble-cctp/testutil/sample"
+	"github.com/circlefin/noble-cctp/x/cctp/types"
+)
+
+// FuzzMessageParse is seeded with the sample messages.
+func FuzzMessageParse(f *testing.F) {
+	for _, seed := range sample.Messages() {
+		f.Add(seed)
+	}
+	f.Add([]byte{})
+	f.Add(make([]byte, types.MessageBodyIndex-1))
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		message, err := new(types.Message).Parse(bz)
+		if err != nil {
+			if len(bz) >= types.MessageBodyIndex {
+				t.Fatalf("failed to parse %d byte message: %s", len(bz), err)
+			}
+			return
+		}
+
+		result, err := message.Bytes()
+		if err != nil {
+			t.Fatalf("failed to serialize parsed message: %s", err)
+		}
+		if !bytes.Equal(bz, result) {
+			t.Fatalf("round trip mismatch:\n%x\n%x", bz, result)
+		}
+	})
+}
+
+// FuzzBurnMessageParse is seeded with the sample burn messages.
+func FuzzBurnMessageParse(f *testing.F) {
+	for _, seed := range sample.BurnMessages() {
+		f.Add(seed)
+	}
+	for _, seed := range sample.Messages() {
+		f.Add(seed[types.MessageBodyIndex:])
+	}
+	f.Add([]byte{})
+
+	f.Fuzz(func(t *testing.T, bz []byte) {
+		burnMessage, err := new(types.BurnMessage).Parse(bz)
+		if err != nil {
+			if len(bz) == types.BurnMessageLen {
+				t.Fatalf("failed to parse %d byte burn message: %s", len(bz), err)
+			}
+			return
+		}
+
+		result, err := burnMessage.Bytes()
+		if err != nil {
+			t.Fatalf("failed to serialize parsed burn message: %s", err)
+		}
+		if !bytes.Equal(bz, result) {
+			t.Fatalf("round trip mismatch:\n%x\n%x", bz, result)
+		}
+	})
+}
@@ -17,6 +17,7 @@
 package types
 
 import (
+	"bytes"
 	"fmt"
 
 	"cosmossdk.io/errors"
@@ -40,6 +41,13 @@ func DefaultGenesis() *GenesisState {
 		TokenPairList:                     []TokenPair{},
 		UsedNoncesList:                    []Nonce{},
 		TokenMessengerList:                []RemoteTokenMessenger{},
+		AttesterHistory:                   []AttesterHistoryEntry{},
+		PendingRoles:                      []PendingRole{},
+		RoleHistory:                       []RoleHistoryEntry{},
+		Forwardings:                       []Forwarding{},
+		UsedNonceRecords:                  []UsedNonceRecord{},
+		RegisteredAccounts32:              []string{},
+		ContractCallbacks:                 []string{},
 	}
 }
 
@@ -118,6 +126,19 @@ func (gs GenesisState) Validate() error {
 		usedNonceIndexMap[index] = struct{}{}
 	}
 
+	// Check that used nonce records are unique and belong to a used nonce
+	usedNonceRecordIndexMap := make(map[string]struct{})
+	for _, elem := range gs.UsedNonceRecords {
+		index := string(UsedNonceKey(elem.Nonce, elem.SourceDomain))
+		if _, ok := usedNonceIndexMap[index]; !ok {
+			return fmt.Errorf("used nonce record for unused nonce")
+		}
+		if _, ok := usedNonceRecordIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for used nonce records")
+		}
+		usedNonceRecordIndexMap[index] = struct{}{}
+	}
+
 	// Check for duplicated index in remote token messengers
 	tokenMessengerIndexMap := make(map[string]struct{})
 	for _, elem := range gs.TokenMessengerList {
@@ -128,5 +149,111 @@ func (gs GenesisState) Validate() error {
 		tokenMessengerIndexMap[index] = struct{}{}
 	}
 
+	// Check for duplicated ids in the attester history
+	attesterHistoryIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.AttesterHistory {
+		if _, ok := attesterHistoryIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for attester history")
+		}
+		attesterHistoryIndexMap[elem.Id] = struct{}{}
+	}
+
+	// Check for invalid or duplicated pending role transfers
+	pendingRoleIndexMap := make(map[Role]struct{})
+	for _, elem := range gs.PendingRoles {
+		if elem.Role == Role_ROLE_UNSPECIFIED || Role_name[int32(elem.Role)] == "" {
+			return fmt.Errorf("invalid role for pending role transfer")
+		}
+		if _, err := sdk.AccAddressFromBech32(elem.Address); err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid pending %s address (%s)", elem.Role.Name(), err)
+		}
+		if elem.ExpiresAtHeight < 0 {
+			return errors.Wrapf(ErrInvalidRoleTransferExpiry, "negative expiry for pending %s", elem.Role.Name())
+		}
+		if _, ok := pendingRoleIndexMap[elem.Role]; ok {
+			return fmt.Errorf("duplicated pending role transfer for %s", elem.Role.Name())
+		}
+		pendingRoleIndexMap[elem.Role] = struct{}{}
+	}
+
+	// Check for duplicated ids in the role history
+	roleHistoryIndexMap := make(map[uint64]struct{})
+	for _, elem := range gs.RoleHistory {
+		if _, ok := roleHistoryIndexMap[elem.Id]; ok {
+			return fmt.Errorf("duplicated id for role history")
+		}
+		roleHistoryIndexMap[elem.Id] = struct{}{}
+	}
+
+	// Check for invalid or duplicated forwardings
+	forwardingIndexMap := make(map[string]struct{})
+	for _, elem := range gs.Forwardings {
+		address, err := sdk.AccAddressFromBech32(elem.Address)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid forwarding address (%s)", err)
+		}
+		index := string(ForwardingKey(address))
+		if _, ok := forwardingIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for forwardings")
+		}
+		forwardingIndexMap[index] = struct{}{}
+	}
+
+	// Check for invalid or duplicated registered 32-byte accounts
+	account32IndexMap := make(map[string]struct{})
+	for _, elem := range gs.RegisteredAccounts32 {
+		address, err := sdk.AccAddressFromBech32(elem)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid registered 32-byte account (%s)", err)
+		}
+		if len(address) != AddressBytesLen || bytes.Equal(address[:12], make([]byte, 12)) {
+			return errors.Wrapf(ErrInvalidAddress, "%s is not a 32-byte account", elem)
+		}
+		index := string(Account32Key(address))
+		if _, ok := account32IndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for registered 32-byte accounts")
+		}
+		account32IndexMap[index] = struct{}{}
+	}
+
+	// Check for invalid or duplicated contract callbacks
+	contractCallbackIndexMap := make(map[string]struct{})
+	for _, elem := range gs.ContractCallbacks {
+		address, err := sdk.AccAddressFromBech32(elem)
+		if err != nil {
+			return errors.Wrapf(ErrInvalidAddress, "invalid contract callback address (%s)", err)
+		}
+		index := string(ContractCallbackKey(address))
+		if _, ok := contractCallbackIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for contract callbacks")
+		}
+		contractCallbackIndexMap[index] = struct{}{}
+	}
+
+	// Check that the local domain is never used as a remote domain
+	if gs.LocalDomain != nil {
+		localDomain := gs.LocalDomain.DomainId
+		for _, elem := range gs.UsedNoncesList {
+			if elem.SourceDomain == localDomain {
+				return fmt.Errorf("used nonce from local domain %d", localDomain)
+			}
+		}
+		for _, elem := range gs.TokenMessengerList {
+			if elem.DomainId == localDomain {
+				return fmt.Errorf("remote token messenger for local domain %d", localDomain)
+			}
+		}
+		for _, elem := range gs.TokenPairList {
+			if elem.RemoteDomain == localDomain {
+				return fmt.Errorf("token pair for local domain %d", localDomain)
+			}
+		}
+		for _, elem := range gs.Forwardings {
+			if elem.DestinationDomain == localDomain {
+				return fmt.Errorf("forwarding to local domain %d", localDomain)
+			}
+		}
+	}
+
 	return nil
 }
@@ -39,6 +39,14 @@ type GenesisState struct {
 	TokenPairList                     []TokenPair                        `protobuf:"bytes,13,rep,name=token_pair_list,json=tokenPairList,proto3" json:"token_pair_list"`
 	UsedNoncesList                    []Nonce                            `protobuf:"bytes,14,rep,name=used_nonces_list,json=usedNoncesList,proto3" json:"used_nonces_list"`
 	TokenMessengerList                []RemoteTokenMessenger             `protobuf:"bytes,15,rep,name=token_messenger_list,json=tokenMessengerList,proto3" json:"token_messenger_list"`
+	AttesterHistory                   []AttesterHistoryEntry             `protobuf:"bytes,16,rep,name=attester_history,json=attesterHistory,proto3" json:"attester_history"`
+	PendingRoles                      []PendingRole                      `protobuf:"bytes,17,rep,name=pending_roles,json=pendingRoles,proto3" json:"pending_roles"`
+	RoleHistory                       []RoleHistoryEntry                 `protobuf:"bytes,18,rep,name=role_history,json=roleHistory,proto3" json:"role_history"`
+	LocalDomain                       *LocalDomain                       `protobuf:"bytes,19,opt,name=local_domain,json=localDomain,proto3" json:"local_domain,omitempty"`
+	Forwardings                       []Forwarding                       `protobuf:"bytes,20,rep,name=forwardings,proto3" json:"forwardings"`
+	UsedNonceRecords                  []UsedNonceRecord                  `protobuf:"bytes,21,rep,name=used_nonce_records,json=usedNonceRecords,proto3" json:"used_nonce_records"`
+	RegisteredAccounts32              []string                           `protobuf:"bytes,22,rep,name=registered_accounts32,json=registeredAccounts32,proto3" json:"registered_accounts32,omitempty"`
+	ContractCallbacks                 []string                           `protobuf:"bytes,23,rep,name=contract_callbacks,json=contractCallbacks,proto3" json:"contract_callbacks,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -172,6 +180,62 @@ func (m *GenesisState) GetTokenMessengerList() []RemoteTokenMessenger {
 	return nil
 }
 
+func (m *GenesisState) GetAttesterHistory() []AttesterHistoryEntry {
+	if m != nil {
+		return m.AttesterHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingRoles() []PendingRole {
+	if m != nil {
+		return m.PendingRoles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleHistory() []RoleHistoryEntry {
+	if m != nil {
+		return m.RoleHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLocalDomain() *LocalDomain {
+	if m != nil {
+		return m.LocalDomain
+	}
+	return nil
+}
+
+func (m *GenesisState) GetForwardings() []Forwarding {
+	if m != nil {
+		return m.Forwardings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUsedNonceRecords() []UsedNonceRecord {
+	if m != nil {
+		return m.UsedNonceRecords
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRegisteredAccounts32() []string {
+	if m != nil {
+		return m.RegisteredAccounts32
+	}
+	return nil
+}
+
+func (m *GenesisState) GetContractCallbacks() []string {
+	if m != nil {
+		return m.ContractCallbacks
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "circle.cctp.v1.GenesisState")
 }
@@ -179,50 +243,64 @@ func init() {
 func init() { proto.RegisterFile("circle/cctp/v1/genesis.proto", fileDescriptor_2053ebb2404c1e41) }
 
 var fileDescriptor_2053ebb2404c1e41 = []byte{
-	// 681 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0xcd, 0x6e, 0x13, 0x31,
-	0x10, 0x4e, 0xfa, 0x47, 0xeb, 0xfe, 0xca, 0xa4, 0x74, 0x09, 0x90, 0x96, 0x02, 0x22, 0xfc, 0x25,
-	0x6a, 0xb9, 0x71, 0x4b, 0x2a, 0xa8, 0x84, 0x1a, 0x54, 0x6d, 0xca, 0x05, 0x21, 0xad, 0xbc, 0xbb,
-	0xc3, 0xd6, 0x62, 0xd7, 0x5e, 0xd9, 0x4e, 0x48, 0x7b, 0xe5, 0x05, 0x78, 0x01, 0xde, 0xa7, 0xc7,
-	0x1e, 0x39, 0x21, 0xd4, 0xbe, 0x08, 0x5a, 0x7b, 0xdd, 0xaa, 0x6e, 0x2a, 0xb8, 0xad, 0xbf, 0xf9,
-	0xbe, 0x6f, 0x3c, 0xeb, 0x99, 0x41, 0xf7, 0x23, 0x2a, 0xa2, 0x14, 0xda, 0x51, 0xa4, 0xf2, 0xf6,
-	0x70, 0xab, 0x9d, 0x00, 0x03, 0x49, 0x65, 0x2b, 0x17, 0x5c, 0x71, 0xbc, 0x64, 0xa2, 0xad, 0x22,
-	0xda, 0x1a, 0x6e, 0xd5, 0x1f, 0x38, 0x6c, 0xa2, 0x14, 0x48, 0x05, 0xc2, 0xd0, 0xeb, 0x6d, 0x27,
-	0x1c, 0x0e, 0x04, 0xa3, 0x2c, 0x09, 0x08, 0x8b, 0x83, 0x8c, 0x32, 0x55, 0x7c, 0xe7, 0x64, 0x20,
-	0x21, 0x2e, 0x05, 0xcf, 0x1d, 0x41, 0x46, 0x46, 0x41, 0x06, 0x52, 0x92, 0x04, 0x82, 0x90, 0xc7,
-	0x47, 0x81, 0xa4, 0xc7, 0x50, 0x72, 0xeb, 0x0e, 0x97, 0x71, 0x16, 0xd9, 0xd8, 0x0b, 0x27, 0x96,
-	0x83, 0xb8, 0xf4, 0x19, 0x08, 0x16, 0xa4, 0x34, 0xa3, 0xea, 0x06, 0xb2, 0x80, 0x8c, 0x2b, 0x08,
-	0x14, 0xff, 0x0a, 0x4c, 0xab, 0x80, 0x25, 0x17, 0x25, 0xbd, 0x71, 0xc8, 0x12, 0x58, 0x6c, 0x4b,
-	0x12, 0x10, 0x01, 0x1d, 0x16, 0xa7, 0x32, 0x97, 0xbc, 0x5a, 0x5d, 0xd3, 0xd5, 0xd2, 0x84, 0x11,
-	0x35, 0x10, 0x10, 0xa8, 0x43, 0x01, 0xf2, 0x90, 0xa7, 0x96, 0xb9, 0xee, 0x30, 0xcd, 0x5d, 0x72,
-	0x42, 0xed, 0x35, 0x6a, 0x09, 0x4f, 0xb8, 0xfe, 0x6c, 0x17, 0x5f, 0x06, 0xdd, 0xfc, 0x39, 0x8b,
-	0x16, 0x76, 0xcd, 0x83, 0xf5, 0x15, 0x51, 0x80, 0x6b, 0x68, 0x9a, 0x7f, 0x63, 0x20, 0xbc, 0x89,
-	0x8d, 0x6a, 0x73, 0xce, 0x37, 0x07, 0xfc, 0x0c, 0xad, 0xd8, 0x87, 0x0a, 0x32, 0xc2, 0x48, 0x02,
-	0xc2, 0x9b, 0xd4, 0x84, 0x65, 0x8b, 0xf7, 0x0c, 0x8c, 0xef, 0xa0, 0x19, 0x5d, 0x82, 0xf0, 0xa6,
-	0x34, 0xa1, 0x3c, 0x15, 0x16, 0xe6, 0x4e, 0x11, 0x67, 0x4a, 0xf0, 0x34, 0x05, 0xe1, 0x4d, 0x1b,
-	0x0b, 0x8d, 0xef, 0x5c, 0xc0, 0x78, 0x07, 0x2d, 0x5e, 0x64, 0x4b, 0xa9, 0x54, 0xde, 0xcc, 0xc6,
-	0x64, 0x73, 0x7e, 0xdb, 0x6b, 0x5d, 0xed, 0xa5, 0x56, 0xa7, 0x24, 0x75, 0xa7, 0x4e, 0x7e, 0xaf,
-	0x57, 0xfc, 0x05, 0x2b, 0xda, 0xa3, 0x52, 0xe1, 0x04, 0xdd, 0x1b, 0xff, 0x86, 0xc6, 0xf2, 0x96,
-	0xb6, 0x7c, 0xe4, 0x5a, 0xee, 0x83, 0xe8, 0x19, 0x45, 0x77, 0x20, 0xd8, 0x5e, 0xc1, 0x2f, 0xdd,
-	0xd7, 0xf2, 0xeb, 0x21, 0x9d, 0x28, 0x46, 0xf5, 0x9b, 0xbb, 0xd4, 0x9b, 0xdd, 0xa8, 0x36, 0xe7,
-	0xb7, 0x9f, 0xba, 0x79, 0xba, 0x46, 0xd1, 0x61, 0x71, 0xcf, 0xf0, 0xf7, 0x35, 0xdd, 0x5f, 0x0b,
-	0xc7, 0x07, 0xf0, 0xf7, 0x2a, 0x7a, 0xf2, 0x5f, 0x9d, 0xe3, 0xcd, 0xe9, 0x8c, 0x5b, 0x6e, 0xc6,
-	0xbe, 0x11, 0x77, 0x58, 0xec, 0x5b, 0x69, 0x59, 0x8e, 0x2c, 0x73, 0x3f, 0x94, 0xff, 0xa2, 0xe0,
-	0x8f, 0x68, 0x75, 0xec, 0x80, 0x79, 0x48, 0x27, 0xdd, 0x74, 0x93, 0xf6, 0xc8, 0xc8, 0xfe, 0x33,
-	0x1e, 0x1f, 0xf5, 0xe9, 0x31, 0xf8, 0x38, 0xbb, 0x86, 0xe1, 0x5d, 0x54, 0x63, 0x30, 0x52, 0x01,
-	0x19, 0x12, 0x9a, 0x92, 0x30, 0x85, 0x40, 0x8f, 0xa6, 0x37, 0xaf, 0x5d, 0x57, 0x5d, 0xd7, 0x0f,
-	0x45, 0xd0, 0xc7, 0x85, 0xa4, 0x63, 0x15, 0x1a, 0xc3, 0x7d, 0x74, 0x7b, 0xcc, 0x88, 0x78, 0x0b,
-	0xe3, 0x6f, 0xd7, 0xb7, 0xd4, 0x03, 0xcb, 0xf4, 0xb1, 0xbc, 0x86, 0xe1, 0x5d, 0xb4, 0x7c, 0x39,
-	0x4d, 0xa6, 0x7b, 0x16, 0x75, 0xf7, 0xdc, 0x75, 0x0d, 0x0f, 0x0a, 0xda, 0x3e, 0xa1, 0xb6, 0x23,
-	0x17, 0x95, 0x05, 0x74, 0xa7, 0xbc, 0x45, 0x2b, 0xc5, 0x5f, 0x34, 0xc5, 0x49, 0xe3, 0xb4, 0xa4,
-	0x9d, 0xc6, 0x97, 0x58, 0xba, 0x2c, 0x15, 0x22, 0x0d, 0x48, 0x6d, 0xf3, 0x19, 0xd5, 0x9c, 0x4d,
-	0x63, 0xac, 0x96, 0xb5, 0xd5, 0x63, 0xd7, 0xca, 0xd7, 0xcb, 0x49, 0x5f, 0xad, 0x67, 0x05, 0xa5,
-	0x33, 0x56, 0x57, 0xd0, 0xc2, 0xfd, 0xfd, 0xd4, 0x6c, 0x75, 0x65, 0xa2, 0x98, 0x5a, 0x41, 0x32,
-	0xd9, 0x7d, 0x77, 0x72, 0xd6, 0xa8, 0x9e, 0x9e, 0x35, 0xaa, 0x7f, 0xce, 0x1a, 0xd5, 0x1f, 0xe7,
-	0x8d, 0xca, 0xe9, 0x79, 0xa3, 0xf2, 0xeb, 0xbc, 0x51, 0xf9, 0xf4, 0x32, 0xa1, 0xea, 0x70, 0x10,
-	0xb6, 0x22, 0x9e, 0x95, 0x4b, 0xfb, 0x0b, 0x65, 0x6d, 0xc6, 0xc3, 0x14, 0x5e, 0xe9, 0x25, 0x34,
-	0x32, 0xbb, 0x48, 0x1d, 0xe5, 0x20, 0xc3, 0x19, 0xbd, 0x6e, 0x5e, 0xff, 0x0d, 0x00, 0x00, 0xff,
-	0xff, 0xb9, 0x08, 0xb7, 0xa1, 0x2d, 0x06, 0x00, 0x00,
+	// 910 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xcd, 0x6e, 0x1b, 0x37,
+	0x10, 0xb6, 0x62, 0xc7, 0x8d, 0x29, 0xd9, 0x56, 0x18, 0x39, 0x66, 0x95, 0x56, 0x56, 0xd2, 0x16,
+	0x55, 0x7f, 0x22, 0xc1, 0xf6, 0xad, 0x87, 0x02, 0x92, 0x1b, 0xbb, 0x2d, 0xac, 0xc2, 0x58, 0xc5,
+	0x97, 0xa2, 0xc0, 0x82, 0xda, 0x65, 0xd6, 0x44, 0x76, 0xc9, 0x05, 0x49, 0x39, 0x52, 0xae, 0x7d,
+	0x81, 0x3e, 0x54, 0x0f, 0x39, 0xfa, 0xd8, 0x53, 0x51, 0xd8, 0x2f, 0x52, 0x2c, 0x7f, 0x24, 0x8b,
+	0x92, 0xd0, 0xde, 0x96, 0xf3, 0xfd, 0xcc, 0x90, 0x9a, 0x21, 0x05, 0x3e, 0x89, 0xa8, 0x88, 0x52,
+	0xd2, 0x89, 0x22, 0x95, 0x77, 0xae, 0x0f, 0x3b, 0x09, 0x61, 0x44, 0x52, 0xd9, 0xce, 0x05, 0x57,
+	0x1c, 0xee, 0x18, 0xb4, 0x5d, 0xa0, 0xed, 0xeb, 0xc3, 0xfa, 0xa7, 0x1e, 0x1b, 0x2b, 0x45, 0xa4,
+	0x22, 0xc2, 0xd0, 0xeb, 0x1d, 0x0f, 0x1e, 0x8e, 0x04, 0xa3, 0x2c, 0x09, 0x31, 0x8b, 0xc3, 0x8c,
+	0x32, 0x55, 0x7c, 0xe7, 0x78, 0x24, 0x49, 0x6c, 0x05, 0x07, 0x9e, 0xe0, 0x0d, 0x17, 0xef, 0xb0,
+	0x88, 0x29, 0x4b, 0x2c, 0xe1, 0xb9, 0x47, 0x48, 0x79, 0x84, 0xd3, 0x30, 0xe6, 0x19, 0xa6, 0xcc,
+	0x52, 0xbe, 0xf6, 0x28, 0x19, 0x1e, 0x87, 0x19, 0x91, 0x12, 0x27, 0x24, 0x1c, 0xf2, 0x78, 0x12,
+	0x4a, 0xfa, 0x9e, 0x58, 0x6e, 0xdd, 0xe3, 0x32, 0xce, 0x22, 0x87, 0x7d, 0xe3, 0x61, 0x39, 0x11,
+	0x33, 0x9f, 0x91, 0x60, 0x61, 0x4a, 0x33, 0xaa, 0x56, 0x90, 0x05, 0xc9, 0xb8, 0x22, 0xa1, 0xe2,
+	0x6f, 0x09, 0xd3, 0x2a, 0xc2, 0x12, 0x22, 0x56, 0x64, 0x15, 0x3c, 0x25, 0xf6, 0x84, 0xeb, 0xdf,
+	0x79, 0x98, 0x24, 0x2c, 0x76, 0x47, 0x26, 0x48, 0x44, 0xe8, 0x75, 0xb1, 0xb2, 0x75, 0xc8, 0xf9,
+	0xd3, 0x6b, 0xf9, 0x5a, 0x9a, 0x30, 0xac, 0x46, 0x82, 0x84, 0xea, 0x4a, 0x10, 0x79, 0xc5, 0xd3,
+	0x55, 0xe7, 0x6c, 0xea, 0xcc, 0x31, 0x75, 0x25, 0xd6, 0x12, 0x9e, 0x70, 0xfd, 0xd9, 0x29, 0xbe,
+	0x4c, 0xf4, 0xc5, 0x9f, 0x15, 0x50, 0x39, 0x33, 0x0d, 0x31, 0x50, 0x58, 0x11, 0x58, 0x03, 0x0f,
+	0xf9, 0x3b, 0x46, 0x04, 0x7a, 0xd0, 0x2c, 0xb5, 0xb6, 0x02, 0xb3, 0x80, 0x5f, 0x81, 0xaa, 0x6b,
+	0x84, 0x30, 0xc3, 0x0c, 0x27, 0x44, 0xa0, 0x75, 0x4d, 0xd8, 0x75, 0xf1, 0xbe, 0x09, 0xc3, 0xa7,
+	0x60, 0x53, 0x6f, 0x41, 0xa0, 0x0d, 0x4d, 0xb0, 0xab, 0xc2, 0xc2, 0xd4, 0x14, 0x71, 0xa6, 0x04,
+	0x4f, 0x53, 0x22, 0xd0, 0x43, 0x63, 0xa1, 0xe3, 0x27, 0xd3, 0x30, 0x3c, 0x01, 0xdb, 0xd3, 0x6c,
+	0x29, 0x95, 0x0a, 0x6d, 0x36, 0xd7, 0x5b, 0xe5, 0x23, 0xd4, 0x9e, 0xef, 0xd5, 0x76, 0xd7, 0x92,
+	0x7a, 0x1b, 0x1f, 0xfe, 0x3e, 0x58, 0x0b, 0x2a, 0x4e, 0x74, 0x4e, 0xa5, 0x82, 0x09, 0x78, 0xb6,
+	0xfc, 0xf7, 0x35, 0x96, 0x1f, 0x69, 0xcb, 0xcf, 0x7c, 0xcb, 0x0b, 0x22, 0xfa, 0x46, 0xd1, 0x1b,
+	0x09, 0x76, 0x5e, 0xf0, 0xad, 0xfb, 0x7e, 0xbe, 0x08, 0xe9, 0x44, 0x31, 0xa8, 0xaf, 0x9e, 0x02,
+	0xf4, 0xa8, 0x59, 0x6a, 0x95, 0x8f, 0xbe, 0xf4, 0xf3, 0xf4, 0x8c, 0xa2, 0xcb, 0xe2, 0xbe, 0xe1,
+	0x5f, 0x68, 0x7a, 0xb0, 0x3f, 0x5c, 0x0e, 0xc0, 0xdf, 0x4b, 0xe0, 0x8b, 0xff, 0xd5, 0x39, 0x68,
+	0x4b, 0x67, 0x3c, 0xf4, 0x33, 0x0e, 0x8c, 0xb8, 0xcb, 0xe2, 0xc0, 0x49, 0xed, 0x76, 0xa4, 0xcd,
+	0xfd, 0x5c, 0xfe, 0x17, 0x05, 0x5e, 0x82, 0xbd, 0xa5, 0xc3, 0x87, 0x80, 0x4e, 0xfa, 0xc2, 0x4f,
+	0xda, 0xc7, 0x63, 0x77, 0x66, 0x3c, 0x9e, 0x0c, 0xe8, 0x7b, 0x12, 0xc0, 0x6c, 0x21, 0x06, 0xcf,
+	0x40, 0x8d, 0x91, 0xb1, 0x0a, 0xf1, 0x35, 0xa6, 0x29, 0x1e, 0xa6, 0x24, 0xd4, 0x63, 0x8b, 0xca,
+	0xda, 0x75, 0xcf, 0x77, 0xfd, 0xa5, 0x00, 0x03, 0x58, 0x48, 0xba, 0x4e, 0xa1, 0x63, 0x70, 0x00,
+	0x9e, 0x2c, 0x19, 0x11, 0x54, 0x59, 0x5e, 0xdd, 0xc0, 0x51, 0x5f, 0x3b, 0x66, 0x00, 0xe5, 0x42,
+	0x0c, 0x9e, 0x81, 0xdd, 0xd9, 0x34, 0x99, 0xee, 0xd9, 0xd6, 0xdd, 0xf3, 0xb1, 0x6f, 0xf8, 0xba,
+	0xa0, 0x5d, 0x60, 0xea, 0x3a, 0x72, 0x5b, 0xb9, 0x80, 0xee, 0x94, 0x57, 0xa0, 0x5a, 0x9c, 0xa2,
+	0xd9, 0x9c, 0x34, 0x4e, 0x3b, 0xcd, 0xf5, 0x95, 0x5b, 0xb4, 0x2e, 0x3b, 0x85, 0x48, 0x07, 0xa4,
+	0xb6, 0xf9, 0x0d, 0xd4, 0xbc, 0x5b, 0xc8, 0x58, 0xed, 0x6a, 0xab, 0xcf, 0x7d, 0xab, 0x40, 0x5f,
+	0x5c, 0xba, 0xb4, 0xbe, 0x13, 0x58, 0x67, 0xa8, 0xe6, 0xa2, 0xda, 0xfd, 0xf2, 0xde, 0xa8, 0x5f,
+	0x51, 0xa9, 0xb8, 0x98, 0xa0, 0xea, 0x72, 0x67, 0x37, 0x7f, 0x3f, 0x1a, 0xda, 0x2b, 0xa6, 0xc4,
+	0xc4, 0x3a, 0xef, 0xe2, 0x79, 0x0c, 0x9e, 0x82, 0xed, 0xdc, 0xb6, 0xaf, 0xbe, 0x1c, 0xd1, 0x63,
+	0xed, 0xf9, 0x6c, 0x71, 0x00, 0x35, 0x29, 0xe0, 0xa9, 0xdb, 0x7e, 0x25, 0x9f, 0x85, 0x24, 0xfc,
+	0x09, 0x54, 0x0a, 0xfd, 0xb4, 0x34, 0xa8, 0x6d, 0x9a, 0x0b, 0x9b, 0xe6, 0x29, 0x59, 0x52, 0x56,
+	0x59, 0xcc, 0xe2, 0xf0, 0x7b, 0x50, 0xb9, 0xff, 0xd8, 0xa0, 0x27, 0xcd, 0xd2, 0xb2, 0x8a, 0xce,
+	0x0b, 0xce, 0x0f, 0x9a, 0x12, 0x94, 0xd3, 0xd9, 0x02, 0xf6, 0x40, 0x79, 0xf6, 0x9a, 0x49, 0x54,
+	0xd3, 0x95, 0xd4, 0x7d, 0xf9, 0xe9, 0x94, 0xe2, 0x6a, 0xb8, 0x27, 0x82, 0x03, 0x00, 0x67, 0x2d,
+	0x51, 0x0c, 0x35, 0x17, 0xb1, 0x44, 0x7b, 0xda, 0xea, 0xc0, 0xb7, 0xba, 0x74, 0x7d, 0x10, 0x68,
+	0x9e, 0xf5, 0xab, 0x8e, 0xe6, 0xc3, 0x12, 0x1e, 0x83, 0x3d, 0x41, 0x12, 0x5a, 0x1c, 0x3f, 0x89,
+	0x43, 0x1c, 0x45, 0x7c, 0xc4, 0x94, 0x3c, 0x3e, 0x42, 0x4f, 0x9b, 0xeb, 0xad, 0xad, 0xa0, 0x36,
+	0x03, 0xbb, 0x53, 0x0c, 0xbe, 0x04, 0x50, 0xdf, 0xcc, 0x38, 0x52, 0x61, 0x84, 0xd3, 0x74, 0x88,
+	0xa3, 0xb7, 0x12, 0xed, 0x6b, 0xc5, 0x63, 0x87, 0x9c, 0x38, 0xe0, 0xe7, 0x8d, 0x47, 0xa5, 0xea,
+	0x83, 0xe2, 0x72, 0x17, 0x38, 0x93, 0xbd, 0xd3, 0x0f, 0xb7, 0x8d, 0xd2, 0xcd, 0x6d, 0xa3, 0xf4,
+	0xcf, 0x6d, 0xa3, 0xf4, 0xc7, 0x5d, 0x63, 0xed, 0xe6, 0xae, 0xb1, 0xf6, 0xd7, 0x5d, 0x63, 0xed,
+	0xd7, 0x6f, 0x13, 0xaa, 0xae, 0x46, 0xc3, 0x76, 0xc4, 0x33, 0xfb, 0xdf, 0xe1, 0x0d, 0x65, 0x1d,
+	0xc6, 0x87, 0x29, 0x79, 0xa9, 0xdf, 0xaa, 0xb1, 0x79, 0xb2, 0xd4, 0x24, 0x27, 0x72, 0xb8, 0xa9,
+	0x5f, 0xa5, 0xe3, 0x7f, 0x07, 0x00, 0x8d, 0xdb, 0x41, 0x66, 0xb4, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -245,6 +323,122 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ContractCallbacks) > 0 {
+		for iNdEx := len(m.ContractCallbacks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContractCallbacks[iNdEx])
+			copy(dAtA[i:], m.ContractCallbacks[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.ContractCallbacks[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xba
+		}
+	}
+	if len(m.RegisteredAccounts32) > 0 {
+		for iNdEx := len(m.RegisteredAccounts32) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RegisteredAccounts32[iNdEx])
+			copy(dAtA[i:], m.RegisteredAccounts32[iNdEx])
+			i = encodeVarintGenesis(dAtA, i, uint64(len(m.RegisteredAccounts32[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.UsedNonceRecords) > 0 {
+		for iNdEx := len(m.UsedNonceRecords) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsedNonceRecords[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Forwardings) > 0 {
+		for iNdEx := len(m.Forwardings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Forwardings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.LocalDomain != nil {
+		{
+			size, err := m.LocalDomain.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.RoleHistory) > 0 {
+		for iNdEx := len(m.RoleHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PendingRoles) > 0 {
+		for iNdEx := len(m.PendingRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.AttesterHistory) > 0 {
+		for iNdEx := len(m.AttesterHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AttesterHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
 	if len(m.TokenMessengerList) > 0 {
 		for iNdEx := len(m.TokenMessengerList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -489,6 +683,52 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.AttesterHistory) > 0 {
+		for _, e := range m.AttesterHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingRoles) > 0 {
+		for _, e := range m.PendingRoles {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoleHistory) > 0 {
+		for _, e := range m.RoleHistory {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LocalDomain != nil {
+		l = m.LocalDomain.Size()
+		n += 2 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Forwardings) > 0 {
+		for _, e := range m.Forwardings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.UsedNonceRecords) > 0 {
+		for _, e := range m.UsedNonceRecords {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RegisteredAccounts32) > 0 {
+		for _, s := range m.RegisteredAccounts32 {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ContractCallbacks) > 0 {
+		for _, s := range m.ContractCallbacks {
+			l = len(s)
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1005,6 +1245,276 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttesterHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttesterHistory = append(m.AttesterHistory, AttesterHistoryEntry{})
+			if err := m.AttesterHistory[len(m.AttesterHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRoles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingRoles = append(m.PendingRoles, PendingRole{})
+			if err := m.PendingRoles[len(m.PendingRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleHistory = append(m.RoleHistory, RoleHistoryEntry{})
+			if err := m.RoleHistory[len(m.RoleHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalDomain", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LocalDomain == nil {
+				m.LocalDomain = &LocalDomain{}
+			}
+			if err := m.LocalDomain.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwardings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Forwardings = append(m.Forwardings, Forwarding{})
+			if err := m.Forwardings[len(m.Forwardings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedNonceRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsedNonceRecords = append(m.UsedNonceRecords, UsedNonceRecord{})
+			if err := m.UsedNonceRecords[len(m.UsedNonceRecords)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredAccounts32", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredAccounts32 = append(m.RegisteredAccounts32, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractCallbacks", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractCallbacks = append(m.ContractCallbacks, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
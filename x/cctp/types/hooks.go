@@ -0,0 +1,103 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CCTPHooks lets other modules observe and veto CCTP actions. An error
+// returned by a hook reverts the action it was called for.
+type CCTPHooks interface {
+	// BeforeDepositForBurn is called before amount of burnToken is burned from
+	// the from account.
+	BeforeDepositForBurn(ctx context.Context, from sdk.AccAddress, amount math.Int, burnToken string, destinationDomain uint32, mintRecipient []byte) error
+	// AfterDepositForBurn is called once the burn was sent as message nonce.
+	AfterDepositForBurn(ctx context.Context, nonce uint64, from sdk.AccAddress, amount math.Int, burnToken string, destinationDomain uint32, mintRecipient []byte) error
+	// BeforeMint is called before coin is minted to recipient for the
+	// message nonce from sourceDomain.
+	BeforeMint(ctx context.Context, sourceDomain uint32, nonce uint64, recipient sdk.AccAddress, coin sdk.Coin) error
+	// AfterMint is called once coin was minted to recipient.
+	AfterMint(ctx context.Context, sourceDomain uint32, nonce uint64, recipient sdk.AccAddress, coin sdk.Coin) error
+	// AfterMessageReceived is called once message was received, including
+	// any mint it carried.
+	AfterMessageReceived(ctx context.Context, message *Message) error
+}
+
+// CCTPHooksWrapper is a wrapper for modules to inject CCTPHooks using depinject.
+type CCTPHooksWrapper struct{ CCTPHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (CCTPHooksWrapper) IsOnePerModuleType() {}
+
+var _ CCTPHooks = MultiCCTPHooks{}
+
+// MultiCCTPHooks combines multiple CCTPHooks, calling them in order and
+// stopping at the first error.
+type MultiCCTPHooks []CCTPHooks
+
+func NewMultiCCTPHooks(hooks ...CCTPHooks) MultiCCTPHooks {
+	return hooks
+}
+
+func (h MultiCCTPHooks) BeforeDepositForBurn(ctx context.Context, from sdk.AccAddress, amount math.Int, burnToken string, destinationDomain uint32, mintRecipient []byte) error {
+	for i := range h {
+		if err := h[i].BeforeDepositForBurn(ctx, from, amount, burnToken, destinationDomain, mintRecipient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiCCTPHooks) AfterDepositForBurn(ctx context.Context, nonce uint64, from sdk.AccAddress, amount math.Int, burnToken string, destinationDomain uint32, mintRecipient []byte) error {
+	for i := range h {
+		if err := h[i].AfterDepositForBurn(ctx, nonce, from, amount, burnToken, destinationDomain, mintRecipient); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiCCTPHooks) BeforeMint(ctx context.Context, sourceDomain uint32, nonce uint64, recipient sdk.AccAddress, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].BeforeMint(ctx, sourceDomain, nonce, recipient, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiCCTPHooks) AfterMint(ctx context.Context, sourceDomain uint32, nonce uint64, recipient sdk.AccAddress, coin sdk.Coin) error {
+	for i := range h {
+		if err := h[i].AfterMint(ctx, sourceDomain, nonce, recipient, coin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h MultiCCTPHooks) AfterMessageReceived(ctx context.Context, message *Message) error {
+	for i := range h {
+		if err := h[i].AfterMessageReceived(ctx, message); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -18,6 +18,7 @@ package types
 
 import (
 	"encoding/binary"
+	"fmt"
 
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -37,18 +38,29 @@ const (
 	MemStoreKey = "mem_" + StoreKey
 
 	BurningAndMintingPausedKey           = "BurningAndMintingPaused/value/"
+	LocalDomainKey                       = "LocalDomain/value/"
 	MaxMessageBodySizeKey                = "MaxMessageBodySize/value/"
 	NextAvailableNonceKey                = "NextAvailableNonce/value/"
 	SendingAndReceivingMessagesPausedKey = "SendingAndReceivingMessagesPaused/value/"
 	SignatureThresholdKey                = "SignatureThreshold/value/"
 
+	Account32KeyPrefix            = "Account32/value/"
 	AttesterKeyPrefix             = "Attester/value/"
+	AttesterHistoryKeyPrefix      = "AttesterHistory/value/"
+	ContractCallbackKeyPrefix     = "ContractCallback/value/"
+	ForwardingKeyPrefix           = "Forwarding/value/"
 	PerMessageBurnLimitKeyPrefix  = "PerMessageBurnLimit/value/"
 	RemoteTokenMessengerKeyPrefix = "RemoteTokenMessenger/value/"
+	RoleHistoryKeyPrefix          = "RoleHistory/value/"
 	TokenPairKeyPrefix            = "TokenPair/value/"
 	UsedNonceKeyPrefix            = "UsedNonce/value/"
+	UsedNonceRecordKeyPrefix      = "UsedNonceRecord/value/"
 )
 
+// RelayFeeBudgetName is the module account that pays the fees of relay
+// transactions subsidized by the ante RelayFeeDecorator.
+const RelayFeeBudgetName = ModuleName + "_relay_fees"
+
 var ModuleAddress = authTypes.NewModuleAddress(ModuleName)
 
 var PaddedModuleAddress = make([]byte, 32)
@@ -58,11 +70,14 @@ func init() {
 }
 
 var (
-	OwnerKey           = []byte("owner")
-	PendingOwnerKey    = []byte("pending-owner")
-	AttesterManagerKey = []byte("attester-manager")
-	PauserKey          = []byte("pauser")
-	TokenControllerKey = []byte("token-controller")
+	OwnerKey                  = []byte("owner")
+	PendingOwnerKey           = []byte("pending-owner")
+	AttesterManagerKey        = []byte("attester-manager")
+	PendingAttesterManagerKey = []byte("pending-attester-manager")
+	PauserKey                 = []byte("pauser")
+	PendingPauserKey          = []byte("pending-pauser")
+	TokenControllerKey        = []byte("token-controller")
+	PendingTokenControllerKey = []byte("pending-token-controller")
 )
 
 func KeyPrefix(p string) []byte {
@@ -74,6 +89,83 @@ func AttesterKey(key []byte) []byte {
 	return append(key, []byte("/")...)
 }
 
+// AttesterHistoryKey returns the store key to retrieve an AttesterHistoryEntry from its id
+func AttesterHistoryKey(id uint64) []byte {
+	idBz := make([]byte, NonceBytesLen)
+	binary.BigEndian.PutUint64(idBz, id)
+
+	return append(idBz, []byte("/")...)
+}
+
+// RoleKey returns the store key of the current holder of a role
+func RoleKey(role Role) []byte {
+	switch role {
+	case Role_ROLE_OWNER:
+		return OwnerKey
+	case Role_ROLE_ATTESTER_MANAGER:
+		return AttesterManagerKey
+	case Role_ROLE_PAUSER:
+		return PauserKey
+	case Role_ROLE_TOKEN_CONTROLLER:
+		return TokenControllerKey
+	default:
+		panic(fmt.Sprintf("unknown role %s", role))
+	}
+}
+
+// PendingRoleKey returns the store key of the pending holder of a role
+func PendingRoleKey(role Role) []byte {
+	switch role {
+	case Role_ROLE_OWNER:
+		return PendingOwnerKey
+	case Role_ROLE_ATTESTER_MANAGER:
+		return PendingAttesterManagerKey
+	case Role_ROLE_PAUSER:
+		return PendingPauserKey
+	case Role_ROLE_TOKEN_CONTROLLER:
+		return PendingTokenControllerKey
+	default:
+		panic(fmt.Sprintf("unknown role %s", role))
+	}
+}
+
+// PendingRoleExpiryKey returns the store key of the expiry height of a pending role transfer
+func PendingRoleExpiryKey(role Role) []byte {
+	pendingKey := PendingRoleKey(role)
+	key := make([]byte, 0, len(pendingKey)+len("-expiry"))
+	key = append(key, pendingKey...)
+	return append(key, []byte("-expiry")...)
+}
+
+// RoleHistoryKey returns the store key to retrieve a RoleHistoryEntry from its id
+func RoleHistoryKey(id uint64) []byte {
+	idBz := make([]byte, NonceBytesLen)
+	binary.BigEndian.PutUint64(idBz, id)
+
+	return append(idBz, []byte("/")...)
+}
+
+// Account32Key returns the store key of a registered 32-byte account
+func Account32Key(address []byte) []byte {
+	key := make([]byte, 0, len(address)+1)
+	key = append(key, address...)
+	return append(key, []byte("/")...)
+}
+
+// ContractCallbackKey returns the store key of a contract opted in to callbacks
+func ContractCallbackKey(address []byte) []byte {
+	key := make([]byte, 0, len(address)+1)
+	key = append(key, address...)
+	return append(key, []byte("/")...)
+}
+
+// ForwardingKey returns the store key to retrieve a Forwarding from its address
+func ForwardingKey(address []byte) []byte {
+	key := make([]byte, 0, len(address)+1)
+	key = append(key, address...)
+	return append(key, []byte("/")...)
+}
+
 // PerMessageBurnLimitKey returns the store key to retrieve a PerMessageBurnLimit from the index fields
 func PerMessageBurnLimitKey(denom string) []byte {
 	return append([]byte(denom), []byte("/")...)
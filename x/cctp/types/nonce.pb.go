@@ -80,26 +80,96 @@ func (m *Nonce) GetNonce() uint64 {
 	return 0
 }
 
+// *
+// UsedNonceRecord keeps the hash of the message that used a nonce, so that
+// relayers receiving it again with allow_already_received set succeed
+// @param source_domain the domain id of the used nonce
+// @param nonce the nonce number
+// @param message_hash keccak256 hash of the received message
+type UsedNonceRecord struct {
+	SourceDomain uint32 `protobuf:"varint,1,opt,name=source_domain,json=sourceDomain,proto3" json:"source_domain,omitempty"`
+	Nonce        uint64 `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	MessageHash  []byte `protobuf:"bytes,3,opt,name=message_hash,json=messageHash,proto3" json:"message_hash,omitempty"`
+}
+
+func (m *UsedNonceRecord) Reset()         { *m = UsedNonceRecord{} }
+func (m *UsedNonceRecord) String() string { return proto.CompactTextString(m) }
+func (*UsedNonceRecord) ProtoMessage()    {}
+func (*UsedNonceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_94414231e4aaff86, []int{1}
+}
+func (m *UsedNonceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UsedNonceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UsedNonceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UsedNonceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UsedNonceRecord.Merge(m, src)
+}
+func (m *UsedNonceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *UsedNonceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_UsedNonceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UsedNonceRecord proto.InternalMessageInfo
+
+func (m *UsedNonceRecord) GetSourceDomain() uint32 {
+	if m != nil {
+		return m.SourceDomain
+	}
+	return 0
+}
+
+func (m *UsedNonceRecord) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+func (m *UsedNonceRecord) GetMessageHash() []byte {
+	if m != nil {
+		return m.MessageHash
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Nonce)(nil), "circle.cctp.v1.Nonce")
+	proto.RegisterType((*UsedNonceRecord)(nil), "circle.cctp.v1.UsedNonceRecord")
 }
 
 func init() { proto.RegisterFile("circle/cctp/v1/nonce.proto", fileDescriptor_94414231e4aaff86) }
 
 var fileDescriptor_94414231e4aaff86 = []byte{
-	// 182 bytes of a gzipped FileDescriptorProto
+	// 228 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4a, 0xce, 0x2c, 0x4a,
 	0xce, 0x49, 0xd5, 0x4f, 0x4e, 0x2e, 0x29, 0xd0, 0x2f, 0x33, 0xd4, 0xcf, 0xcb, 0xcf, 0x4b, 0x4e,
 	0xd5, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
 	0x95, 0x9c, 0xb8, 0x58, 0xfd, 0x40, 0xd2, 0x42, 0xca, 0x5c, 0xbc, 0xc5, 0xf9, 0xa5, 0x45, 0xc9,
 	0xa9, 0xf1, 0x29, 0xf9, 0xb9, 0x89, 0x99, 0x79, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xbc, 0x41, 0x3c,
 	0x10, 0x41, 0x17, 0xb0, 0x98, 0x90, 0x08, 0x17, 0x2b, 0xd8, 0x30, 0x09, 0x26, 0x05, 0x46, 0x0d,
-	0x96, 0x20, 0x08, 0xc7, 0xc9, 0xed, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c,
-	0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2,
-	0x74, 0xd2, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x21, 0x16, 0xa7, 0x65,
-	0xe6, 0xe9, 0xe7, 0xe5, 0x27, 0xe5, 0xa4, 0xea, 0x82, 0x5d, 0x57, 0x01, 0x71, 0x64, 0x49, 0x65,
-	0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x89, 0xc6, 0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0x41, 0x3b,
-	0xb3, 0x0e, 0xc0, 0x00, 0x00, 0x00,
+	0x96, 0x20, 0x08, 0x47, 0x29, 0x9f, 0x8b, 0x3f, 0xb4, 0x38, 0x35, 0x05, 0x6c, 0x4e, 0x50, 0x6a,
+	0x72, 0x7e, 0x51, 0x0a, 0x05, 0xa6, 0x09, 0x29, 0x72, 0xf1, 0xe4, 0xa6, 0x16, 0x17, 0x27, 0xa6,
+	0xa7, 0xc6, 0x67, 0x24, 0x16, 0x67, 0x48, 0x30, 0x2b, 0x30, 0x6a, 0xf0, 0x04, 0x71, 0x43, 0xc5,
+	0x3c, 0x12, 0x8b, 0x33, 0x9c, 0xdc, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1,
+	0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21,
+	0x4a, 0x27, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xe2, 0xd3, 0xb4,
+	0xcc, 0x3c, 0xfd, 0xbc, 0xfc, 0xa4, 0x9c, 0x54, 0x5d, 0x70, 0x70, 0x54, 0x40, 0x42, 0xa5, 0xa4,
+	0xb2, 0x20, 0xb5, 0x38, 0x89, 0x0d, 0x1c, 0x26, 0xc6, 0x80, 0x01, 0x00, 0x47, 0xd5, 0x1e, 0x87,
+	0x31, 0x01, 0x00, 0x00,
 }
 
 func (m *Nonce) Marshal() (dAtA []byte, err error) {
@@ -135,6 +205,46 @@ func (m *Nonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UsedNonceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UsedNonceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UsedNonceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageHash) > 0 {
+		i -= len(m.MessageHash)
+		copy(dAtA[i:], m.MessageHash)
+		i = encodeVarintNonce(dAtA, i, uint64(len(m.MessageHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Nonce != 0 {
+		i = encodeVarintNonce(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SourceDomain != 0 {
+		i = encodeVarintNonce(dAtA, i, uint64(m.SourceDomain))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintNonce(dAtA []byte, offset int, v uint64) int {
 	offset -= sovNonce(v)
 	base := offset
@@ -161,6 +271,25 @@ func (m *Nonce) Size() (n int) {
 	return n
 }
 
+func (m *UsedNonceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SourceDomain != 0 {
+		n += 1 + sovNonce(uint64(m.SourceDomain))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovNonce(uint64(m.Nonce))
+	}
+	l = len(m.MessageHash)
+	if l > 0 {
+		n += 1 + l + sovNonce(uint64(l))
+	}
+	return n
+}
+
 func sovNonce(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -255,6 +384,128 @@ func (m *Nonce) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *UsedNonceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowNonce
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UsedNonceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UsedNonceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNonce
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNonce
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowNonce
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthNonce
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthNonce
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageHash = append(m.MessageHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageHash == nil {
+				m.MessageHash = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipNonce(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthNonce
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipNonce(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
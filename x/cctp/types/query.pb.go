@@ -136,6 +136,199 @@ func (m *QueryRolesResponse) GetTokenController() string {
 	return ""
 }
 
+// QueryPendingRolesRequest is the request type for the Query/PendingRoles RPC
+// method.
+type QueryPendingRolesRequest struct {
+}
+
+func (m *QueryPendingRolesRequest) Reset()         { *m = QueryPendingRolesRequest{} }
+func (m *QueryPendingRolesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRolesRequest) ProtoMessage()    {}
+func (*QueryPendingRolesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{2}
+}
+func (m *QueryPendingRolesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRolesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRolesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRolesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRolesRequest.Merge(m, src)
+}
+func (m *QueryPendingRolesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRolesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRolesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRolesRequest proto.InternalMessageInfo
+
+// QueryPendingRolesResponse is the response type for the Query/PendingRoles
+// RPC method.
+type QueryPendingRolesResponse struct {
+	PendingRoles []PendingRole `protobuf:"bytes,1,rep,name=pending_roles,json=pendingRoles,proto3" json:"pending_roles"`
+}
+
+func (m *QueryPendingRolesResponse) Reset()         { *m = QueryPendingRolesResponse{} }
+func (m *QueryPendingRolesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingRolesResponse) ProtoMessage()    {}
+func (*QueryPendingRolesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{3}
+}
+func (m *QueryPendingRolesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingRolesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingRolesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingRolesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingRolesResponse.Merge(m, src)
+}
+func (m *QueryPendingRolesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingRolesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingRolesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingRolesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingRolesResponse) GetPendingRoles() []PendingRole {
+	if m != nil {
+		return m.PendingRoles
+	}
+	return nil
+}
+
+// QueryRoleHistoryRequest is the request type for the Query/RoleHistory RPC
+// method.
+type QueryRoleHistoryRequest struct {
+	// role optionally restricts the history to a single role.
+	Role       Role               `protobuf:"varint,1,opt,name=role,proto3,enum=circle.cctp.v1.Role" json:"role,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoleHistoryRequest) Reset()         { *m = QueryRoleHistoryRequest{} }
+func (m *QueryRoleHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleHistoryRequest) ProtoMessage()    {}
+func (*QueryRoleHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{4}
+}
+func (m *QueryRoleHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleHistoryRequest.Merge(m, src)
+}
+func (m *QueryRoleHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryRoleHistoryRequest) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return Role_ROLE_UNSPECIFIED
+}
+
+func (m *QueryRoleHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRoleHistoryResponse is the response type for the Query/RoleHistory RPC
+// method.
+type QueryRoleHistoryResponse struct {
+	History    []RoleHistoryEntry  `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoleHistoryResponse) Reset()         { *m = QueryRoleHistoryResponse{} }
+func (m *QueryRoleHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleHistoryResponse) ProtoMessage()    {}
+func (*QueryRoleHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{5}
+}
+func (m *QueryRoleHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleHistoryResponse.Merge(m, src)
+}
+func (m *QueryRoleHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryRoleHistoryResponse) GetHistory() []RoleHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryRoleHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryAttestersRequest is the request type for the Query/Attester RPC method.
 type QueryGetAttesterRequest struct {
 	Attester string `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
@@ -145,7 +338,7 @@ func (m *QueryGetAttesterRequest) Reset()         { *m = QueryGetAttesterRequest
 func (m *QueryGetAttesterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAttesterRequest) ProtoMessage()    {}
 func (*QueryGetAttesterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{2}
+	return fileDescriptor_e7468ed27f019b2f, []int{6}
 }
 func (m *QueryGetAttesterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +384,7 @@ func (m *QueryGetAttesterResponse) Reset()         { *m = QueryGetAttesterRespon
 func (m *QueryGetAttesterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetAttesterResponse) ProtoMessage()    {}
 func (*QueryGetAttesterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{3}
+	return fileDescriptor_e7468ed27f019b2f, []int{7}
 }
 func (m *QueryGetAttesterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -237,7 +430,7 @@ func (m *QueryAllAttestersRequest) Reset()         { *m = QueryAllAttestersReque
 func (m *QueryAllAttestersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAttestersRequest) ProtoMessage()    {}
 func (*QueryAllAttestersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{4}
+	return fileDescriptor_e7468ed27f019b2f, []int{8}
 }
 func (m *QueryAllAttestersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -284,7 +477,7 @@ func (m *QueryAllAttestersResponse) Reset()         { *m = QueryAllAttestersResp
 func (m *QueryAllAttestersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllAttestersResponse) ProtoMessage()    {}
 func (*QueryAllAttestersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{5}
+	return fileDescriptor_e7468ed27f019b2f, []int{9}
 }
 func (m *QueryAllAttestersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +530,7 @@ func (m *QueryGetPerMessageBurnLimitRequest) Reset()         { *m = QueryGetPerM
 func (m *QueryGetPerMessageBurnLimitRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPerMessageBurnLimitRequest) ProtoMessage()    {}
 func (*QueryGetPerMessageBurnLimitRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{6}
+	return fileDescriptor_e7468ed27f019b2f, []int{10}
 }
 func (m *QueryGetPerMessageBurnLimitRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -383,7 +576,7 @@ func (m *QueryGetPerMessageBurnLimitResponse) Reset()         { *m = QueryGetPer
 func (m *QueryGetPerMessageBurnLimitResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetPerMessageBurnLimitResponse) ProtoMessage()    {}
 func (*QueryGetPerMessageBurnLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{7}
+	return fileDescriptor_e7468ed27f019b2f, []int{11}
 }
 func (m *QueryGetPerMessageBurnLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -429,7 +622,7 @@ func (m *QueryAllPerMessageBurnLimitsRequest) Reset()         { *m = QueryAllPer
 func (m *QueryAllPerMessageBurnLimitsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPerMessageBurnLimitsRequest) ProtoMessage()    {}
 func (*QueryAllPerMessageBurnLimitsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{8}
+	return fileDescriptor_e7468ed27f019b2f, []int{12}
 }
 func (m *QueryAllPerMessageBurnLimitsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,7 +669,7 @@ func (m *QueryAllPerMessageBurnLimitsResponse) Reset()         { *m = QueryAllPe
 func (m *QueryAllPerMessageBurnLimitsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllPerMessageBurnLimitsResponse) ProtoMessage()    {}
 func (*QueryAllPerMessageBurnLimitsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{9}
+	return fileDescriptor_e7468ed27f019b2f, []int{13}
 }
 func (m *QueryAllPerMessageBurnLimitsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -530,7 +723,7 @@ func (m *QueryGetBurningAndMintingPausedRequest) Reset() {
 func (m *QueryGetBurningAndMintingPausedRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBurningAndMintingPausedRequest) ProtoMessage()    {}
 func (*QueryGetBurningAndMintingPausedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{10}
+	return fileDescriptor_e7468ed27f019b2f, []int{14}
 }
 func (m *QueryGetBurningAndMintingPausedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -571,7 +764,7 @@ func (m *QueryGetBurningAndMintingPausedResponse) Reset() {
 func (m *QueryGetBurningAndMintingPausedResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetBurningAndMintingPausedResponse) ProtoMessage()    {}
 func (*QueryGetBurningAndMintingPausedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{11}
+	return fileDescriptor_e7468ed27f019b2f, []int{15}
 }
 func (m *QueryGetBurningAndMintingPausedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +813,7 @@ func (m *QueryGetSendingAndReceivingMessagesPausedRequest) String() string {
 }
 func (*QueryGetSendingAndReceivingMessagesPausedRequest) ProtoMessage() {}
 func (*QueryGetSendingAndReceivingMessagesPausedRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{12}
+	return fileDescriptor_e7468ed27f019b2f, []int{16}
 }
 func (m *QueryGetSendingAndReceivingMessagesPausedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -663,7 +856,7 @@ func (m *QueryGetSendingAndReceivingMessagesPausedResponse) String() string {
 }
 func (*QueryGetSendingAndReceivingMessagesPausedResponse) ProtoMessage() {}
 func (*QueryGetSendingAndReceivingMessagesPausedResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{13}
+	return fileDescriptor_e7468ed27f019b2f, []int{17}
 }
 func (m *QueryGetSendingAndReceivingMessagesPausedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -708,7 +901,7 @@ func (m *QueryGetMaxMessageBodySizeRequest) Reset()         { *m = QueryGetMaxMe
 func (m *QueryGetMaxMessageBodySizeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMaxMessageBodySizeRequest) ProtoMessage()    {}
 func (*QueryGetMaxMessageBodySizeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{14}
+	return fileDescriptor_e7468ed27f019b2f, []int{18}
 }
 func (m *QueryGetMaxMessageBodySizeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -747,7 +940,7 @@ func (m *QueryGetMaxMessageBodySizeResponse) Reset()         { *m = QueryGetMaxM
 func (m *QueryGetMaxMessageBodySizeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetMaxMessageBodySizeResponse) ProtoMessage()    {}
 func (*QueryGetMaxMessageBodySizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{15}
+	return fileDescriptor_e7468ed27f019b2f, []int{19}
 }
 func (m *QueryGetMaxMessageBodySizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -792,7 +985,7 @@ func (m *QueryGetNextAvailableNonceRequest) Reset()         { *m = QueryGetNextA
 func (m *QueryGetNextAvailableNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNextAvailableNonceRequest) ProtoMessage()    {}
 func (*QueryGetNextAvailableNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{16}
+	return fileDescriptor_e7468ed27f019b2f, []int{20}
 }
 func (m *QueryGetNextAvailableNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -831,7 +1024,7 @@ func (m *QueryGetNextAvailableNonceResponse) Reset()         { *m = QueryGetNext
 func (m *QueryGetNextAvailableNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetNextAvailableNonceResponse) ProtoMessage()    {}
 func (*QueryGetNextAvailableNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{17}
+	return fileDescriptor_e7468ed27f019b2f, []int{21}
 }
 func (m *QueryGetNextAvailableNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -876,7 +1069,7 @@ func (m *QueryGetSignatureThresholdRequest) Reset()         { *m = QueryGetSigna
 func (m *QueryGetSignatureThresholdRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSignatureThresholdRequest) ProtoMessage()    {}
 func (*QueryGetSignatureThresholdRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{18}
+	return fileDescriptor_e7468ed27f019b2f, []int{22}
 }
 func (m *QueryGetSignatureThresholdRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -915,7 +1108,7 @@ func (m *QueryGetSignatureThresholdResponse) Reset()         { *m = QueryGetSign
 func (m *QueryGetSignatureThresholdResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetSignatureThresholdResponse) ProtoMessage()    {}
 func (*QueryGetSignatureThresholdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{19}
+	return fileDescriptor_e7468ed27f019b2f, []int{23}
 }
 func (m *QueryGetSignatureThresholdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -962,7 +1155,7 @@ func (m *QueryGetTokenPairRequest) Reset()         { *m = QueryGetTokenPairReque
 func (m *QueryGetTokenPairRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTokenPairRequest) ProtoMessage()    {}
 func (*QueryGetTokenPairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{20}
+	return fileDescriptor_e7468ed27f019b2f, []int{24}
 }
 func (m *QueryGetTokenPairRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1015,7 +1208,7 @@ func (m *QueryGetTokenPairResponse) Reset()         { *m = QueryGetTokenPairResp
 func (m *QueryGetTokenPairResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetTokenPairResponse) ProtoMessage()    {}
 func (*QueryGetTokenPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{21}
+	return fileDescriptor_e7468ed27f019b2f, []int{25}
 }
 func (m *QueryGetTokenPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1061,7 +1254,7 @@ func (m *QueryAllTokenPairsRequest) Reset()         { *m = QueryAllTokenPairsReq
 func (m *QueryAllTokenPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllTokenPairsRequest) ProtoMessage()    {}
 func (*QueryAllTokenPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{22}
+	return fileDescriptor_e7468ed27f019b2f, []int{26}
 }
 func (m *QueryAllTokenPairsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1108,7 +1301,7 @@ func (m *QueryAllTokenPairsResponse) Reset()         { *m = QueryAllTokenPairsRe
 func (m *QueryAllTokenPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllTokenPairsResponse) ProtoMessage()    {}
 func (*QueryAllTokenPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{23}
+	return fileDescriptor_e7468ed27f019b2f, []int{27}
 }
 func (m *QueryAllTokenPairsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1162,7 +1355,7 @@ func (m *QueryGetUsedNonceRequest) Reset()         { *m = QueryGetUsedNonceReque
 func (m *QueryGetUsedNonceRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryGetUsedNonceRequest) ProtoMessage()    {}
 func (*QueryGetUsedNonceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{24}
+	return fileDescriptor_e7468ed27f019b2f, []int{28}
 }
 func (m *QueryGetUsedNonceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1215,7 +1408,7 @@ func (m *QueryGetUsedNonceResponse) Reset()         { *m = QueryGetUsedNonceResp
 func (m *QueryGetUsedNonceResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryGetUsedNonceResponse) ProtoMessage()    {}
 func (*QueryGetUsedNonceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{25}
+	return fileDescriptor_e7468ed27f019b2f, []int{29}
 }
 func (m *QueryGetUsedNonceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1261,7 +1454,7 @@ func (m *QueryAllUsedNoncesRequest) Reset()         { *m = QueryAllUsedNoncesReq
 func (m *QueryAllUsedNoncesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAllUsedNoncesRequest) ProtoMessage()    {}
 func (*QueryAllUsedNoncesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{26}
+	return fileDescriptor_e7468ed27f019b2f, []int{30}
 }
 func (m *QueryAllUsedNoncesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1308,7 +1501,7 @@ func (m *QueryAllUsedNoncesResponse) Reset()         { *m = QueryAllUsedNoncesRe
 func (m *QueryAllUsedNoncesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAllUsedNoncesResponse) ProtoMessage()    {}
 func (*QueryAllUsedNoncesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{27}
+	return fileDescriptor_e7468ed27f019b2f, []int{31}
 }
 func (m *QueryAllUsedNoncesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1361,7 +1554,7 @@ func (m *QueryRemoteTokenMessengerRequest) Reset()         { *m = QueryRemoteTok
 func (m *QueryRemoteTokenMessengerRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRemoteTokenMessengerRequest) ProtoMessage()    {}
 func (*QueryRemoteTokenMessengerRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{28}
+	return fileDescriptor_e7468ed27f019b2f, []int{32}
 }
 func (m *QueryRemoteTokenMessengerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +1600,7 @@ func (m *QueryRemoteTokenMessengerResponse) Reset()         { *m = QueryRemoteTo
 func (m *QueryRemoteTokenMessengerResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRemoteTokenMessengerResponse) ProtoMessage()    {}
 func (*QueryRemoteTokenMessengerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{29}
+	return fileDescriptor_e7468ed27f019b2f, []int{33}
 }
 func (m *QueryRemoteTokenMessengerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1453,7 +1646,7 @@ func (m *QueryRemoteTokenMessengersRequest) Reset()         { *m = QueryRemoteTo
 func (m *QueryRemoteTokenMessengersRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryRemoteTokenMessengersRequest) ProtoMessage()    {}
 func (*QueryRemoteTokenMessengersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{30}
+	return fileDescriptor_e7468ed27f019b2f, []int{34}
 }
 func (m *QueryRemoteTokenMessengersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1500,7 +1693,7 @@ func (m *QueryRemoteTokenMessengersResponse) Reset()         { *m = QueryRemoteT
 func (m *QueryRemoteTokenMessengersResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryRemoteTokenMessengersResponse) ProtoMessage()    {}
 func (*QueryRemoteTokenMessengersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{31}
+	return fileDescriptor_e7468ed27f019b2f, []int{35}
 }
 func (m *QueryRemoteTokenMessengersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1552,7 +1745,7 @@ func (m *QueryBurnMessageVersionRequest) Reset()         { *m = QueryBurnMessage
 func (m *QueryBurnMessageVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBurnMessageVersionRequest) ProtoMessage()    {}
 func (*QueryBurnMessageVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{32}
+	return fileDescriptor_e7468ed27f019b2f, []int{36}
 }
 func (m *QueryBurnMessageVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1592,7 +1785,7 @@ func (m *QueryBurnMessageVersionResponse) Reset()         { *m = QueryBurnMessag
 func (m *QueryBurnMessageVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBurnMessageVersionResponse) ProtoMessage()    {}
 func (*QueryBurnMessageVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{33}
+	return fileDescriptor_e7468ed27f019b2f, []int{37}
 }
 func (m *QueryBurnMessageVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1637,7 +1830,7 @@ func (m *QueryLocalMessageVersionRequest) Reset()         { *m = QueryLocalMessa
 func (m *QueryLocalMessageVersionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLocalMessageVersionRequest) ProtoMessage()    {}
 func (*QueryLocalMessageVersionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{34}
+	return fileDescriptor_e7468ed27f019b2f, []int{38}
 }
 func (m *QueryLocalMessageVersionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1677,7 +1870,7 @@ func (m *QueryLocalMessageVersionResponse) Reset()         { *m = QueryLocalMess
 func (m *QueryLocalMessageVersionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLocalMessageVersionResponse) ProtoMessage()    {}
 func (*QueryLocalMessageVersionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{35}
+	return fileDescriptor_e7468ed27f019b2f, []int{39}
 }
 func (m *QueryLocalMessageVersionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1722,7 +1915,7 @@ func (m *QueryLocalDomainRequest) Reset()         { *m = QueryLocalDomainRequest
 func (m *QueryLocalDomainRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryLocalDomainRequest) ProtoMessage()    {}
 func (*QueryLocalDomainRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{36}
+	return fileDescriptor_e7468ed27f019b2f, []int{40}
 }
 func (m *QueryLocalDomainRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1762,7 +1955,7 @@ func (m *QueryLocalDomainResponse) Reset()         { *m = QueryLocalDomainRespon
 func (m *QueryLocalDomainResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryLocalDomainResponse) ProtoMessage()    {}
 func (*QueryLocalDomainResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e7468ed27f019b2f, []int{37}
+	return fileDescriptor_e7468ed27f019b2f, []int{41}
 }
 func (m *QueryLocalDomainResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1798,179 +1991,513 @@ func (m *QueryLocalDomainResponse) GetDomainId() uint32 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*QueryRolesRequest)(nil), "circle.cctp.v1.QueryRolesRequest")
-	proto.RegisterType((*QueryRolesResponse)(nil), "circle.cctp.v1.QueryRolesResponse")
-	proto.RegisterType((*QueryGetAttesterRequest)(nil), "circle.cctp.v1.QueryGetAttesterRequest")
-	proto.RegisterType((*QueryGetAttesterResponse)(nil), "circle.cctp.v1.QueryGetAttesterResponse")
-	proto.RegisterType((*QueryAllAttestersRequest)(nil), "circle.cctp.v1.QueryAllAttestersRequest")
-	proto.RegisterType((*QueryAllAttestersResponse)(nil), "circle.cctp.v1.QueryAllAttestersResponse")
-	proto.RegisterType((*QueryGetPerMessageBurnLimitRequest)(nil), "circle.cctp.v1.QueryGetPerMessageBurnLimitRequest")
-	proto.RegisterType((*QueryGetPerMessageBurnLimitResponse)(nil), "circle.cctp.v1.QueryGetPerMessageBurnLimitResponse")
-	proto.RegisterType((*QueryAllPerMessageBurnLimitsRequest)(nil), "circle.cctp.v1.QueryAllPerMessageBurnLimitsRequest")
-	proto.RegisterType((*QueryAllPerMessageBurnLimitsResponse)(nil), "circle.cctp.v1.QueryAllPerMessageBurnLimitsResponse")
-	proto.RegisterType((*QueryGetBurningAndMintingPausedRequest)(nil), "circle.cctp.v1.QueryGetBurningAndMintingPausedRequest")
-	proto.RegisterType((*QueryGetBurningAndMintingPausedResponse)(nil), "circle.cctp.v1.QueryGetBurningAndMintingPausedResponse")
-	proto.RegisterType((*QueryGetSendingAndReceivingMessagesPausedRequest)(nil), "circle.cctp.v1.QueryGetSendingAndReceivingMessagesPausedRequest")
-	proto.RegisterType((*QueryGetSendingAndReceivingMessagesPausedResponse)(nil), "circle.cctp.v1.QueryGetSendingAndReceivingMessagesPausedResponse")
-	proto.RegisterType((*QueryGetMaxMessageBodySizeRequest)(nil), "circle.cctp.v1.QueryGetMaxMessageBodySizeRequest")
-	proto.RegisterType((*QueryGetMaxMessageBodySizeResponse)(nil), "circle.cctp.v1.QueryGetMaxMessageBodySizeResponse")
-	proto.RegisterType((*QueryGetNextAvailableNonceRequest)(nil), "circle.cctp.v1.QueryGetNextAvailableNonceRequest")
-	proto.RegisterType((*QueryGetNextAvailableNonceResponse)(nil), "circle.cctp.v1.QueryGetNextAvailableNonceResponse")
-	proto.RegisterType((*QueryGetSignatureThresholdRequest)(nil), "circle.cctp.v1.QueryGetSignatureThresholdRequest")
-	proto.RegisterType((*QueryGetSignatureThresholdResponse)(nil), "circle.cctp.v1.QueryGetSignatureThresholdResponse")
-	proto.RegisterType((*QueryGetTokenPairRequest)(nil), "circle.cctp.v1.QueryGetTokenPairRequest")
-	proto.RegisterType((*QueryGetTokenPairResponse)(nil), "circle.cctp.v1.QueryGetTokenPairResponse")
-	proto.RegisterType((*QueryAllTokenPairsRequest)(nil), "circle.cctp.v1.QueryAllTokenPairsRequest")
-	proto.RegisterType((*QueryAllTokenPairsResponse)(nil), "circle.cctp.v1.QueryAllTokenPairsResponse")
-	proto.RegisterType((*QueryGetUsedNonceRequest)(nil), "circle.cctp.v1.QueryGetUsedNonceRequest")
-	proto.RegisterType((*QueryGetUsedNonceResponse)(nil), "circle.cctp.v1.QueryGetUsedNonceResponse")
-	proto.RegisterType((*QueryAllUsedNoncesRequest)(nil), "circle.cctp.v1.QueryAllUsedNoncesRequest")
-	proto.RegisterType((*QueryAllUsedNoncesResponse)(nil), "circle.cctp.v1.QueryAllUsedNoncesResponse")
-	proto.RegisterType((*QueryRemoteTokenMessengerRequest)(nil), "circle.cctp.v1.QueryRemoteTokenMessengerRequest")
-	proto.RegisterType((*QueryRemoteTokenMessengerResponse)(nil), "circle.cctp.v1.QueryRemoteTokenMessengerResponse")
-	proto.RegisterType((*QueryRemoteTokenMessengersRequest)(nil), "circle.cctp.v1.QueryRemoteTokenMessengersRequest")
-	proto.RegisterType((*QueryRemoteTokenMessengersResponse)(nil), "circle.cctp.v1.QueryRemoteTokenMessengersResponse")
-	proto.RegisterType((*QueryBurnMessageVersionRequest)(nil), "circle.cctp.v1.QueryBurnMessageVersionRequest")
-	proto.RegisterType((*QueryBurnMessageVersionResponse)(nil), "circle.cctp.v1.QueryBurnMessageVersionResponse")
-	proto.RegisterType((*QueryLocalMessageVersionRequest)(nil), "circle.cctp.v1.QueryLocalMessageVersionRequest")
-	proto.RegisterType((*QueryLocalMessageVersionResponse)(nil), "circle.cctp.v1.QueryLocalMessageVersionResponse")
-	proto.RegisterType((*QueryLocalDomainRequest)(nil), "circle.cctp.v1.QueryLocalDomainRequest")
-	proto.RegisterType((*QueryLocalDomainResponse)(nil), "circle.cctp.v1.QueryLocalDomainResponse")
+// QueryAttesterHistoryRequest is the request type for the
+// Query/AttesterHistory RPC method.
+type QueryAttesterHistoryRequest struct {
+	// attester optionally restricts the history to a single attester.
+	Attester   string             `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("circle/cctp/v1/query.proto", fileDescriptor_e7468ed27f019b2f) }
-
-var fileDescriptor_e7468ed27f019b2f = []byte{
-	// 1746 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcb, 0x6f, 0x1b, 0x55,
-	0x17, 0xcf, 0xb4, 0x49, 0xbf, 0xe6, 0xa4, 0xfd, 0x80, 0xdb, 0xa4, 0x71, 0xa6, 0xad, 0x9b, 0x4c,
-	0xd2, 0x26, 0x7d, 0x79, 0xea, 0x84, 0x12, 0x29, 0x2d, 0xa2, 0x09, 0xd0, 0x02, 0x6a, 0x4a, 0x48,
-	0x1f, 0x48, 0x6c, 0xcc, 0xd8, 0xbe, 0x75, 0x47, 0x1d, 0xcf, 0xb8, 0x33, 0xe3, 0x90, 0x34, 0xca,
-	0x86, 0x97, 0x90, 0xd8, 0x20, 0xb1, 0x61, 0xc3, 0x86, 0x22, 0x15, 0xd8, 0x20, 0xb1, 0x01, 0x09,
-	0x89, 0x75, 0x25, 0x36, 0x45, 0x6c, 0x58, 0x21, 0xd4, 0x22, 0xf1, 0x6f, 0xa0, 0xb9, 0xf7, 0xdc,
-	0xb1, 0x3d, 0x73, 0xaf, 0x1f, 0xc5, 0x3b, 0xcf, 0x9d, 0xf3, 0xf8, 0x9d, 0x73, 0xee, 0x3d, 0xf7,
-	0xfc, 0xc6, 0xa0, 0x97, 0x6c, 0xbf, 0xe4, 0x50, 0xb3, 0x54, 0x0a, 0x6b, 0xe6, 0x46, 0xde, 0xbc,
-	0x5b, 0xa7, 0xfe, 0x56, 0xae, 0xe6, 0x7b, 0xa1, 0x47, 0xfe, 0xcf, 0xdf, 0xe5, 0xa2, 0x77, 0xb9,
-	0x8d, 0xbc, 0x7e, 0x24, 0x21, 0x6b, 0x85, 0x21, 0x0d, 0x42, 0xea, 0x73, 0x71, 0xdd, 0x4c, 0xbc,
-	0x2e, 0xd6, 0x7d, 0xd7, 0x76, 0x2b, 0x05, 0xcb, 0x2d, 0x17, 0xaa, 0xb6, 0x1b, 0x46, 0xbf, 0x6b,
-	0x56, 0x3d, 0xa0, 0x65, 0x54, 0x38, 0x99, 0x50, 0xa8, 0x5a, 0x9b, 0x85, 0x2a, 0x0d, 0x02, 0xab,
-	0x42, 0x0b, 0x45, 0xaf, 0xbc, 0x55, 0x08, 0xec, 0x7b, 0x14, 0x65, 0x93, 0x38, 0x5d, 0xcf, 0x2d,
-	0x89, 0x77, 0xa7, 0x12, 0xef, 0x6a, 0xd4, 0x6f, 0xd8, 0xa9, 0xfb, 0x6e, 0xc1, 0xb1, 0xab, 0x76,
-	0xa8, 0x10, 0xf6, 0x69, 0xd5, 0x0b, 0x69, 0x21, 0xf4, 0xee, 0x50, 0x97, 0x69, 0x51, 0xb7, 0x12,
-	0x87, 0xb4, 0x94, 0x10, 0x0e, 0xa8, 0x5b, 0x16, 0x21, 0xf9, 0xb4, 0x44, 0xed, 0x8d, 0xe8, 0x09,
-	0x7d, 0x05, 0xad, 0xd1, 0xcd, 0x25, 0x75, 0xed, 0x8a, 0x6b, 0x85, 0x75, 0x9f, 0x16, 0xc2, 0xdb,
-	0x3e, 0x0d, 0x6e, 0x7b, 0x8e, 0x90, 0x3c, 0x9a, 0x90, 0xe4, 0x58, 0x6a, 0x96, 0xed, 0xc7, 0x89,
-	0xf2, 0x82, 0xaa, 0x17, 0x98, 0x45, 0x2b, 0xa0, 0xbc, 0x42, 0xe6, 0x46, 0xbe, 0x48, 0x43, 0x2b,
-	0x6f, 0xd6, 0xac, 0x8a, 0xed, 0x5a, 0xa1, 0xed, 0xb9, 0x28, 0x3b, 0x5a, 0xf1, 0x2a, 0x1e, 0xfb,
-	0x69, 0x46, 0xbf, 0x70, 0xf5, 0x70, 0xc5, 0xf3, 0x2a, 0x0e, 0x35, 0xad, 0x9a, 0x6d, 0x5a, 0xae,
-	0xeb, 0x85, 0x4c, 0x25, 0xe0, 0x6f, 0x8d, 0x03, 0xf0, 0xdc, 0x5b, 0x91, 0xd5, 0x75, 0xcf, 0xa1,
-	0xc1, 0x3a, 0xbd, 0x5b, 0xa7, 0x41, 0x68, 0x7c, 0xa1, 0x01, 0x69, 0x5e, 0x0d, 0x6a, 0x9e, 0x1b,
-	0x50, 0x32, 0x0a, 0x43, 0xde, 0x7b, 0x2e, 0xf5, 0x33, 0xda, 0xa4, 0x36, 0x37, 0xbc, 0xce, 0x1f,
-	0xc8, 0x09, 0x78, 0x56, 0xec, 0x86, 0x42, 0xd5, 0x72, 0xad, 0x0a, 0xf5, 0x33, 0xbb, 0x98, 0xc0,
-	0x33, 0x62, 0x7d, 0x95, 0x2f, 0x93, 0x83, 0xb0, 0x87, 0xe5, 0xc9, 0xcf, 0xec, 0x66, 0x02, 0xf8,
-	0x14, 0x99, 0xe0, 0x81, 0x97, 0x3c, 0x37, 0xf4, 0x3d, 0xc7, 0xa1, 0x7e, 0x66, 0x90, 0x9b, 0x60,
-	0xeb, 0x2f, 0xc7, 0xcb, 0xc6, 0x39, 0x18, 0x67, 0xc8, 0x2e, 0xd3, 0x70, 0x19, 0xad, 0x23, 0x6a,
-	0xa2, 0xc3, 0x5e, 0xe1, 0x10, 0x11, 0xc6, 0xcf, 0xc6, 0x4d, 0xc8, 0xa4, 0xd5, 0x30, 0xac, 0xa5,
-	0x84, 0xde, 0xc8, 0x7c, 0x26, 0xd7, 0xba, 0xfd, 0x73, 0x42, 0x67, 0x65, 0xf0, 0xe1, 0x9f, 0x47,
-	0x07, 0x9a, 0xec, 0x16, 0xd1, 0xee, 0xb2, 0xe3, 0x08, 0x19, 0x91, 0x45, 0x72, 0x09, 0xa0, 0x51,
-	0x22, 0xb4, 0x7c, 0x3c, 0xc7, 0xeb, 0x99, 0x8b, 0xea, 0x99, 0xe3, 0x27, 0x0e, 0xeb, 0x99, 0x5b,
-	0xb3, 0x2a, 0x14, 0x75, 0xd7, 0x9b, 0x34, 0x8d, 0xaf, 0x34, 0x98, 0x90, 0x38, 0x41, 0xf4, 0x17,
-	0x60, 0x58, 0xa0, 0x09, 0x32, 0xda, 0xe4, 0xee, 0x2e, 0xe0, 0x37, 0x14, 0xc8, 0xe5, 0x16, 0x8c,
-	0xbb, 0x18, 0xc6, 0xd9, 0x8e, 0x18, 0xb9, 0xeb, 0x16, 0x90, 0x4b, 0x60, 0x88, 0x04, 0xaf, 0x51,
-	0x7f, 0x95, 0x1f, 0x8b, 0x95, 0xba, 0xef, 0x5e, 0x89, 0x0e, 0xa0, 0x48, 0xc9, 0x28, 0x0c, 0x95,
-	0xa9, 0xeb, 0x55, 0xc5, 0x0e, 0x62, 0x0f, 0x86, 0x07, 0xd3, 0x6d, 0x75, 0x31, 0xd2, 0xd7, 0x00,
-	0x1a, 0x47, 0x1a, 0xf3, 0x39, 0x9d, 0x0c, 0x55, 0x62, 0x40, 0x44, 0x5d, 0x14, 0x0b, 0x46, 0x15,
-	0x1d, 0x2e, 0x3b, 0x8e, 0x44, 0xbe, 0xef, 0x05, 0xfc, 0x59, 0x83, 0x99, 0xf6, 0xfe, 0x30, 0xc2,
-	0x37, 0x60, 0xa4, 0x11, 0xa1, 0xa8, 0x66, 0x0f, 0x21, 0x42, 0x1c, 0x62, 0x1f, 0x2b, 0x3b, 0x07,
-	0xc7, 0x45, 0x75, 0x56, 0x78, 0x5b, 0x5f, 0x76, 0xcb, 0xab, 0xbc, 0xa9, 0xaf, 0xb1, 0xae, 0x27,
-	0xda, 0x46, 0x0d, 0x66, 0x3b, 0x4a, 0x62, 0xa4, 0xaf, 0x62, 0x27, 0x28, 0x63, 0x5a, 0x67, 0x93,
-	0x41, 0x2a, 0x0c, 0x60, 0xa0, 0xa8, 0x6c, 0xcc, 0xc3, 0x59, 0xe1, 0xf1, 0x1a, 0xef, 0xcf, 0xcb,
-	0x6e, 0x79, 0x5d, 0x74, 0x67, 0xcc, 0x52, 0xd0, 0x8a, 0xf2, 0x43, 0x0d, 0xf2, 0x3d, 0x28, 0x21,
-	0xe0, 0x37, 0x13, 0x80, 0xf3, 0x49, 0xc0, 0x1d, 0x4d, 0x25, 0xa0, 0x4f, 0xc3, 0x94, 0x40, 0xb1,
-	0x6a, 0x6d, 0x8a, 0x82, 0x7a, 0xe5, 0xad, 0x6b, 0xf6, 0x3d, 0xb1, 0x8b, 0x8c, 0x5b, 0x8d, 0x53,
-	0x25, 0x13, 0x42, 0x6c, 0x17, 0x61, 0x8f, 0x55, 0xf5, 0xea, 0xae, 0x38, 0x14, 0x46, 0x12, 0x5b,
-	0x5a, 0x57, 0x80, 0xe1, 0x7a, 0xcd, 0x60, 0xae, 0xd2, 0xcd, 0x70, 0x79, 0xc3, 0xb2, 0x1d, 0xab,
-	0xe8, 0xd0, 0xab, 0xd1, 0x55, 0x2b, 0xc0, 0xbc, 0xdd, 0x00, 0x23, 0x13, 0x42, 0x30, 0x79, 0x18,
-	0x62, 0x17, 0x34, 0x62, 0x19, 0x4b, 0x62, 0x61, 0xd2, 0xe8, 0x9e, 0x4b, 0x36, 0x7b, 0xbf, 0x26,
-	0x6e, 0xca, 0xeb, 0xe2, 0xa2, 0x94, 0xa4, 0x42, 0x26, 0xd4, 0x6d, 0x2a, 0xd2, 0xba, 0x89, 0x54,
-	0x14, 0x1b, 0x37, 0xc5, 0xf5, 0xe8, 0xee, 0x59, 0xb3, 0xec, 0xf8, 0x86, 0x99, 0x86, 0xfd, 0x38,
-	0x33, 0x94, 0xbd, 0xaa, 0x65, 0xf3, 0x9e, 0xb0, 0x7f, 0x7d, 0x1f, 0x5f, 0x7c, 0x85, 0xad, 0x91,
-	0x29, 0xd8, 0xd7, 0x3c, 0x58, 0xe0, 0x5d, 0x38, 0xc2, 0xd7, 0x98, 0x49, 0x63, 0x0d, 0x1b, 0x7a,
-	0xab, 0x0f, 0x0c, 0x61, 0x01, 0x06, 0xa3, 0xfb, 0x1f, 0x03, 0x98, 0x48, 0x06, 0x10, 0x2b, 0x20,
-	0x6e, 0x26, 0x6c, 0x94, 0x1a, 0x57, 0x44, 0x2c, 0xd0, 0xf7, 0x3e, 0xf6, 0x40, 0x03, 0x5d, 0xe6,
-	0x25, 0xce, 0xfd, 0x48, 0x63, 0x7c, 0x11, 0xdd, 0xab, 0x23, 0x7e, 0x08, 0x63, 0x4b, 0xfd, 0xeb,
-	0x59, 0x37, 0x1a, 0x45, 0xbc, 0x11, 0xd0, 0x72, 0xf3, 0x36, 0x8e, 0x8a, 0x18, 0x78, 0x75, 0xbf,
-	0x94, 0x2c, 0x22, 0x5f, 0xc4, 0x22, 0x8e, 0x8a, 0x5d, 0x1c, 0x81, 0x18, 0x14, 0x1b, 0xf5, 0x6a,
-	0xa3, 0x6e, 0x4d, 0x66, 0x9f, 0x7e, 0xe3, 0x37, 0x55, 0x2d, 0xb6, 0xd7, 0xf7, 0xaa, 0xdd, 0x6f,
-	0xaa, 0x5a, 0xb3, 0x97, 0x78, 0x7e, 0x18, 0x89, 0xfa, 0x51, 0x81, 0x21, 0x12, 0x55, 0x6b, 0x0b,
-	0x1e, 0xea, 0xb1, 0x95, 0xfe, 0x55, 0xec, 0x25, 0x98, 0xe4, 0x13, 0x67, 0xe3, 0x98, 0xac, 0x8a,
-	0x89, 0x5c, 0x64, 0xe4, 0x10, 0x0c, 0xf3, 0x92, 0x15, 0xec, 0x32, 0x56, 0x6d, 0x2f, 0x5f, 0x78,
-	0xbd, 0x6c, 0x7c, 0xa4, 0x61, 0x17, 0x91, 0x5b, 0xc0, 0x68, 0xdf, 0x85, 0x83, 0xf2, 0xa9, 0x1f,
-	0x13, 0x3c, 0x93, 0x0c, 0x5c, 0x66, 0x0d, 0xf3, 0x30, 0xea, 0x4b, 0xde, 0x19, 0x77, 0xda, 0xc0,
-	0xe8, 0x7b, 0x6d, 0x7f, 0xd3, 0xb0, 0x2b, 0x2a, 0xbc, 0x61, 0xd4, 0x45, 0x18, 0x97, 0x47, 0x2d,
-	0xea, 0xdd, 0x4b, 0xd8, 0x63, 0xb2, 0xb0, 0xfb, 0xb8, 0x13, 0x26, 0x21, 0xcb, 0x42, 0x8a, 0x26,
-	0x00, 0xbc, 0xb5, 0x6e, 0x52, 0x3f, 0xb0, 0x3d, 0x57, 0x5c, 0x05, 0xe7, 0xe1, 0xa8, 0x52, 0x02,
-	0x23, 0xce, 0xc0, 0xff, 0x36, 0xf8, 0x12, 0x6e, 0x14, 0xf1, 0x68, 0x4c, 0xa1, 0xf2, 0x15, 0xaf,
-	0x64, 0x39, 0x72, 0xfb, 0x17, 0x70, 0x2f, 0x4a, 0x45, 0x3a, 0x3a, 0x98, 0x40, 0x86, 0xc2, 0xb4,
-	0x79, 0x3b, 0x11, 0x86, 0x17, 0xb1, 0x2d, 0xb5, 0xbc, 0x42, 0x83, 0xed, 0x36, 0xf7, 0xfc, 0xaf,
-	0x19, 0x18, 0x62, 0x9a, 0xa4, 0x0a, 0x43, 0x8c, 0x94, 0x91, 0xa9, 0x64, 0xc9, 0x52, 0x34, 0x4e,
-	0x37, 0xda, 0x89, 0x70, 0xb7, 0xc6, 0x91, 0xf7, 0x7f, 0xff, 0xfb, 0xf3, 0x5d, 0xe3, 0x64, 0x2c,
-	0x49, 0xe1, 0x7d, 0xe6, 0xe5, 0x53, 0x0d, 0xf6, 0x0a, 0xf6, 0x40, 0x66, 0xa5, 0xf6, 0xd2, 0x4c,
-	0x4c, 0x9f, 0xeb, 0x2c, 0x88, 0xee, 0x4f, 0x33, 0xf7, 0xc7, 0xc9, 0x8c, 0xa9, 0xf8, 0xc0, 0x10,
-	0x98, 0xdb, 0xe2, 0xe7, 0x0e, 0xf9, 0x40, 0x83, 0xe1, 0x98, 0x01, 0x11, 0xb9, 0x17, 0x09, 0x13,
-	0xd3, 0x4f, 0x74, 0x21, 0x89, 0x80, 0xa6, 0x18, 0xa0, 0x43, 0x64, 0x42, 0x09, 0x88, 0xfc, 0xa8,
-	0xc1, 0x01, 0xc9, 0x0c, 0x4e, 0xe6, 0x55, 0x51, 0xab, 0x09, 0x91, 0xbe, 0xd0, 0x93, 0x0e, 0x62,
-	0x5c, 0x64, 0x18, 0xf3, 0xc4, 0x34, 0xbb, 0xfa, 0xfa, 0x11, 0x98, 0xdb, 0x8c, 0x67, 0xed, 0x90,
-	0x1f, 0x34, 0x18, 0x95, 0x11, 0x10, 0xb2, 0xa0, 0x4a, 0x50, 0x1b, 0x7a, 0xa4, 0x3f, 0xdf, 0x9b,
-	0x12, 0x82, 0x37, 0x19, 0xf8, 0x13, 0x64, 0xb6, 0x4b, 0xf0, 0xe4, 0x17, 0x0d, 0xc6, 0x15, 0x6c,
-	0x80, 0xbc, 0xa0, 0x4a, 0x5f, 0x7b, 0xa6, 0xa2, 0x2f, 0xf6, 0xac, 0x87, 0xe8, 0xe7, 0x19, 0xfa,
-	0xd3, 0xe4, 0x64, 0xf7, 0x5f, 0xbc, 0xc8, 0x3f, 0x1a, 0x4c, 0x75, 0x64, 0x07, 0xe4, 0xa2, 0x0a,
-	0x52, 0xb7, 0xc4, 0x46, 0x5f, 0xfe, 0x0f, 0x16, 0x30, 0xbc, 0x17, 0x59, 0x78, 0x8b, 0xe4, 0x9c,
-	0xf9, 0x34, 0x5f, 0xbf, 0xc8, 0x77, 0x1a, 0x90, 0x34, 0xd7, 0x20, 0x79, 0x15, 0x30, 0x25, 0xf1,
-	0xd1, 0xe7, 0x7b, 0x51, 0x41, 0xf0, 0x67, 0x18, 0xf8, 0x59, 0x72, 0xcc, 0xec, 0xe6, 0xe3, 0x22,
-	0xf9, 0x56, 0x03, 0x92, 0xe6, 0x31, 0x6a, 0xb0, 0x4a, 0x62, 0xa4, 0x06, 0xab, 0xa6, 0x49, 0xea,
-	0xc6, 0xe7, 0xd2, 0xcd, 0xb0, 0x60, 0x09, 0x25, 0x3e, 0x96, 0x91, 0x6f, 0x34, 0x20, 0x69, 0xe6,
-	0xa2, 0xc6, 0xaa, 0xa4, 0x51, 0x6a, 0xac, 0x6a, 0x52, 0x65, 0x9c, 0x62, 0x58, 0x8f, 0x91, 0x69,
-	0xb3, 0xf3, 0x77, 0x4d, 0xf2, 0xb5, 0x06, 0xc3, 0xf1, 0x8c, 0x4f, 0x94, 0x37, 0x41, 0x92, 0x5b,
-	0x29, 0x7a, 0xb4, 0x8c, 0x21, 0x19, 0x2b, 0x0c, 0xcf, 0x05, 0xb2, 0x64, 0x2a, 0xbf, 0x9e, 0x06,
-	0xe6, 0x76, 0x0b, 0x53, 0xdb, 0x89, 0x9f, 0x99, 0xcc, 0x0e, 0xf9, 0x44, 0x03, 0x68, 0x70, 0x18,
-	0xa2, 0xbc, 0x21, 0x52, 0x6c, 0x4a, 0x3f, 0xd9, 0x8d, 0x28, 0x22, 0x9d, 0x66, 0x48, 0x8f, 0x90,
-	0x43, 0x6d, 0x90, 0x92, 0x2f, 0x35, 0x18, 0x8e, 0x07, 0x73, 0x75, 0xc6, 0x92, 0x44, 0x46, 0x9d,
-	0xb1, 0x14, 0x37, 0x31, 0xce, 0x33, 0x1c, 0xe7, 0xc8, 0x42, 0x12, 0x47, 0xd3, 0xe8, 0x6f, 0x6e,
-	0xb7, 0xd0, 0xa2, 0x1d, 0x73, 0x9b, 0xad, 0xf3, 0x54, 0x35, 0x88, 0x83, 0x3a, 0x55, 0x29, 0x0a,
-	0xa3, 0x4e, 0x55, 0x9a, 0x87, 0xa8, 0x53, 0xd5, 0x04, 0x91, 0xfc, 0xa4, 0xc1, 0xa8, 0x6c, 0x34,
-	0x25, 0x67, 0xe5, 0xa3, 0x8e, 0x9a, 0x4c, 0xe8, 0xf9, 0x1e, 0x34, 0x3a, 0x65, 0x51, 0x31, 0x5c,
-	0x9b, 0xdb, 0xf1, 0x44, 0xb7, 0x43, 0xbe, 0xd7, 0x60, 0x4c, 0x3a, 0xa5, 0x93, 0xee, 0x91, 0x04,
-	0xed, 0x4f, 0x71, 0x5b, 0x12, 0xa0, 0xbe, 0x78, 0x15, 0xe8, 0xc9, 0x7d, 0x0d, 0x48, 0x7a, 0xc4,
-	0x26, 0x39, 0xa9, 0x6f, 0xe5, 0xb4, 0xae, 0x9b, 0x5d, 0xcb, 0x77, 0x6a, 0x8d, 0x6c, 0x2a, 0x10,
-	0x8d, 0x1c, 0xc7, 0x6d, 0xf2, 0x40, 0x83, 0x03, 0x92, 0x41, 0x9d, 0xc8, 0xdd, 0xaa, 0xa7, 0x7e,
-	0xfd, 0x6c, 0xf7, 0x0a, 0x9d, 0x2e, 0x1c, 0x27, 0x52, 0x4a, 0x21, 0xfd, 0x58, 0x83, 0x91, 0xa6,
-	0xc9, 0x5f, 0x31, 0x4e, 0xa7, 0x69, 0x83, 0x62, 0x9c, 0x96, 0x90, 0x08, 0x63, 0x86, 0x21, 0xca,
-	0x92, 0xc3, 0x72, 0x44, 0x7c, 0x3b, 0xae, 0x5c, 0x7a, 0xf8, 0x38, 0xab, 0x3d, 0x7a, 0x9c, 0xd5,
-	0xfe, 0x7a, 0x9c, 0xd5, 0x3e, 0x7b, 0x92, 0x1d, 0x78, 0xf4, 0x24, 0x3b, 0xf0, 0xc7, 0x93, 0xec,
-	0xc0, 0x3b, 0xa7, 0x2b, 0x76, 0x78, 0xbb, 0x5e, 0xcc, 0x95, 0xbc, 0x2a, 0x5a, 0xb8, 0x65, 0xbb,
-	0xa6, 0xeb, 0x15, 0x1d, 0x7a, 0x86, 0x99, 0xda, 0xe4, 0x16, 0xc3, 0xad, 0x1a, 0x0d, 0x8a, 0x7b,
-	0xd8, 0x5f, 0x48, 0x0b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xfc, 0xea, 0x64, 0xfc, 0x49, 0x1c,
-	0x00, 0x00,
+func (m *QueryAttesterHistoryRequest) Reset()         { *m = QueryAttesterHistoryRequest{} }
+func (m *QueryAttesterHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttesterHistoryRequest) ProtoMessage()    {}
+func (*QueryAttesterHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{42}
+}
+func (m *QueryAttesterHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttesterHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttesterHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttesterHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttesterHistoryRequest.Merge(m, src)
+}
+func (m *QueryAttesterHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttesterHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttesterHistoryRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryAttesterHistoryRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryAttesterHistoryRequest) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
+func (m *QueryAttesterHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAttesterHistoryResponse is the response type for the
+// Query/AttesterHistory RPC method.
+type QueryAttesterHistoryResponse struct {
+	History    []AttesterHistoryEntry `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
+	Pagination *query.PageResponse    `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAttesterHistoryResponse) Reset()         { *m = QueryAttesterHistoryResponse{} }
+func (m *QueryAttesterHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttesterHistoryResponse) ProtoMessage()    {}
+func (*QueryAttesterHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{43}
+}
+func (m *QueryAttesterHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttesterHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttesterHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttesterHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttesterHistoryResponse.Merge(m, src)
+}
+func (m *QueryAttesterHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttesterHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttesterHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttesterHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryAttesterHistoryResponse) GetHistory() []AttesterHistoryEntry {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *QueryAttesterHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryDestinationCallerRequest is the request type for the
+// Query/DestinationCaller RPC method.
+type QueryDestinationCallerRequest struct {
+	// message is the raw message to read the destination caller from.
+	Message []byte `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *QueryDestinationCallerRequest) Reset()         { *m = QueryDestinationCallerRequest{} }
+func (m *QueryDestinationCallerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDestinationCallerRequest) ProtoMessage()    {}
+func (*QueryDestinationCallerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{44}
+}
+func (m *QueryDestinationCallerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDestinationCallerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDestinationCallerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDestinationCallerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDestinationCallerRequest.Merge(m, src)
+}
+func (m *QueryDestinationCallerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDestinationCallerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDestinationCallerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDestinationCallerRequest proto.InternalMessageInfo
+
+func (m *QueryDestinationCallerRequest) GetMessage() []byte {
+	if m != nil {
+		return m.Message
+	}
+	return nil
+}
+
+// QueryDestinationCallerResponse is the response type for the
+// Query/DestinationCaller RPC method.
+type QueryDestinationCallerResponse struct {
+	// destination_caller is the bech32 address allowed to receive the message,
+	// or empty if anyone may receive it.
+	DestinationCaller string `protobuf:"bytes,1,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+}
+
+func (m *QueryDestinationCallerResponse) Reset()         { *m = QueryDestinationCallerResponse{} }
+func (m *QueryDestinationCallerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDestinationCallerResponse) ProtoMessage()    {}
+func (*QueryDestinationCallerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{45}
+}
+func (m *QueryDestinationCallerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDestinationCallerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDestinationCallerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDestinationCallerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDestinationCallerResponse.Merge(m, src)
+}
+func (m *QueryDestinationCallerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDestinationCallerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDestinationCallerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDestinationCallerResponse proto.InternalMessageInfo
+
+func (m *QueryDestinationCallerResponse) GetDestinationCaller() string {
+	if m != nil {
+		return m.DestinationCaller
+	}
+	return ""
+}
+
+// QueryGetForwardingRequest is the request type for the Query/Forwarding RPC
+// method.
+type QueryGetForwardingRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetForwardingRequest) Reset()         { *m = QueryGetForwardingRequest{} }
+func (m *QueryGetForwardingRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetForwardingRequest) ProtoMessage()    {}
+func (*QueryGetForwardingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{46}
+}
+func (m *QueryGetForwardingRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetForwardingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetForwardingRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetForwardingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetForwardingRequest.Merge(m, src)
+}
+func (m *QueryGetForwardingRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetForwardingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetForwardingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetForwardingRequest proto.InternalMessageInfo
+
+func (m *QueryGetForwardingRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetForwardingResponse is the response type for the Query/Forwarding RPC
+// method.
+type QueryGetForwardingResponse struct {
+	Forwarding Forwarding `protobuf:"bytes,1,opt,name=forwarding,proto3" json:"forwarding"`
+}
+
+func (m *QueryGetForwardingResponse) Reset()         { *m = QueryGetForwardingResponse{} }
+func (m *QueryGetForwardingResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetForwardingResponse) ProtoMessage()    {}
+func (*QueryGetForwardingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e7468ed27f019b2f, []int{47}
+}
+func (m *QueryGetForwardingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetForwardingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetForwardingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetForwardingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetForwardingResponse.Merge(m, src)
+}
+func (m *QueryGetForwardingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetForwardingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetForwardingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetForwardingResponse proto.InternalMessageInfo
+
+func (m *QueryGetForwardingResponse) GetForwarding() Forwarding {
+	if m != nil {
+		return m.Forwarding
+	}
+	return Forwarding{}
+}
+
+func init() {
+	proto.RegisterType((*QueryRolesRequest)(nil), "circle.cctp.v1.QueryRolesRequest")
+	proto.RegisterType((*QueryRolesResponse)(nil), "circle.cctp.v1.QueryRolesResponse")
+	proto.RegisterType((*QueryPendingRolesRequest)(nil), "circle.cctp.v1.QueryPendingRolesRequest")
+	proto.RegisterType((*QueryPendingRolesResponse)(nil), "circle.cctp.v1.QueryPendingRolesResponse")
+	proto.RegisterType((*QueryRoleHistoryRequest)(nil), "circle.cctp.v1.QueryRoleHistoryRequest")
+	proto.RegisterType((*QueryRoleHistoryResponse)(nil), "circle.cctp.v1.QueryRoleHistoryResponse")
+	proto.RegisterType((*QueryGetAttesterRequest)(nil), "circle.cctp.v1.QueryGetAttesterRequest")
+	proto.RegisterType((*QueryGetAttesterResponse)(nil), "circle.cctp.v1.QueryGetAttesterResponse")
+	proto.RegisterType((*QueryAllAttestersRequest)(nil), "circle.cctp.v1.QueryAllAttestersRequest")
+	proto.RegisterType((*QueryAllAttestersResponse)(nil), "circle.cctp.v1.QueryAllAttestersResponse")
+	proto.RegisterType((*QueryGetPerMessageBurnLimitRequest)(nil), "circle.cctp.v1.QueryGetPerMessageBurnLimitRequest")
+	proto.RegisterType((*QueryGetPerMessageBurnLimitResponse)(nil), "circle.cctp.v1.QueryGetPerMessageBurnLimitResponse")
+	proto.RegisterType((*QueryAllPerMessageBurnLimitsRequest)(nil), "circle.cctp.v1.QueryAllPerMessageBurnLimitsRequest")
+	proto.RegisterType((*QueryAllPerMessageBurnLimitsResponse)(nil), "circle.cctp.v1.QueryAllPerMessageBurnLimitsResponse")
+	proto.RegisterType((*QueryGetBurningAndMintingPausedRequest)(nil), "circle.cctp.v1.QueryGetBurningAndMintingPausedRequest")
+	proto.RegisterType((*QueryGetBurningAndMintingPausedResponse)(nil), "circle.cctp.v1.QueryGetBurningAndMintingPausedResponse")
+	proto.RegisterType((*QueryGetSendingAndReceivingMessagesPausedRequest)(nil), "circle.cctp.v1.QueryGetSendingAndReceivingMessagesPausedRequest")
+	proto.RegisterType((*QueryGetSendingAndReceivingMessagesPausedResponse)(nil), "circle.cctp.v1.QueryGetSendingAndReceivingMessagesPausedResponse")
+	proto.RegisterType((*QueryGetMaxMessageBodySizeRequest)(nil), "circle.cctp.v1.QueryGetMaxMessageBodySizeRequest")
+	proto.RegisterType((*QueryGetMaxMessageBodySizeResponse)(nil), "circle.cctp.v1.QueryGetMaxMessageBodySizeResponse")
+	proto.RegisterType((*QueryGetNextAvailableNonceRequest)(nil), "circle.cctp.v1.QueryGetNextAvailableNonceRequest")
+	proto.RegisterType((*QueryGetNextAvailableNonceResponse)(nil), "circle.cctp.v1.QueryGetNextAvailableNonceResponse")
+	proto.RegisterType((*QueryGetSignatureThresholdRequest)(nil), "circle.cctp.v1.QueryGetSignatureThresholdRequest")
+	proto.RegisterType((*QueryGetSignatureThresholdResponse)(nil), "circle.cctp.v1.QueryGetSignatureThresholdResponse")
+	proto.RegisterType((*QueryGetTokenPairRequest)(nil), "circle.cctp.v1.QueryGetTokenPairRequest")
+	proto.RegisterType((*QueryGetTokenPairResponse)(nil), "circle.cctp.v1.QueryGetTokenPairResponse")
+	proto.RegisterType((*QueryAllTokenPairsRequest)(nil), "circle.cctp.v1.QueryAllTokenPairsRequest")
+	proto.RegisterType((*QueryAllTokenPairsResponse)(nil), "circle.cctp.v1.QueryAllTokenPairsResponse")
+	proto.RegisterType((*QueryGetUsedNonceRequest)(nil), "circle.cctp.v1.QueryGetUsedNonceRequest")
+	proto.RegisterType((*QueryGetUsedNonceResponse)(nil), "circle.cctp.v1.QueryGetUsedNonceResponse")
+	proto.RegisterType((*QueryAllUsedNoncesRequest)(nil), "circle.cctp.v1.QueryAllUsedNoncesRequest")
+	proto.RegisterType((*QueryAllUsedNoncesResponse)(nil), "circle.cctp.v1.QueryAllUsedNoncesResponse")
+	proto.RegisterType((*QueryRemoteTokenMessengerRequest)(nil), "circle.cctp.v1.QueryRemoteTokenMessengerRequest")
+	proto.RegisterType((*QueryRemoteTokenMessengerResponse)(nil), "circle.cctp.v1.QueryRemoteTokenMessengerResponse")
+	proto.RegisterType((*QueryRemoteTokenMessengersRequest)(nil), "circle.cctp.v1.QueryRemoteTokenMessengersRequest")
+	proto.RegisterType((*QueryRemoteTokenMessengersResponse)(nil), "circle.cctp.v1.QueryRemoteTokenMessengersResponse")
+	proto.RegisterType((*QueryBurnMessageVersionRequest)(nil), "circle.cctp.v1.QueryBurnMessageVersionRequest")
+	proto.RegisterType((*QueryBurnMessageVersionResponse)(nil), "circle.cctp.v1.QueryBurnMessageVersionResponse")
+	proto.RegisterType((*QueryLocalMessageVersionRequest)(nil), "circle.cctp.v1.QueryLocalMessageVersionRequest")
+	proto.RegisterType((*QueryLocalMessageVersionResponse)(nil), "circle.cctp.v1.QueryLocalMessageVersionResponse")
+	proto.RegisterType((*QueryLocalDomainRequest)(nil), "circle.cctp.v1.QueryLocalDomainRequest")
+	proto.RegisterType((*QueryLocalDomainResponse)(nil), "circle.cctp.v1.QueryLocalDomainResponse")
+	proto.RegisterType((*QueryAttesterHistoryRequest)(nil), "circle.cctp.v1.QueryAttesterHistoryRequest")
+	proto.RegisterType((*QueryAttesterHistoryResponse)(nil), "circle.cctp.v1.QueryAttesterHistoryResponse")
+	proto.RegisterType((*QueryDestinationCallerRequest)(nil), "circle.cctp.v1.QueryDestinationCallerRequest")
+	proto.RegisterType((*QueryDestinationCallerResponse)(nil), "circle.cctp.v1.QueryDestinationCallerResponse")
+	proto.RegisterType((*QueryGetForwardingRequest)(nil), "circle.cctp.v1.QueryGetForwardingRequest")
+	proto.RegisterType((*QueryGetForwardingResponse)(nil), "circle.cctp.v1.QueryGetForwardingResponse")
+}
+
+func init() { proto.RegisterFile("circle/cctp/v1/query.proto", fileDescriptor_e7468ed27f019b2f) }
+
+var fileDescriptor_e7468ed27f019b2f = []byte{
+	// 2131 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xdf, 0x6f, 0x1c, 0x57,
+	0x15, 0xce, 0x6d, 0xed, 0x24, 0x3e, 0xeb, 0xb4, 0xe4, 0xc6, 0xa9, 0xd7, 0xe3, 0x78, 0x63, 0x8f,
+	0x9d, 0x78, 0xed, 0x24, 0x3b, 0xd9, 0x35, 0x21, 0x22, 0x0d, 0xa2, 0x76, 0xd3, 0xb4, 0xa0, 0x3a,
+	0x35, 0x9b, 0xa4, 0x48, 0x3c, 0xb0, 0xcc, 0xee, 0xdc, 0x6c, 0x46, 0x9d, 0x9d, 0xd9, 0xce, 0xcc,
+	0xba, 0x76, 0x2d, 0x3f, 0xf0, 0x1b, 0xd1, 0x17, 0x04, 0x12, 0xe2, 0x05, 0x1e, 0x28, 0xa8, 0x80,
+	0x84, 0x10, 0xbc, 0x80, 0x84, 0xc4, 0x73, 0x1f, 0x8b, 0x78, 0xe1, 0x09, 0xa1, 0x04, 0x89, 0x7f,
+	0x03, 0xcd, 0x9d, 0x73, 0x67, 0x76, 0x67, 0xe6, 0xee, 0xce, 0x86, 0x55, 0xdf, 0x3c, 0xf7, 0x9e,
+	0x73, 0xee, 0x77, 0xce, 0xb9, 0xf7, 0xdc, 0xfb, 0x9d, 0x35, 0x28, 0x2d, 0xd3, 0x6d, 0x59, 0x4c,
+	0x6b, 0xb5, 0xfc, 0xae, 0xb6, 0x5f, 0xd5, 0xde, 0xed, 0x31, 0xf7, 0xb0, 0xd2, 0x75, 0x1d, 0xdf,
+	0xa1, 0x2f, 0x84, 0x73, 0x95, 0x60, 0xae, 0xb2, 0x5f, 0x55, 0x96, 0x12, 0xb2, 0xba, 0xef, 0x33,
+	0xcf, 0x67, 0x6e, 0x28, 0xae, 0x68, 0x89, 0xe9, 0x66, 0xcf, 0xb5, 0x4d, 0xbb, 0xdd, 0xd0, 0x6d,
+	0xa3, 0xd1, 0x31, 0x6d, 0x3f, 0xf8, 0xbb, 0xab, 0xf7, 0x3c, 0x66, 0xa0, 0xc2, 0xc5, 0x84, 0xc2,
+	0x23, 0xc7, 0x7d, 0x4f, 0x77, 0x0d, 0xd3, 0x6e, 0xa3, 0xc0, 0x66, 0x42, 0xa0, 0xa3, 0x1f, 0x34,
+	0x3a, 0xcc, 0xf3, 0xf4, 0x36, 0x6b, 0x34, 0x1d, 0xe3, 0xb0, 0xe1, 0x99, 0xef, 0x33, 0x94, 0x4d,
+	0x3a, 0x62, 0x3b, 0x76, 0x4b, 0xcc, 0x5d, 0x49, 0xcc, 0x75, 0x99, 0x1b, 0xdb, 0xe9, 0xb9, 0x76,
+	0xc3, 0x32, 0x3b, 0xa6, 0x2f, 0x11, 0x76, 0x59, 0xc7, 0xf1, 0x59, 0xc3, 0x77, 0xde, 0x61, 0x36,
+	0xd7, 0x62, 0x76, 0x9b, 0xb9, 0x92, 0x55, 0x5d, 0xc7, 0x62, 0x1e, 0xce, 0xdd, 0x4a, 0xcc, 0x79,
+	0xcc, 0x36, 0x44, 0x3c, 0x5c, 0xd6, 0x62, 0xe6, 0x7e, 0xf0, 0x85, 0x38, 0xbc, 0xc1, 0xd0, 0x94,
+	0x93, 0xba, 0x66, 0xdb, 0xd6, 0xfd, 0x9e, 0xcb, 0x1a, 0xfe, 0x63, 0x97, 0x79, 0x8f, 0x1d, 0x4b,
+	0x16, 0xc4, 0x10, 0x67, 0x57, 0x37, 0xdd, 0x28, 0x88, 0x8e, 0xd7, 0x71, 0x3c, 0xad, 0xa9, 0x7b,
+	0x2c, 0x4c, 0xaf, 0xb6, 0x5f, 0x6d, 0x32, 0x5f, 0xaf, 0x6a, 0x5d, 0xbd, 0x6d, 0xda, 0xba, 0x6f,
+	0x3a, 0x36, 0xca, 0xce, 0xb5, 0x9d, 0xb6, 0xc3, 0xff, 0xd4, 0x82, 0xbf, 0x70, 0xf4, 0x42, 0xdb,
+	0x71, 0xda, 0x16, 0xd3, 0xf4, 0xae, 0xa9, 0xe9, 0xb6, 0xed, 0xf8, 0x5c, 0x05, 0xdd, 0x54, 0xcf,
+	0xc1, 0xd9, 0xaf, 0x04, 0x56, 0xeb, 0x81, 0xeb, 0x75, 0xf6, 0x6e, 0x8f, 0x79, 0xbe, 0xfa, 0x33,
+	0x02, 0xb4, 0x7f, 0xd4, 0xeb, 0x3a, 0xb6, 0xc7, 0xe8, 0x1c, 0x4c, 0x3b, 0xef, 0xd9, 0xcc, 0x2d,
+	0x92, 0x65, 0x52, 0x9e, 0xa9, 0x87, 0x1f, 0x74, 0x03, 0x3e, 0x23, 0xb6, 0x52, 0xa3, 0xa3, 0xdb,
+	0x7a, 0x9b, 0xb9, 0xc5, 0xe7, 0xb8, 0xc0, 0x8b, 0x62, 0x7c, 0x37, 0x1c, 0xa6, 0x2f, 0xc1, 0x49,
+	0x1e, 0x27, 0xb7, 0xf8, 0x3c, 0x17, 0xc0, 0xaf, 0xc0, 0x44, 0xe8, 0x78, 0xcb, 0xb1, 0x7d, 0xd7,
+	0xb1, 0x2c, 0xe6, 0x16, 0xa7, 0x42, 0x13, 0x7c, 0xfc, 0xd5, 0x68, 0x58, 0x55, 0xa0, 0xc8, 0x91,
+	0xed, 0x85, 0xe9, 0x18, 0x80, 0xdd, 0x82, 0x85, 0x8c, 0x39, 0x04, 0x7f, 0x17, 0xce, 0x74, 0x31,
+	0x85, 0x3c, 0xcd, 0x45, 0xb2, 0xfc, 0x7c, 0xb9, 0x50, 0x5b, 0xac, 0x0c, 0x1e, 0x93, 0x4a, 0x9f,
+	0xf2, 0xce, 0xd4, 0xc7, 0xff, 0xba, 0x78, 0xa2, 0x3e, 0xdb, 0xed, 0xb3, 0xa7, 0x7e, 0x40, 0x60,
+	0x3e, 0x8a, 0xcd, 0x1b, 0xa6, 0xe7, 0x3b, 0xee, 0x21, 0x02, 0xa0, 0x65, 0x98, 0x0a, 0x6c, 0xf3,
+	0xf8, 0xbc, 0x50, 0x9b, 0x4b, 0x9a, 0x0e, 0x34, 0xea, 0x5c, 0x82, 0xde, 0x05, 0x88, 0xd3, 0xc7,
+	0xc3, 0x55, 0xa8, 0x5d, 0xae, 0x84, 0xb9, 0xae, 0x04, 0xb9, 0xae, 0x84, 0x47, 0x19, 0x73, 0x5d,
+	0xd9, 0xd3, 0xdb, 0x0c, 0x57, 0xa9, 0xf7, 0x69, 0xaa, 0xbf, 0x26, 0x18, 0x8f, 0x01, 0x34, 0xe8,
+	0xf2, 0x2b, 0x70, 0xea, 0x71, 0x38, 0x84, 0xce, 0x2e, 0x67, 0x21, 0x42, 0xad, 0xd7, 0x6c, 0xdf,
+	0x3d, 0x44, 0x8f, 0x85, 0x1a, 0x7d, 0x3d, 0x03, 0xe6, 0xfa, 0x48, 0x98, 0xe1, 0xf2, 0x03, 0x38,
+	0x6f, 0x60, 0xd0, 0x5e, 0x67, 0xfe, 0x36, 0x6e, 0x0a, 0x11, 0x34, 0x05, 0x4e, 0x8b, 0x7d, 0x82,
+	0x1b, 0x2b, 0xfa, 0x56, 0xdf, 0x86, 0x62, 0x5a, 0x0d, 0xbd, 0xbb, 0x95, 0xd0, 0x2b, 0xd4, 0x8a,
+	0x49, 0xf7, 0x84, 0x0e, 0xba, 0x15, 0xdb, 0x6d, 0xa2, 0xdd, 0x6d, 0xcb, 0x12, 0x32, 0x62, 0x17,
+	0x25, 0x52, 0x43, 0x9e, 0x39, 0x35, 0xbf, 0x24, 0xb0, 0x90, 0xb1, 0x08, 0xa2, 0xbf, 0x0d, 0x33,
+	0x02, 0x8d, 0xd8, 0x8a, 0xa3, 0xe0, 0xc7, 0x0a, 0x93, 0xcb, 0xcb, 0x2d, 0x50, 0x45, 0x80, 0xf7,
+	0x98, 0xbb, 0x1b, 0x56, 0xb3, 0x9d, 0x9e, 0x6b, 0xbf, 0x19, 0xd4, 0x54, 0x11, 0x92, 0x39, 0x98,
+	0x36, 0x98, 0xed, 0x74, 0xc4, 0xc1, 0xe7, 0x1f, 0xaa, 0x03, 0xab, 0x43, 0x75, 0xd1, 0xd3, 0x37,
+	0x00, 0xe2, 0x2a, 0x8d, 0xf1, 0x5c, 0x4d, 0x9f, 0xba, 0x94, 0x01, 0xe1, 0x75, 0x53, 0x0c, 0xa8,
+	0x1d, 0x5c, 0x70, 0xdb, 0xb2, 0x32, 0xe4, 0x27, 0x9e, 0xc0, 0xbf, 0x12, 0x58, 0x1b, 0xbe, 0x1e,
+	0x7a, 0xf8, 0x65, 0x28, 0xc4, 0x1e, 0x8a, 0x6c, 0x8e, 0xe1, 0x22, 0x44, 0x2e, 0x4e, 0x30, 0xb3,
+	0x65, 0xb8, 0x2c, 0xb2, 0xb3, 0x13, 0x5e, 0xe5, 0xdb, 0xb6, 0xb1, 0x1b, 0x5e, 0xe4, 0x7b, 0xfc,
+	0xb2, 0x12, 0x65, 0xb3, 0x0b, 0xeb, 0x23, 0x25, 0xd1, 0xd3, 0xd7, 0xb0, 0x80, 0x1b, 0x18, 0xd6,
+	0xf5, 0xa4, 0x93, 0x12, 0x03, 0xe8, 0x28, 0x2a, 0xab, 0x35, 0xb8, 0x2e, 0x56, 0xbc, 0x1f, 0xd6,
+	0xd6, 0x6d, 0xdb, 0xa8, 0x8b, 0x4b, 0x15, 0xa3, 0xe4, 0x0d, 0xa2, 0xfc, 0x0e, 0x81, 0xea, 0x18,
+	0x4a, 0x08, 0xf8, 0xad, 0x04, 0xe0, 0x6a, 0x12, 0xf0, 0x48, 0x53, 0x09, 0xe8, 0xab, 0xb0, 0x22,
+	0x50, 0xec, 0xea, 0x07, 0x22, 0xa1, 0x8e, 0x71, 0x78, 0xdf, 0x7c, 0x5f, 0xec, 0x22, 0xf5, 0x11,
+	0xa8, 0xc3, 0x84, 0xa2, 0xf2, 0x7c, 0x52, 0xef, 0x38, 0x3d, 0x5b, 0x1c, 0x0a, 0x35, 0x89, 0x2d,
+	0xad, 0x2b, 0xc0, 0x84, 0x7a, 0xfd, 0x60, 0xee, 0xb1, 0x03, 0x7f, 0x7b, 0x5f, 0x37, 0x2d, 0xbd,
+	0x69, 0xb1, 0x7b, 0x8e, 0xdd, 0x8a, 0xc0, 0x7c, 0x15, 0xd4, 0x61, 0x42, 0x08, 0xa6, 0x0a, 0xd3,
+	0xfc, 0xcd, 0x85, 0x58, 0xce, 0x27, 0xb1, 0x70, 0x69, 0x5c, 0x3e, 0x94, 0xec, 0x5f, 0xfd, 0xbe,
+	0x78, 0xe0, 0x3c, 0x10, 0xef, 0x9b, 0x8c, 0x50, 0x64, 0x09, 0xe5, 0x0d, 0x45, 0x5a, 0x37, 0x11,
+	0x8a, 0x66, 0x7c, 0x53, 0x3c, 0x08, 0x9e, 0x0c, 0x7b, 0xba, 0x19, 0xdd, 0x30, 0xab, 0x70, 0x06,
+	0x9f, 0x81, 0x86, 0xd3, 0xd1, 0xcd, 0xb0, 0x26, 0x9c, 0xa9, 0xcf, 0x86, 0x83, 0x77, 0xf8, 0x18,
+	0x5d, 0x81, 0xd9, 0xfe, 0xb7, 0x22, 0x3e, 0x61, 0x0a, 0xe1, 0x18, 0x37, 0xa9, 0xee, 0xc1, 0x42,
+	0xc6, 0x1a, 0xe8, 0xc2, 0x16, 0x4c, 0x05, 0xcf, 0x36, 0x74, 0x60, 0x21, 0xe9, 0x40, 0xa4, 0x80,
+	0xb8, 0xb9, 0x70, 0xf4, 0x62, 0xd9, 0xb6, 0xac, 0x48, 0x60, 0xe2, 0x75, 0xec, 0x23, 0x02, 0x4a,
+	0xd6, 0x2a, 0x51, 0xec, 0x0b, 0xf1, 0xab, 0x53, 0x54, 0xaf, 0x91, 0xf8, 0xc1, 0x8f, 0x2c, 0x4d,
+	0xae, 0x66, 0x3d, 0x8c, 0x93, 0xf8, 0xd0, 0x63, 0x46, 0xff, 0x36, 0x0e, 0x92, 0xe8, 0x39, 0x3d,
+	0xb7, 0x95, 0x4c, 0x62, 0x38, 0x88, 0x49, 0x9c, 0x13, 0xbb, 0x38, 0x00, 0x31, 0x25, 0x36, 0xea,
+	0x3d, 0x58, 0xc8, 0x30, 0xfb, 0xec, 0x1b, 0xbf, 0x2f, 0x6b, 0x91, 0xbd, 0x89, 0x67, 0xed, 0xc3,
+	0xbe, 0xac, 0xf5, 0xaf, 0x12, 0xbd, 0x1f, 0x0a, 0x41, 0x3d, 0x6a, 0x70, 0x44, 0x22, 0x6b, 0x43,
+	0xc1, 0x43, 0x2f, 0xb2, 0x32, 0xb9, 0x8c, 0x7d, 0x11, 0x96, 0xc3, 0xe7, 0x67, 0x7c, 0x4c, 0x76,
+	0x05, 0xc9, 0x12, 0x11, 0x59, 0x84, 0x99, 0x30, 0x65, 0x0d, 0xd3, 0xc0, 0xac, 0x9d, 0x0e, 0x07,
+	0xbe, 0x64, 0xa8, 0xdf, 0x25, 0xb0, 0x32, 0xc4, 0x02, 0x7a, 0xfb, 0x0d, 0x78, 0x29, 0x9b, 0xc8,
+	0x61, 0x80, 0xd7, 0x52, 0x0f, 0xdb, 0x0c, 0x6b, 0x18, 0x87, 0x39, 0x37, 0x63, 0x4e, 0x7d, 0x67,
+	0x08, 0x8c, 0x89, 0xe7, 0xf6, 0xef, 0x04, 0xd4, 0x61, 0xab, 0xa1, 0xd7, 0x4d, 0x98, 0xcf, 0xf6,
+	0x5a, 0xe4, 0x7b, 0x1c, 0xb7, 0xcf, 0x67, 0xb9, 0x3d, 0xc1, 0x9d, 0xb0, 0x0c, 0x25, 0xee, 0x52,
+	0xf0, 0x02, 0xc0, 0x5b, 0xeb, 0x6d, 0xe6, 0x7a, 0xa6, 0x63, 0x8b, 0xab, 0xe0, 0x65, 0xb8, 0x28,
+	0x95, 0x40, 0x8f, 0x8b, 0x70, 0x6a, 0x3f, 0x1c, 0xc2, 0x8d, 0x22, 0x3e, 0xd5, 0x15, 0x54, 0x7e,
+	0xd3, 0x69, 0xe9, 0x56, 0xb6, 0xfd, 0xdb, 0xb0, 0x2c, 0x17, 0x19, 0xb9, 0xc0, 0x02, 0xcc, 0xc7,
+	0xda, 0x61, 0x39, 0x11, 0x86, 0x6f, 0x42, 0x31, 0x3d, 0x85, 0x06, 0x87, 0x6e, 0xee, 0x6f, 0x12,
+	0x58, 0x0c, 0xcf, 0x30, 0xbe, 0xdc, 0x13, 0x7c, 0x71, 0x08, 0xf5, 0x99, 0x18, 0x43, 0xfc, 0x3d,
+	0x81, 0x0b, 0xd9, 0x18, 0xd0, 0x83, 0x3b, 0x49, 0x96, 0xb8, 0x26, 0xe3, 0x21, 0x9f, 0x0a, 0x53,
+	0xfc, 0x3c, 0x2c, 0x71, 0xb8, 0x77, 0x98, 0xe7, 0xe3, 0xd8, 0xab, 0xba, 0x65, 0xc5, 0xe5, 0xa4,
+	0x08, 0xa7, 0xb0, 0xeb, 0xc2, 0x63, 0x36, 0x5b, 0x17, 0x9f, 0xea, 0x5b, 0x50, 0x92, 0xa9, 0xa2,
+	0xaf, 0xd7, 0x80, 0x1a, 0xf1, 0x64, 0xa3, 0xc5, 0x67, 0x31, 0xf4, 0x67, 0x8d, 0xa4, 0x9a, 0x7a,
+	0x23, 0xbe, 0x38, 0xee, 0x46, 0xdd, 0xad, 0x3e, 0x1c, 0xba, 0x61, 0xb8, 0xcc, 0xf3, 0xd0, 0x80,
+	0xf8, 0x54, 0xbf, 0x0e, 0x4a, 0x96, 0x5a, 0x74, 0xdf, 0x42, 0xdc, 0x2a, 0xc3, 0x22, 0xa2, 0x24,
+	0x43, 0x1e, 0xeb, 0x89, 0xea, 0x1d, 0xeb, 0xd4, 0xfe, 0x58, 0x82, 0x69, 0xbe, 0x00, 0xed, 0xc0,
+	0x34, 0xef, 0x4a, 0xd0, 0x95, 0xa4, 0x81, 0x54, 0x53, 0x47, 0x51, 0x87, 0x89, 0x84, 0xd8, 0xd4,
+	0xa5, 0x6f, 0xfd, 0xe3, 0x3f, 0x3f, 0x79, 0x6e, 0x9e, 0x9e, 0xd7, 0xb2, 0x3a, 0x63, 0xf4, 0x87,
+	0x04, 0x66, 0xfb, 0x9b, 0x2b, 0xb4, 0x9c, 0x69, 0x33, 0xa3, 0x37, 0xa3, 0x6c, 0xe4, 0x90, 0x44,
+	0x10, 0x97, 0x38, 0x88, 0x8b, 0x74, 0x29, 0x13, 0x84, 0x86, 0xdd, 0x18, 0xfa, 0x7d, 0x02, 0x85,
+	0xbe, 0xfe, 0x05, 0x5d, 0x97, 0xfa, 0x37, 0x78, 0xea, 0x94, 0xf2, 0x68, 0xc1, 0x7c, 0x48, 0xc4,
+	0xde, 0xff, 0x80, 0xc0, 0x69, 0x71, 0x46, 0x24, 0x30, 0xd2, 0x7d, 0x0f, 0xa5, 0x3c, 0x5a, 0x10,
+	0x61, 0x5c, 0xe5, 0x30, 0x2e, 0xd3, 0x35, 0x4d, 0xd2, 0xc2, 0xf5, 0xb4, 0x23, 0xf1, 0xe7, 0x31,
+	0xfd, 0x36, 0x81, 0x19, 0x61, 0x42, 0x96, 0xa1, 0x8c, 0xbe, 0x87, 0xb2, 0x91, 0x43, 0x12, 0x01,
+	0xad, 0x70, 0x40, 0x8b, 0x74, 0x41, 0x0a, 0x88, 0xfe, 0x99, 0xc0, 0xb9, 0x0c, 0xc6, 0x4b, 0x6b,
+	0x32, 0xaf, 0xe5, 0xed, 0x07, 0x65, 0x6b, 0x2c, 0x1d, 0xc4, 0x78, 0x93, 0x63, 0xac, 0x52, 0x4d,
+	0xcb, 0xd5, 0x3e, 0xf6, 0xb4, 0x23, 0xde, 0xd5, 0x38, 0xa6, 0x7f, 0x22, 0x30, 0x97, 0x61, 0xd8,
+	0xa3, 0x5b, 0xb2, 0x00, 0x0d, 0x69, 0x46, 0x28, 0x9f, 0x1d, 0x4f, 0x09, 0xc1, 0x6b, 0x1c, 0xfc,
+	0x06, 0x5d, 0xcf, 0x09, 0x9e, 0xfe, 0x8d, 0xc0, 0xbc, 0x84, 0x7b, 0xd3, 0xcf, 0xc9, 0xc2, 0x37,
+	0xbc, 0x2f, 0xa0, 0xdc, 0x1c, 0x5b, 0x0f, 0xd1, 0xd7, 0x38, 0xfa, 0xab, 0x74, 0x33, 0xff, 0x6f,
+	0x0a, 0xf4, 0xbf, 0x04, 0x56, 0x46, 0x72, 0x71, 0xfa, 0x8a, 0x0c, 0x52, 0xde, 0x36, 0x82, 0xb2,
+	0xfd, 0x7f, 0x58, 0x40, 0xf7, 0xbe, 0xc0, 0xdd, 0xbb, 0x49, 0x6f, 0x68, 0xcf, 0xf2, 0x13, 0x01,
+	0xfd, 0x1d, 0x01, 0x9a, 0x66, 0xf6, 0xb4, 0x2a, 0x03, 0x26, 0x6d, 0x33, 0x28, 0xb5, 0x71, 0x54,
+	0x10, 0xfc, 0x35, 0x0e, 0x7e, 0x9d, 0x5e, 0xd2, 0xf2, 0xfc, 0x3a, 0x43, 0x7f, 0x4b, 0x80, 0xa6,
+	0xbb, 0x06, 0x72, 0xb0, 0xd2, 0x36, 0x84, 0x52, 0x1b, 0x47, 0x65, 0x54, 0xe1, 0xb3, 0xd9, 0x81,
+	0xdf, 0xd0, 0x85, 0x52, 0x48, 0x82, 0xe8, 0x6f, 0x08, 0xd0, 0x74, 0x9f, 0x40, 0x8e, 0x55, 0xda,
+	0xb4, 0x50, 0x6a, 0xe3, 0xa8, 0x20, 0xd6, 0x2b, 0x1c, 0xeb, 0x25, 0xba, 0xaa, 0x8d, 0xfe, 0xf1,
+	0x87, 0xfe, 0x8a, 0xc0, 0x4c, 0xc4, 0xa8, 0xa9, 0xf4, 0x26, 0x48, 0x76, 0x32, 0x94, 0x8d, 0x1c,
+	0x92, 0x88, 0x67, 0x87, 0xe3, 0xb9, 0x4d, 0x6f, 0x69, 0xd2, 0x9f, 0x98, 0x3c, 0xed, 0x68, 0xa0,
+	0x2f, 0x72, 0x1c, 0x7d, 0x73, 0x99, 0x63, 0xfa, 0x03, 0x02, 0xf0, 0x20, 0xe6, 0xf9, 0xd2, 0x1b,
+	0x22, 0xd5, 0xbb, 0x50, 0x36, 0xf3, 0x88, 0x22, 0xd2, 0x55, 0x8e, 0x74, 0x89, 0x2e, 0x0e, 0x41,
+	0x4a, 0x7f, 0x4e, 0x60, 0x26, 0xa2, 0xc1, 0xf2, 0x88, 0x25, 0xdb, 0x06, 0xca, 0x46, 0x0e, 0x49,
+	0xc4, 0xf1, 0x32, 0xc7, 0x71, 0x83, 0x6e, 0x25, 0x71, 0xf4, 0x11, 0x6d, 0xed, 0x68, 0xa0, 0x09,
+	0x71, 0xac, 0x1d, 0xf1, 0xf1, 0x30, 0x54, 0x0f, 0x63, 0x82, 0x2d, 0x0d, 0x55, 0xaa, 0x61, 0xa0,
+	0x6c, 0xe6, 0x11, 0x1d, 0x15, 0xaa, 0x3e, 0x88, 0xf4, 0x2f, 0x04, 0xe6, 0xb2, 0x88, 0x20, 0xbd,
+	0x9e, 0xfd, 0xf0, 0x91, 0x53, 0x77, 0xa5, 0x3a, 0x86, 0xc6, 0xa8, 0x28, 0x4a, 0xa8, 0xac, 0x76,
+	0x14, 0xf1, 0xa7, 0x63, 0xfa, 0x07, 0x02, 0xe7, 0xeb, 0x99, 0x3c, 0x35, 0x3f, 0x12, 0x6f, 0xf8,
+	0x29, 0x1e, 0x4a, 0xb9, 0xe5, 0x17, 0xaf, 0x04, 0x3d, 0xfd, 0x90, 0x00, 0x4d, 0x13, 0x5a, 0x5a,
+	0xc9, 0x5c, 0x5b, 0xca, 0x8d, 0x15, 0x2d, 0xb7, 0xfc, 0xa8, 0xd2, 0xc8, 0x5f, 0x05, 0xa2, 0x90,
+	0x23, 0xb9, 0xa5, 0x1f, 0x11, 0x38, 0x97, 0x41, 0x8b, 0x69, 0xf6, 0xb2, 0x72, 0x8e, 0xad, 0x5c,
+	0xcf, 0xaf, 0x30, 0xea, 0xc2, 0xb1, 0x02, 0xa5, 0x14, 0xd2, 0xef, 0x11, 0x28, 0xf4, 0xf1, 0x6c,
+	0xc9, 0x73, 0x3a, 0x4d, 0xd2, 0x95, 0xf2, 0x68, 0x41, 0x44, 0xb4, 0xc6, 0x11, 0x95, 0xe8, 0x85,
+	0x6c, 0x44, 0xe1, 0x76, 0xa4, 0x3f, 0x25, 0xf0, 0x62, 0x82, 0xf8, 0xd2, 0x2b, 0xd9, 0x47, 0x35,
+	0x93, 0xdc, 0x2b, 0x57, 0xf3, 0x09, 0x23, 0xa8, 0x32, 0x07, 0xa5, 0xd2, 0x65, 0xd9, 0x93, 0xba,
+	0x21, 0xd8, 0xc6, 0x2f, 0x08, 0x9c, 0x4d, 0x31, 0x5c, 0x7a, 0x2d, 0x73, 0x35, 0x19, 0x89, 0x56,
+	0x2a, 0x79, 0xc5, 0x11, 0xde, 0x26, 0x87, 0xb7, 0x46, 0xd5, 0x24, 0xbc, 0x34, 0x9d, 0xa6, 0x3f,
+	0x26, 0x00, 0x31, 0x7f, 0xa5, 0xd2, 0x0a, 0x9c, 0xa2, 0xd4, 0xca, 0x66, 0x1e, 0xd1, 0x51, 0xfb,
+	0x2a, 0x26, 0xca, 0x01, 0x2d, 0x0a, 0x29, 0xf9, 0xf1, 0xce, 0xdd, 0x8f, 0x9f, 0x94, 0xc8, 0x27,
+	0x4f, 0x4a, 0xe4, 0xdf, 0x4f, 0x4a, 0xe4, 0x47, 0x4f, 0x4b, 0x27, 0x3e, 0x79, 0x5a, 0x3a, 0xf1,
+	0xcf, 0xa7, 0xa5, 0x13, 0x5f, 0xbb, 0xda, 0x36, 0xfd, 0xc7, 0xbd, 0x66, 0xa5, 0xe5, 0x74, 0xd0,
+	0xd4, 0x23, 0xd3, 0xd6, 0x6c, 0xa7, 0x69, 0xb1, 0x6b, 0xdc, 0xe6, 0x41, 0x68, 0xda, 0x3f, 0xec,
+	0x32, 0xaf, 0x79, 0x92, 0xff, 0xdb, 0xc4, 0xd6, 0xff, 0x06, 0x00, 0x45, 0xf7, 0xb7, 0xf2, 0x7a,
+	0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type QueryClient interface {
 	Roles(ctx context.Context, in *QueryRolesRequest, opts ...grpc.CallOption) (*QueryRolesResponse, error)
+	// Queries the role transfers awaiting acceptance
+	PendingRoles(ctx context.Context, in *QueryPendingRolesRequest, opts ...grpc.CallOption) (*QueryPendingRolesResponse, error)
+	// Queries the history of role holders
+	RoleHistory(ctx context.Context, in *QueryRoleHistoryRequest, opts ...grpc.CallOption) (*QueryRoleHistoryResponse, error)
 	// Queries an Attester by index
 	Attester(ctx context.Context, in *QueryGetAttesterRequest, opts ...grpc.CallOption) (*QueryGetAttesterResponse, error)
-	// Queries a list of Attesters
+	// Queries a list of enabled Attesters
 	Attesters(ctx context.Context, in *QueryAllAttestersRequest, opts ...grpc.CallOption) (*QueryAllAttestersResponse, error)
 	// Queries a PerMessageBurnLimit by index
 	PerMessageBurnLimit(ctx context.Context, in *QueryGetPerMessageBurnLimitRequest, opts ...grpc.CallOption) (*QueryGetPerMessageBurnLimitResponse, error)
@@ -2001,6 +2528,12 @@ type QueryClient interface {
 	BurnMessageVersion(ctx context.Context, in *QueryBurnMessageVersionRequest, opts ...grpc.CallOption) (*QueryBurnMessageVersionResponse, error)
 	LocalMessageVersion(ctx context.Context, in *QueryLocalMessageVersionRequest, opts ...grpc.CallOption) (*QueryLocalMessageVersionResponse, error)
 	LocalDomain(ctx context.Context, in *QueryLocalDomainRequest, opts ...grpc.CallOption) (*QueryLocalDomainResponse, error)
+	// Queries the history of changes to the attester set
+	AttesterHistory(ctx context.Context, in *QueryAttesterHistoryRequest, opts ...grpc.CallOption) (*QueryAttesterHistoryResponse, error)
+	// Renders the destination caller of a message as a Noble address
+	DestinationCaller(ctx context.Context, in *QueryDestinationCallerRequest, opts ...grpc.CallOption) (*QueryDestinationCallerResponse, error)
+	// Queries the Forwarding registered by an address
+	Forwarding(ctx context.Context, in *QueryGetForwardingRequest, opts ...grpc.CallOption) (*QueryGetForwardingResponse, error)
 }
 
 type queryClient struct {
@@ -2020,6 +2553,24 @@ func (c *queryClient) Roles(ctx context.Context, in *QueryRolesRequest, opts ...
 	return out, nil
 }
 
+func (c *queryClient) PendingRoles(ctx context.Context, in *QueryPendingRolesRequest, opts ...grpc.CallOption) (*QueryPendingRolesResponse, error) {
+	out := new(QueryPendingRolesResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Query/PendingRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoleHistory(ctx context.Context, in *QueryRoleHistoryRequest, opts ...grpc.CallOption) (*QueryRoleHistoryResponse, error) {
+	out := new(QueryRoleHistoryResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Query/RoleHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Attester(ctx context.Context, in *QueryGetAttesterRequest, opts ...grpc.CallOption) (*QueryGetAttesterResponse, error) {
 	out := new(QueryGetAttesterResponse)
 	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Query/Attester", in, out, opts...)
@@ -2182,12 +2733,43 @@ func (c *queryClient) LocalDomain(ctx context.Context, in *QueryLocalDomainReque
 	return out, nil
 }
 
+func (c *queryClient) AttesterHistory(ctx context.Context, in *QueryAttesterHistoryRequest, opts ...grpc.CallOption) (*QueryAttesterHistoryResponse, error) {
+	out := new(QueryAttesterHistoryResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Query/AttesterHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DestinationCaller(ctx context.Context, in *QueryDestinationCallerRequest, opts ...grpc.CallOption) (*QueryDestinationCallerResponse, error) {
+	out := new(QueryDestinationCallerResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Query/DestinationCaller", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Forwarding(ctx context.Context, in *QueryGetForwardingRequest, opts ...grpc.CallOption) (*QueryGetForwardingResponse, error) {
+	out := new(QueryGetForwardingResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Query/Forwarding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Roles(context.Context, *QueryRolesRequest) (*QueryRolesResponse, error)
+	// Queries the role transfers awaiting acceptance
+	PendingRoles(context.Context, *QueryPendingRolesRequest) (*QueryPendingRolesResponse, error)
+	// Queries the history of role holders
+	RoleHistory(context.Context, *QueryRoleHistoryRequest) (*QueryRoleHistoryResponse, error)
 	// Queries an Attester by index
 	Attester(context.Context, *QueryGetAttesterRequest) (*QueryGetAttesterResponse, error)
-	// Queries a list of Attesters
+	// Queries a list of enabled Attesters
 	Attesters(context.Context, *QueryAllAttestersRequest) (*QueryAllAttestersResponse, error)
 	// Queries a PerMessageBurnLimit by index
 	PerMessageBurnLimit(context.Context, *QueryGetPerMessageBurnLimitRequest) (*QueryGetPerMessageBurnLimitResponse, error)
@@ -2218,6 +2800,12 @@ type QueryServer interface {
 	BurnMessageVersion(context.Context, *QueryBurnMessageVersionRequest) (*QueryBurnMessageVersionResponse, error)
 	LocalMessageVersion(context.Context, *QueryLocalMessageVersionRequest) (*QueryLocalMessageVersionResponse, error)
 	LocalDomain(context.Context, *QueryLocalDomainRequest) (*QueryLocalDomainResponse, error)
+	// Queries the history of changes to the attester set
+	AttesterHistory(context.Context, *QueryAttesterHistoryRequest) (*QueryAttesterHistoryResponse, error)
+	// Renders the destination caller of a message as a Noble address
+	DestinationCaller(context.Context, *QueryDestinationCallerRequest) (*QueryDestinationCallerResponse, error)
+	// Queries the Forwarding registered by an address
+	Forwarding(context.Context, *QueryGetForwardingRequest) (*QueryGetForwardingResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2227,6 +2815,12 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Roles(ctx context.Context, req *QueryRolesRequest) (*QueryRolesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Roles not implemented")
 }
+func (*UnimplementedQueryServer) PendingRoles(ctx context.Context, req *QueryPendingRolesRequest) (*QueryPendingRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingRoles not implemented")
+}
+func (*UnimplementedQueryServer) RoleHistory(ctx context.Context, req *QueryRoleHistoryRequest) (*QueryRoleHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleHistory not implemented")
+}
 func (*UnimplementedQueryServer) Attester(ctx context.Context, req *QueryGetAttesterRequest) (*QueryGetAttesterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Attester not implemented")
 }
@@ -2281,6 +2875,15 @@ func (*UnimplementedQueryServer) LocalMessageVersion(ctx context.Context, req *Q
 func (*UnimplementedQueryServer) LocalDomain(ctx context.Context, req *QueryLocalDomainRequest) (*QueryLocalDomainResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LocalDomain not implemented")
 }
+func (*UnimplementedQueryServer) AttesterHistory(ctx context.Context, req *QueryAttesterHistoryRequest) (*QueryAttesterHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttesterHistory not implemented")
+}
+func (*UnimplementedQueryServer) DestinationCaller(ctx context.Context, req *QueryDestinationCallerRequest) (*QueryDestinationCallerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DestinationCaller not implemented")
+}
+func (*UnimplementedQueryServer) Forwarding(ctx context.Context, req *QueryGetForwardingRequest) (*QueryGetForwardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Forwarding not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2304,63 +2907,99 @@ func _Query_Roles_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Attester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetAttesterRequest)
+func _Query_PendingRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingRolesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Attester(ctx, in)
+		return srv.(QueryServer).PendingRoles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/circle.cctp.v1.Query/Attester",
+		FullMethod: "/circle.cctp.v1.Query/PendingRoles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Attester(ctx, req.(*QueryGetAttesterRequest))
+		return srv.(QueryServer).PendingRoles(ctx, req.(*QueryPendingRolesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_Attesters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllAttestersRequest)
+func _Query_RoleHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).Attesters(ctx, in)
+		return srv.(QueryServer).RoleHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/circle.cctp.v1.Query/Attesters",
+		FullMethod: "/circle.cctp.v1.Query/RoleHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Attesters(ctx, req.(*QueryAllAttestersRequest))
+		return srv.(QueryServer).RoleHistory(ctx, req.(*QueryRoleHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_PerMessageBurnLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetPerMessageBurnLimitRequest)
+func _Query_Attester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttesterRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(QueryServer).PerMessageBurnLimit(ctx, in)
+		return srv.(QueryServer).Attester(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/circle.cctp.v1.Query/PerMessageBurnLimit",
+		FullMethod: "/circle.cctp.v1.Query/Attester",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).PerMessageBurnLimit(ctx, req.(*QueryGetPerMessageBurnLimitRequest))
+		return srv.(QueryServer).Attester(ctx, req.(*QueryGetAttesterRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Query_PerMessageBurnLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllPerMessageBurnLimitsRequest)
-	if err := dec(in); err != nil {
+func _Query_Attesters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttestersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Attesters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Query/Attesters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Attesters(ctx, req.(*QueryAllAttestersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PerMessageBurnLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPerMessageBurnLimitRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PerMessageBurnLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Query/PerMessageBurnLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PerMessageBurnLimit(ctx, req.(*QueryGetPerMessageBurnLimitRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PerMessageBurnLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPerMessageBurnLimitsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
@@ -2628,6 +3267,60 @@ func _Query_LocalDomain_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AttesterHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttesterHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttesterHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Query/AttesterHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttesterHistory(ctx, req.(*QueryAttesterHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DestinationCaller_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDestinationCallerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DestinationCaller(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Query/DestinationCaller",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DestinationCaller(ctx, req.(*QueryDestinationCallerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Forwarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetForwardingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Forwarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Query/Forwarding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Forwarding(ctx, req.(*QueryGetForwardingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "circle.cctp.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2636,6 +3329,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Roles",
 			Handler:    _Query_Roles_Handler,
 		},
+		{
+			MethodName: "PendingRoles",
+			Handler:    _Query_PendingRoles_Handler,
+		},
+		{
+			MethodName: "RoleHistory",
+			Handler:    _Query_RoleHistory_Handler,
+		},
 		{
 			MethodName: "Attester",
 			Handler:    _Query_Attester_Handler,
@@ -2708,6 +3409,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LocalDomain",
 			Handler:    _Query_LocalDomain_Handler,
 		},
+		{
+			MethodName: "AttesterHistory",
+			Handler:    _Query_AttesterHistory_Handler,
+		},
+		{
+			MethodName: "DestinationCaller",
+			Handler:    _Query_DestinationCaller_Handler,
+		},
+		{
+			MethodName: "Forwarding",
+			Handler:    _Query_Forwarding_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "circle/cctp/v1/query.proto",
@@ -2787,6 +3500,155 @@ func (m *QueryRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPendingRolesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRolesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRolesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingRoles) > 0 {
+		for iNdEx := len(m.PendingRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryGetAttesterRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3961,40 +4823,254 @@ func (m *QueryLocalDomainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAttesterHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryRolesRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+
+func (m *QueryAttesterHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRolesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryAttesterHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.AttesterManager)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttesterHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttesterHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttesterHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDestinationCallerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDestinationCallerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDestinationCallerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDestinationCallerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDestinationCallerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDestinationCallerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetForwardingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetForwardingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetForwardingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetForwardingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetForwardingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetForwardingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Forwarding.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AttesterManager)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	l = len(m.Pauser)
 	if l > 0 {
 		n += 1 + l + sovQuery(uint64(l))
@@ -4006,6 +5082,65 @@ func (m *QueryRolesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryPendingRolesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingRoles) > 0 {
+		for _, e := range m.PendingRoles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRoleHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoleHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryGetAttesterRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4453,16 +5588,102 @@ func (m *QueryLocalDomainResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *QueryRolesRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
+func (m *QueryAttesterHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttesterHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDestinationCallerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDestinationCallerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetForwardingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetForwardingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Forwarding.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRolesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
 		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
@@ -4687,7 +5908,7 @@ func (m *QueryRolesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAttesterRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPendingRolesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4710,17 +5931,67 @@ func (m *QueryGetAttesterRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAttesterRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryPendingRolesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAttesterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryPendingRolesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingRolesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingRolesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingRoles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4730,23 +6001,25 @@ func (m *QueryGetAttesterRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attester = string(dAtA[iNdEx:postIndex])
+			m.PendingRoles = append(m.PendingRoles, PendingRole{})
+			if err := m.PendingRoles[len(m.PendingRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4769,7 +6042,7 @@ func (m *QueryGetAttesterRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetAttesterResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoleHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4792,15 +6065,34 @@ func (m *QueryGetAttesterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetAttesterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoleHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetAttesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoleHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4827,7 +6119,10 @@ func (m *QueryGetAttesterResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Attester.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4852,7 +6147,7 @@ func (m *QueryGetAttesterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAttestersRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoleHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4875,13 +6170,47 @@ func (m *QueryAllAttestersRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAttestersRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoleHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAttestersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoleHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, RoleHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -4911,7 +6240,7 @@ func (m *QueryAllAttestersRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4938,7 +6267,7 @@ func (m *QueryAllAttestersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllAttestersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAttesterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4961,17 +6290,17 @@ func (m *QueryAllAttestersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllAttestersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAttesterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllAttestersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAttesterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4981,29 +6310,920 @@ func (m *QueryAllAttestersResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetAttesterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetAttesterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetAttesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attester.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAttestersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAttestersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAttestersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllAttestersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllAttestersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllAttestersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attesters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attesters = append(m.Attesters, Attester{})
+			if err := m.Attesters[len(m.Attesters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPerMessageBurnLimitRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPerMessageBurnLimitRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPerMessageBurnLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPerMessageBurnLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPerMessageBurnLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPerMessageBurnLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPerMessageBurnLimitsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPerMessageBurnLimitsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPerMessageBurnLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPerMessageBurnLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPerMessageBurnLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPerMessageBurnLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnLimits = append(m.BurnLimits, PerMessageBurnLimit{})
+			if err := m.BurnLimits[len(m.BurnLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBurningAndMintingPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBurningAndMintingPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBurningAndMintingPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetBurningAndMintingPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetBurningAndMintingPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetBurningAndMintingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSendingAndReceivingMessagesPausedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSendingAndReceivingMessagesPausedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSendingAndReceivingMessagesPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetSendingAndReceivingMessagesPausedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attesters = append(m.Attesters, Attester{})
-			if err := m.Attesters[len(m.Attesters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetSendingAndReceivingMessagesPausedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetSendingAndReceivingMessagesPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5030,10 +7250,7 @@ func (m *QueryAllAttestersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5058,7 +7275,7 @@ func (m *QueryAllAttestersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPerMessageBurnLimitRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMaxMessageBodySizeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5081,44 +7298,12 @@ func (m *QueryGetPerMessageBurnLimitRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPerMessageBurnLimitRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMaxMessageBodySizeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPerMessageBurnLimitRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMaxMessageBodySizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5140,7 +7325,7 @@ func (m *QueryGetPerMessageBurnLimitRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetPerMessageBurnLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5163,15 +7348,15 @@ func (m *QueryGetPerMessageBurnLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetPerMessageBurnLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetMaxMessageBodySizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetPerMessageBurnLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetMaxMessageBodySizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5198,7 +7383,7 @@ func (m *QueryGetPerMessageBurnLimitResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BurnLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5223,7 +7408,7 @@ func (m *QueryGetPerMessageBurnLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPerMessageBurnLimitsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNextAvailableNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5246,48 +7431,12 @@ func (m *QueryAllPerMessageBurnLimitsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPerMessageBurnLimitsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNextAvailableNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPerMessageBurnLimitsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNextAvailableNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5309,7 +7458,7 @@ func (m *QueryAllPerMessageBurnLimitsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllPerMessageBurnLimitsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetNextAvailableNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5332,49 +7481,15 @@ func (m *QueryAllPerMessageBurnLimitsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllPerMessageBurnLimitsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetNextAvailableNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllPerMessageBurnLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetNextAvailableNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnLimits", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BurnLimits = append(m.BurnLimits, PerMessageBurnLimit{})
-			if err := m.BurnLimits[len(m.BurnLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5401,10 +7516,7 @@ func (m *QueryAllPerMessageBurnLimitsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5429,7 +7541,7 @@ func (m *QueryAllPerMessageBurnLimitsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBurningAndMintingPausedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSignatureThresholdRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5452,10 +7564,10 @@ func (m *QueryGetBurningAndMintingPausedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBurningAndMintingPausedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSignatureThresholdRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBurningAndMintingPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSignatureThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5479,7 +7591,7 @@ func (m *QueryGetBurningAndMintingPausedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetBurningAndMintingPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5502,15 +7614,15 @@ func (m *QueryGetBurningAndMintingPausedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetBurningAndMintingPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetSignatureThresholdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetBurningAndMintingPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetSignatureThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5537,7 +7649,7 @@ func (m *QueryGetBurningAndMintingPausedResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5562,7 +7674,7 @@ func (m *QueryGetBurningAndMintingPausedResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSendingAndReceivingMessagesPausedRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTokenPairRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5585,12 +7697,63 @@ func (m *QueryGetSendingAndReceivingMessagesPausedRequest) Unmarshal(dAtA []byte
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSendingAndReceivingMessagesPausedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTokenPairRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSendingAndReceivingMessagesPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+			}
+			m.RemoteDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemoteDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoteToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5612,7 +7775,7 @@ func (m *QueryGetSendingAndReceivingMessagesPausedRequest) Unmarshal(dAtA []byte
 	}
 	return nil
 }
-func (m *QueryGetSendingAndReceivingMessagesPausedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5635,15 +7798,15 @@ func (m *QueryGetSendingAndReceivingMessagesPausedResponse) Unmarshal(dAtA []byt
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSendingAndReceivingMessagesPausedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSendingAndReceivingMessagesPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5670,7 +7833,7 @@ func (m *QueryGetSendingAndReceivingMessagesPausedResponse) Unmarshal(dAtA []byt
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Paused.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5695,7 +7858,7 @@ func (m *QueryGetSendingAndReceivingMessagesPausedResponse) Unmarshal(dAtA []byt
 	}
 	return nil
 }
-func (m *QueryGetMaxMessageBodySizeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5718,12 +7881,48 @@ func (m *QueryGetMaxMessageBodySizeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMaxMessageBodySizeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTokenPairsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMaxMessageBodySizeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5745,7 +7944,7 @@ func (m *QueryGetMaxMessageBodySizeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5768,15 +7967,15 @@ func (m *QueryGetMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetMaxMessageBodySizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllTokenPairsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetMaxMessageBodySizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5803,7 +8002,44 @@ func (m *QueryGetMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TokenPairs = append(m.TokenPairs, TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5828,7 +8064,7 @@ func (m *QueryGetMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNextAvailableNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetUsedNonceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5851,12 +8087,50 @@ func (m *QueryGetNextAvailableNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNextAvailableNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetUsedNonceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNextAvailableNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetUsedNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
+			}
+			m.SourceDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SourceDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5878,7 +8152,7 @@ func (m *QueryGetNextAvailableNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetNextAvailableNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetUsedNonceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5901,10 +8175,10 @@ func (m *QueryGetNextAvailableNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetNextAvailableNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetUsedNonceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetNextAvailableNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetUsedNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5961,7 +8235,7 @@ func (m *QueryGetNextAvailableNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSignatureThresholdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUsedNoncesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5984,12 +8258,48 @@ func (m *QueryGetSignatureThresholdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSignatureThresholdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUsedNoncesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSignatureThresholdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUsedNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6011,7 +8321,7 @@ func (m *QueryGetSignatureThresholdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllUsedNoncesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6034,15 +8344,15 @@ func (m *QueryGetSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetSignatureThresholdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllUsedNoncesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetSignatureThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllUsedNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedNonces", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6069,7 +8379,44 @@ func (m *QueryGetSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.UsedNonces = append(m.UsedNonces, Nonce{})
+			if err := m.UsedNonces[len(m.UsedNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6094,7 +8441,7 @@ func (m *QueryGetSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTokenPairRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRemoteTokenMessengerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6117,36 +8464,17 @@ func (m *QueryGetTokenPairRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTokenPairRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemoteTokenMessengerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTokenPairRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemoteTokenMessengerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
-			}
-			m.RemoteDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RemoteDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var stringLen uint64
+			m.DomainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6156,24 +8484,11 @@ func (m *QueryGetTokenPairRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DomainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RemoteToken = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6195,7 +8510,7 @@ func (m *QueryGetTokenPairRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6218,15 +8533,15 @@ func (m *QueryGetTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemoteTokenMessengerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemoteTokenMessengerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pair", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6253,7 +8568,7 @@ func (m *QueryGetTokenPairResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Pair.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RemoteTokenMessenger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6278,7 +8593,7 @@ func (m *QueryGetTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRemoteTokenMessengersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6301,10 +8616,10 @@ func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTokenPairsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemoteTokenMessengersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemoteTokenMessengersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6364,7 +8679,7 @@ func (m *QueryAllTokenPairsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRemoteTokenMessengersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6387,15 +8702,15 @@ func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllTokenPairsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRemoteTokenMessengersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRemoteTokenMessengersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessengers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6422,8 +8737,8 @@ func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPairs = append(m.TokenPairs, TokenPair{})
-			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RemoteTokenMessengers = append(m.RemoteTokenMessengers, RemoteTokenMessenger{})
+			if err := m.RemoteTokenMessengers[len(m.RemoteTokenMessengers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6484,7 +8799,7 @@ func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUsedNonceRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnMessageVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6507,50 +8822,12 @@ func (m *QueryGetUsedNonceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUsedNonceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnMessageVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUsedNonceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnMessageVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SourceDomain", wireType)
-			}
-			m.SourceDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SourceDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6572,7 +8849,7 @@ func (m *QueryGetUsedNonceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetUsedNonceResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnMessageVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6595,17 +8872,17 @@ func (m *QueryGetUsedNonceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetUsedNonceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnMessageVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetUsedNonceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnMessageVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6615,25 +8892,11 @@ func (m *QueryGetUsedNonceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Nonce.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6655,7 +8918,7 @@ func (m *QueryGetUsedNonceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUsedNoncesRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLocalMessageVersionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6678,48 +8941,12 @@ func (m *QueryAllUsedNoncesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUsedNoncesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLocalMessageVersionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUsedNoncesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLocalMessageVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6741,7 +8968,7 @@ func (m *QueryAllUsedNoncesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllUsedNoncesResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryLocalMessageVersionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6764,17 +8991,17 @@ func (m *QueryAllUsedNoncesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllUsedNoncesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLocalMessageVersionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllUsedNoncesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLocalMessageVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UsedNonces", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			var msglen int
+			m.Version = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6784,62 +9011,61 @@ func (m *QueryAllUsedNoncesResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Version |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UsedNonces = append(m.UsedNonces, Nonce{})
-			if err := m.UsedNonces[len(m.UsedNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLocalDomainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLocalDomainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLocalDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6861,7 +9087,7 @@ func (m *QueryAllUsedNoncesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRemoteTokenMessengerRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLocalDomainResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6884,10 +9110,10 @@ func (m *QueryRemoteTokenMessengerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemoteTokenMessengerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLocalDomainResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemoteTokenMessengerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLocalDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6930,7 +9156,7 @@ func (m *QueryRemoteTokenMessengerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttesterHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6953,17 +9179,17 @@ func (m *QueryRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemoteTokenMessengerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttesterHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemoteTokenMessengerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttesterHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessenger", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6973,76 +9199,25 @@ func (m *QueryRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RemoteTokenMessenger.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRemoteTokenMessengersRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemoteTokenMessengersRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemoteTokenMessengersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7099,7 +9274,7 @@ func (m *QueryRemoteTokenMessengersRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRemoteTokenMessengersResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttesterHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7122,15 +9297,15 @@ func (m *QueryRemoteTokenMessengersResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRemoteTokenMessengersResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttesterHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRemoteTokenMessengersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttesterHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteTokenMessengers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7157,8 +9332,8 @@ func (m *QueryRemoteTokenMessengersResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteTokenMessengers = append(m.RemoteTokenMessengers, RemoteTokenMessenger{})
-			if err := m.RemoteTokenMessengers[len(m.RemoteTokenMessengers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.History = append(m.History, AttesterHistoryEntry{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7219,7 +9394,7 @@ func (m *QueryRemoteTokenMessengersResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnMessageVersionRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryDestinationCallerRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7242,12 +9417,46 @@ func (m *QueryBurnMessageVersionRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnMessageVersionRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDestinationCallerRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnMessageVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDestinationCallerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7269,7 +9478,7 @@ func (m *QueryBurnMessageVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBurnMessageVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryDestinationCallerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7292,17 +9501,17 @@ func (m *QueryBurnMessageVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBurnMessageVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryDestinationCallerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBurnMessageVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryDestinationCallerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7312,61 +9521,24 @@ func (m *QueryBurnMessageVersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLocalMessageVersionRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLocalMessageVersionRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLocalMessageVersionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.DestinationCaller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7388,7 +9560,7 @@ func (m *QueryLocalMessageVersionRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLocalMessageVersionResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetForwardingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7411,17 +9583,17 @@ func (m *QueryLocalMessageVersionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLocalMessageVersionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetForwardingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLocalMessageVersionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetForwardingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Version = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7431,61 +9603,24 @@ func (m *QueryLocalMessageVersionResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Version |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryLocalDomainRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLocalDomainRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLocalDomainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -7507,7 +9642,7 @@ func (m *QueryLocalDomainRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLocalDomainResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetForwardingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7530,17 +9665,17 @@ func (m *QueryLocalDomainResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLocalDomainResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetForwardingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLocalDomainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetForwardingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forwarding", wireType)
 			}
-			m.DomainId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -7550,11 +9685,25 @@ func (m *QueryLocalDomainResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DomainId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Forwarding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -0,0 +1,52 @@
+// Copyright 2024 Circle Internet Group, Inc.  All rights reserved.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Roles lists every privileged role of the module, in the order they are
+// reported by queries and exported in genesis.
+var Roles = []Role{
+	Role_ROLE_OWNER,
+	Role_ROLE_ATTESTER_MANAGER,
+	Role_ROLE_PAUSER,
+	Role_ROLE_TOKEN_CONTROLLER,
+}
+
+// Name returns the human readable name of the role, as used in error messages.
+func (r Role) Name() string {
+	return strings.ReplaceAll(strings.ToLower(strings.TrimPrefix(r.String(), "ROLE_")), "_", " ")
+}
+
+// ParseRole parses a role from either its enum name (ROLE_PAUSER) or its
+// short form (pauser, attester-manager).
+func ParseRole(s string) (Role, error) {
+	name := strings.ToUpper(strings.ReplaceAll(s, "-", "_"))
+	if !strings.HasPrefix(name, "ROLE_") {
+		name = "ROLE_" + name
+	}
+
+	role, found := Role_value[name]
+	if !found || Role(role) == Role_ROLE_UNSPECIFIED {
+		return Role_ROLE_UNSPECIFIED, fmt.Errorf("unknown role %s", s)
+	}
+
+	return Role(role), nil
+}
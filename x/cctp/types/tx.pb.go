@@ -33,8 +33,9 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type MsgUpdateOwner struct {
-	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	NewOwner string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	From            string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	NewOwner        string `protobuf:"bytes,2,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	ExpiresAtHeight int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *MsgUpdateOwner) Reset()         { *m = MsgUpdateOwner{} }
@@ -109,6 +110,7 @@ var xxx_messageInfo_MsgUpdateOwnerResponse proto.InternalMessageInfo
 type MsgUpdateAttesterManager struct {
 	From               string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	NewAttesterManager string `protobuf:"bytes,2,opt,name=new_attester_manager,json=newAttesterManager,proto3" json:"new_attester_manager,omitempty"`
+	ExpiresAtHeight    int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *MsgUpdateAttesterManager) Reset()         { *m = MsgUpdateAttesterManager{} }
@@ -183,6 +185,7 @@ var xxx_messageInfo_MsgUpdateAttesterManagerResponse proto.InternalMessageInfo
 type MsgUpdateTokenController struct {
 	From               string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	NewTokenController string `protobuf:"bytes,2,opt,name=new_token_controller,json=newTokenController,proto3" json:"new_token_controller,omitempty"`
+	ExpiresAtHeight    int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *MsgUpdateTokenController) Reset()         { *m = MsgUpdateTokenController{} }
@@ -255,8 +258,9 @@ func (m *MsgUpdateTokenControllerResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgUpdateTokenControllerResponse proto.InternalMessageInfo
 
 type MsgUpdatePauser struct {
-	From      string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	NewPauser string `protobuf:"bytes,2,opt,name=new_pauser,json=newPauser,proto3" json:"new_pauser,omitempty"`
+	From            string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	NewPauser       string `protobuf:"bytes,2,opt,name=new_pauser,json=newPauser,proto3" json:"new_pauser,omitempty"`
+	ExpiresAtHeight int64  `protobuf:"varint,3,opt,name=expires_at_height,json=expiresAtHeight,proto3" json:"expires_at_height,omitempty"`
 }
 
 func (m *MsgUpdatePauser) Reset()         { *m = MsgUpdatePauser{} }
@@ -401,16 +405,237 @@ func (m *MsgAcceptOwnerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAcceptOwnerResponse proto.InternalMessageInfo
 
+type MsgAcceptAttesterManager struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (m *MsgAcceptAttesterManager) Reset()         { *m = MsgAcceptAttesterManager{} }
+func (m *MsgAcceptAttesterManager) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAttesterManager) ProtoMessage()    {}
+func (*MsgAcceptAttesterManager) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{10}
+}
+func (m *MsgAcceptAttesterManager) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAttesterManager) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAttesterManager.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAttesterManager) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAttesterManager.Merge(m, src)
+}
+func (m *MsgAcceptAttesterManager) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAttesterManager) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAttesterManager.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAttesterManager proto.InternalMessageInfo
+
+type MsgAcceptAttesterManagerResponse struct {
+}
+
+func (m *MsgAcceptAttesterManagerResponse) Reset()         { *m = MsgAcceptAttesterManagerResponse{} }
+func (m *MsgAcceptAttesterManagerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptAttesterManagerResponse) ProtoMessage()    {}
+func (*MsgAcceptAttesterManagerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{11}
+}
+func (m *MsgAcceptAttesterManagerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptAttesterManagerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptAttesterManagerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptAttesterManagerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptAttesterManagerResponse.Merge(m, src)
+}
+func (m *MsgAcceptAttesterManagerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptAttesterManagerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptAttesterManagerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptAttesterManagerResponse proto.InternalMessageInfo
+
+type MsgAcceptPauser struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (m *MsgAcceptPauser) Reset()         { *m = MsgAcceptPauser{} }
+func (m *MsgAcceptPauser) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPauser) ProtoMessage()    {}
+func (*MsgAcceptPauser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{12}
+}
+func (m *MsgAcceptPauser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPauser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptPauser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPauser.Merge(m, src)
+}
+func (m *MsgAcceptPauser) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPauser) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPauser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptPauser proto.InternalMessageInfo
+
+type MsgAcceptPauserResponse struct {
+}
+
+func (m *MsgAcceptPauserResponse) Reset()         { *m = MsgAcceptPauserResponse{} }
+func (m *MsgAcceptPauserResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptPauserResponse) ProtoMessage()    {}
+func (*MsgAcceptPauserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{13}
+}
+func (m *MsgAcceptPauserResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptPauserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptPauserResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptPauserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptPauserResponse.Merge(m, src)
+}
+func (m *MsgAcceptPauserResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptPauserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptPauserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptPauserResponse proto.InternalMessageInfo
+
+type MsgAcceptTokenController struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (m *MsgAcceptTokenController) Reset()         { *m = MsgAcceptTokenController{} }
+func (m *MsgAcceptTokenController) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptTokenController) ProtoMessage()    {}
+func (*MsgAcceptTokenController) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{14}
+}
+func (m *MsgAcceptTokenController) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptTokenController) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptTokenController.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptTokenController) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptTokenController.Merge(m, src)
+}
+func (m *MsgAcceptTokenController) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptTokenController) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptTokenController.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptTokenController proto.InternalMessageInfo
+
+type MsgAcceptTokenControllerResponse struct {
+}
+
+func (m *MsgAcceptTokenControllerResponse) Reset()         { *m = MsgAcceptTokenControllerResponse{} }
+func (m *MsgAcceptTokenControllerResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptTokenControllerResponse) ProtoMessage()    {}
+func (*MsgAcceptTokenControllerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{15}
+}
+func (m *MsgAcceptTokenControllerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptTokenControllerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptTokenControllerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptTokenControllerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptTokenControllerResponse.Merge(m, src)
+}
+func (m *MsgAcceptTokenControllerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptTokenControllerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptTokenControllerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptTokenControllerResponse proto.InternalMessageInfo
+
 type MsgEnableAttester struct {
-	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Attester string `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	From     string  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Attester string  `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	KeyType  KeyType `protobuf:"varint,3,opt,name=key_type,json=keyType,proto3,enum=circle.cctp.v1.KeyType" json:"key_type,omitempty"`
+	Operator string  `protobuf:"bytes,4,opt,name=operator,proto3" json:"operator,omitempty"`
 }
 
 func (m *MsgEnableAttester) Reset()         { *m = MsgEnableAttester{} }
 func (m *MsgEnableAttester) String() string { return proto.CompactTextString(m) }
 func (*MsgEnableAttester) ProtoMessage()    {}
 func (*MsgEnableAttester) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{10}
+	return fileDescriptor_0b990d866e8d1445, []int{16}
 }
 func (m *MsgEnableAttester) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,7 +671,7 @@ func (m *MsgEnableAttesterResponse) Reset()         { *m = MsgEnableAttesterResp
 func (m *MsgEnableAttesterResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEnableAttesterResponse) ProtoMessage()    {}
 func (*MsgEnableAttesterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{11}
+	return fileDescriptor_0b990d866e8d1445, []int{17}
 }
 func (m *MsgEnableAttesterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,13 +703,14 @@ var xxx_messageInfo_MsgEnableAttesterResponse proto.InternalMessageInfo
 type MsgDisableAttester struct {
 	From     string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	Attester string `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	Reason   string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
 func (m *MsgDisableAttester) Reset()         { *m = MsgDisableAttester{} }
 func (m *MsgDisableAttester) String() string { return proto.CompactTextString(m) }
 func (*MsgDisableAttester) ProtoMessage()    {}
 func (*MsgDisableAttester) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{12}
+	return fileDescriptor_0b990d866e8d1445, []int{18}
 }
 func (m *MsgDisableAttester) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -520,7 +746,7 @@ func (m *MsgDisableAttesterResponse) Reset()         { *m = MsgDisableAttesterRe
 func (m *MsgDisableAttesterResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDisableAttesterResponse) ProtoMessage()    {}
 func (*MsgDisableAttesterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{13}
+	return fileDescriptor_0b990d866e8d1445, []int{19}
 }
 func (m *MsgDisableAttesterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -557,7 +783,7 @@ func (m *MsgPauseBurningAndMinting) Reset()         { *m = MsgPauseBurningAndMin
 func (m *MsgPauseBurningAndMinting) String() string { return proto.CompactTextString(m) }
 func (*MsgPauseBurningAndMinting) ProtoMessage()    {}
 func (*MsgPauseBurningAndMinting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{14}
+	return fileDescriptor_0b990d866e8d1445, []int{20}
 }
 func (m *MsgPauseBurningAndMinting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +819,7 @@ func (m *MsgPauseBurningAndMintingResponse) Reset()         { *m = MsgPauseBurni
 func (m *MsgPauseBurningAndMintingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPauseBurningAndMintingResponse) ProtoMessage()    {}
 func (*MsgPauseBurningAndMintingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{15}
+	return fileDescriptor_0b990d866e8d1445, []int{21}
 }
 func (m *MsgPauseBurningAndMintingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -630,7 +856,7 @@ func (m *MsgUnpauseBurningAndMinting) Reset()         { *m = MsgUnpauseBurningAn
 func (m *MsgUnpauseBurningAndMinting) String() string { return proto.CompactTextString(m) }
 func (*MsgUnpauseBurningAndMinting) ProtoMessage()    {}
 func (*MsgUnpauseBurningAndMinting) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{16}
+	return fileDescriptor_0b990d866e8d1445, []int{22}
 }
 func (m *MsgUnpauseBurningAndMinting) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -666,7 +892,7 @@ func (m *MsgUnpauseBurningAndMintingResponse) Reset()         { *m = MsgUnpauseB
 func (m *MsgUnpauseBurningAndMintingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnpauseBurningAndMintingResponse) ProtoMessage()    {}
 func (*MsgUnpauseBurningAndMintingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{17}
+	return fileDescriptor_0b990d866e8d1445, []int{23}
 }
 func (m *MsgUnpauseBurningAndMintingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -703,7 +929,7 @@ func (m *MsgPauseSendingAndReceivingMessages) Reset()         { *m = MsgPauseSen
 func (m *MsgPauseSendingAndReceivingMessages) String() string { return proto.CompactTextString(m) }
 func (*MsgPauseSendingAndReceivingMessages) ProtoMessage()    {}
 func (*MsgPauseSendingAndReceivingMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{18}
+	return fileDescriptor_0b990d866e8d1445, []int{24}
 }
 func (m *MsgPauseSendingAndReceivingMessages) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -743,7 +969,7 @@ func (m *MsgPauseSendingAndReceivingMessagesResponse) String() string {
 }
 func (*MsgPauseSendingAndReceivingMessagesResponse) ProtoMessage() {}
 func (*MsgPauseSendingAndReceivingMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{19}
+	return fileDescriptor_0b990d866e8d1445, []int{25}
 }
 func (m *MsgPauseSendingAndReceivingMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -780,7 +1006,7 @@ func (m *MsgUnpauseSendingAndReceivingMessages) Reset()         { *m = MsgUnpaus
 func (m *MsgUnpauseSendingAndReceivingMessages) String() string { return proto.CompactTextString(m) }
 func (*MsgUnpauseSendingAndReceivingMessages) ProtoMessage()    {}
 func (*MsgUnpauseSendingAndReceivingMessages) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{20}
+	return fileDescriptor_0b990d866e8d1445, []int{26}
 }
 func (m *MsgUnpauseSendingAndReceivingMessages) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -820,7 +1046,7 @@ func (m *MsgUnpauseSendingAndReceivingMessagesResponse) String() string {
 }
 func (*MsgUnpauseSendingAndReceivingMessagesResponse) ProtoMessage() {}
 func (*MsgUnpauseSendingAndReceivingMessagesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{21}
+	return fileDescriptor_0b990d866e8d1445, []int{27}
 }
 func (m *MsgUnpauseSendingAndReceivingMessagesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -858,7 +1084,7 @@ func (m *MsgUpdateMaxMessageBodySize) Reset()         { *m = MsgUpdateMaxMessage
 func (m *MsgUpdateMaxMessageBodySize) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMaxMessageBodySize) ProtoMessage()    {}
 func (*MsgUpdateMaxMessageBodySize) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{22}
+	return fileDescriptor_0b990d866e8d1445, []int{28}
 }
 func (m *MsgUpdateMaxMessageBodySize) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -894,7 +1120,7 @@ func (m *MsgUpdateMaxMessageBodySizeResponse) Reset()         { *m = MsgUpdateMa
 func (m *MsgUpdateMaxMessageBodySizeResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateMaxMessageBodySizeResponse) ProtoMessage()    {}
 func (*MsgUpdateMaxMessageBodySizeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{23}
+	return fileDescriptor_0b990d866e8d1445, []int{29}
 }
 func (m *MsgUpdateMaxMessageBodySizeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -933,7 +1159,7 @@ func (m *MsgSetMaxBurnAmountPerMessage) Reset()         { *m = MsgSetMaxBurnAmou
 func (m *MsgSetMaxBurnAmountPerMessage) String() string { return proto.CompactTextString(m) }
 func (*MsgSetMaxBurnAmountPerMessage) ProtoMessage()    {}
 func (*MsgSetMaxBurnAmountPerMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{24}
+	return fileDescriptor_0b990d866e8d1445, []int{30}
 }
 func (m *MsgSetMaxBurnAmountPerMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -969,7 +1195,7 @@ func (m *MsgSetMaxBurnAmountPerMessageResponse) Reset()         { *m = MsgSetMax
 func (m *MsgSetMaxBurnAmountPerMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSetMaxBurnAmountPerMessageResponse) ProtoMessage()    {}
 func (*MsgSetMaxBurnAmountPerMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{25}
+	return fileDescriptor_0b990d866e8d1445, []int{31}
 }
 func (m *MsgSetMaxBurnAmountPerMessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1010,7 +1236,7 @@ func (m *MsgDepositForBurn) Reset()         { *m = MsgDepositForBurn{} }
 func (m *MsgDepositForBurn) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositForBurn) ProtoMessage()    {}
 func (*MsgDepositForBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{26}
+	return fileDescriptor_0b990d866e8d1445, []int{32}
 }
 func (m *MsgDepositForBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1047,7 +1273,7 @@ func (m *MsgDepositForBurnResponse) Reset()         { *m = MsgDepositForBurnResp
 func (m *MsgDepositForBurnResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositForBurnResponse) ProtoMessage()    {}
 func (*MsgDepositForBurnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{27}
+	return fileDescriptor_0b990d866e8d1445, []int{33}
 }
 func (m *MsgDepositForBurnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1096,7 +1322,7 @@ func (m *MsgDepositForBurnWithCaller) Reset()         { *m = MsgDepositForBurnWi
 func (m *MsgDepositForBurnWithCaller) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositForBurnWithCaller) ProtoMessage()    {}
 func (*MsgDepositForBurnWithCaller) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{28}
+	return fileDescriptor_0b990d866e8d1445, []int{34}
 }
 func (m *MsgDepositForBurnWithCaller) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1133,7 +1359,7 @@ func (m *MsgDepositForBurnWithCallerResponse) Reset()         { *m = MsgDepositF
 func (m *MsgDepositForBurnWithCallerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgDepositForBurnWithCallerResponse) ProtoMessage()    {}
 func (*MsgDepositForBurnWithCallerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{29}
+	return fileDescriptor_0b990d866e8d1445, []int{35}
 }
 func (m *MsgDepositForBurnWithCallerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1170,18 +1396,19 @@ func (m *MsgDepositForBurnWithCallerResponse) GetNonce() uint64 {
 }
 
 type MsgReplaceDepositForBurn struct {
-	From                 string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	OriginalMessage      []byte `protobuf:"bytes,2,opt,name=original_message,json=originalMessage,proto3" json:"original_message,omitempty"`
-	OriginalAttestation  []byte `protobuf:"bytes,3,opt,name=original_attestation,json=originalAttestation,proto3" json:"original_attestation,omitempty"`
-	NewDestinationCaller []byte `protobuf:"bytes,4,opt,name=new_destination_caller,json=newDestinationCaller,proto3" json:"new_destination_caller,omitempty"`
-	NewMintRecipient     []byte `protobuf:"bytes,5,opt,name=new_mint_recipient,json=newMintRecipient,proto3" json:"new_mint_recipient,omitempty"`
+	From                 string  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	OriginalMessage      []byte  `protobuf:"bytes,2,opt,name=original_message,json=originalMessage,proto3" json:"original_message,omitempty"`
+	OriginalAttestation  []byte  `protobuf:"bytes,3,opt,name=original_attestation,json=originalAttestation,proto3" json:"original_attestation,omitempty"`
+	NewDestinationCaller []byte  `protobuf:"bytes,4,opt,name=new_destination_caller,json=newDestinationCaller,proto3" json:"new_destination_caller,omitempty"`
+	NewMintRecipient     []byte  `protobuf:"bytes,5,opt,name=new_mint_recipient,json=newMintRecipient,proto3" json:"new_mint_recipient,omitempty"`
+	KeyType              KeyType `protobuf:"varint,6,opt,name=key_type,json=keyType,proto3,enum=circle.cctp.v1.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *MsgReplaceDepositForBurn) Reset()         { *m = MsgReplaceDepositForBurn{} }
 func (m *MsgReplaceDepositForBurn) String() string { return proto.CompactTextString(m) }
 func (*MsgReplaceDepositForBurn) ProtoMessage()    {}
 func (*MsgReplaceDepositForBurn) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{30}
+	return fileDescriptor_0b990d866e8d1445, []int{36}
 }
 func (m *MsgReplaceDepositForBurn) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1217,7 +1444,7 @@ func (m *MsgReplaceDepositForBurnResponse) Reset()         { *m = MsgReplaceDepo
 func (m *MsgReplaceDepositForBurnResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgReplaceDepositForBurnResponse) ProtoMessage()    {}
 func (*MsgReplaceDepositForBurnResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{31}
+	return fileDescriptor_0b990d866e8d1445, []int{37}
 }
 func (m *MsgReplaceDepositForBurnResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1247,16 +1474,20 @@ func (m *MsgReplaceDepositForBurnResponse) XXX_DiscardUnknown() {
 var xxx_messageInfo_MsgReplaceDepositForBurnResponse proto.InternalMessageInfo
 
 type MsgReceiveMessage struct {
-	From        string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	Message     []byte `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
-	Attestation []byte `protobuf:"bytes,3,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	From        string  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Message     []byte  `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Attestation []byte  `protobuf:"bytes,3,opt,name=attestation,proto3" json:"attestation,omitempty"`
+	KeyType     KeyType `protobuf:"varint,4,opt,name=key_type,json=keyType,proto3,enum=circle.cctp.v1.KeyType" json:"key_type,omitempty"`
+	// allow_already_received makes the message succeed, instead of failing
+	// with nonce already used, if this exact message was already received.
+	AllowAlreadyReceived bool `protobuf:"varint,5,opt,name=allow_already_received,json=allowAlreadyReceived,proto3" json:"allow_already_received,omitempty"`
 }
 
 func (m *MsgReceiveMessage) Reset()         { *m = MsgReceiveMessage{} }
 func (m *MsgReceiveMessage) String() string { return proto.CompactTextString(m) }
 func (*MsgReceiveMessage) ProtoMessage()    {}
 func (*MsgReceiveMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{32}
+	return fileDescriptor_0b990d866e8d1445, []int{38}
 }
 func (m *MsgReceiveMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1287,13 +1518,16 @@ var xxx_messageInfo_MsgReceiveMessage proto.InternalMessageInfo
 
 type MsgReceiveMessageResponse struct {
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	// already_received is set when the message had already been received and
+	// this call made no state changes.
+	AlreadyReceived bool `protobuf:"varint,2,opt,name=already_received,json=alreadyReceived,proto3" json:"already_received,omitempty"`
 }
 
 func (m *MsgReceiveMessageResponse) Reset()         { *m = MsgReceiveMessageResponse{} }
 func (m *MsgReceiveMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgReceiveMessageResponse) ProtoMessage()    {}
 func (*MsgReceiveMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{33}
+	return fileDescriptor_0b990d866e8d1445, []int{39}
 }
 func (m *MsgReceiveMessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1329,6 +1563,13 @@ func (m *MsgReceiveMessageResponse) GetSuccess() bool {
 	return false
 }
 
+func (m *MsgReceiveMessageResponse) GetAlreadyReceived() bool {
+	if m != nil {
+		return m.AlreadyReceived
+	}
+	return false
+}
+
 type MsgSendMessage struct {
 	From              string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	DestinationDomain uint32 `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
@@ -1340,7 +1581,7 @@ func (m *MsgSendMessage) Reset()         { *m = MsgSendMessage{} }
 func (m *MsgSendMessage) String() string { return proto.CompactTextString(m) }
 func (*MsgSendMessage) ProtoMessage()    {}
 func (*MsgSendMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{34}
+	return fileDescriptor_0b990d866e8d1445, []int{40}
 }
 func (m *MsgSendMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1618,7 @@ func (m *MsgSendMessageResponse) Reset()         { *m = MsgSendMessageResponse{}
 func (m *MsgSendMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendMessageResponse) ProtoMessage()    {}
 func (*MsgSendMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{35}
+	return fileDescriptor_0b990d866e8d1445, []int{41}
 }
 func (m *MsgSendMessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1425,7 +1666,7 @@ func (m *MsgSendMessageWithCaller) Reset()         { *m = MsgSendMessageWithCall
 func (m *MsgSendMessageWithCaller) String() string { return proto.CompactTextString(m) }
 func (*MsgSendMessageWithCaller) ProtoMessage()    {}
 func (*MsgSendMessageWithCaller) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{36}
+	return fileDescriptor_0b990d866e8d1445, []int{42}
 }
 func (m *MsgSendMessageWithCaller) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1462,7 +1703,7 @@ func (m *MsgSendMessageWithCallerResponse) Reset()         { *m = MsgSendMessage
 func (m *MsgSendMessageWithCallerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendMessageWithCallerResponse) ProtoMessage()    {}
 func (*MsgSendMessageWithCallerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{37}
+	return fileDescriptor_0b990d866e8d1445, []int{43}
 }
 func (m *MsgSendMessageWithCallerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,18 +1740,19 @@ func (m *MsgSendMessageWithCallerResponse) GetNonce() uint64 {
 }
 
 type MsgReplaceMessage struct {
-	From                 string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
-	OriginalMessage      []byte `protobuf:"bytes,2,opt,name=original_message,json=originalMessage,proto3" json:"original_message,omitempty"`
-	OriginalAttestation  []byte `protobuf:"bytes,3,opt,name=original_attestation,json=originalAttestation,proto3" json:"original_attestation,omitempty"`
-	NewMessageBody       []byte `protobuf:"bytes,4,opt,name=new_message_body,json=newMessageBody,proto3" json:"new_message_body,omitempty"`
-	NewDestinationCaller []byte `protobuf:"bytes,5,opt,name=new_destination_caller,json=newDestinationCaller,proto3" json:"new_destination_caller,omitempty"`
+	From                 string  `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	OriginalMessage      []byte  `protobuf:"bytes,2,opt,name=original_message,json=originalMessage,proto3" json:"original_message,omitempty"`
+	OriginalAttestation  []byte  `protobuf:"bytes,3,opt,name=original_attestation,json=originalAttestation,proto3" json:"original_attestation,omitempty"`
+	NewMessageBody       []byte  `protobuf:"bytes,4,opt,name=new_message_body,json=newMessageBody,proto3" json:"new_message_body,omitempty"`
+	NewDestinationCaller []byte  `protobuf:"bytes,5,opt,name=new_destination_caller,json=newDestinationCaller,proto3" json:"new_destination_caller,omitempty"`
+	KeyType              KeyType `protobuf:"varint,6,opt,name=key_type,json=keyType,proto3,enum=circle.cctp.v1.KeyType" json:"key_type,omitempty"`
 }
 
 func (m *MsgReplaceMessage) Reset()         { *m = MsgReplaceMessage{} }
 func (m *MsgReplaceMessage) String() string { return proto.CompactTextString(m) }
 func (*MsgReplaceMessage) ProtoMessage()    {}
 func (*MsgReplaceMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{38}
+	return fileDescriptor_0b990d866e8d1445, []int{44}
 }
 func (m *MsgReplaceMessage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1546,7 +1788,7 @@ func (m *MsgReplaceMessageResponse) Reset()         { *m = MsgReplaceMessageResp
 func (m *MsgReplaceMessageResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgReplaceMessageResponse) ProtoMessage()    {}
 func (*MsgReplaceMessageResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{39}
+	return fileDescriptor_0b990d866e8d1445, []int{45}
 }
 func (m *MsgReplaceMessageResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1584,7 +1826,7 @@ func (m *MsgUpdateSignatureThreshold) Reset()         { *m = MsgUpdateSignatureT
 func (m *MsgUpdateSignatureThreshold) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSignatureThreshold) ProtoMessage()    {}
 func (*MsgUpdateSignatureThreshold) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{40}
+	return fileDescriptor_0b990d866e8d1445, []int{46}
 }
 func (m *MsgUpdateSignatureThreshold) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1620,7 +1862,7 @@ func (m *MsgUpdateSignatureThresholdResponse) Reset()         { *m = MsgUpdateSi
 func (m *MsgUpdateSignatureThresholdResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateSignatureThresholdResponse) ProtoMessage()    {}
 func (*MsgUpdateSignatureThresholdResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{41}
+	return fileDescriptor_0b990d866e8d1445, []int{47}
 }
 func (m *MsgUpdateSignatureThresholdResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1660,7 +1902,7 @@ func (m *MsgLinkTokenPair) Reset()         { *m = MsgLinkTokenPair{} }
 func (m *MsgLinkTokenPair) String() string { return proto.CompactTextString(m) }
 func (*MsgLinkTokenPair) ProtoMessage()    {}
 func (*MsgLinkTokenPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{42}
+	return fileDescriptor_0b990d866e8d1445, []int{48}
 }
 func (m *MsgLinkTokenPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1696,7 +1938,7 @@ func (m *MsgLinkTokenPairResponse) Reset()         { *m = MsgLinkTokenPairRespon
 func (m *MsgLinkTokenPairResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgLinkTokenPairResponse) ProtoMessage()    {}
 func (*MsgLinkTokenPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{43}
+	return fileDescriptor_0b990d866e8d1445, []int{49}
 }
 func (m *MsgLinkTokenPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1736,7 +1978,7 @@ func (m *MsgUnlinkTokenPair) Reset()         { *m = MsgUnlinkTokenPair{} }
 func (m *MsgUnlinkTokenPair) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlinkTokenPair) ProtoMessage()    {}
 func (*MsgUnlinkTokenPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{44}
+	return fileDescriptor_0b990d866e8d1445, []int{50}
 }
 func (m *MsgUnlinkTokenPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1772,7 +2014,7 @@ func (m *MsgUnlinkTokenPairResponse) Reset()         { *m = MsgUnlinkTokenPairRe
 func (m *MsgUnlinkTokenPairResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUnlinkTokenPairResponse) ProtoMessage()    {}
 func (*MsgUnlinkTokenPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{45}
+	return fileDescriptor_0b990d866e8d1445, []int{51}
 }
 func (m *MsgUnlinkTokenPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1811,7 +2053,7 @@ func (m *MsgAddRemoteTokenMessenger) Reset()         { *m = MsgAddRemoteTokenMes
 func (m *MsgAddRemoteTokenMessenger) String() string { return proto.CompactTextString(m) }
 func (*MsgAddRemoteTokenMessenger) ProtoMessage()    {}
 func (*MsgAddRemoteTokenMessenger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{46}
+	return fileDescriptor_0b990d866e8d1445, []int{52}
 }
 func (m *MsgAddRemoteTokenMessenger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1847,7 +2089,7 @@ func (m *MsgAddRemoteTokenMessengerResponse) Reset()         { *m = MsgAddRemote
 func (m *MsgAddRemoteTokenMessengerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgAddRemoteTokenMessengerResponse) ProtoMessage()    {}
 func (*MsgAddRemoteTokenMessengerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{47}
+	return fileDescriptor_0b990d866e8d1445, []int{53}
 }
 func (m *MsgAddRemoteTokenMessengerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1885,7 +2127,7 @@ func (m *MsgRemoveRemoteTokenMessenger) Reset()         { *m = MsgRemoveRemoteTo
 func (m *MsgRemoveRemoteTokenMessenger) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRemoteTokenMessenger) ProtoMessage()    {}
 func (*MsgRemoveRemoteTokenMessenger) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{48}
+	return fileDescriptor_0b990d866e8d1445, []int{54}
 }
 func (m *MsgRemoveRemoteTokenMessenger) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1921,7 +2163,7 @@ func (m *MsgRemoveRemoteTokenMessengerResponse) Reset()         { *m = MsgRemove
 func (m *MsgRemoveRemoteTokenMessengerResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRemoteTokenMessengerResponse) ProtoMessage()    {}
 func (*MsgRemoveRemoteTokenMessengerResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0b990d866e8d1445, []int{49}
+	return fileDescriptor_0b990d866e8d1445, []int{55}
 }
 func (m *MsgRemoveRemoteTokenMessengerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1950,44 +2192,424 @@ func (m *MsgRemoveRemoteTokenMessengerResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveRemoteTokenMessengerResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateOwner)(nil), "circle.cctp.v1.MsgUpdateOwner")
-	proto.RegisterType((*MsgUpdateOwnerResponse)(nil), "circle.cctp.v1.MsgUpdateOwnerResponse")
-	proto.RegisterType((*MsgUpdateAttesterManager)(nil), "circle.cctp.v1.MsgUpdateAttesterManager")
-	proto.RegisterType((*MsgUpdateAttesterManagerResponse)(nil), "circle.cctp.v1.MsgUpdateAttesterManagerResponse")
-	proto.RegisterType((*MsgUpdateTokenController)(nil), "circle.cctp.v1.MsgUpdateTokenController")
-	proto.RegisterType((*MsgUpdateTokenControllerResponse)(nil), "circle.cctp.v1.MsgUpdateTokenControllerResponse")
-	proto.RegisterType((*MsgUpdatePauser)(nil), "circle.cctp.v1.MsgUpdatePauser")
-	proto.RegisterType((*MsgUpdatePauserResponse)(nil), "circle.cctp.v1.MsgUpdatePauserResponse")
-	proto.RegisterType((*MsgAcceptOwner)(nil), "circle.cctp.v1.MsgAcceptOwner")
-	proto.RegisterType((*MsgAcceptOwnerResponse)(nil), "circle.cctp.v1.MsgAcceptOwnerResponse")
-	proto.RegisterType((*MsgEnableAttester)(nil), "circle.cctp.v1.MsgEnableAttester")
-	proto.RegisterType((*MsgEnableAttesterResponse)(nil), "circle.cctp.v1.MsgEnableAttesterResponse")
-	proto.RegisterType((*MsgDisableAttester)(nil), "circle.cctp.v1.MsgDisableAttester")
-	proto.RegisterType((*MsgDisableAttesterResponse)(nil), "circle.cctp.v1.MsgDisableAttesterResponse")
-	proto.RegisterType((*MsgPauseBurningAndMinting)(nil), "circle.cctp.v1.MsgPauseBurningAndMinting")
-	proto.RegisterType((*MsgPauseBurningAndMintingResponse)(nil), "circle.cctp.v1.MsgPauseBurningAndMintingResponse")
-	proto.RegisterType((*MsgUnpauseBurningAndMinting)(nil), "circle.cctp.v1.MsgUnpauseBurningAndMinting")
-	proto.RegisterType((*MsgUnpauseBurningAndMintingResponse)(nil), "circle.cctp.v1.MsgUnpauseBurningAndMintingResponse")
-	proto.RegisterType((*MsgPauseSendingAndReceivingMessages)(nil), "circle.cctp.v1.MsgPauseSendingAndReceivingMessages")
-	proto.RegisterType((*MsgPauseSendingAndReceivingMessagesResponse)(nil), "circle.cctp.v1.MsgPauseSendingAndReceivingMessagesResponse")
-	proto.RegisterType((*MsgUnpauseSendingAndReceivingMessages)(nil), "circle.cctp.v1.MsgUnpauseSendingAndReceivingMessages")
-	proto.RegisterType((*MsgUnpauseSendingAndReceivingMessagesResponse)(nil), "circle.cctp.v1.MsgUnpauseSendingAndReceivingMessagesResponse")
-	proto.RegisterType((*MsgUpdateMaxMessageBodySize)(nil), "circle.cctp.v1.MsgUpdateMaxMessageBodySize")
-	proto.RegisterType((*MsgUpdateMaxMessageBodySizeResponse)(nil), "circle.cctp.v1.MsgUpdateMaxMessageBodySizeResponse")
-	proto.RegisterType((*MsgSetMaxBurnAmountPerMessage)(nil), "circle.cctp.v1.MsgSetMaxBurnAmountPerMessage")
-	proto.RegisterType((*MsgSetMaxBurnAmountPerMessageResponse)(nil), "circle.cctp.v1.MsgSetMaxBurnAmountPerMessageResponse")
-	proto.RegisterType((*MsgDepositForBurn)(nil), "circle.cctp.v1.MsgDepositForBurn")
-	proto.RegisterType((*MsgDepositForBurnResponse)(nil), "circle.cctp.v1.MsgDepositForBurnResponse")
-	proto.RegisterType((*MsgDepositForBurnWithCaller)(nil), "circle.cctp.v1.MsgDepositForBurnWithCaller")
-	proto.RegisterType((*MsgDepositForBurnWithCallerResponse)(nil), "circle.cctp.v1.MsgDepositForBurnWithCallerResponse")
-	proto.RegisterType((*MsgReplaceDepositForBurn)(nil), "circle.cctp.v1.MsgReplaceDepositForBurn")
-	proto.RegisterType((*MsgReplaceDepositForBurnResponse)(nil), "circle.cctp.v1.MsgReplaceDepositForBurnResponse")
-	proto.RegisterType((*MsgReceiveMessage)(nil), "circle.cctp.v1.MsgReceiveMessage")
-	proto.RegisterType((*MsgReceiveMessageResponse)(nil), "circle.cctp.v1.MsgReceiveMessageResponse")
-	proto.RegisterType((*MsgSendMessage)(nil), "circle.cctp.v1.MsgSendMessage")
-	proto.RegisterType((*MsgSendMessageResponse)(nil), "circle.cctp.v1.MsgSendMessageResponse")
-	proto.RegisterType((*MsgSendMessageWithCaller)(nil), "circle.cctp.v1.MsgSendMessageWithCaller")
+type MsgSetForwarding struct {
+	From              string                `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	DestinationDomain uint32                `protobuf:"varint,2,opt,name=destination_domain,json=destinationDomain,proto3" json:"destination_domain,omitempty"`
+	MintRecipient     []byte                `protobuf:"bytes,3,opt,name=mint_recipient,json=mintRecipient,proto3" json:"mint_recipient,omitempty"`
+	DestinationCaller []byte                `protobuf:"bytes,4,opt,name=destination_caller,json=destinationCaller,proto3" json:"destination_caller,omitempty"`
+	Fee               cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=fee,proto3,customtype=cosmossdk.io/math.Int" json:"fee"`
+}
+
+func (m *MsgSetForwarding) Reset()         { *m = MsgSetForwarding{} }
+func (m *MsgSetForwarding) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForwarding) ProtoMessage()    {}
+func (*MsgSetForwarding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{56}
+}
+func (m *MsgSetForwarding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForwarding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForwarding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetForwarding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForwarding.Merge(m, src)
+}
+func (m *MsgSetForwarding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetForwarding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForwarding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetForwarding proto.InternalMessageInfo
+
+type MsgSetForwardingResponse struct {
+}
+
+func (m *MsgSetForwardingResponse) Reset()         { *m = MsgSetForwardingResponse{} }
+func (m *MsgSetForwardingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetForwardingResponse) ProtoMessage()    {}
+func (*MsgSetForwardingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{57}
+}
+func (m *MsgSetForwardingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetForwardingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetForwardingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetForwardingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetForwardingResponse.Merge(m, src)
+}
+func (m *MsgSetForwardingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetForwardingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetForwardingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetForwardingResponse proto.InternalMessageInfo
+
+type MsgRemoveForwarding struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (m *MsgRemoveForwarding) Reset()         { *m = MsgRemoveForwarding{} }
+func (m *MsgRemoveForwarding) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveForwarding) ProtoMessage()    {}
+func (*MsgRemoveForwarding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{58}
+}
+func (m *MsgRemoveForwarding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveForwarding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveForwarding.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveForwarding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveForwarding.Merge(m, src)
+}
+func (m *MsgRemoveForwarding) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveForwarding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveForwarding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveForwarding proto.InternalMessageInfo
+
+type MsgRemoveForwardingResponse struct {
+}
+
+func (m *MsgRemoveForwardingResponse) Reset()         { *m = MsgRemoveForwardingResponse{} }
+func (m *MsgRemoveForwardingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveForwardingResponse) ProtoMessage()    {}
+func (*MsgRemoveForwardingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{59}
+}
+func (m *MsgRemoveForwardingResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveForwardingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveForwardingResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveForwardingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveForwardingResponse.Merge(m, src)
+}
+func (m *MsgRemoveForwardingResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveForwardingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveForwardingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveForwardingResponse proto.InternalMessageInfo
+
+// MsgRegisterAccount32 registers the signer, a 32-byte account, so that mint
+// recipients and message recipients equal to its address are used in full
+// instead of as a left padded 20-byte account.
+type MsgRegisterAccount32 struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (m *MsgRegisterAccount32) Reset()         { *m = MsgRegisterAccount32{} }
+func (m *MsgRegisterAccount32) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAccount32) ProtoMessage()    {}
+func (*MsgRegisterAccount32) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{60}
+}
+func (m *MsgRegisterAccount32) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAccount32) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAccount32.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAccount32) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAccount32.Merge(m, src)
+}
+func (m *MsgRegisterAccount32) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAccount32) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAccount32.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAccount32 proto.InternalMessageInfo
+
+type MsgRegisterAccount32Response struct {
+}
+
+func (m *MsgRegisterAccount32Response) Reset()         { *m = MsgRegisterAccount32Response{} }
+func (m *MsgRegisterAccount32Response) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterAccount32Response) ProtoMessage()    {}
+func (*MsgRegisterAccount32Response) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{61}
+}
+func (m *MsgRegisterAccount32Response) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterAccount32Response) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterAccount32Response.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterAccount32Response) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterAccount32Response.Merge(m, src)
+}
+func (m *MsgRegisterAccount32Response) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterAccount32Response) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterAccount32Response.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterAccount32Response proto.InternalMessageInfo
+
+// MsgEnableContractCallback opts the signer, a CosmWasm contract, in to sudo
+// callbacks for the messages and mints it receives.
+type MsgEnableContractCallback struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (m *MsgEnableContractCallback) Reset()         { *m = MsgEnableContractCallback{} }
+func (m *MsgEnableContractCallback) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableContractCallback) ProtoMessage()    {}
+func (*MsgEnableContractCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{62}
+}
+func (m *MsgEnableContractCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableContractCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableContractCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableContractCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableContractCallback.Merge(m, src)
+}
+func (m *MsgEnableContractCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableContractCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableContractCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableContractCallback proto.InternalMessageInfo
+
+type MsgEnableContractCallbackResponse struct {
+}
+
+func (m *MsgEnableContractCallbackResponse) Reset()         { *m = MsgEnableContractCallbackResponse{} }
+func (m *MsgEnableContractCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnableContractCallbackResponse) ProtoMessage()    {}
+func (*MsgEnableContractCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{63}
+}
+func (m *MsgEnableContractCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEnableContractCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnableContractCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnableContractCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnableContractCallbackResponse.Merge(m, src)
+}
+func (m *MsgEnableContractCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnableContractCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnableContractCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEnableContractCallbackResponse proto.InternalMessageInfo
+
+type MsgDisableContractCallback struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+}
+
+func (m *MsgDisableContractCallback) Reset()         { *m = MsgDisableContractCallback{} }
+func (m *MsgDisableContractCallback) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableContractCallback) ProtoMessage()    {}
+func (*MsgDisableContractCallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{64}
+}
+func (m *MsgDisableContractCallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableContractCallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableContractCallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableContractCallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableContractCallback.Merge(m, src)
+}
+func (m *MsgDisableContractCallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableContractCallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableContractCallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableContractCallback proto.InternalMessageInfo
+
+type MsgDisableContractCallbackResponse struct {
+}
+
+func (m *MsgDisableContractCallbackResponse) Reset()         { *m = MsgDisableContractCallbackResponse{} }
+func (m *MsgDisableContractCallbackResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisableContractCallbackResponse) ProtoMessage()    {}
+func (*MsgDisableContractCallbackResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b990d866e8d1445, []int{65}
+}
+func (m *MsgDisableContractCallbackResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisableContractCallbackResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisableContractCallbackResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisableContractCallbackResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisableContractCallbackResponse.Merge(m, src)
+}
+func (m *MsgDisableContractCallbackResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisableContractCallbackResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisableContractCallbackResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisableContractCallbackResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateOwner)(nil), "circle.cctp.v1.MsgUpdateOwner")
+	proto.RegisterType((*MsgUpdateOwnerResponse)(nil), "circle.cctp.v1.MsgUpdateOwnerResponse")
+	proto.RegisterType((*MsgUpdateAttesterManager)(nil), "circle.cctp.v1.MsgUpdateAttesterManager")
+	proto.RegisterType((*MsgUpdateAttesterManagerResponse)(nil), "circle.cctp.v1.MsgUpdateAttesterManagerResponse")
+	proto.RegisterType((*MsgUpdateTokenController)(nil), "circle.cctp.v1.MsgUpdateTokenController")
+	proto.RegisterType((*MsgUpdateTokenControllerResponse)(nil), "circle.cctp.v1.MsgUpdateTokenControllerResponse")
+	proto.RegisterType((*MsgUpdatePauser)(nil), "circle.cctp.v1.MsgUpdatePauser")
+	proto.RegisterType((*MsgUpdatePauserResponse)(nil), "circle.cctp.v1.MsgUpdatePauserResponse")
+	proto.RegisterType((*MsgAcceptOwner)(nil), "circle.cctp.v1.MsgAcceptOwner")
+	proto.RegisterType((*MsgAcceptOwnerResponse)(nil), "circle.cctp.v1.MsgAcceptOwnerResponse")
+	proto.RegisterType((*MsgAcceptAttesterManager)(nil), "circle.cctp.v1.MsgAcceptAttesterManager")
+	proto.RegisterType((*MsgAcceptAttesterManagerResponse)(nil), "circle.cctp.v1.MsgAcceptAttesterManagerResponse")
+	proto.RegisterType((*MsgAcceptPauser)(nil), "circle.cctp.v1.MsgAcceptPauser")
+	proto.RegisterType((*MsgAcceptPauserResponse)(nil), "circle.cctp.v1.MsgAcceptPauserResponse")
+	proto.RegisterType((*MsgAcceptTokenController)(nil), "circle.cctp.v1.MsgAcceptTokenController")
+	proto.RegisterType((*MsgAcceptTokenControllerResponse)(nil), "circle.cctp.v1.MsgAcceptTokenControllerResponse")
+	proto.RegisterType((*MsgEnableAttester)(nil), "circle.cctp.v1.MsgEnableAttester")
+	proto.RegisterType((*MsgEnableAttesterResponse)(nil), "circle.cctp.v1.MsgEnableAttesterResponse")
+	proto.RegisterType((*MsgDisableAttester)(nil), "circle.cctp.v1.MsgDisableAttester")
+	proto.RegisterType((*MsgDisableAttesterResponse)(nil), "circle.cctp.v1.MsgDisableAttesterResponse")
+	proto.RegisterType((*MsgPauseBurningAndMinting)(nil), "circle.cctp.v1.MsgPauseBurningAndMinting")
+	proto.RegisterType((*MsgPauseBurningAndMintingResponse)(nil), "circle.cctp.v1.MsgPauseBurningAndMintingResponse")
+	proto.RegisterType((*MsgUnpauseBurningAndMinting)(nil), "circle.cctp.v1.MsgUnpauseBurningAndMinting")
+	proto.RegisterType((*MsgUnpauseBurningAndMintingResponse)(nil), "circle.cctp.v1.MsgUnpauseBurningAndMintingResponse")
+	proto.RegisterType((*MsgPauseSendingAndReceivingMessages)(nil), "circle.cctp.v1.MsgPauseSendingAndReceivingMessages")
+	proto.RegisterType((*MsgPauseSendingAndReceivingMessagesResponse)(nil), "circle.cctp.v1.MsgPauseSendingAndReceivingMessagesResponse")
+	proto.RegisterType((*MsgUnpauseSendingAndReceivingMessages)(nil), "circle.cctp.v1.MsgUnpauseSendingAndReceivingMessages")
+	proto.RegisterType((*MsgUnpauseSendingAndReceivingMessagesResponse)(nil), "circle.cctp.v1.MsgUnpauseSendingAndReceivingMessagesResponse")
+	proto.RegisterType((*MsgUpdateMaxMessageBodySize)(nil), "circle.cctp.v1.MsgUpdateMaxMessageBodySize")
+	proto.RegisterType((*MsgUpdateMaxMessageBodySizeResponse)(nil), "circle.cctp.v1.MsgUpdateMaxMessageBodySizeResponse")
+	proto.RegisterType((*MsgSetMaxBurnAmountPerMessage)(nil), "circle.cctp.v1.MsgSetMaxBurnAmountPerMessage")
+	proto.RegisterType((*MsgSetMaxBurnAmountPerMessageResponse)(nil), "circle.cctp.v1.MsgSetMaxBurnAmountPerMessageResponse")
+	proto.RegisterType((*MsgDepositForBurn)(nil), "circle.cctp.v1.MsgDepositForBurn")
+	proto.RegisterType((*MsgDepositForBurnResponse)(nil), "circle.cctp.v1.MsgDepositForBurnResponse")
+	proto.RegisterType((*MsgDepositForBurnWithCaller)(nil), "circle.cctp.v1.MsgDepositForBurnWithCaller")
+	proto.RegisterType((*MsgDepositForBurnWithCallerResponse)(nil), "circle.cctp.v1.MsgDepositForBurnWithCallerResponse")
+	proto.RegisterType((*MsgReplaceDepositForBurn)(nil), "circle.cctp.v1.MsgReplaceDepositForBurn")
+	proto.RegisterType((*MsgReplaceDepositForBurnResponse)(nil), "circle.cctp.v1.MsgReplaceDepositForBurnResponse")
+	proto.RegisterType((*MsgReceiveMessage)(nil), "circle.cctp.v1.MsgReceiveMessage")
+	proto.RegisterType((*MsgReceiveMessageResponse)(nil), "circle.cctp.v1.MsgReceiveMessageResponse")
+	proto.RegisterType((*MsgSendMessage)(nil), "circle.cctp.v1.MsgSendMessage")
+	proto.RegisterType((*MsgSendMessageResponse)(nil), "circle.cctp.v1.MsgSendMessageResponse")
+	proto.RegisterType((*MsgSendMessageWithCaller)(nil), "circle.cctp.v1.MsgSendMessageWithCaller")
 	proto.RegisterType((*MsgSendMessageWithCallerResponse)(nil), "circle.cctp.v1.MsgSendMessageWithCallerResponse")
 	proto.RegisterType((*MsgReplaceMessage)(nil), "circle.cctp.v1.MsgReplaceMessage")
 	proto.RegisterType((*MsgReplaceMessageResponse)(nil), "circle.cctp.v1.MsgReplaceMessageResponse")
@@ -2001,130 +2623,169 @@ func init() {
 	proto.RegisterType((*MsgAddRemoteTokenMessengerResponse)(nil), "circle.cctp.v1.MsgAddRemoteTokenMessengerResponse")
 	proto.RegisterType((*MsgRemoveRemoteTokenMessenger)(nil), "circle.cctp.v1.MsgRemoveRemoteTokenMessenger")
 	proto.RegisterType((*MsgRemoveRemoteTokenMessengerResponse)(nil), "circle.cctp.v1.MsgRemoveRemoteTokenMessengerResponse")
+	proto.RegisterType((*MsgSetForwarding)(nil), "circle.cctp.v1.MsgSetForwarding")
+	proto.RegisterType((*MsgSetForwardingResponse)(nil), "circle.cctp.v1.MsgSetForwardingResponse")
+	proto.RegisterType((*MsgRemoveForwarding)(nil), "circle.cctp.v1.MsgRemoveForwarding")
+	proto.RegisterType((*MsgRemoveForwardingResponse)(nil), "circle.cctp.v1.MsgRemoveForwardingResponse")
+	proto.RegisterType((*MsgRegisterAccount32)(nil), "circle.cctp.v1.MsgRegisterAccount32")
+	proto.RegisterType((*MsgRegisterAccount32Response)(nil), "circle.cctp.v1.MsgRegisterAccount32Response")
+	proto.RegisterType((*MsgEnableContractCallback)(nil), "circle.cctp.v1.MsgEnableContractCallback")
+	proto.RegisterType((*MsgEnableContractCallbackResponse)(nil), "circle.cctp.v1.MsgEnableContractCallbackResponse")
+	proto.RegisterType((*MsgDisableContractCallback)(nil), "circle.cctp.v1.MsgDisableContractCallback")
+	proto.RegisterType((*MsgDisableContractCallbackResponse)(nil), "circle.cctp.v1.MsgDisableContractCallbackResponse")
 }
 
 func init() { proto.RegisterFile("circle/cctp/v1/tx.proto", fileDescriptor_0b990d866e8d1445) }
 
 var fileDescriptor_0b990d866e8d1445 = []byte{
-	// 1883 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcf, 0x6f, 0xd4, 0xc6,
-	0x17, 0x8f, 0x43, 0x02, 0xc9, 0xcb, 0x0f, 0x12, 0x13, 0x92, 0xc5, 0x21, 0xbf, 0xcc, 0x37, 0x64,
-	0x49, 0xc8, 0x2e, 0x21, 0xdf, 0xa8, 0x55, 0x10, 0x87, 0x84, 0x14, 0x89, 0xaa, 0xab, 0xa2, 0x0d,
-	0xa8, 0x55, 0x2b, 0x75, 0xe5, 0xd8, 0x83, 0x63, 0xb1, 0x3b, 0x5e, 0x79, 0x9c, 0x5f, 0x88, 0x03,
-	0xe2, 0x84, 0xe8, 0x85, 0x03, 0x87, 0xb6, 0x52, 0x25, 0xaa, 0x5e, 0xaa, 0x9e, 0x38, 0xb4, 0xb7,
-	0x9e, 0x2b, 0x8e, 0xa8, 0x95, 0xda, 0xaa, 0xad, 0x50, 0x05, 0x07, 0xfa, 0x67, 0x54, 0x9e, 0xf1,
-	0x7a, 0x6d, 0xef, 0x8c, 0xbd, 0xbb, 0x85, 0x4a, 0x5c, 0xa2, 0x78, 0xe6, 0x33, 0x6f, 0xde, 0xe7,
-	0xcd, 0x7b, 0x6f, 0xde, 0xcc, 0x2c, 0x8c, 0xe9, 0x96, 0xa3, 0x97, 0x51, 0x5e, 0xd7, 0xdd, 0x6a,
-	0x7e, 0x77, 0x29, 0xef, 0xee, 0xe7, 0xaa, 0x8e, 0xed, 0xda, 0xf2, 0x20, 0xeb, 0xc8, 0x79, 0x1d,
-	0xb9, 0xdd, 0x25, 0x65, 0x58, 0xab, 0x58, 0xd8, 0xce, 0xd3, 0xbf, 0x0c, 0xa2, 0x8c, 0xe9, 0x36,
-	0xa9, 0xd8, 0x24, 0x5f, 0x21, 0xa6, 0x37, 0xb4, 0x42, 0x4c, 0xbf, 0xe3, 0x04, 0xeb, 0x28, 0xd1,
-	0xaf, 0x3c, 0xfb, 0xf0, 0xbb, 0x46, 0x4c, 0xdb, 0xb4, 0x59, 0xbb, 0xf7, 0x1f, 0x6b, 0x55, 0xbf,
-	0x94, 0x60, 0xb0, 0x40, 0xcc, 0xeb, 0x55, 0x43, 0x73, 0xd1, 0xfb, 0x7b, 0x18, 0x39, 0xf2, 0x59,
-	0xe8, 0xba, 0xe1, 0xd8, 0x95, 0x8c, 0x34, 0x2d, 0x65, 0x7b, 0xd7, 0x33, 0x3f, 0x7d, 0xb7, 0x38,
-	0xe2, 0x0b, 0x5a, 0x33, 0x0c, 0x07, 0x11, 0xb2, 0xe9, 0x3a, 0x16, 0x36, 0x8b, 0x14, 0x25, 0xaf,
-	0x40, 0x2f, 0x46, 0x7b, 0x25, 0xdb, 0x1b, 0x9a, 0xe9, 0x4c, 0x19, 0xd2, 0x83, 0xd1, 0x1e, 0x9d,
-	0x64, 0xf5, 0xf4, 0xbd, 0x47, 0x53, 0x1d, 0x7f, 0x3f, 0x9a, 0xea, 0xb8, 0xfb, 0xf2, 0xf1, 0x3c,
-	0x95, 0x74, 0xff, 0xe5, 0xe3, 0xf9, 0x21, 0x6a, 0x8c, 0x90, 0x32, 0x6a, 0x06, 0x46, 0xa3, 0xea,
-	0x15, 0x11, 0xa9, 0xda, 0x98, 0x20, 0xf5, 0x47, 0x09, 0x32, 0x41, 0xd7, 0x9a, 0xeb, 0x22, 0xe2,
-	0x22, 0xa7, 0xa0, 0x61, 0xcd, 0x6c, 0x99, 0xc3, 0xbb, 0x30, 0xe2, 0x71, 0xd0, 0x7c, 0x21, 0xa5,
-	0x0a, 0x93, 0x92, 0x4a, 0x47, 0xc6, 0x68, 0x2f, 0x36, 0xf3, 0xea, 0x39, 0x2e, 0x31, 0x25, 0x44,
-	0x2c, 0x36, 0x42, 0x55, 0x61, 0x5a, 0xc4, 0x83, 0x4f, 0xf6, 0x9a, 0x7d, 0x13, 0xe1, 0x4b, 0x36,
-	0x76, 0x1d, 0xbb, 0x5c, 0x6e, 0x97, 0xac, 0xeb, 0x09, 0x29, 0xe9, 0x81, 0x94, 0xa6, 0xc8, 0xc6,
-	0x66, 0x6e, 0x82, 0x6c, 0x6c, 0x44, 0x84, 0x6c, 0xac, 0x2f, 0x20, 0xfb, 0x95, 0x04, 0x47, 0x03,
-	0xd0, 0x55, 0x6d, 0x87, 0xb4, 0xcc, 0xf1, 0x2d, 0x00, 0x8f, 0x63, 0x95, 0x8e, 0x4d, 0x65, 0xe6,
-	0x39, 0x30, 0x9b, 0x66, 0x75, 0x8e, 0x4b, 0x68, 0x38, 0x44, 0x88, 0x01, 0xd5, 0x13, 0x30, 0x16,
-	0x53, 0x31, 0x50, 0xff, 0x06, 0x8d, 0xa8, 0x35, 0x5d, 0x47, 0x55, 0xb7, 0x8d, 0x88, 0x4a, 0x0c,
-	0x8d, 0x90, 0x54, 0x3f, 0x34, 0x42, 0x2d, 0x81, 0x06, 0xf7, 0x24, 0x18, 0x2e, 0x10, 0xf3, 0x1d,
-	0xac, 0x6d, 0x95, 0x03, 0x97, 0x6a, 0xd1, 0x84, 0x0a, 0xf4, 0xd4, 0xe2, 0x81, 0x19, 0xb0, 0x18,
-	0x7c, 0xaf, 0x9e, 0xe1, 0x6a, 0x78, 0x8c, 0x6a, 0x18, 0x9d, 0x54, 0x1d, 0x87, 0x13, 0x0d, 0x9a,
-	0x04, 0x7a, 0xde, 0x97, 0x40, 0x2e, 0x10, 0x73, 0xc3, 0x22, 0xaf, 0x49, 0xd1, 0x79, 0xae, 0xa2,
-	0x23, 0x54, 0xd1, 0xd8, 0xac, 0xea, 0x49, 0x50, 0x1a, 0x75, 0x09, 0x54, 0xbd, 0x4d, 0x79, 0xd0,
-	0x95, 0x5e, 0xdf, 0x71, 0xb0, 0x85, 0xcd, 0x35, 0x6c, 0x14, 0x2c, 0xec, 0x5a, 0xd8, 0x6c, 0x71,
-	0x7d, 0x97, 0xb8, 0x4a, 0x8d, 0x53, 0xa5, 0xf8, 0x13, 0xa8, 0xa7, 0x60, 0x46, 0x38, 0x7b, 0xa0,
-	0xe2, 0x1d, 0x09, 0xc6, 0x3d, 0x9f, 0xc4, 0xd5, 0x57, 0xa1, 0xe5, 0x32, 0x57, 0xcb, 0x09, 0x16,
-	0x09, 0x82, 0x29, 0xd4, 0x59, 0x38, 0x95, 0xa0, 0x41, 0xa0, 0xe9, 0x03, 0x89, 0xe2, 0x28, 0x9f,
-	0x4d, 0x84, 0x0d, 0x86, 0x2a, 0x22, 0x1d, 0x59, 0xbb, 0x16, 0x36, 0x0b, 0x88, 0x10, 0xcd, 0x44,
-	0xa4, 0x45, 0x8d, 0x2f, 0x70, 0x35, 0x9e, 0xad, 0xdb, 0x35, 0x61, 0x2a, 0x75, 0x11, 0x16, 0x9a,
-	0xd0, 0x28, 0x60, 0xf0, 0x50, 0x82, 0xd9, 0x3a, 0xd3, 0x57, 0xc7, 0xe1, 0x22, 0x97, 0xc3, 0x5c,
-	0xd8, 0xea, 0x49, 0x2c, 0xf2, 0xb0, 0xd8, 0x94, 0x56, 0x01, 0x8f, 0xaf, 0x7d, 0x9f, 0xa1, 0x79,
-	0xac, 0xa0, 0xed, 0xfb, 0x80, 0x75, 0xdb, 0x38, 0xd8, 0xb4, 0x6e, 0xa1, 0x16, 0x43, 0x71, 0x06,
-	0xfa, 0x2b, 0x4c, 0x40, 0x89, 0x58, 0xb7, 0x10, 0x0d, 0xc7, 0xae, 0x62, 0x9f, 0xdf, 0xe6, 0x09,
-	0x4c, 0x76, 0x2b, 0x81, 0x16, 0x35, 0xb7, 0x12, 0x74, 0x07, 0x64, 0xfe, 0x94, 0x60, 0xa2, 0x40,
-	0xcc, 0x4d, 0xe4, 0x16, 0xb4, 0x7d, 0xcf, 0xfb, 0xd6, 0x2a, 0xf6, 0x0e, 0x76, 0xaf, 0x22, 0xc7,
-	0x1f, 0xd1, 0x22, 0x9d, 0x29, 0xe8, 0x2b, 0xdb, 0xba, 0x56, 0x66, 0x7b, 0xa5, 0x9f, 0x5c, 0x80,
-	0x36, 0xd1, 0xad, 0x4b, 0x5e, 0x81, 0xc3, 0x1a, 0x9d, 0x22, 0x73, 0x88, 0x0a, 0x9c, 0x78, 0xf2,
-	0x6c, 0xaa, 0xe3, 0xf7, 0x67, 0x53, 0xc7, 0x99, 0x50, 0x62, 0xdc, 0xcc, 0x59, 0x76, 0xbe, 0xa2,
-	0xb9, 0xdb, 0xb9, 0x2b, 0xd8, 0x2d, 0xfa, 0xe0, 0xd5, 0x15, 0xae, 0x0d, 0xa6, 0xa8, 0x0d, 0xc4,
-	0xca, 0xab, 0x73, 0xd4, 0xe5, 0xc4, 0x80, 0xc0, 0x0e, 0x9f, 0x75, 0xd2, 0xf4, 0xbf, 0x81, 0xaa,
-	0x36, 0xb1, 0xdc, 0xcb, 0xb6, 0xe3, 0xa1, 0x5b, 0x2e, 0xeb, 0x6a, 0xd4, 0x3a, 0x5b, 0xa0, 0x26,
-	0x2f, 0x82, 0x6c, 0x20, 0xe2, 0x5a, 0x58, 0x73, 0x2d, 0x1b, 0x97, 0x0c, 0xbb, 0xa2, 0x59, 0x98,
-	0x5a, 0x67, 0xa0, 0x38, 0x1c, 0xea, 0xd9, 0xa0, 0x1d, 0xf2, 0x2c, 0x0c, 0x56, 0x2c, 0xec, 0x96,
-	0x1c, 0xa4, 0x5b, 0x55, 0x0b, 0x61, 0x37, 0xd3, 0x35, 0x2d, 0x65, 0xfb, 0x8b, 0x03, 0x5e, 0x6b,
-	0xb1, 0xd6, 0x28, 0x4f, 0x00, 0x6c, 0xed, 0x38, 0xd8, 0x5f, 0x87, 0x6e, 0xba, 0x0e, 0xbd, 0x5e,
-	0x0b, 0x5d, 0x86, 0xc4, 0xed, 0x28, 0x6a, 0x04, 0x75, 0x89, 0xa6, 0xf1, 0x68, 0x63, 0xcd, 0x6e,
-	0xf2, 0x08, 0x74, 0x63, 0x1b, 0xeb, 0x88, 0x9a, 0xa8, 0xab, 0xc8, 0x3e, 0xd4, 0x3f, 0x3a, 0x69,
-	0x88, 0x44, 0xc7, 0x7c, 0x60, 0xb9, 0xdb, 0x97, 0xb4, 0x36, 0xaa, 0xaf, 0x37, 0xc8, 0xae, 0xf1,
-	0x49, 0x75, 0xca, 0x37, 0x73, 0x98, 0x4a, 0x0a, 0x4f, 0xca, 0x0c, 0x91, 0x18, 0xda, 0x22, 0xeb,
-	0xa9, 0x17, 0x68, 0x68, 0x8b, 0xba, 0x53, 0x96, 0xe6, 0x87, 0x4e, 0x5a, 0x15, 0x17, 0x51, 0xb5,
-	0xac, 0xe9, 0xe8, 0x5f, 0xf9, 0xfb, 0x19, 0x18, 0xb2, 0x1d, 0xcb, 0xb4, 0xb0, 0x56, 0x2e, 0xf9,
-	0xf9, 0x8a, 0xae, 0x50, 0x7f, 0xf1, 0x68, 0xad, 0xbd, 0x96, 0x44, 0x96, 0x60, 0x24, 0x80, 0xb2,
-	0x4a, 0x83, 0x5a, 0x81, 0xae, 0x46, 0x7f, 0xf1, 0x58, 0xad, 0x6f, 0xad, 0xde, 0x25, 0xff, 0x1f,
-	0x46, 0xbd, 0x7a, 0x94, 0x63, 0x4d, 0xb6, 0x2e, 0x5e, 0x45, 0xbe, 0x11, 0x37, 0xa8, 0x7c, 0x16,
-	0xbc, 0x9a, 0xbb, 0x14, 0x5b, 0xc9, 0x6e, 0x3a, 0x62, 0x08, 0xa3, 0xbd, 0x42, 0x78, 0x31, 0x13,
-	0x6b, 0x71, 0xae, 0x85, 0xfc, 0x5a, 0x9c, 0xdb, 0x17, 0xe4, 0x92, 0x6f, 0x58, 0x29, 0xc9, 0x76,
-	0x10, 0xd4, 0x5e, 0x1e, 0xcd, 0xc0, 0x91, 0xa8, 0x49, 0x6b, 0x9f, 0xf2, 0x34, 0xf4, 0x35, 0x5a,
-	0x30, 0xdc, 0x94, 0x18, 0xdb, 0x51, 0xa5, 0xd4, 0x15, 0x1a, 0xdb, 0xd1, 0xc6, 0xc0, 0x81, 0x32,
-	0x70, 0x84, 0xec, 0xe8, 0x3a, 0x22, 0x84, 0x2a, 0xdd, 0x53, 0xac, 0x7d, 0xaa, 0xbf, 0xb0, 0x13,
-	0xb0, 0xb7, 0x5b, 0xb6, 0x47, 0x8f, 0x1f, 0x9b, 0x9d, 0xa2, 0xd8, 0x3c, 0x09, 0xbd, 0xf5, 0xc5,
-	0x64, 0x8c, 0xeb, 0x0d, 0xe1, 0x2d, 0x74, 0xcb, 0x36, 0x0e, 0x7c, 0xff, 0xa8, 0x6d, 0xa1, 0xde,
-	0x96, 0x97, 0x78, 0x3e, 0x08, 0xb1, 0x50, 0x73, 0xf4, 0x7c, 0x10, 0x6a, 0x49, 0x89, 0xa6, 0xcf,
-	0x59, 0x34, 0x85, 0x06, 0xb4, 0x9d, 0xe5, 0xfe, 0x63, 0x93, 0x08, 0x32, 0x55, 0xb7, 0x28, 0x53,
-	0x25, 0x85, 0x0a, 0x97, 0xbe, 0xfa, 0x36, 0x0d, 0x15, 0x6e, 0x5f, 0x8a, 0x55, 0xbf, 0xed, 0xf4,
-	0x03, 0x88, 0x46, 0x59, 0x7b, 0x1e, 0xf6, 0x7a, 0x93, 0x53, 0x16, 0x86, 0x68, 0x9a, 0x69, 0xb4,
-	0xf1, 0xa0, 0x97, 0x64, 0x42, 0x66, 0x16, 0xa7, 0xb1, 0x6e, 0x71, 0x1a, 0x4b, 0x09, 0xe1, 0xb0,
-	0x59, 0xfc, 0xd3, 0x62, 0xb4, 0xb1, 0x5e, 0xd6, 0x84, 0x6b, 0xd5, 0x4d, 0xcb, 0xc4, 0x9a, 0xbb,
-	0xe3, 0xa0, 0x6b, 0xdb, 0x0e, 0x22, 0xdb, 0x76, 0xd9, 0x68, 0xd1, 0xa6, 0xa3, 0x91, 0x8d, 0x78,
-	0x20, 0x28, 0xce, 0xd2, 0x0b, 0xd4, 0xc6, 0xa9, 0x23, 0x05, 0x6a, 0x63, 0x77, 0xc0, 0xe0, 0x67,
-	0x09, 0x86, 0x0a, 0xc4, 0x7c, 0xcf, 0xc2, 0x37, 0xe9, 0x0e, 0x7b, 0x55, 0xb3, 0x5a, 0x8d, 0xac,
-	0x53, 0x30, 0xe0, 0xa0, 0x8a, 0xed, 0xa2, 0x68, 0x50, 0xf5, 0xb3, 0x46, 0x3f, 0x9e, 0x66, 0xc0,
-	0xff, 0xf6, 0x77, 0x76, 0x3f, 0xaf, 0xb2, 0x36, 0xb6, 0xb7, 0xc7, 0x6a, 0xdb, 0xae, 0x78, 0x6d,
-	0xbb, 0x9a, 0xe5, 0xda, 0x41, 0xa6, 0x76, 0x88, 0x10, 0x50, 0x15, 0x9a, 0x36, 0x22, 0x6d, 0x01,
-	0xe3, 0x5f, 0xd9, 0x09, 0xff, 0x3a, 0x2e, 0xbf, 0x09, 0x9c, 0x93, 0xae, 0x0b, 0x62, 0x14, 0xfc,
-	0xeb, 0x82, 0x58, 0x6b, 0xc0, 0xfb, 0x7b, 0x89, 0x76, 0xaf, 0x19, 0x46, 0xb1, 0xae, 0x80, 0xe7,
-	0xd0, 0x08, 0xb7, 0x7e, 0x3d, 0x39, 0x0e, 0xbd, 0x8c, 0x78, 0xc9, 0x32, 0x7c, 0xee, 0x3d, 0xac,
-	0xe1, 0x8a, 0xe1, 0x6d, 0x6c, 0x1a, 0x1b, 0xe3, 0x53, 0xae, 0x7d, 0xae, 0x9e, 0xe7, 0xb2, 0x39,
-	0xc9, 0xee, 0x91, 0xf8, 0x8a, 0xa9, 0xff, 0x03, 0x55, 0xac, 0x76, 0xf8, 0x82, 0x6e, 0x82, 0xc6,
-	0x69, 0xc5, 0xde, 0x45, 0xaf, 0x99, 0x60, 0xe2, 0x69, 0x49, 0xac, 0x81, 0x7f, 0x5a, 0x12, 0x03,
-	0x6a, 0x64, 0xce, 0x7f, 0x3a, 0x0a, 0x87, 0x0a, 0xc4, 0x94, 0xaf, 0x43, 0x5f, 0xf8, 0xce, 0x6e,
-	0x32, 0x17, 0xbd, 0x86, 0xcf, 0x45, 0xef, 0xda, 0x94, 0xd3, 0xc9, 0xfd, 0xc1, 0xae, 0x70, 0x00,
-	0x63, 0x22, 0x2f, 0x98, 0xe7, 0x89, 0xe0, 0x63, 0x95, 0xf3, 0xcd, 0x63, 0x83, 0xa9, 0x3f, 0x81,
-	0xc1, 0x58, 0x4d, 0x3c, 0xc3, 0x91, 0x12, 0x85, 0x28, 0x67, 0x52, 0x21, 0x81, 0xfc, 0xdb, 0x90,
-	0x11, 0x9e, 0x8a, 0x16, 0x52, 0xc5, 0xd4, 0xc1, 0xca, 0x72, 0x0b, 0xe0, 0x60, 0x76, 0x0d, 0x8e,
-	0xc6, 0x2f, 0x0e, 0x55, 0x9e, 0x9c, 0x28, 0x46, 0x99, 0x4f, 0xc7, 0x84, 0x0d, 0x18, 0xbb, 0x43,
-	0xe5, 0x19, 0x30, 0x0a, 0xe1, 0x1a, 0x90, 0x7f, 0xff, 0x29, 0x7f, 0x0c, 0x03, 0xd1, 0xbd, 0x60,
-	0x9a, 0x33, 0x36, 0x82, 0x50, 0xb2, 0x69, 0x88, 0x40, 0xf8, 0x2e, 0x8c, 0x0a, 0xae, 0x2b, 0x79,
-	0x1a, 0xf2, 0xa1, 0xca, 0x52, 0xd3, 0xd0, 0x60, 0xde, 0x87, 0x12, 0x4c, 0xa7, 0xde, 0xec, 0x2d,
-	0x8b, 0xe4, 0x26, 0x0c, 0x52, 0x2e, 0xb4, 0x31, 0x28, 0xbc, 0x96, 0xb1, 0x43, 0x0c, 0x6f, 0x2d,
-	0xa3, 0x10, 0xee, 0x5a, 0x0a, 0x0e, 0x18, 0x77, 0x25, 0x50, 0x12, 0x12, 0xe2, 0x22, 0x57, 0x92,
-	0x08, 0xae, 0xac, 0xb4, 0x04, 0x0f, 0x94, 0x20, 0x70, 0x9c, 0x7f, 0x18, 0xce, 0x72, 0xe5, 0x71,
-	0x90, 0xca, 0xb9, 0x66, 0x91, 0x51, 0xcb, 0x46, 0xaa, 0xdb, 0x19, 0xb1, 0x8c, 0x64, 0xcb, 0xf2,
-	0xea, 0x3e, 0x2f, 0x31, 0x87, 0x0f, 0x67, 0xbc, 0xc4, 0x1c, 0xea, 0xe7, 0x26, 0x66, 0xde, 0x21,
-	0x88, 0xc0, 0x71, 0xfe, 0x51, 0x27, 0x9b, 0x2c, 0x20, 0x94, 0xb7, 0xce, 0x35, 0x8b, 0x0c, 0x27,
-	0xad, 0x78, 0x2d, 0xc4, 0x4b, 0x5a, 0x31, 0x0c, 0x37, 0x69, 0x09, 0x4a, 0x0f, 0x2f, 0x2b, 0x0b,
-	0x9f, 0x00, 0x16, 0xb8, 0x72, 0xf8, 0x60, 0x6e, 0x56, 0x4e, 0xbb, 0xda, 0x97, 0xbf, 0x90, 0x40,
-	0x6d, 0xe2, 0x56, 0x7c, 0x45, 0x2c, 0x3b, 0x29, 0x03, 0x5c, 0x6c, 0x6b, 0x58, 0xd8, 0x93, 0xc2,
-	0x0f, 0xdd, 0x3c, 0x4f, 0x0a, 0xf5, 0x73, 0x3d, 0x89, 0xf3, 0x12, 0xed, 0x79, 0x12, 0xff, 0x15,
-	0x3a, 0x2b, 0x14, 0x10, 0x43, 0x72, 0x3d, 0x29, 0xf1, 0x45, 0xb8, 0x3e, 0x69, 0xfc, 0x35, 0x58,
-	0x3c, 0x69, 0x0c, 0x99, 0x30, 0xa9, 0xe0, 0x65, 0x56, 0xfe, 0x10, 0xfa, 0x23, 0xaf, 0xb2, 0x53,
-	0x42, 0x09, 0x0c, 0xa0, 0xcc, 0xa5, 0x00, 0x22, 0x5e, 0x2b, 0x7a, 0x84, 0x58, 0x10, 0x0a, 0x69,
-	0x04, 0xf3, 0xbd, 0x36, 0xe5, 0xe5, 0x80, 0x26, 0xef, 0x84, 0x67, 0x83, 0x45, 0x6e, 0x9c, 0x8b,
-	0xe0, 0xdc, 0xe4, 0x9d, 0x7e, 0x6d, 0x5f, 0x37, 0x01, 0xe7, 0x6c, 0x2b, 0x36, 0x41, 0x23, 0x38,
-	0xc1, 0x04, 0xe2, 0xb3, 0xa9, 0xd2, 0x7d, 0xe7, 0xe5, 0xe3, 0x79, 0x69, 0xfd, 0xf2, 0x93, 0xe7,
-	0x93, 0xd2, 0xd3, 0xe7, 0x93, 0xd2, 0x5f, 0xcf, 0x27, 0xa5, 0x07, 0x2f, 0x26, 0x3b, 0x9e, 0xbe,
-	0x98, 0xec, 0xf8, 0xed, 0xc5, 0x64, 0xc7, 0x47, 0x67, 0x4d, 0xcb, 0xdd, 0xde, 0xd9, 0xca, 0xe9,
-	0x76, 0x25, 0xcf, 0xe4, 0xdf, 0xb0, 0x70, 0x1e, 0xdb, 0x5b, 0x65, 0xb4, 0x48, 0x8b, 0xf1, 0x7d,
-	0xf6, 0x53, 0x16, 0xf7, 0xa0, 0x8a, 0xc8, 0xd6, 0x61, 0xfa, 0xf3, 0x92, 0xe5, 0x7f, 0x02, 0x00,
-	0x00, 0xff, 0xff, 0xa4, 0xc2, 0x10, 0xea, 0xe6, 0x22, 0x00, 0x00,
+	// 2340 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcb, 0x6f, 0x1c, 0x49,
+	0x19, 0x77, 0x3b, 0xb6, 0x63, 0x7f, 0x7e, 0x4f, 0x1c, 0x7b, 0xd2, 0x7e, 0xb7, 0x37, 0xc9, 0xf8,
+	0x39, 0x7e, 0xac, 0x05, 0x72, 0xb4, 0x87, 0xf1, 0x86, 0x15, 0x0b, 0x8c, 0x88, 0xc6, 0x89, 0x40,
+	0x20, 0x31, 0xb4, 0xbb, 0x2b, 0xed, 0x96, 0x67, 0xaa, 0x47, 0xdd, 0xed, 0x57, 0xb4, 0x42, 0xab,
+	0x3d, 0x21, 0x4e, 0x2b, 0xb4, 0x07, 0xe0, 0x14, 0xe0, 0x1f, 0xc8, 0x01, 0xfe, 0x87, 0xe5, 0x80,
+	0xb4, 0x02, 0x09, 0x56, 0x80, 0x22, 0x94, 0x1c, 0xc2, 0x09, 0x21, 0xfe, 0x02, 0xd4, 0x55, 0x3d,
+	0x35, 0xd5, 0x3d, 0x55, 0x3d, 0xdd, 0x93, 0x04, 0x69, 0x2f, 0x96, 0xbb, 0xbe, 0x5f, 0x7d, 0x8f,
+	0x5f, 0x55, 0x7d, 0xf5, 0x55, 0xd5, 0xc0, 0x8c, 0x61, 0xbb, 0x46, 0x0d, 0x15, 0x0d, 0xc3, 0x6f,
+	0x14, 0xcf, 0x77, 0x8a, 0xfe, 0xe5, 0x56, 0xc3, 0x75, 0x7c, 0x27, 0x37, 0x46, 0x05, 0x5b, 0x81,
+	0x60, 0xeb, 0x7c, 0x47, 0x9d, 0xd4, 0xeb, 0x36, 0x76, 0x8a, 0xe4, 0x2f, 0x85, 0xa8, 0xf3, 0xb1,
+	0xbe, 0xba, 0xef, 0x23, 0xcf, 0x47, 0x6e, 0x28, 0x9e, 0x31, 0x1c, 0xaf, 0xee, 0x78, 0xc5, 0xba,
+	0x67, 0x05, 0xd2, 0xba, 0x67, 0x85, 0x82, 0x5b, 0x54, 0x50, 0x25, 0x5f, 0x45, 0xfa, 0x11, 0x8a,
+	0xa6, 0x2c, 0xc7, 0x72, 0x68, 0x7b, 0xf0, 0x1f, 0x6d, 0xd5, 0xfe, 0xa0, 0xc0, 0x58, 0xd9, 0xb3,
+	0x1e, 0x35, 0x4c, 0xdd, 0x47, 0xdf, 0xbd, 0xc0, 0xc8, 0xcd, 0x6d, 0x40, 0xdf, 0x63, 0xd7, 0xa9,
+	0xe7, 0x95, 0x25, 0xa5, 0x30, 0x74, 0x98, 0xff, 0xd3, 0xef, 0x36, 0xa7, 0x42, 0x45, 0x25, 0xd3,
+	0x74, 0x91, 0xe7, 0x1d, 0xf9, 0xae, 0x8d, 0xad, 0x0a, 0x41, 0xe5, 0xf6, 0x61, 0x08, 0xa3, 0x8b,
+	0xaa, 0x13, 0x74, 0xcd, 0xf7, 0x76, 0xe8, 0x32, 0x88, 0xd1, 0x05, 0x35, 0xb2, 0x06, 0x93, 0xe8,
+	0xb2, 0x61, 0xbb, 0xc8, 0xab, 0xea, 0x7e, 0xf5, 0x04, 0xd9, 0xd6, 0x89, 0x9f, 0xbf, 0xb6, 0xa4,
+	0x14, 0xae, 0x55, 0xc6, 0x43, 0x41, 0xc9, 0xff, 0x26, 0x69, 0x3e, 0xb8, 0xf3, 0xd3, 0xa7, 0x8b,
+	0x3d, 0xff, 0x7a, 0xba, 0xd8, 0xf3, 0xc9, 0xab, 0x67, 0x6b, 0xc4, 0xea, 0xcf, 0x5e, 0x3d, 0x5b,
+	0x9b, 0x20, 0xdc, 0x70, 0x8e, 0x6b, 0x79, 0x98, 0x8e, 0x86, 0x52, 0x41, 0x5e, 0xc3, 0xc1, 0x1e,
+	0xd2, 0xfe, 0xab, 0x40, 0x9e, 0x89, 0x4a, 0x21, 0x97, 0x65, 0x1d, 0xeb, 0x56, 0xe6, 0x78, 0xbf,
+	0x05, 0x53, 0x41, 0xbc, 0xcd, 0x01, 0xa9, 0xd6, 0xa9, 0x96, 0x8e, 0xa1, 0xe7, 0x30, 0xba, 0x88,
+	0x5b, 0xce, 0x42, 0xc2, 0xb6, 0x90, 0x04, 0x95, 0x23, 0x21, 0xa6, 0x5d, 0xd3, 0x60, 0x49, 0x16,
+	0xb3, 0x98, 0x98, 0x87, 0xce, 0x29, 0xc2, 0xef, 0x3b, 0xd8, 0x77, 0x9d, 0x5a, 0xad, 0x5b, 0x62,
+	0xfc, 0x40, 0x49, 0xd5, 0x60, 0x5a, 0x52, 0x11, 0x13, 0xb7, 0xfc, 0x66, 0x89, 0x89, 0x69, 0x8f,
+	0x10, 0x13, 0x93, 0x31, 0x62, 0xfe, 0xa8, 0xc0, 0x38, 0x03, 0x3d, 0xd0, 0xcf, 0xbc, 0xcc, 0x7c,
+	0x7c, 0x0d, 0x20, 0xe0, 0xa3, 0x41, 0xfa, 0x76, 0x64, 0x21, 0x58, 0x44, 0xa1, 0x99, 0x2c, 0xc1,
+	0xdf, 0x15, 0x06, 0x3f, 0xc9, 0x05, 0x4f, 0x95, 0x6a, 0xb7, 0x60, 0x26, 0x16, 0x0e, 0x0b, 0xf5,
+	0x31, 0xc9, 0x00, 0x25, 0xc3, 0x40, 0x0d, 0xbf, 0x8b, 0x0c, 0x90, 0xb8, 0x3c, 0x39, 0xad, 0xe1,
+	0xf2, 0xe4, 0x5a, 0x98, 0x07, 0x4f, 0x20, 0xcf, 0x24, 0xaf, 0xb5, 0x3a, 0x13, 0x27, 0x83, 0x50,
+	0x7f, 0x38, 0x19, 0x84, 0x32, 0xe6, 0xdf, 0x09, 0x8c, 0x33, 0x4c, 0x37, 0x73, 0x21, 0x71, 0x98,
+	0x78, 0xb5, 0xe1, 0x30, 0xf1, 0x4d, 0x42, 0x92, 0x5e, 0x6b, 0xa5, 0xa6, 0x20, 0x49, 0xbc, 0x62,
+	0x84, 0x32, 0xe6, 0xdf, 0x97, 0x0a, 0x4c, 0x96, 0x3d, 0xeb, 0x1b, 0x58, 0x3f, 0xae, 0xb1, 0x7c,
+	0x93, 0x71, 0xcd, 0xa8, 0x30, 0xd8, 0x4c, 0xac, 0x74, 0xc5, 0x54, 0xd8, 0x77, 0x6e, 0x17, 0x06,
+	0x4f, 0xd1, 0x55, 0xd5, 0xbf, 0x6a, 0x20, 0xb2, 0x1a, 0xc6, 0x76, 0x67, 0xb6, 0xa2, 0x1b, 0xe9,
+	0xd6, 0xb7, 0xd1, 0xd5, 0xc3, 0xab, 0x06, 0xaa, 0x5c, 0x3f, 0xa5, 0xff, 0x04, 0xfa, 0x9c, 0x06,
+	0x72, 0x75, 0xdf, 0x71, 0xf3, 0x7d, 0x54, 0x5f, 0xf3, 0xfb, 0x60, 0x55, 0xc8, 0xc2, 0x0d, 0xc2,
+	0x42, 0x34, 0x08, 0x6d, 0x16, 0x6e, 0xb5, 0x45, 0xc6, 0xe2, 0xfe, 0x8d, 0x02, 0xb9, 0xb2, 0x67,
+	0xdd, 0xb7, 0xbd, 0xb7, 0x14, 0xf8, 0x34, 0x0c, 0xb8, 0x48, 0xf7, 0x1c, 0x4c, 0xc2, 0x1e, 0xaa,
+	0x84, 0x5f, 0x07, 0x6b, 0xc2, 0x00, 0xa6, 0x48, 0x00, 0x31, 0x6f, 0xb4, 0x39, 0x50, 0xdb, 0x7d,
+	0x64, 0x21, 0x7c, 0x44, 0xe2, 0x23, 0xf3, 0xed, 0xf0, 0xcc, 0xc5, 0x36, 0xb6, 0x4a, 0xd8, 0x2c,
+	0xdb, 0xd8, 0xb7, 0xb1, 0x95, 0x71, 0x6e, 0xed, 0x08, 0x9d, 0x9a, 0x25, 0x4e, 0x89, 0x0d, 0x68,
+	0x2b, 0xb0, 0x2c, 0xb5, 0xce, 0x5c, 0xfc, 0x58, 0x81, 0xd9, 0x20, 0x81, 0xe1, 0xc6, 0x9b, 0xf0,
+	0x72, 0x4f, 0xe8, 0xe5, 0x3c, 0x4d, 0x9b, 0x12, 0x13, 0xda, 0x6d, 0x58, 0x49, 0xf0, 0x80, 0x79,
+	0xfa, 0xa9, 0x02, 0x2b, 0xcd, 0x78, 0x8e, 0x10, 0x36, 0x29, 0xaa, 0x82, 0x0c, 0x64, 0x9f, 0xdb,
+	0xd8, 0x2a, 0x23, 0xcf, 0xd3, 0x2d, 0xe4, 0x65, 0xf4, 0xf8, 0x9e, 0xd0, 0xe3, 0xdb, 0x2d, 0x5e,
+	0x13, 0x4c, 0x69, 0x9b, 0xb0, 0x9e, 0xc2, 0x23, 0x16, 0xc1, 0x67, 0x0a, 0xdc, 0x6e, 0x45, 0xfa,
+	0xe6, 0x62, 0x78, 0x4f, 0x18, 0xc3, 0x5d, 0x9e, 0xf5, 0xa4, 0x28, 0x8a, 0xb0, 0x99, 0xca, 0x2b,
+	0x16, 0xc7, 0x6f, 0xc3, 0x39, 0x43, 0x36, 0xbd, 0xb2, 0x7e, 0x19, 0x02, 0x0e, 0x1d, 0xf3, 0xea,
+	0xc8, 0x7e, 0x82, 0x32, 0x2e, 0xd1, 0x65, 0x18, 0xa9, 0x53, 0x05, 0x55, 0xcf, 0x7e, 0x82, 0xc8,
+	0x32, 0xed, 0xab, 0x0c, 0x87, 0x6d, 0x81, 0xc2, 0xe4, 0x69, 0x25, 0xf1, 0xa2, 0x39, 0xad, 0x24,
+	0x62, 0x16, 0xcc, 0x3f, 0x14, 0x98, 0x2f, 0x7b, 0xd6, 0x11, 0xf2, 0xcb, 0xfa, 0x65, 0x30, 0xfb,
+	0x4a, 0x75, 0xe7, 0x0c, 0xfb, 0x0f, 0x90, 0x1b, 0xf6, 0xc8, 0x18, 0xce, 0x22, 0x0c, 0xd7, 0x1c,
+	0x43, 0xaf, 0xd1, 0x82, 0x2d, 0x4c, 0x3a, 0x40, 0x9a, 0x48, 0x86, 0xcf, 0xed, 0xc3, 0x80, 0x4e,
+	0x4c, 0xd0, 0xb4, 0x73, 0x38, 0xff, 0xf9, 0xf3, 0xc5, 0x9e, 0xbf, 0x3d, 0x5f, 0xbc, 0x49, 0x95,
+	0x7a, 0xe6, 0xe9, 0x96, 0xed, 0x14, 0xeb, 0xba, 0x7f, 0xb2, 0xf5, 0x21, 0xf6, 0x2b, 0x21, 0xf8,
+	0x60, 0x5f, 0xc8, 0xc1, 0x22, 0xe1, 0x40, 0xee, 0xbc, 0x76, 0x97, 0x4c, 0x39, 0x39, 0x80, 0xf1,
+	0xf0, 0x8b, 0x5e, 0xb2, 0xcd, 0xdc, 0x47, 0x0d, 0xc7, 0xb3, 0xfd, 0x0f, 0x1c, 0x37, 0x40, 0x67,
+	0x3e, 0xb3, 0x34, 0x43, 0xeb, 0xcd, 0x10, 0x5a, 0x6e, 0x13, 0x72, 0x26, 0xf2, 0x7c, 0x1b, 0xeb,
+	0xbe, 0xed, 0xe0, 0xaa, 0xe9, 0xd4, 0x75, 0x9b, 0x26, 0xe5, 0xd1, 0xca, 0x24, 0x27, 0xb9, 0x4f,
+	0x04, 0xb9, 0xdb, 0x30, 0x56, 0xb7, 0xb1, 0x5f, 0x75, 0x91, 0x61, 0x37, 0x6c, 0x84, 0x7d, 0xb2,
+	0x05, 0x8d, 0x54, 0x46, 0x83, 0xd6, 0x4a, 0xb3, 0x31, 0x37, 0x0f, 0x70, 0x7c, 0xe6, 0xe2, 0x70,
+	0x1c, 0xfa, 0xc9, 0x38, 0x0c, 0x05, 0x2d, 0x64, 0x18, 0x12, 0xb7, 0xa9, 0x28, 0x09, 0xda, 0x0e,
+	0x49, 0xe3, 0xd1, 0xc6, 0x26, 0x6f, 0xb9, 0x29, 0xe8, 0xc7, 0x0e, 0x36, 0x10, 0xa1, 0xa8, 0xaf,
+	0x42, 0x3f, 0xb4, 0xbf, 0xf7, 0xc2, 0x6c, 0x5b, 0x9f, 0xef, 0xd9, 0xfe, 0xc9, 0xfb, 0x7a, 0x17,
+	0x47, 0x80, 0xaf, 0x10, 0xaf, 0x71, 0xa3, 0x06, 0x89, 0x37, 0x3f, 0x40, 0x34, 0xf1, 0x46, 0x29,
+	0x11, 0x89, 0x4b, 0x5b, 0xc6, 0x9e, 0x76, 0x8f, 0x2c, 0x6d, 0x99, 0xb8, 0xc3, 0xd0, 0xfc, 0xa7,
+	0x97, 0x14, 0x7c, 0x15, 0xd4, 0xa8, 0xe9, 0x06, 0x7a, 0xad, 0xf9, 0xbe, 0x0a, 0x13, 0x8e, 0x6b,
+	0x5b, 0x36, 0xd6, 0x6b, 0xd5, 0x30, 0x5f, 0x91, 0x11, 0x1a, 0xa9, 0x8c, 0x37, 0xdb, 0x9b, 0x49,
+	0x64, 0x07, 0xa6, 0x18, 0x94, 0x56, 0x20, 0x84, 0x05, 0x32, 0x1a, 0x23, 0x95, 0x1b, 0x4d, 0x59,
+	0xa9, 0x25, 0xca, 0xbd, 0x0b, 0xd3, 0xc1, 0x41, 0x47, 0xc0, 0x26, 0x1d, 0x97, 0xe0, 0x58, 0x78,
+	0x3f, 0x4e, 0x68, 0x6e, 0x03, 0x82, 0x83, 0x5f, 0x35, 0x36, 0x92, 0xfd, 0xa4, 0xc7, 0x04, 0x46,
+	0x17, 0xe5, 0xc8, 0x60, 0xf2, 0xc5, 0xdf, 0x40, 0xba, 0xe2, 0x2f, 0xb1, 0xcc, 0x15, 0xb2, 0x1a,
+	0x96, 0xb9, 0x42, 0x19, 0xcb, 0x3f, 0x3f, 0xa7, 0xf9, 0x87, 0xee, 0x3a, 0xa8, 0xbb, 0xdc, 0x9b,
+	0x87, 0xeb, 0xd1, 0x61, 0x68, 0x7e, 0xe6, 0x96, 0x60, 0xb8, 0x9d, 0x75, 0xbe, 0x29, 0xc2, 0x44,
+	0x5f, 0xca, 0x32, 0xf8, 0x5d, 0x98, 0xd6, 0x6b, 0x35, 0xe7, 0xa2, 0xaa, 0xd7, 0x5c, 0xa4, 0x9b,
+	0x57, 0x55, 0x97, 0x7a, 0x6f, 0x12, 0xbe, 0x07, 0x2b, 0x53, 0x44, 0x5a, 0xa2, 0xc2, 0x30, 0x32,
+	0x33, 0x31, 0xf3, 0x44, 0xc3, 0xd7, 0x7e, 0x4c, 0x32, 0x4f, 0xb4, 0x91, 0x4d, 0xef, 0x3c, 0x5c,
+	0xf7, 0xce, 0x0c, 0x03, 0x79, 0x1e, 0xa1, 0x67, 0xb0, 0xd2, 0xfc, 0x0c, 0xe6, 0x65, 0x9b, 0x47,
+	0xbd, 0x04, 0x32, 0xae, 0x47, 0x9d, 0xd1, 0xfe, 0x42, 0xef, 0xa9, 0x82, 0x6d, 0xbf, 0x3b, 0xce,
+	0xc5, 0x49, 0xa6, 0x57, 0x96, 0x64, 0xe6, 0x60, 0xa8, 0x35, 0x2b, 0xe9, 0x30, 0xb4, 0x1a, 0xf8,
+	0x5a, 0xe0, 0xd8, 0x31, 0xaf, 0xc2, 0x89, 0x3e, 0x5c, 0x6f, 0xed, 0xdd, 0x89, 0xa7, 0x62, 0x2e,
+	0x0a, 0x6d, 0x8b, 0x9c, 0x8a, 0xb9, 0x96, 0x0e, 0x69, 0xe1, 0x97, 0x34, 0x2d, 0x70, 0x1d, 0xba,
+	0x4e, 0xd7, 0xff, 0x67, 0x4a, 0x24, 0x29, 0xb7, 0x5f, 0x96, 0x72, 0x93, 0xd6, 0xaf, 0x30, 0x7c,
+	0xed, 0xeb, 0x64, 0xfd, 0x0a, 0x65, 0x1d, 0x58, 0x7d, 0xde, 0x5c, 0xd5, 0x64, 0xe9, 0x77, 0x37,
+	0xc3, 0xde, 0x6e, 0x96, 0x2d, 0xc0, 0x04, 0xc9, 0x97, 0xed, 0x1c, 0x8f, 0x05, 0xd9, 0x92, 0xa3,
+	0x59, 0x9e, 0x8f, 0xfb, 0x13, 0xf2, 0x71, 0x37, 0x19, 0x36, 0x39, 0x43, 0xf0, 0x54, 0x86, 0x47,
+	0xe8, 0x68, 0x63, 0xab, 0xa6, 0xe3, 0x0b, 0xf5, 0x23, 0xdb, 0xc2, 0xba, 0x7f, 0xe6, 0xa2, 0x87,
+	0x27, 0x2e, 0xf2, 0x4e, 0x9c, 0x9a, 0x99, 0x71, 0x1c, 0xa6, 0x23, 0x55, 0xc8, 0x28, 0xab, 0x4c,
+	0x3b, 0x57, 0xe7, 0xed, 0xa6, 0x23, 0xd5, 0x79, 0xbb, 0x98, 0x45, 0xf0, 0x67, 0x05, 0x26, 0xca,
+	0x9e, 0xf5, 0x1d, 0x1b, 0x9f, 0x92, 0xf2, 0xe2, 0x81, 0x6e, 0x67, 0x5d, 0x8d, 0x2b, 0x30, 0xea,
+	0xa2, 0xba, 0xe3, 0xa3, 0xe8, 0x42, 0x1c, 0xa1, 0x8d, 0xe1, 0x1a, 0x5c, 0x86, 0xf0, 0x3b, 0x2c,
+	0x6b, 0xc2, 0x0d, 0x82, 0xb6, 0xd1, 0xc2, 0x26, 0x56, 0xd8, 0xf7, 0xc5, 0x0b, 0xfb, 0x83, 0x82,
+	0x90, 0x87, 0x1c, 0xe1, 0x21, 0x12, 0x80, 0xa6, 0x92, 0x54, 0x13, 0x69, 0x63, 0x11, 0xff, 0x95,
+	0x5e, 0x7b, 0x3c, 0xc2, 0xb5, 0xaf, 0x42, 0xcc, 0x49, 0x77, 0x25, 0xb1, 0x10, 0xc2, 0xbb, 0x92,
+	0x58, 0x2b, 0x8b, 0xfb, 0xf7, 0x0a, 0x11, 0x97, 0x4c, 0xb3, 0xd2, 0x72, 0x20, 0x98, 0xd0, 0x08,
+	0x67, 0x7f, 0x4c, 0x98, 0x85, 0x21, 0x1a, 0x78, 0xd5, 0x36, 0xc3, 0xd8, 0x07, 0x69, 0xc3, 0x87,
+	0x66, 0xb0, 0x6f, 0xea, 0xb4, 0x4f, 0x18, 0x72, 0xf3, 0xf3, 0x60, 0x57, 0x18, 0xcd, 0x1c, 0xbd,
+	0xc0, 0x13, 0x3b, 0xa6, 0xbd, 0x03, 0x9a, 0xdc, 0x6d, 0x16, 0xdd, 0xaf, 0xe9, 0x29, 0x33, 0xc0,
+	0x9c, 0xa3, 0xb7, 0x1c, 0x60, 0xe2, 0x51, 0x51, 0xee, 0x41, 0x78, 0x54, 0x94, 0x03, 0x58, 0x30,
+	0x4f, 0x7b, 0xc9, 0xa2, 0x3c, 0x42, 0x41, 0x11, 0x77, 0xa1, 0xbb, 0x66, 0xe6, 0x8b, 0xa2, 0xac,
+	0x5b, 0x64, 0xfb, 0xd1, 0xe4, 0x9a, 0xe8, 0x68, 0x22, 0xde, 0x08, 0xfb, 0x24, 0x1b, 0x61, 0xae,
+	0x08, 0xd7, 0x1e, 0x23, 0x94, 0xef, 0x4f, 0x73, 0xa6, 0x0a, 0x90, 0x89, 0x2b, 0x3c, 0xc2, 0x46,
+	0xb8, 0xc2, 0x23, 0x6d, 0x8c, 0xbe, 0x06, 0xdc, 0x60, 0x3c, 0x77, 0x4b, 0xe0, 0xc1, 0xba, 0xd0,
+	0x95, 0x9b, 0xdc, 0x18, 0x73, 0xde, 0xcc, 0x93, 0x6d, 0x20, 0xde, 0xcc, 0x1c, 0x72, 0x61, 0x8a,
+	0x88, 0x2d, 0xdb, 0xf3, 0x91, 0x5b, 0x32, 0x8c, 0x20, 0xad, 0xef, 0xed, 0x66, 0xf4, 0x68, 0x43,
+	0xe8, 0xd1, 0x74, 0xe8, 0x51, 0x4c, 0xb7, 0xb6, 0x00, 0x73, 0x22, 0x9b, 0xb1, 0xab, 0x53, 0x7a,
+	0x35, 0x4c, 0x2e, 0xc5, 0x75, 0xc3, 0x0f, 0x86, 0xed, 0x58, 0x37, 0x4e, 0xdf, 0xe0, 0xd5, 0xa9,
+	0xd8, 0x40, 0x78, 0x75, 0x2a, 0x16, 0x32, 0x17, 0x7f, 0xc2, 0xdf, 0xfd, 0xbe, 0xa6, 0x8f, 0x49,
+	0x99, 0x47, 0x62, 0x21, 0xcc, 0x3c, 0x12, 0x69, 0xd3, 0xcb, 0xdd, 0x7f, 0xcf, 0xc1, 0xb5, 0xb2,
+	0x67, 0xe5, 0x1e, 0xc1, 0x30, 0xff, 0x14, 0xb5, 0x10, 0x2f, 0x42, 0xa2, 0x4f, 0x48, 0xea, 0x9d,
+	0x64, 0x39, 0x2b, 0xfb, 0x3c, 0xb8, 0x29, 0x7e, 0x5f, 0x2a, 0x48, 0x15, 0xc4, 0x90, 0xea, 0x76,
+	0x5a, 0x24, 0x33, 0xfa, 0x7d, 0x18, 0x89, 0x3c, 0x1a, 0x2d, 0x4a, 0x35, 0x50, 0x80, 0x7a, 0xb7,
+	0x03, 0xa0, 0x3d, 0x9c, 0xf8, 0x4b, 0x90, 0x3c, 0x9c, 0x18, 0x52, 0xdd, 0x4e, 0x8b, 0x64, 0x46,
+	0xaf, 0x60, 0x46, 0xb6, 0xed, 0xad, 0x89, 0x94, 0x89, 0xb1, 0xea, 0x6e, 0x7a, 0x2c, 0x33, 0xfd,
+	0x23, 0x18, 0x8b, 0xdd, 0x80, 0x2c, 0x0b, 0xb4, 0x44, 0x21, 0xea, 0x6a, 0x47, 0x08, 0xd3, 0xff,
+	0x11, 0xe4, 0xa5, 0x77, 0x60, 0xeb, 0x1d, 0xd5, 0xb4, 0xc0, 0xea, 0x5e, 0x06, 0x30, 0xb3, 0xae,
+	0xc3, 0x78, 0xfc, 0xf9, 0x48, 0x13, 0xe9, 0x89, 0x62, 0xd4, 0xb5, 0xce, 0x18, 0x9e, 0xc0, 0xd8,
+	0xcb, 0x9c, 0x88, 0xc0, 0x28, 0x44, 0x5d, 0xed, 0x08, 0x61, 0xfa, 0x7f, 0x08, 0xa3, 0xd1, 0xe2,
+	0x77, 0x49, 0xd0, 0x37, 0x82, 0x50, 0x0b, 0x9d, 0x10, 0x4c, 0xf9, 0x39, 0x4c, 0x4b, 0x1e, 0xa7,
+	0x44, 0x1e, 0x8a, 0xa1, 0xea, 0x4e, 0x6a, 0x28, 0xb3, 0xfb, 0x99, 0x02, 0x4b, 0x1d, 0xdf, 0x71,
+	0xf6, 0x64, 0x7a, 0x13, 0x3a, 0xa9, 0xf7, 0xba, 0xe8, 0xc4, 0x8f, 0x65, 0xec, 0xfa, 0x49, 0x34,
+	0x96, 0x51, 0x88, 0xba, 0xda, 0x11, 0xc2, 0xf4, 0x7f, 0xa2, 0x80, 0x9a, 0x50, 0x01, 0x6e, 0x0a,
+	0x35, 0xc9, 0xe0, 0xea, 0x7e, 0x26, 0x38, 0x9f, 0xe1, 0xc4, 0x57, 0x9f, 0x05, 0xa1, 0x3e, 0x01,
+	0x52, 0xdd, 0x4e, 0x8b, 0x8c, 0x32, 0x1b, 0xb9, 0x02, 0x58, 0x96, 0xeb, 0x48, 0x66, 0x56, 0x74,
+	0xd0, 0x0d, 0x36, 0x37, 0xfe, 0x06, 0x4b, 0xb4, 0xb9, 0x71, 0x72, 0xf5, 0x4e, 0xb2, 0x9c, 0xe7,
+	0x4a, 0x7c, 0x1f, 0x54, 0x48, 0x56, 0xc0, 0xe5, 0xad, 0xed, 0xb4, 0x48, 0x3e, 0x69, 0xc5, 0x0f,
+	0x7f, 0xa2, 0xa4, 0x15, 0xc3, 0xa8, 0x6b, 0x9d, 0x31, 0x7c, 0x56, 0x96, 0x3e, 0xf8, 0xae, 0x0b,
+	0xf5, 0x88, 0xc1, 0xea, 0x5e, 0x06, 0x30, 0xb3, 0xfe, 0x2b, 0x05, 0xb4, 0x14, 0x6f, 0xa0, 0xfb,
+	0x72, 0xdd, 0x49, 0x19, 0xe0, 0xbd, 0xae, 0xba, 0xf1, 0x33, 0x89, 0xff, 0xcd, 0x9e, 0x68, 0x26,
+	0x71, 0x72, 0xf5, 0x4e, 0xb2, 0x9c, 0x9f, 0x49, 0xe2, 0x1f, 0xc9, 0x15, 0xa4, 0x0a, 0xd2, 0x94,
+	0x49, 0x89, 0x3f, 0x42, 0x6b, 0x19, 0x4d, 0x53, 0xcc, 0x08, 0x91, 0xea, 0x76, 0x5a, 0x24, 0x5f,
+	0x9b, 0x45, 0x7e, 0xdc, 0xb5, 0x28, 0xd5, 0x90, 0x50, 0x9b, 0x89, 0x7e, 0x4f, 0x45, 0x66, 0xad,
+	0xec, 0xc9, 0x79, 0x5d, 0xaa, 0xa4, 0x1d, 0xac, 0xee, 0x65, 0x00, 0x47, 0x92, 0x77, 0xc2, 0x23,
+	0xf1, 0xa6, 0x70, 0x9d, 0xcb, 0xe0, 0xea, 0x7e, 0x26, 0x78, 0x3b, 0x05, 0x82, 0xcb, 0x3c, 0x39,
+	0x05, 0xed, 0x60, 0x75, 0x2f, 0x03, 0x98, 0xaf, 0x45, 0xa2, 0x67, 0xfe, 0x25, 0x71, 0x14, 0x2d,
+	0x84, 0x5a, 0xe8, 0x84, 0x60, 0xca, 0x4d, 0x98, 0x68, 0x3b, 0x12, 0xaf, 0x48, 0xb7, 0x38, 0xce,
+	0xc4, 0x7a, 0x0a, 0x10, 0xb3, 0x62, 0xc1, 0x64, 0xfb, 0x39, 0xf7, 0x1d, 0xa1, 0x86, 0x18, 0x4a,
+	0xdd, 0x48, 0x83, 0xe2, 0x4b, 0x2b, 0xc9, 0xe1, 0x55, 0x5e, 0xfc, 0xc5, 0xa1, 0xea, 0x4e, 0x6a,
+	0x28, 0x7f, 0x96, 0x90, 0x9d, 0x48, 0x13, 0xca, 0xda, 0x36, 0xcb, 0xbb, 0xe9, 0xb1, 0x4d, 0xd3,
+	0x6a, 0xff, 0xc7, 0xaf, 0x9e, 0xad, 0x29, 0x87, 0x1f, 0x7c, 0xfe, 0x62, 0x41, 0xf9, 0xe2, 0xc5,
+	0x82, 0xf2, 0xcf, 0x17, 0x0b, 0xca, 0xa7, 0x2f, 0x17, 0x7a, 0xbe, 0x78, 0xb9, 0xd0, 0xf3, 0xe5,
+	0xcb, 0x85, 0x9e, 0x1f, 0x6c, 0x58, 0xb6, 0x7f, 0x72, 0x76, 0xbc, 0x65, 0x38, 0xf5, 0x22, 0x55,
+	0xff, 0xd8, 0xc6, 0x45, 0xec, 0x1c, 0xd7, 0xd0, 0x26, 0x39, 0xe9, 0x5e, 0xd2, 0xdf, 0x65, 0x07,
+	0x77, 0xe5, 0xde, 0xf1, 0x00, 0xf9, 0x21, 0xf5, 0xde, 0xff, 0x06, 0x00, 0x73, 0xca, 0x1e, 0x45,
+	0xef, 0x2d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2140,6 +2801,9 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ...grpc.CallOption) (*MsgAcceptOwnerResponse, error)
+	AcceptAttesterManager(ctx context.Context, in *MsgAcceptAttesterManager, opts ...grpc.CallOption) (*MsgAcceptAttesterManagerResponse, error)
+	AcceptPauser(ctx context.Context, in *MsgAcceptPauser, opts ...grpc.CallOption) (*MsgAcceptPauserResponse, error)
+	AcceptTokenController(ctx context.Context, in *MsgAcceptTokenController, opts ...grpc.CallOption) (*MsgAcceptTokenControllerResponse, error)
 	AddRemoteTokenMessenger(ctx context.Context, in *MsgAddRemoteTokenMessenger, opts ...grpc.CallOption) (*MsgAddRemoteTokenMessengerResponse, error)
 	DepositForBurn(ctx context.Context, in *MsgDepositForBurn, opts ...grpc.CallOption) (*MsgDepositForBurnResponse, error)
 	DepositForBurnWithCaller(ctx context.Context, in *MsgDepositForBurnWithCaller, opts ...grpc.CallOption) (*MsgDepositForBurnWithCallerResponse, error)
@@ -2164,6 +2828,11 @@ type MsgClient interface {
 	UpdateMaxMessageBodySize(ctx context.Context, in *MsgUpdateMaxMessageBodySize, opts ...grpc.CallOption) (*MsgUpdateMaxMessageBodySizeResponse, error)
 	SetMaxBurnAmountPerMessage(ctx context.Context, in *MsgSetMaxBurnAmountPerMessage, opts ...grpc.CallOption) (*MsgSetMaxBurnAmountPerMessageResponse, error)
 	UpdateSignatureThreshold(ctx context.Context, in *MsgUpdateSignatureThreshold, opts ...grpc.CallOption) (*MsgUpdateSignatureThresholdResponse, error)
+	SetForwarding(ctx context.Context, in *MsgSetForwarding, opts ...grpc.CallOption) (*MsgSetForwardingResponse, error)
+	RemoveForwarding(ctx context.Context, in *MsgRemoveForwarding, opts ...grpc.CallOption) (*MsgRemoveForwardingResponse, error)
+	RegisterAccount32(ctx context.Context, in *MsgRegisterAccount32, opts ...grpc.CallOption) (*MsgRegisterAccount32Response, error)
+	EnableContractCallback(ctx context.Context, in *MsgEnableContractCallback, opts ...grpc.CallOption) (*MsgEnableContractCallbackResponse, error)
+	DisableContractCallback(ctx context.Context, in *MsgDisableContractCallback, opts ...grpc.CallOption) (*MsgDisableContractCallbackResponse, error)
 }
 
 type msgClient struct {
@@ -2183,9 +2852,36 @@ func (c *msgClient) AcceptOwner(ctx context.Context, in *MsgAcceptOwner, opts ..
 	return out, nil
 }
 
-func (c *msgClient) AddRemoteTokenMessenger(ctx context.Context, in *MsgAddRemoteTokenMessenger, opts ...grpc.CallOption) (*MsgAddRemoteTokenMessengerResponse, error) {
-	out := new(MsgAddRemoteTokenMessengerResponse)
-	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/AddRemoteTokenMessenger", in, out, opts...)
+func (c *msgClient) AcceptAttesterManager(ctx context.Context, in *MsgAcceptAttesterManager, opts ...grpc.CallOption) (*MsgAcceptAttesterManagerResponse, error) {
+	out := new(MsgAcceptAttesterManagerResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/AcceptAttesterManager", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptPauser(ctx context.Context, in *MsgAcceptPauser, opts ...grpc.CallOption) (*MsgAcceptPauserResponse, error) {
+	out := new(MsgAcceptPauserResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/AcceptPauser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptTokenController(ctx context.Context, in *MsgAcceptTokenController, opts ...grpc.CallOption) (*MsgAcceptTokenControllerResponse, error) {
+	out := new(MsgAcceptTokenControllerResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/AcceptTokenController", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AddRemoteTokenMessenger(ctx context.Context, in *MsgAddRemoteTokenMessenger, opts ...grpc.CallOption) (*MsgAddRemoteTokenMessengerResponse, error) {
+	out := new(MsgAddRemoteTokenMessengerResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/AddRemoteTokenMessenger", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2399,9 +3095,57 @@ func (c *msgClient) UpdateSignatureThreshold(ctx context.Context, in *MsgUpdateS
 	return out, nil
 }
 
+func (c *msgClient) SetForwarding(ctx context.Context, in *MsgSetForwarding, opts ...grpc.CallOption) (*MsgSetForwardingResponse, error) {
+	out := new(MsgSetForwardingResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/SetForwarding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveForwarding(ctx context.Context, in *MsgRemoveForwarding, opts ...grpc.CallOption) (*MsgRemoveForwardingResponse, error) {
+	out := new(MsgRemoveForwardingResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/RemoveForwarding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterAccount32(ctx context.Context, in *MsgRegisterAccount32, opts ...grpc.CallOption) (*MsgRegisterAccount32Response, error) {
+	out := new(MsgRegisterAccount32Response)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/RegisterAccount32", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnableContractCallback(ctx context.Context, in *MsgEnableContractCallback, opts ...grpc.CallOption) (*MsgEnableContractCallbackResponse, error) {
+	out := new(MsgEnableContractCallbackResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/EnableContractCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisableContractCallback(ctx context.Context, in *MsgDisableContractCallback, opts ...grpc.CallOption) (*MsgDisableContractCallbackResponse, error) {
+	out := new(MsgDisableContractCallbackResponse)
+	err := c.cc.Invoke(ctx, "/circle.cctp.v1.Msg/DisableContractCallback", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	AcceptOwner(context.Context, *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error)
+	AcceptAttesterManager(context.Context, *MsgAcceptAttesterManager) (*MsgAcceptAttesterManagerResponse, error)
+	AcceptPauser(context.Context, *MsgAcceptPauser) (*MsgAcceptPauserResponse, error)
+	AcceptTokenController(context.Context, *MsgAcceptTokenController) (*MsgAcceptTokenControllerResponse, error)
 	AddRemoteTokenMessenger(context.Context, *MsgAddRemoteTokenMessenger) (*MsgAddRemoteTokenMessengerResponse, error)
 	DepositForBurn(context.Context, *MsgDepositForBurn) (*MsgDepositForBurnResponse, error)
 	DepositForBurnWithCaller(context.Context, *MsgDepositForBurnWithCaller) (*MsgDepositForBurnWithCallerResponse, error)
@@ -2426,6 +3170,11 @@ type MsgServer interface {
 	UpdateMaxMessageBodySize(context.Context, *MsgUpdateMaxMessageBodySize) (*MsgUpdateMaxMessageBodySizeResponse, error)
 	SetMaxBurnAmountPerMessage(context.Context, *MsgSetMaxBurnAmountPerMessage) (*MsgSetMaxBurnAmountPerMessageResponse, error)
 	UpdateSignatureThreshold(context.Context, *MsgUpdateSignatureThreshold) (*MsgUpdateSignatureThresholdResponse, error)
+	SetForwarding(context.Context, *MsgSetForwarding) (*MsgSetForwardingResponse, error)
+	RemoveForwarding(context.Context, *MsgRemoveForwarding) (*MsgRemoveForwardingResponse, error)
+	RegisterAccount32(context.Context, *MsgRegisterAccount32) (*MsgRegisterAccount32Response, error)
+	EnableContractCallback(context.Context, *MsgEnableContractCallback) (*MsgEnableContractCallbackResponse, error)
+	DisableContractCallback(context.Context, *MsgDisableContractCallback) (*MsgDisableContractCallbackResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -2435,6 +3184,15 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) AcceptOwner(ctx context.Context, req *MsgAcceptOwner) (*MsgAcceptOwnerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AcceptOwner not implemented")
 }
+func (*UnimplementedMsgServer) AcceptAttesterManager(ctx context.Context, req *MsgAcceptAttesterManager) (*MsgAcceptAttesterManagerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptAttesterManager not implemented")
+}
+func (*UnimplementedMsgServer) AcceptPauser(ctx context.Context, req *MsgAcceptPauser) (*MsgAcceptPauserResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptPauser not implemented")
+}
+func (*UnimplementedMsgServer) AcceptTokenController(ctx context.Context, req *MsgAcceptTokenController) (*MsgAcceptTokenControllerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTokenController not implemented")
+}
 func (*UnimplementedMsgServer) AddRemoteTokenMessenger(ctx context.Context, req *MsgAddRemoteTokenMessenger) (*MsgAddRemoteTokenMessengerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddRemoteTokenMessenger not implemented")
 }
@@ -2507,6 +3265,21 @@ func (*UnimplementedMsgServer) SetMaxBurnAmountPerMessage(ctx context.Context, r
 func (*UnimplementedMsgServer) UpdateSignatureThreshold(ctx context.Context, req *MsgUpdateSignatureThreshold) (*MsgUpdateSignatureThresholdResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateSignatureThreshold not implemented")
 }
+func (*UnimplementedMsgServer) SetForwarding(ctx context.Context, req *MsgSetForwarding) (*MsgSetForwardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetForwarding not implemented")
+}
+func (*UnimplementedMsgServer) RemoveForwarding(ctx context.Context, req *MsgRemoveForwarding) (*MsgRemoveForwardingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveForwarding not implemented")
+}
+func (*UnimplementedMsgServer) RegisterAccount32(ctx context.Context, req *MsgRegisterAccount32) (*MsgRegisterAccount32Response, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAccount32 not implemented")
+}
+func (*UnimplementedMsgServer) EnableContractCallback(ctx context.Context, req *MsgEnableContractCallback) (*MsgEnableContractCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableContractCallback not implemented")
+}
+func (*UnimplementedMsgServer) DisableContractCallback(ctx context.Context, req *MsgDisableContractCallback) (*MsgDisableContractCallbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableContractCallback not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -2530,6 +3303,60 @@ func _Msg_AcceptOwner_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_AcceptAttesterManager_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptAttesterManager)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptAttesterManager(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Msg/AcceptAttesterManager",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptAttesterManager(ctx, req.(*MsgAcceptAttesterManager))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptPauser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptPauser)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptPauser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Msg/AcceptPauser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptPauser(ctx, req.(*MsgAcceptPauser))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptTokenController_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptTokenController)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptTokenController(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Msg/AcceptTokenController",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptTokenController(ctx, req.(*MsgAcceptTokenController))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_AddRemoteTokenMessenger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgAddRemoteTokenMessenger)
 	if err := dec(in); err != nil {
@@ -2962,6 +3789,96 @@ func _Msg_UpdateSignatureThreshold_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetForwarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetForwarding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetForwarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Msg/SetForwarding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetForwarding(ctx, req.(*MsgSetForwarding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RemoveForwarding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveForwarding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveForwarding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Msg/RemoveForwarding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveForwarding(ctx, req.(*MsgRemoveForwarding))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterAccount32_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterAccount32)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterAccount32(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Msg/RegisterAccount32",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterAccount32(ctx, req.(*MsgRegisterAccount32))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnableContractCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnableContractCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnableContractCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Msg/EnableContractCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnableContractCallback(ctx, req.(*MsgEnableContractCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisableContractCallback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisableContractCallback)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisableContractCallback(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/circle.cctp.v1.Msg/DisableContractCallback",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisableContractCallback(ctx, req.(*MsgDisableContractCallback))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "circle.cctp.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -2970,6 +3887,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AcceptOwner",
 			Handler:    _Msg_AcceptOwner_Handler,
 		},
+		{
+			MethodName: "AcceptAttesterManager",
+			Handler:    _Msg_AcceptAttesterManager_Handler,
+		},
+		{
+			MethodName: "AcceptPauser",
+			Handler:    _Msg_AcceptPauser_Handler,
+		},
+		{
+			MethodName: "AcceptTokenController",
+			Handler:    _Msg_AcceptTokenController_Handler,
+		},
 		{
 			MethodName: "AddRemoteTokenMessenger",
 			Handler:    _Msg_AddRemoteTokenMessenger_Handler,
@@ -3066,6 +3995,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateSignatureThreshold",
 			Handler:    _Msg_UpdateSignatureThreshold_Handler,
 		},
+		{
+			MethodName: "SetForwarding",
+			Handler:    _Msg_SetForwarding_Handler,
+		},
+		{
+			MethodName: "RemoveForwarding",
+			Handler:    _Msg_RemoveForwarding_Handler,
+		},
+		{
+			MethodName: "RegisterAccount32",
+			Handler:    _Msg_RegisterAccount32_Handler,
+		},
+		{
+			MethodName: "EnableContractCallback",
+			Handler:    _Msg_EnableContractCallback_Handler,
+		},
+		{
+			MethodName: "DisableContractCallback",
+			Handler:    _Msg_DisableContractCallback_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "circle/cctp/v1/tx.proto",
@@ -3091,6 +4040,11 @@ func (m *MsgUpdateOwner) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.NewOwner) > 0 {
 		i -= len(m.NewOwner)
 		copy(dAtA[i:], m.NewOwner)
@@ -3151,6 +4105,11 @@ func (m *MsgUpdateAttesterManager) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.NewAttesterManager) > 0 {
 		i -= len(m.NewAttesterManager)
 		copy(dAtA[i:], m.NewAttesterManager)
@@ -3211,6 +4170,11 @@ func (m *MsgUpdateTokenController) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.NewTokenController) > 0 {
 		i -= len(m.NewTokenController)
 		copy(dAtA[i:], m.NewTokenController)
@@ -3271,6 +4235,11 @@ func (m *MsgUpdatePauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiresAtHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresAtHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.NewPauser) > 0 {
 		i -= len(m.NewPauser)
 		copy(dAtA[i:], m.NewPauser)
@@ -3364,7 +4333,7 @@ func (m *MsgAcceptOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEnableAttester) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptAttesterManager) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3374,23 +4343,16 @@ func (m *MsgEnableAttester) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEnableAttester) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptAttesterManager) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEnableAttester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptAttesterManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Attester) > 0 {
-		i -= len(m.Attester)
-		copy(dAtA[i:], m.Attester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Attester)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
@@ -3401,7 +4363,7 @@ func (m *MsgEnableAttester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgEnableAttesterResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptAttesterManagerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3411,12 +4373,12 @@ func (m *MsgEnableAttesterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgEnableAttesterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptAttesterManagerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEnableAttesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptAttesterManagerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3424,7 +4386,7 @@ func (m *MsgEnableAttesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDisableAttester) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptPauser) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3434,23 +4396,16 @@ func (m *MsgDisableAttester) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDisableAttester) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptPauser) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDisableAttester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptPauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Attester) > 0 {
-		i -= len(m.Attester)
-		copy(dAtA[i:], m.Attester)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Attester)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
@@ -3461,7 +4416,7 @@ func (m *MsgDisableAttester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDisableAttesterResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptPauserResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3471,12 +4426,12 @@ func (m *MsgDisableAttesterResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDisableAttesterResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptPauserResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDisableAttesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptPauserResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3484,7 +4439,7 @@ func (m *MsgDisableAttesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPauseBurningAndMinting) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptTokenController) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3494,12 +4449,12 @@ func (m *MsgPauseBurningAndMinting) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPauseBurningAndMinting) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptTokenController) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPauseBurningAndMinting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptTokenController) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3514,7 +4469,7 @@ func (m *MsgPauseBurningAndMinting) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPauseBurningAndMintingResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAcceptTokenControllerResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3524,12 +4479,12 @@ func (m *MsgPauseBurningAndMintingResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgPauseBurningAndMintingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAcceptTokenControllerResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPauseBurningAndMintingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAcceptTokenControllerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3537,7 +4492,7 @@ func (m *MsgPauseBurningAndMintingResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnpauseBurningAndMinting) Marshal() (dAtA []byte, err error) {
+func (m *MsgEnableAttester) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3547,16 +4502,35 @@ func (m *MsgUnpauseBurningAndMinting) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnpauseBurningAndMinting) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEnableAttester) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnpauseBurningAndMinting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEnableAttester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Operator) > 0 {
+		i -= len(m.Operator)
+		copy(dAtA[i:], m.Operator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Operator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.From) > 0 {
 		i -= len(m.From)
 		copy(dAtA[i:], m.From)
@@ -3567,7 +4541,7 @@ func (m *MsgUnpauseBurningAndMinting) MarshalToSizedBuffer(dAtA []byte) (int, er
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUnpauseBurningAndMintingResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgEnableAttesterResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3577,12 +4551,12 @@ func (m *MsgUnpauseBurningAndMintingResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgUnpauseBurningAndMintingResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEnableAttesterResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUnpauseBurningAndMintingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEnableAttesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3590,7 +4564,7 @@ func (m *MsgUnpauseBurningAndMintingResponse) MarshalToSizedBuffer(dAtA []byte)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgPauseSendingAndReceivingMessages) Marshal() (dAtA []byte, err error) {
+func (m *MsgDisableAttester) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3600,18 +4574,191 @@ func (m *MsgPauseSendingAndReceivingMessages) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgPauseSendingAndReceivingMessages) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDisableAttester) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgPauseSendingAndReceivingMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDisableAttester) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.From) > 0 {
-		i -= len(m.From)
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisableAttesterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableAttesterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableAttesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseBurningAndMinting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseBurningAndMinting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseBurningAndMinting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseBurningAndMintingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseBurningAndMintingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseBurningAndMintingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseBurningAndMinting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseBurningAndMinting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseBurningAndMinting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnpauseBurningAndMintingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnpauseBurningAndMintingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnpauseBurningAndMintingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseSendingAndReceivingMessages) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseSendingAndReceivingMessages) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseSendingAndReceivingMessages) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
 		copy(dAtA[i:], m.From)
 		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
 		i--
@@ -4025,6 +5172,11 @@ func (m *MsgReplaceDepositForBurn) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.NewMintRecipient) > 0 {
 		i -= len(m.NewMintRecipient)
 		copy(dAtA[i:], m.NewMintRecipient)
@@ -4106,6 +5258,21 @@ func (m *MsgReceiveMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllowAlreadyReceived {
+		i--
+		if m.AllowAlreadyReceived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Attestation) > 0 {
 		i -= len(m.Attestation)
 		copy(dAtA[i:], m.Attestation)
@@ -4150,6 +5317,16 @@ func (m *MsgReceiveMessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	_ = i
 	var l int
 	_ = l
+	if m.AlreadyReceived {
+		i--
+		if m.AlreadyReceived {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Success {
 		i--
 		if m.Success {
@@ -4344,6 +5521,11 @@ func (m *MsgReplaceMessage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.KeyType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.KeyType))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.NewDestinationCaller) > 0 {
 		i -= len(m.NewDestinationCaller)
 		copy(dAtA[i:], m.NewDestinationCaller)
@@ -4730,144 +5912,312 @@ func (m *MsgRemoveRemoteTokenMessengerResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateOwner) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetForwarding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgSetForwarding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateAttesterManager) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetForwarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.Fee.Size()
+		i -= size
+		if _, err := m.Fee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	l = len(m.NewAttesterManager)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DestinationCaller) > 0 {
+		i -= len(m.DestinationCaller)
+		copy(dAtA[i:], m.DestinationCaller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DestinationCaller)))
+		i--
+		dAtA[i] = 0x22
 	}
-	return n
+	if len(m.MintRecipient) > 0 {
+		i -= len(m.MintRecipient)
+		copy(dAtA[i:], m.MintRecipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MintRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.DestinationDomain != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DestinationDomain))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateAttesterManagerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetForwardingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetForwardingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetForwardingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTokenController) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveForwarding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveForwarding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveForwarding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.NewTokenController)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveForwardingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateTokenControllerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveForwardingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveForwardingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterAccount32) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccount32) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccount32) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePauser) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRegisterAccount32Response) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterAccount32Response) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterAccount32Response) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnableContractCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.NewPauser)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableContractCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableContractCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdatePauserResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEnableContractCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnableContractCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnableContractCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAcceptOwner) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDisableContractCallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableContractCallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableContractCallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAcceptOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDisableContractCallbackResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisableContractCallbackResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisableContractCallbackResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEnableAttester) Size() (n int) {
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateOwner) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4877,14 +6227,17 @@ func (m *MsgEnableAttester) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Attester)
+	l = len(m.NewOwner)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
 	return n
 }
 
-func (m *MsgEnableAttesterResponse) Size() (n int) {
+func (m *MsgUpdateOwnerResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4893,7 +6246,7 @@ func (m *MsgEnableAttesterResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgDisableAttester) Size() (n int) {
+func (m *MsgUpdateAttesterManager) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -4903,14 +6256,226 @@ func (m *MsgDisableAttester) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = len(m.Attester)
+	l = len(m.NewAttesterManager)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
 	return n
 }
 
-func (m *MsgDisableAttesterResponse) Size() (n int) {
+func (m *MsgUpdateAttesterManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateTokenController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewTokenController)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *MsgUpdateTokenControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePauser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewPauser)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresAtHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresAtHeight))
+	}
+	return n
+}
+
+func (m *MsgUpdatePauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptOwner) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptAttesterManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptAttesterManagerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptPauser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptPauserResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptTokenController) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptTokenControllerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnableAttester) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	l = len(m.Operator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableAttesterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableAttester) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableAttesterResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -5166,6 +6731,9 @@ func (m *MsgReplaceDepositForBurn) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
 	return n
 }
 
@@ -5196,6 +6764,12 @@ func (m *MsgReceiveMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
+	if m.AllowAlreadyReceived {
+		n += 2
+	}
 	return n
 }
 
@@ -5208,6 +6782,9 @@ func (m *MsgReceiveMessageResponse) Size() (n int) {
 	if m.Success {
 		n += 2
 	}
+	if m.AlreadyReceived {
+		n += 2
+	}
 	return n
 }
 
@@ -5313,6 +6890,9 @@ func (m *MsgReplaceMessage) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
+	if m.KeyType != 0 {
+		n += 1 + sovTx(uint64(m.KeyType))
+	}
 	return n
 }
 
@@ -5433,50 +7013,1648 @@ func (m *MsgAddRemoteTokenMessenger) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *MsgAddRemoteTokenMessengerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRemoteTokenMessenger) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DomainId != 0 {
+		n += 1 + sovTx(uint64(m.DomainId))
+	}
+	return n
+}
+
+func (m *MsgRemoveRemoteTokenMessengerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetForwarding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DestinationDomain != 0 {
+		n += 1 + sovTx(uint64(m.DestinationDomain))
+	}
+	l = len(m.MintRecipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DestinationCaller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetForwardingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveForwarding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveForwardingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterAccount32) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterAccount32Response) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEnableContractCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnableContractCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisableContractCallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisableContractCallbackResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttesterManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttesterManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttesterManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewAttesterManager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewAttesterManager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAttesterManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAttesterManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAttesterManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTokenController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTokenController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTokenController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewTokenController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateTokenControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateTokenControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateTokenControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePauser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePauser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePauser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewPauser = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAtHeight", wireType)
+			}
+			m.ExpiresAtHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAtHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdatePauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdatePauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdatePauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptOwner: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAttesterManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAttesterManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAttesterManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptAttesterManagerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptAttesterManagerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptAttesterManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptPauser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptPauser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptPauser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptPauserResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptPauserResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptPauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptTokenController) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptTokenController: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptTokenController: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptTokenControllerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptTokenControllerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptTokenControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnableAttester) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableAttester: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableAttester: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgAddRemoteTokenMessengerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveRemoteTokenMessenger) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.DomainId != 0 {
-		n += 1 + sovTx(uint64(m.DomainId))
+func (m *MsgEnableAttesterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnableAttesterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnableAttesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveRemoteTokenMessengerResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableAttester) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5499,10 +8677,10 @@ func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableAttester: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableAttester: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5539,7 +8717,7 @@ func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5567,7 +8745,39 @@ func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5590,7 +8800,7 @@ func (m *MsgUpdateOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableAttesterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5613,10 +8823,10 @@ func (m *MsgUpdateOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableAttesterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableAttesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5640,7 +8850,7 @@ func (m *MsgUpdateOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttesterManager) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseBurningAndMinting) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5663,10 +8873,10 @@ func (m *MsgUpdateAttesterManager) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttesterManager: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseBurningAndMinting: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttesterManager: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseBurningAndMinting: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5701,9 +8911,109 @@ func (m *MsgUpdateAttesterManager) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPauseBurningAndMintingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPauseBurningAndMintingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPauseBurningAndMintingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnpauseBurningAndMinting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnpauseBurningAndMinting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnpauseBurningAndMinting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewAttesterManager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5731,7 +9041,7 @@ func (m *MsgUpdateAttesterManager) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewAttesterManager = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5754,7 +9064,7 @@ func (m *MsgUpdateAttesterManager) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateAttesterManagerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseBurningAndMintingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5777,10 +9087,10 @@ func (m *MsgUpdateAttesterManagerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateAttesterManagerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseBurningAndMintingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateAttesterManagerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseBurningAndMintingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5804,7 +9114,7 @@ func (m *MsgUpdateAttesterManagerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTokenController) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseSendingAndReceivingMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5827,10 +9137,10 @@ func (m *MsgUpdateTokenController) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTokenController: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseSendingAndReceivingMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTokenController: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseSendingAndReceivingMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5865,38 +9175,6 @@ func (m *MsgUpdateTokenController) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenController", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewTokenController = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5918,7 +9196,7 @@ func (m *MsgUpdateTokenController) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateTokenControllerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseSendingAndReceivingMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5941,10 +9219,10 @@ func (m *MsgUpdateTokenControllerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateTokenControllerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseSendingAndReceivingMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateTokenControllerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseSendingAndReceivingMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5968,7 +9246,7 @@ func (m *MsgUpdateTokenControllerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePauser) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseSendingAndReceivingMessages) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5991,10 +9269,10 @@ func (m *MsgUpdatePauser) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePauser: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseSendingAndReceivingMessages: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePauser: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseSendingAndReceivingMessages: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6029,38 +9307,6 @@ func (m *MsgUpdatePauser) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewPauser", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewPauser = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6082,7 +9328,7 @@ func (m *MsgUpdatePauser) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdatePauserResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnpauseSendingAndReceivingMessagesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6105,10 +9351,10 @@ func (m *MsgUpdatePauserResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdatePauserResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnpauseSendingAndReceivingMessagesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdatePauserResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnpauseSendingAndReceivingMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6132,7 +9378,7 @@ func (m *MsgUpdatePauserResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMaxMessageBodySize) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6155,10 +9401,10 @@ func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptOwner: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMaxMessageBodySize: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptOwner: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMaxMessageBodySize: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6193,6 +9439,25 @@ func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageSize", wireType)
+			}
+			m.MessageSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MessageSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6214,7 +9479,7 @@ func (m *MsgAcceptOwner) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6237,10 +9502,10 @@ func (m *MsgAcceptOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateMaxMessageBodySizeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateMaxMessageBodySizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6264,7 +9529,7 @@ func (m *MsgAcceptOwnerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableAttester) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxBurnAmountPerMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6287,10 +9552,10 @@ func (m *MsgEnableAttester) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableAttester: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxBurnAmountPerMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableAttester: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxBurnAmountPerMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6327,7 +9592,7 @@ func (m *MsgEnableAttester) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6355,7 +9620,41 @@ func (m *MsgEnableAttester) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attester = string(dAtA[iNdEx:postIndex])
+			m.LocalToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6378,7 +9677,7 @@ func (m *MsgEnableAttester) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEnableAttesterResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetMaxBurnAmountPerMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6401,10 +9700,10 @@ func (m *MsgEnableAttesterResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEnableAttesterResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetMaxBurnAmountPerMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEnableAttesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetMaxBurnAmountPerMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6428,7 +9727,7 @@ func (m *MsgEnableAttesterResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDisableAttester) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositForBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6451,10 +9750,10 @@ func (m *MsgDisableAttester) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableAttester: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositForBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableAttester: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6491,7 +9790,7 @@ func (m *MsgDisableAttester) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6519,111 +9818,66 @@ func (m *MsgDisableAttester) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Attester = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgDisableAttesterResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDisableAttesterResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDisableAttesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgPauseBurningAndMinting) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseBurningAndMinting: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseBurningAndMinting: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6651,7 +9905,7 @@ func (m *MsgPauseBurningAndMinting) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.BurnToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6674,7 +9928,7 @@ func (m *MsgPauseBurningAndMinting) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseBurningAndMintingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositForBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6697,12 +9951,31 @@ func (m *MsgPauseBurningAndMintingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseBurningAndMintingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositForBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseBurningAndMintingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositForBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6724,7 +9997,7 @@ func (m *MsgPauseBurningAndMintingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnpauseBurningAndMinting) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositForBurnWithCaller) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6747,10 +10020,10 @@ func (m *MsgUnpauseBurningAndMinting) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseBurningAndMinting: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositForBurnWithCaller: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseBurningAndMinting: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositForBurnWithCaller: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6785,109 +10058,96 @@ func (m *MsgUnpauseBurningAndMinting) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUnpauseBurningAndMintingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseBurningAndMintingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseBurningAndMintingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			m.DestinationDomain = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgPauseSendingAndReceivingMessages) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseSendingAndReceivingMessages: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseSendingAndReceivingMessages: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnToken", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6915,7 +10175,41 @@ func (m *MsgPauseSendingAndReceivingMessages) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.BurnToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6938,7 +10232,7 @@ func (m *MsgPauseSendingAndReceivingMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPauseSendingAndReceivingMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositForBurnWithCallerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6961,12 +10255,31 @@ func (m *MsgPauseSendingAndReceivingMessagesResponse) Unmarshal(dAtA []byte) err
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPauseSendingAndReceivingMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositForBurnWithCallerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPauseSendingAndReceivingMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositForBurnWithCallerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6988,7 +10301,7 @@ func (m *MsgPauseSendingAndReceivingMessagesResponse) Unmarshal(dAtA []byte) err
 	}
 	return nil
 }
-func (m *MsgUnpauseSendingAndReceivingMessages) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7011,10 +10324,10 @@ func (m *MsgUnpauseSendingAndReceivingMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseSendingAndReceivingMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceDepositForBurn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseSendingAndReceivingMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceDepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7049,111 +10362,113 @@ func (m *MsgUnpauseSendingAndReceivingMessages) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalMessage", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUnpauseSendingAndReceivingMessagesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.OriginalMessage = append(m.OriginalMessage[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalMessage == nil {
+				m.OriginalMessage = []byte{}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttestation", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnpauseSendingAndReceivingMessagesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnpauseSendingAndReceivingMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateMaxMessageBodySize) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+			m.OriginalAttestation = append(m.OriginalAttestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalAttestation == nil {
+				m.OriginalAttestation = []byte{}
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDestinationCaller", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.NewDestinationCaller = append(m.NewDestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewDestinationCaller == nil {
+				m.NewDestinationCaller = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMaxMessageBodySize: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMaxMessageBodySize: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMintRecipient", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7163,29 +10478,31 @@ func (m *MsgUpdateMaxMessageBodySize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.NewMintRecipient = append(m.NewMintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewMintRecipient == nil {
+				m.NewMintRecipient = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageSize", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
 			}
-			m.MessageSize = 0
+			m.KeyType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7195,7 +10512,7 @@ func (m *MsgUpdateMaxMessageBodySize) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MessageSize |= uint64(b&0x7F) << shift
+				m.KeyType |= KeyType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -7221,7 +10538,7 @@ func (m *MsgUpdateMaxMessageBodySize) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceDepositForBurnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7244,10 +10561,10 @@ func (m *MsgUpdateMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateMaxMessageBodySizeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceDepositForBurnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateMaxMessageBodySizeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceDepositForBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7271,7 +10588,7 @@ func (m *MsgUpdateMaxMessageBodySizeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMaxBurnAmountPerMessage) Unmarshal(dAtA []byte) error {
+func (m *MsgReceiveMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7294,10 +10611,10 @@ func (m *MsgSetMaxBurnAmountPerMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMaxBurnAmountPerMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReceiveMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMaxBurnAmountPerMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReceiveMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7334,9 +10651,9 @@ func (m *MsgSetMaxBurnAmountPerMessage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7346,29 +10663,31 @@ func (m *MsgSetMaxBurnAmountPerMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalToken = string(dAtA[iNdEx:postIndex])
+			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
+			if m.Message == nil {
+				m.Message = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7378,26 +10697,65 @@ func (m *MsgSetMaxBurnAmountPerMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
+			if m.Attestation == nil {
+				m.Attestation = []byte{}
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowAlreadyReceived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllowAlreadyReceived = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7419,7 +10777,7 @@ func (m *MsgSetMaxBurnAmountPerMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetMaxBurnAmountPerMessageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReceiveMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7442,12 +10800,52 @@ func (m *MsgSetMaxBurnAmountPerMessageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetMaxBurnAmountPerMessageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReceiveMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetMaxBurnAmountPerMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReceiveMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlreadyReceived", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AlreadyReceived = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7469,7 +10867,7 @@ func (m *MsgSetMaxBurnAmountPerMessageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositForBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgSendMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7492,10 +10890,10 @@ func (m *MsgDepositForBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositForBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7531,40 +10929,6 @@ func (m *MsgDepositForBurn) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
@@ -7583,9 +10947,9 @@ func (m *MsgDepositForBurn) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7612,16 +10976,16 @@ func (m *MsgDepositForBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.MintRecipient == nil {
-				m.MintRecipient = []byte{}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBody", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7631,23 +10995,25 @@ func (m *MsgDepositForBurn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnToken = string(dAtA[iNdEx:postIndex])
+			m.MessageBody = append(m.MessageBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageBody == nil {
+				m.MessageBody = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7670,7 +11036,7 @@ func (m *MsgDepositForBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositForBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7693,10 +11059,10 @@ func (m *MsgDepositForBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositForBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositForBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7739,7 +11105,7 @@ func (m *MsgDepositForBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositForBurnWithCaller) Unmarshal(dAtA []byte) error {
+func (m *MsgSendMessageWithCaller) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7754,55 +11120,23 @@ func (m *MsgDepositForBurnWithCaller) Unmarshal(dAtA []byte) error {
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositForBurnWithCaller: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositForBurnWithCaller: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendMessageWithCaller: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendMessageWithCaller: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7830,11 +11164,9 @@ func (m *MsgDepositForBurnWithCaller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
@@ -7853,9 +11185,9 @@ func (m *MsgDepositForBurnWithCaller) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -7882,16 +11214,16 @@ func (m *MsgDepositForBurnWithCaller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.MintRecipient == nil {
-				m.MintRecipient = []byte{}
+			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.Recipient == nil {
+				m.Recipient = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BurnToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageBody", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7901,25 +11233,27 @@ func (m *MsgDepositForBurnWithCaller) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BurnToken = string(dAtA[iNdEx:postIndex])
+			m.MessageBody = append(m.MessageBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.MessageBody == nil {
+				m.MessageBody = []byte{}
+			}
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
 			}
@@ -7974,7 +11308,7 @@ func (m *MsgDepositForBurnWithCaller) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositForBurnWithCallerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSendMessageWithCallerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7997,10 +11331,10 @@ func (m *MsgDepositForBurnWithCallerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositForBurnWithCallerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSendMessageWithCallerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositForBurnWithCallerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSendMessageWithCallerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8043,7 +11377,7 @@ func (m *MsgDepositForBurnWithCallerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceMessage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8066,10 +11400,10 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceDepositForBurn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceMessage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceDepositForBurn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceMessage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8174,7 +11508,7 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewDestinationCaller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewMessageBody", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8201,14 +11535,14 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewDestinationCaller = append(m.NewDestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewDestinationCaller == nil {
-				m.NewDestinationCaller = []byte{}
+			m.NewMessageBody = append(m.NewMessageBody[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewMessageBody == nil {
+				m.NewMessageBody = []byte{}
 			}
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMintRecipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDestinationCaller", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8235,11 +11569,30 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewMintRecipient = append(m.NewMintRecipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewMintRecipient == nil {
-				m.NewMintRecipient = []byte{}
+			m.NewDestinationCaller = append(m.NewDestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.NewDestinationCaller == nil {
+				m.NewDestinationCaller = []byte{}
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			m.KeyType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyType |= KeyType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8261,7 +11614,7 @@ func (m *MsgReplaceDepositForBurn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceDepositForBurnResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReplaceMessageResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8284,10 +11637,10 @@ func (m *MsgReplaceDepositForBurnResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceDepositForBurnResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReplaceMessageResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceDepositForBurnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReplaceMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8311,7 +11664,7 @@ func (m *MsgReplaceDepositForBurnResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReceiveMessage) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSignatureThreshold) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8334,10 +11687,10 @@ func (m *MsgReceiveMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReceiveMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSignatureThreshold: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReceiveMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSignatureThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8373,44 +11726,10 @@ func (m *MsgReceiveMessage) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = append(m.Message[:0], dAtA[iNdEx:postIndex]...)
-			if m.Message == nil {
-				m.Message = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			m.Amount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8420,26 +11739,11 @@ func (m *MsgReceiveMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Amount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Attestation = append(m.Attestation[:0], dAtA[iNdEx:postIndex]...)
-			if m.Attestation == nil {
-				m.Attestation = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8461,7 +11765,7 @@ func (m *MsgReceiveMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReceiveMessageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8484,32 +11788,12 @@ func (m *MsgReceiveMessageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReceiveMessageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSignatureThresholdResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReceiveMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSignatureThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.Success = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8531,7 +11815,7 @@ func (m *MsgReceiveMessageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendMessage) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8554,10 +11838,10 @@ func (m *MsgSendMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgLinkTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8594,9 +11878,9 @@ func (m *MsgSendMessage) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
 			}
-			m.DestinationDomain = 0
+			m.RemoteDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8606,14 +11890,14 @@ func (m *MsgSendMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
+				m.RemoteDomain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8640,16 +11924,16 @@ func (m *MsgSendMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipient == nil {
-				m.Recipient = []byte{}
+			m.RemoteToken = append(m.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.RemoteToken == nil {
+				m.RemoteToken = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageBody", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8659,25 +11943,23 @@ func (m *MsgSendMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MessageBody = append(m.MessageBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageBody == nil {
-				m.MessageBody = []byte{}
-			}
+			m.LocalToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8700,7 +11982,7 @@ func (m *MsgSendMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendMessageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgLinkTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8723,31 +12005,12 @@ func (m *MsgSendMessageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendMessageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgLinkTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
+			return fmt.Errorf("proto: MsgLinkTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -8769,7 +12032,7 @@ func (m *MsgSendMessageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendMessageWithCaller) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlinkTokenPair) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8792,10 +12055,10 @@ func (m *MsgSendMessageWithCaller) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendMessageWithCaller: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlinkTokenPair: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendMessageWithCaller: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlinkTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8832,9 +12095,9 @@ func (m *MsgSendMessageWithCaller) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
 			}
-			m.DestinationDomain = 0
+			m.RemoteDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8844,14 +12107,14 @@ func (m *MsgSendMessageWithCaller) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.DestinationDomain |= uint32(b&0x7F) << shift
+				m.RemoteDomain |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -8878,50 +12141,16 @@ func (m *MsgSendMessageWithCaller) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Recipient = append(m.Recipient[:0], dAtA[iNdEx:postIndex]...)
-			if m.Recipient == nil {
-				m.Recipient = []byte{}
+			m.RemoteToken = append(m.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
+			if m.RemoteToken == nil {
+				m.RemoteToken = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MessageBody", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.MessageBody = append(m.MessageBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.MessageBody == nil {
-				m.MessageBody = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -8931,25 +12160,23 @@ func (m *MsgSendMessageWithCaller) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-			if m.DestinationCaller == nil {
-				m.DestinationCaller = []byte{}
-			}
+			m.LocalToken = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8972,7 +12199,7 @@ func (m *MsgSendMessageWithCaller) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendMessageWithCallerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnlinkTokenPairResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8995,31 +12222,12 @@ func (m *MsgSendMessageWithCallerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendMessageWithCallerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnlinkTokenPairResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendMessageWithCallerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnlinkTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
-			}
-			m.Nonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -9041,7 +12249,7 @@ func (m *MsgSendMessageWithCallerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceMessage) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9064,10 +12272,10 @@ func (m *MsgReplaceMessage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceMessage: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRemoteTokenMessenger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceMessage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRemoteTokenMessenger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9103,10 +12311,10 @@ func (m *MsgReplaceMessage) Unmarshal(dAtA []byte) error {
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalMessage", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			var byteLen int
+			m.DomainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9116,97 +12324,14 @@ func (m *MsgReplaceMessage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.DomainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalMessage = append(m.OriginalMessage[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalMessage == nil {
-				m.OriginalMessage = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OriginalAttestation", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OriginalAttestation = append(m.OriginalAttestation[:0], dAtA[iNdEx:postIndex]...)
-			if m.OriginalAttestation == nil {
-				m.OriginalAttestation = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewMessageBody", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewMessageBody = append(m.NewMessageBody[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewMessageBody == nil {
-				m.NewMessageBody = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewDestinationCaller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9233,9 +12358,9 @@ func (m *MsgReplaceMessage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewDestinationCaller = append(m.NewDestinationCaller[:0], dAtA[iNdEx:postIndex]...)
-			if m.NewDestinationCaller == nil {
-				m.NewDestinationCaller = []byte{}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -9259,7 +12384,7 @@ func (m *MsgReplaceMessage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgReplaceMessageResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAddRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9282,10 +12407,10 @@ func (m *MsgReplaceMessageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgReplaceMessageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAddRemoteTokenMessengerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgReplaceMessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAddRemoteTokenMessengerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9309,7 +12434,7 @@ func (m *MsgReplaceMessageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSignatureThreshold) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9332,10 +12457,10 @@ func (m *MsgUpdateSignatureThreshold) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSignatureThreshold: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRemoteTokenMessenger: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSignatureThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRemoteTokenMessenger: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9372,9 +12497,9 @@ func (m *MsgUpdateSignatureThreshold) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
 			}
-			m.Amount = 0
+			m.DomainId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9384,7 +12509,7 @@ func (m *MsgUpdateSignatureThreshold) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Amount |= uint32(b&0x7F) << shift
+				m.DomainId |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9410,7 +12535,7 @@ func (m *MsgUpdateSignatureThreshold) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9433,10 +12558,10 @@ func (m *MsgUpdateSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateSignatureThresholdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRemoteTokenMessengerResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateSignatureThresholdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRemoteTokenMessengerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9460,7 +12585,7 @@ func (m *MsgUpdateSignatureThresholdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLinkTokenPair) Unmarshal(dAtA []byte) error {
+func (m *MsgSetForwarding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9483,10 +12608,10 @@ func (m *MsgLinkTokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLinkTokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetForwarding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLinkTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetForwarding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9523,9 +12648,9 @@ func (m *MsgLinkTokenPair) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationDomain", wireType)
 			}
-			m.RemoteDomain = 0
+			m.DestinationDomain = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -9535,14 +12660,48 @@ func (m *MsgLinkTokenPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RemoteDomain |= uint32(b&0x7F) << shift
+				m.DestinationDomain |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintRecipient", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintRecipient = append(m.MintRecipient[:0], dAtA[iNdEx:postIndex]...)
+			if m.MintRecipient == nil {
+				m.MintRecipient = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DestinationCaller", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -9569,14 +12728,14 @@ func (m *MsgLinkTokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteToken = append(m.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.RemoteToken == nil {
-				m.RemoteToken = []byte{}
+			m.DestinationCaller = append(m.DestinationCaller[:0], dAtA[iNdEx:postIndex]...)
+			if m.DestinationCaller == nil {
+				m.DestinationCaller = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9604,7 +12763,9 @@ func (m *MsgLinkTokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalToken = string(dAtA[iNdEx:postIndex])
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9627,7 +12788,7 @@ func (m *MsgLinkTokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgLinkTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetForwardingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9650,10 +12811,10 @@ func (m *MsgLinkTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgLinkTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetForwardingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgLinkTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetForwardingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9677,7 +12838,7 @@ func (m *MsgLinkTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnlinkTokenPair) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveForwarding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9700,10 +12861,10 @@ func (m *MsgUnlinkTokenPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnlinkTokenPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveForwarding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnlinkTokenPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveForwarding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9738,62 +12899,109 @@ func (m *MsgUnlinkTokenPair) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteDomain", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.RemoteDomain = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RemoteDomain |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RemoteToken", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveForwardingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveForwardingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveForwardingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RemoteToken = append(m.RemoteToken[:0], dAtA[iNdEx:postIndex]...)
-			if m.RemoteToken == nil {
-				m.RemoteToken = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterAccount32) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 4:
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterAccount32: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterAccount32: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LocalToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9821,7 +13029,7 @@ func (m *MsgUnlinkTokenPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LocalToken = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9844,7 +13052,7 @@ func (m *MsgUnlinkTokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnlinkTokenPairResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterAccount32Response) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9867,10 +13075,10 @@ func (m *MsgUnlinkTokenPairResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnlinkTokenPairResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterAccount32Response: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnlinkTokenPairResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterAccount32Response: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -9894,7 +13102,7 @@ func (m *MsgUnlinkTokenPairResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableContractCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9917,10 +13125,10 @@ func (m *MsgAddRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRemoteTokenMessenger: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableContractCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRemoteTokenMessenger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableContractCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9955,59 +13163,6 @@ func (m *MsgAddRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			m.DomainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DomainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10029,7 +13184,7 @@ func (m *MsgAddRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEnableContractCallbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10052,10 +13207,10 @@ func (m *MsgAddRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddRemoteTokenMessengerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEnableContractCallbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddRemoteTokenMessengerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEnableContractCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -10079,7 +13234,7 @@ func (m *MsgAddRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableContractCallback) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10102,10 +13257,10 @@ func (m *MsgRemoveRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRemoteTokenMessenger: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableContractCallback: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRemoteTokenMessenger: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableContractCallback: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -10140,25 +13295,6 @@ func (m *MsgRemoveRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
 			}
 			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DomainId", wireType)
-			}
-			m.DomainId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DomainId |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -10180,7 +13316,7 @@ func (m *MsgRemoveRemoteTokenMessenger) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisableContractCallbackResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10203,10 +13339,10 @@ func (m *MsgRemoveRemoteTokenMessengerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRemoteTokenMessengerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisableContractCallbackResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRemoteTokenMessengerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisableContractCallbackResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default: